@@ -34,6 +34,9 @@ var flagApiManagerEndpoint string   // api manager endpoint of the environment t
 var flagAdminEndpoint string        // admin endpoint of the environment to be added
 var flagMiManagementEndpoint string // mi management endpoint of the environment to be added
 
+// TLS and proxy settings of the environment to be added
+var flagEnvConnection utils.ConnectionConfig
+
 // AddEnv command related Info
 const AddEnvCmdLiteral = "env [environment]"
 const AddEnvCmdLiteralTrimmed = "env"
@@ -62,10 +65,19 @@ const addEnvCmdExamples = utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnv
 --registration https://idp.com:9443 \
 --token https://gw.com:8243/token
 
+` + utils.ProjectName + ` ` + AddCmdLiteral + ` ` + AddEnvCmdLiteralTrimmed + ` prod \
+--apim https://apim.com:9443 \
+--ca-cert /path/to/ca.pem \
+--client-cert /path/to/client.p12 --client-cert-password pass \
+--proxy http://proxy.corp.com:3128 --no-proxy localhost,.corp.com
+
 You can either provide only the flag --apim , or all the other 4 flags (--registration --publisher --devportal --admin) without providing --apim flag.
 If you are omitting any of --registration --publisher --devportal --admin flags, you need to specify --apim flag with the API Manager endpoint. In both of the
 cases --token flag is optional and use it to specify the gateway token endpoint. This will be used for "apictl get-keys" operation.
-To add a micro integrator instance to an environment you can use the --mi flag.`
+To add a micro integrator instance to an environment you can use the --mi flag.
+The TLS and proxy settings given with --ca-cert, --client-cert, --client-key, --server-name, --insecure-skip-tls-verify,
+--proxy and --no-proxy flags are used when connecting to all the endpoints of the environment. A PKCS#12 client
+certificate (.p12 or .pfx) is converted to PEM files in the apictl config directory.`
 
 // addEnvCmd represents the addEnv command
 var addEnvCmd = &cobra.Command{
@@ -92,6 +104,7 @@ func executeAddEnvCmd(mainConfigFilePath string) {
 	envEndpoints.AdminEndpoint = flagAdminEndpoint
 	envEndpoints.TokenEndpoint = flagTokenEndpoint
 	envEndpoints.MiManagementEndpoint = flagMiManagementEndpoint
+	envEndpoints.Connection = flagEnvConnection
 	err := impl.AddEnv(envToBeAdded, envEndpoints, mainConfigFilePath, AddEnvCmdLiteral)
 	if err != nil {
 		utils.HandleErrorAndExit("Error adding environment", err)
@@ -110,5 +123,22 @@ func init() {
 		"Registration endpoint for the environment")
 	addEnvCmd.Flags().StringVar(&flagAdminEndpoint, "admin", "", "Admin endpoint for the environment")
 	addEnvCmd.Flags().StringVar(&flagMiManagementEndpoint, "mi", "", "Micro Integrator Management endpoint for the environment")
+
+	// TLS and proxy settings
+	addEnvCmd.Flags().StringVar(&flagEnvConnection.CACertFile, "ca-cert", "",
+		"PEM CA bundle to trust for the environment in addition to the certificates in the certs directory")
+	addEnvCmd.Flags().StringVar(&flagEnvConnection.ClientCertFile, "client-cert", "",
+		"Client certificate for mutual TLS, PEM or PKCS#12 (.p12 or .pfx)")
+	addEnvCmd.Flags().StringVar(&flagEnvConnection.ClientKeyFile, "client-key", "",
+		"PEM private key of the client certificate")
+	addEnvCmd.Flags().StringVar(&flagEnvConnection.ClientCertPassword, "client-cert-password", "",
+		"Password of the PKCS#12 client certificate")
+	addEnvCmd.Flags().StringVar(&flagEnvConnection.ServerName, "server-name", "",
+		"Server name to verify the certificate of the environment instead of the host name")
+	addEnvCmd.Flags().BoolVar(&flagEnvConnection.Insecure, "insecure-skip-tls-verify", false,
+		"Allow connections to the environment without verifying the server certificate")
+	addEnvCmd.Flags().StringVar(&flagEnvConnection.Proxy, "proxy", "", "HTTP proxy URL to connect to the environment")
+	addEnvCmd.Flags().StringVar(&flagEnvConnection.NoProxy, "no-proxy", "",
+		"Comma separated hosts and domains of the environment to connect without the proxy")
 	_ = addEnvCmd.MarkFlagRequired("environment")
 }
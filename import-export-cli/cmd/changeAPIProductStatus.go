@@ -19,6 +19,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -61,7 +62,8 @@ var ChangeAPIProductStatusCmd = &cobra.Command{
 
 // executeChangeAPIProductStatusCmd executes the change api product status command
 func executeChangeAPIProductStatusCmd(credential credentials.Credential) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, apiProductStateChangeEnvironment)
+	accessToken, preCommandErr := credentials.GetOAuthAccessToken(context.Background(), credential,
+		apiProductStateChangeEnvironment)
 	if preCommandErr == nil {
 		resp, err := impl.ChangeAPIProductStatusInEnv(accessToken, apiProductStateChangeEnvironment, apiProductStateChangeAction,
 			apiProductNameForStateChange, apiProductProviderForStateChange)
@@ -72,17 +74,22 @@ func executeChangeAPIProductStatusCmd(credential credentials.Credential) {
 		utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
 		if resp.StatusCode() == http.StatusOK {
 			// 200 OK
+			utils.SetResultData(map[string]string{
+				"name":     apiProductNameForStateChange,
+				"provider": apiProductProviderForStateChange,
+				"action":   apiProductStateChangeAction,
+			})
 			fmt.Println(apiNameForStateChange + " API Product state changed successfully!")
 		} else if resp.StatusCode() == http.StatusInternalServerError {
 			// 500 Internal Server Error
-			fmt.Println(string(resp.Body()))
+			utils.HandleErrorAndExit("Error while changing API Product Status", utils.NewResponseError(resp, ""))
 		} else {
 			// Neither 200 nor 500
-			fmt.Println("Error while changing API Product Status: ", resp.Status(), "\n", string(resp.Body()))
+			utils.HandleErrorAndExit("Error while changing API Product Status: "+resp.Status(), utils.NewResponseError(resp, ""))
 		}
 	} else {
 		// Error changing the API Product status
-		fmt.Println("Error getting OAuth tokens while changing status of the API Product : " + preCommandErr.Error())
+		utils.HandleErrorAndExit("Error getting OAuth tokens while changing status of the API Product", preCommandErr)
 	}
 }
 
@@ -19,6 +19,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"net/http"
@@ -63,10 +64,11 @@ var ChangeAPIStatusCmd = &cobra.Command{
 
 // executeChangeAPIStatusCmd executes the change api status command
 func executeChangeAPIStatusCmd(credential credentials.Credential) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, apiStateChangeEnvironment)
+	accessToken, preCommandErr := credentials.GetOAuthAccessToken(context.Background(), credential,
+		apiStateChangeEnvironment)
 	if preCommandErr == nil {
-		resp, err := impl.ChangeAPIStatusInEnv(accessToken, apiStateChangeEnvironment, apiStateChangeAction,
-			apiNameForStateChange, apiVersionForStateChange, apiProviderForStateChange)
+		resp, err := impl.ChangeAPIStatusInEnv(context.Background(), accessToken, apiStateChangeEnvironment,
+			apiStateChangeAction, apiNameForStateChange, apiVersionForStateChange, apiProviderForStateChange)
 		if err != nil {
 			utils.HandleErrorAndExit("Error while changing the API status", err)
 		}
@@ -74,17 +76,23 @@ func executeChangeAPIStatusCmd(credential credentials.Credential) {
 		utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
 		if resp.StatusCode() == http.StatusOK {
 			// 200 OK
+			utils.SetResultData(map[string]string{
+				"name":     apiNameForStateChange,
+				"version":  apiVersionForStateChange,
+				"provider": apiProviderForStateChange,
+				"action":   apiStateChangeAction,
+			})
 			fmt.Println(apiNameForStateChange + " API state changed successfully!")
 		} else if resp.StatusCode() == http.StatusInternalServerError {
 			// 500 Internal Server Error
-			fmt.Println(string(resp.Body()))
+			utils.HandleErrorAndExit("Error while changing API Status", utils.NewResponseError(resp, ""))
 		} else {
 			// Neither 200 nor 500
-			fmt.Println("Error while changing API Status: ", resp.Status(), "\n", string(resp.Body()))
+			utils.HandleErrorAndExit("Error while changing API Status: "+resp.Status(), utils.NewResponseError(resp, ""))
 		}
 	} else {
 		// Error changing the API status
-		fmt.Println("Error getting OAuth tokens while changing status of the API:" + preCommandErr.Error())
+		utils.HandleErrorAndExit("Error getting OAuth tokens while changing status of the API", preCommandErr)
 	}
 }
 
@@ -0,0 +1,53 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// config command related usage Info
+const configCmdLiteral = "config"
+const configCmdShortDesc = "View the configuration and manage contexts of " + utils.ProjectName
+const configCmdLongDesc = `View the configuration and manage the contexts defined in '` + utils.MainConfigFileName + `'. A context bundles an API Manager
+environment, a Micro Integrator environment, a Microgateway cluster, a default tenant and a default output format.
+Commands run without --environment (-e) use the environment of the current context, which can be overridden with the
+` + utils.ContextEnvVar + ` environment variable.`
+const configCmdExamples = utils.ProjectName + ` ` + configCmdLiteral + ` ` + configSetContextCmdLiteralTrimmed + ` dev --apim dev --mi dev-mi --mg-cluster dev-mg
+` + utils.ProjectName + ` ` + configCmdLiteral + ` ` + configUseContextCmdLiteralTrimmed + ` dev
+` + utils.ProjectName + ` ` + configCmdLiteral + ` ` + configGetContextsCmdLiteral + `
+` + utils.ProjectName + ` ` + configCmdLiteral + ` ` + configCurrentContextCmdLiteral + `
+` + utils.ProjectName + ` ` + configCmdLiteral + ` ` + configViewCmdLiteral + ` --resolved`
+
+// ConfigCmd represents the config command
+var ConfigCmd = &cobra.Command{
+	Use:     configCmdLiteral,
+	Short:   configCmdShortDesc,
+	Long:    configCmdLongDesc,
+	Example: configCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + configCmdLiteral + " called")
+		cmd.Help()
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(ConfigCmd)
+}
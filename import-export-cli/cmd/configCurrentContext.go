@@ -0,0 +1,58 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// "config current-context" command related usage Info
+const configCurrentContextCmdLiteral = "current-context"
+const configCurrentContextCmdShortDesc = "Display the current context"
+const configCurrentContextCmdLongDesc = `Display the name of the current context. The ` + utils.ContextEnvVar + ` environment
+variable takes precedence over the current context set in '` + utils.MainConfigFileName + `'.`
+const configCurrentContextCmdExamples = utils.ProjectName + ` ` + configCmdLiteral + ` ` + configCurrentContextCmdLiteral
+
+// configCurrentContextCmd represents the config current-context command
+var configCurrentContextCmd = &cobra.Command{
+	Use:     configCurrentContextCmdLiteral,
+	Short:   configCurrentContextCmdShortDesc,
+	Long:    configCurrentContextCmdLongDesc,
+	Example: configCurrentContextCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + configCurrentContextCmdLiteral + " called")
+		name, _, err := utils.GetCurrentContext(utils.MainConfigFilePath)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting current context", err)
+		}
+		if name == "" {
+			utils.HandleErrorAndExit("Error getting current context", errors.New("current context is not set"))
+		}
+		utils.SetResultData(map[string]string{"currentContext": name})
+		fmt.Println(name)
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configCurrentContextCmd)
+}
@@ -0,0 +1,53 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// "config delete-context" command related usage Info
+const configDeleteContextCmdLiteral = "delete-context [context]"
+const configDeleteContextCmdLiteralTrimmed = "delete-context"
+const configDeleteContextCmdShortDesc = "Delete a context"
+const configDeleteContextCmdLongDesc = `Delete a context from '` + utils.MainConfigFileName + `'. The current context is
+unset if it is the deleted one. The environments of the context are not removed.`
+const configDeleteContextCmdExamples = utils.ProjectName + ` ` + configCmdLiteral + ` ` + configDeleteContextCmdLiteralTrimmed + ` dev`
+
+// configDeleteContextCmd represents the config delete-context command
+var configDeleteContextCmd = &cobra.Command{
+	Use:     configDeleteContextCmdLiteral,
+	Short:   configDeleteContextCmdShortDesc,
+	Long:    configDeleteContextCmdLongDesc,
+	Example: configDeleteContextCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + configDeleteContextCmdLiteralTrimmed + " called")
+		if err := impl.DeleteContext(args[0], utils.MainConfigFilePath); err != nil {
+			utils.HandleErrorAndExit("Error deleting context", err)
+		}
+		utils.SetResultData(map[string]string{"name": args[0]})
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configDeleteContextCmd)
+}
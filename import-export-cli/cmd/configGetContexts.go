@@ -0,0 +1,59 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const defaultContextsTableFormat = "table {{.Current}}\t{{.Name}}\t{{.APIM}}\t{{.MI}}\t{{.MgwCluster}}\t{{.Tenant}}\t{{.Output}}"
+
+var getContextsCmdFormat string
+
+// "config get-contexts" command related usage Info
+const configGetContextsCmdLiteral = "get-contexts"
+const configGetContextsCmdShortDesc = "Display the list of contexts"
+const configGetContextsCmdLongDesc = `Display a list of contexts defined in '` + utils.MainConfigFileName + `' file. The
+current context is marked with *`
+const configGetContextsCmdExamples = utils.ProjectName + ` ` + configCmdLiteral + ` ` + configGetContextsCmdLiteral
+
+// configGetContextsCmd represents the config get-contexts command
+var configGetContextsCmd = &cobra.Command{
+	Use:     configGetContextsCmdLiteral,
+	Short:   configGetContextsCmdShortDesc,
+	Long:    configGetContextsCmdLongDesc,
+	Example: configGetContextsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + configGetContextsCmdLiteral + " called")
+		contexts := utils.GetMainConfigFromFile(utils.MainConfigFilePath).Contexts
+		current := utils.GetCurrentContextName(utils.MainConfigFilePath)
+		utils.SetResultData(map[string]interface{}{"currentContext": current, "contexts": contexts})
+		impl.PrintContexts(contexts, current, getContextsCmdFormat, defaultContextsTableFormat)
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configGetContextsCmd)
+	configGetContextsCmd.Flags().StringVarP(&getContextsCmdFormat, "format", "", defaultContextsTableFormat,
+		"Pretty-print contexts using go templates or as csv, markdown or wide")
+	formatter.AddListFlags(configGetContextsCmd.Flags())
+}
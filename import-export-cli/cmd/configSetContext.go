@@ -0,0 +1,93 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var setContextAPIMEnv string      // APIM environment of the context
+var setContextMIEnv string        // MI environment of the context
+var setContextMgwCluster string   // Microgateway cluster of the context
+var setContextTenant string       // default tenant domain of the context
+var setContextOutputFormat string // default output format of the context
+
+// "config set-context" command related usage Info
+const configSetContextCmdLiteral = "set-context [context]"
+const configSetContextCmdLiteralTrimmed = "set-context"
+const configSetContextCmdShortDesc = "Add or update a context"
+const configSetContextCmdLongDesc = `Add a context to '` + utils.MainConfigFileName + `' or update an existing one. Only the
+given flags are changed when updating a context. Pass an empty value to a flag to clear it.`
+const configSetContextCmdExamples = utils.ProjectName + ` ` + configCmdLiteral + ` ` + configSetContextCmdLiteralTrimmed + ` dev --apim dev --mi dev-mi --mg-cluster dev-mg
+` + utils.ProjectName + ` ` + configCmdLiteral + ` ` + configSetContextCmdLiteralTrimmed + ` dev --tenant wso2.com --default-output json`
+
+// configSetContextCmd represents the config set-context command
+var configSetContextCmd = &cobra.Command{
+	Use:     configSetContextCmdLiteral,
+	Short:   configSetContextCmdShortDesc,
+	Long:    configSetContextCmdLongDesc,
+	Example: configSetContextCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	// the flags are the values of the context to be set
+	Annotations: map[string]string{NoContextAnnotation: "true"},
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + configSetContextCmdLiteralTrimmed + " called")
+		name := args[0]
+		context := utils.Context{}
+		if existing, err := utils.GetContext(name, utils.MainConfigFilePath); err == nil {
+			context = *existing
+		}
+		if cmd.Flags().Changed("apim") {
+			context.APIMEnvironment = setContextAPIMEnv
+		}
+		if cmd.Flags().Changed("mi") {
+			context.MIEnvironment = setContextMIEnv
+		}
+		if cmd.Flags().Changed("mg-cluster") {
+			context.MgwCluster = setContextMgwCluster
+		}
+		if cmd.Flags().Changed("tenant") {
+			context.Tenant = setContextTenant
+		}
+		if cmd.Flags().Changed("default-output") {
+			context.Output = setContextOutputFormat
+		}
+		if err := impl.SetContext(name, context, utils.MainConfigFilePath); err != nil {
+			utils.HandleErrorAndExit("Error setting context", err)
+		}
+		utils.SetResultData(map[string]interface{}{"name": name, "context": context})
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configSetContextCmd)
+
+	configSetContextCmd.Flags().StringVar(&setContextAPIMEnv, "apim", "",
+		"API Manager environment of the context")
+	configSetContextCmd.Flags().StringVar(&setContextMIEnv, "mi", "",
+		"Micro Integrator environment of the context")
+	configSetContextCmd.Flags().StringVar(&setContextMgwCluster, "mg-cluster", "",
+		"Microgateway cluster of the context")
+	configSetContextCmd.Flags().StringVar(&setContextTenant, "tenant", "",
+		"Default tenant domain of the context")
+	configSetContextCmd.Flags().StringVar(&setContextOutputFormat, "default-output", "",
+		"Default output format (json or yaml) of the commands run with the context")
+}
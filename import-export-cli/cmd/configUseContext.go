@@ -0,0 +1,53 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// "config use-context" command related usage Info
+const configUseContextCmdLiteral = "use-context [context]"
+const configUseContextCmdLiteralTrimmed = "use-context"
+const configUseContextCmdShortDesc = "Set the current context"
+const configUseContextCmdLongDesc = `Set the current context in '` + utils.MainConfigFileName + `'. The environments of the
+current context are used by the commands run without --environment (-e).`
+const configUseContextCmdExamples = utils.ProjectName + ` ` + configCmdLiteral + ` ` + configUseContextCmdLiteralTrimmed + ` dev`
+
+// configUseContextCmd represents the config use-context command
+var configUseContextCmd = &cobra.Command{
+	Use:     configUseContextCmdLiteral,
+	Short:   configUseContextCmdShortDesc,
+	Long:    configUseContextCmdLongDesc,
+	Example: configUseContextCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + configUseContextCmdLiteralTrimmed + " called")
+		if err := impl.UseContext(args[0], utils.MainConfigFilePath); err != nil {
+			utils.HandleErrorAndExit("Error switching context", err)
+		}
+		utils.SetResultData(map[string]string{"currentContext": args[0]})
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configUseContextCmd)
+}
@@ -0,0 +1,86 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const defaultConfigValuesTableFormat = "table {{.Key}}\t{{.Value}}\t{{.Source}}"
+
+var configViewResolved bool
+var configViewCmdFormat string
+
+// "config view" command related usage Info
+const configViewCmdLiteral = "view"
+const configViewCmdShortDesc = "Display the configuration"
+const configViewCmdLongDesc = `Display the configuration stored in '` + utils.MainConfigFileName + `'. Use --resolved to display
+the configuration used by the commands instead, which is '` + utils.MainConfigFileName + `' merged with the nearest
+'` + utils.LocalConfigFileName + `' of the current directory or its parents and the ` + utils.ConfigEnvVarPrefix + `* environment variables,
+along with where each value came from.
+
+The fields of the config section are set by ` + utils.ConfigEnvVarPrefix + `<FIELD> (e.g. ` + utils.ConfigEnvVarPrefix + `HTTP_REQUEST_TIMEOUT,
+` + utils.ConfigEnvVarPrefix + `EXPORT_DIRECTORY, ` + utils.ConfigEnvVarPrefix + `TLS_RENEGOTIATION_MODE), environments by
+` + utils.EnvironmentEnvVarPrefix + `<NAME>_<FIELD> (e.g. ` + utils.EnvironmentEnvVarPrefix + `DEV_APIM, ` + utils.EnvironmentEnvVarPrefix + `DEV_CONNECTION_CA_CERT) and
+Microgateway clusters by ` + utils.MgwClusterEnvVarPrefix + `<NAME>_<FIELD> (e.g. ` + utils.MgwClusterEnvVarPrefix + `DEV_ADAPTER). The names of the
+environments and clusters are lower cased.`
+const configViewCmdExamples = utils.ProjectName + ` ` + configCmdLiteral + ` ` + configViewCmdLiteral + `
+` + utils.ProjectName + ` ` + configCmdLiteral + ` ` + configViewCmdLiteral + ` --resolved
+` + utils.ConfigEnvVarPrefix + `HTTP_REQUEST_TIMEOUT=30000 ` + utils.EnvironmentEnvVarPrefix + `DEV_APIM=https://localhost:9443 ` + utils.ProjectName + ` ` + configCmdLiteral + ` ` + configViewCmdLiteral + ` --resolved`
+
+// configViewCmd represents the config view command
+var configViewCmd = &cobra.Command{
+	Use:     configViewCmdLiteral,
+	Short:   configViewCmdShortDesc,
+	Long:    configViewCmdLongDesc,
+	Example: configViewCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + configViewCmdLiteral + " called")
+		if configViewResolved {
+			values, err := utils.GetResolvedConfigValues(utils.MainConfigFilePath)
+			if err != nil {
+				utils.HandleErrorAndExit("Error resolving the configuration", err)
+			}
+			utils.SetResultData(values)
+			impl.PrintConfigValues(values, configViewCmdFormat, defaultConfigValuesTableFormat)
+			return
+		}
+		data, err := ioutil.ReadFile(utils.MainConfigFilePath)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading "+utils.MainConfigFilePath, err)
+		}
+		utils.SetResultData(utils.ReadMainConfigFile(utils.MainConfigFilePath))
+		fmt.Print(string(data))
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(configViewCmd)
+	configViewCmd.Flags().BoolVar(&configViewResolved, "resolved", false,
+		"Display the merged configuration and where each value came from")
+	configViewCmd.Flags().StringVarP(&configViewCmdFormat, "format", "", defaultConfigValuesTableFormat,
+		"Pretty-print the resolved configuration using go templates or as csv, markdown or wide")
+	formatter.AddListFlags(configViewCmd.Flags())
+}
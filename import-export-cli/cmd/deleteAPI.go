@@ -19,12 +19,13 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -69,17 +70,19 @@ var DeleteAPICmd = &cobra.Command{
 
 // executeDeleteAPICmd executes the delete api command
 func executeDeleteAPICmd(credential credentials.Credential) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, deleteAPIEnvironment)
-	if preCommandErr == nil {
-		resp, err := impl.DeleteAPI(accessToken, deleteAPIEnvironment, deleteAPIName, deleteAPIVersion, deleteAPIProvider)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while deleting API ", err)
-		}
-		impl.PrintDeleteAPIResponse(resp, err)
-	} else {
-		// Error deleting API
-		fmt.Println("Error getting OAuth tokens while deleting API:" + preCommandErr.Error())
+	client, err := apictl.NewClient(deleteAPIEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error deleting API", err)
+	}
+	if err = client.DeleteAPI(context.Background(), deleteAPIName, deleteAPIVersion, deleteAPIProvider); err != nil {
+		utils.HandleErrorAndExit("Error deleting API", err)
 	}
+	utils.SetResultData(map[string]string{
+		"name":     deleteAPIName,
+		"version":  deleteAPIVersion,
+		"provider": deleteAPIProvider,
+	})
+	fmt.Println("API deleted successfully!")
 }
 
 // Init using Cobra
@@ -19,9 +19,10 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
@@ -62,17 +63,15 @@ var DeleteAPIProductCmd = &cobra.Command{
 
 // executeDeleteAPIProductCmd executes the delete api command
 func executeDeleteAPIProductCmd(credential credentials.Credential) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, deleteAPIProductEnvironment)
-	if preCommandErr == nil {
-		resp, err := impl.DeleteAPIProduct(accessToken, deleteAPIProductEnvironment, deleteAPIProductName, deleteAPIProductProvider)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while deleting API Product", err)
-		}
-		impl.PrintDeleteAPIProductResponse(resp, err)
-	} else {
-		// Error deleting API Product
-		fmt.Println("Error getting OAuth tokens while deleting API Product:" + preCommandErr.Error())
+	client, err := apictl.NewClient(deleteAPIProductEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error deleting API Product", err)
+	}
+	if err = client.DeleteAPIProduct(context.Background(), deleteAPIProductName, deleteAPIProductProvider); err != nil {
+		utils.HandleErrorAndExit("Error deleting API Product", err)
 	}
+	utils.SetResultData(map[string]string{"name": deleteAPIProductName, "provider": deleteAPIProductProvider})
+	fmt.Println("API Product deleted successfully!")
 }
 
 // Init using Cobra
@@ -19,10 +19,11 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 
 	"github.com/spf13/cobra"
@@ -60,20 +61,18 @@ var DeleteAppCmd = &cobra.Command{
 
 // executeDeleteAppCmd executes the delete app command
 func executeDeleteAppCmd(credential credentials.Credential) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, deleteAppEnvironment)
-	if preCommandErr == nil {
-		if deleteAppOwner == "" {
-			deleteAppOwner = credential.Username
-		}
-		resp, err := impl.DeleteApplication(accessToken, deleteAppEnvironment, deleteAppName, deleteAppOwner)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while deleting Application ", err)
-		}
-		impl.PrintDeleteAppResponse(resp, err)
-	} else {
-		// Error deleting Application
-		fmt.Println("Error getting OAuth tokens while deleting Application:" + preCommandErr.Error())
+	if deleteAppOwner == "" {
+		deleteAppOwner = credential.Username
+	}
+	client, err := apictl.NewClient(deleteAppEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error deleting Application", err)
+	}
+	if err = client.DeleteApp(context.Background(), deleteAppName, deleteAppOwner); err != nil {
+		utils.HandleErrorAndExit("Error deleting Application", err)
 	}
+	utils.SetResultData(map[string]string{"name": deleteAppName, "owner": deleteAppOwner})
+	fmt.Println("Application deleted successfully!")
 }
 
 // Init using Cobra
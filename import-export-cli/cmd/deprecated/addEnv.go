@@ -59,11 +59,12 @@ cases --token flag is optional and use it to specify the gateway token endpoint.
 
 // addEnvCmdDeprecated represents the addEnv command
 var addEnvCmdDeprecated = &cobra.Command{
-	Use:        addEnvCmdLiteral,
-	Short:      addEnvCmdShortDesc,
-	Long:       addEnvCmdLongDesc,
-	Example:    addEnvCmdExamples,
-	Deprecated: "instead use \"" + cmd.AddCmdLiteral + " " + cmd.AddEnvCmdLiteral + "\".",
+	Use:         addEnvCmdLiteral,
+	Short:       addEnvCmdShortDesc,
+	Long:        addEnvCmdLongDesc,
+	Example:     addEnvCmdExamples,
+	Deprecated:  "instead use \"" + cmd.AddCmdLiteral + " " + cmd.AddEnvCmdLiteral + "\".",
+	Annotations: map[string]string{cmd.NoContextAnnotation: "true"},
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(utils.LogPrefixInfo + addEnvCmdLiteral + " called")
 		executeAddEnvCmd(utils.MainConfigFilePath)
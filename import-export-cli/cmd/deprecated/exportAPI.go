@@ -19,12 +19,12 @@
 package deprecated
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
@@ -37,7 +37,6 @@ var exportAPIVersion string
 var exportProvider string
 var exportAPIPreserveStatus bool
 var exportAPIFormat string
-var runningExportApiCommand bool
 
 // ExportAPI command related usage info
 const exportAPICmdLiteral = "export-api"
@@ -71,31 +70,22 @@ var ExportAPICmdDeprecated = &cobra.Command{
 }
 
 func executeExportAPICmd(credential credentials.Credential, exportDirectory string) {
-	runningExportApiCommand = true
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, cmd.CmdExportEnvironment)
-
-	if preCommandErr == nil {
-		resp, err := impl.ExportAPIFromEnv(accessToken, exportAPIName, exportAPIVersion, "",
-			exportProvider, exportAPIFormat, cmd.CmdExportEnvironment, exportAPIPreserveStatus, false)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while exporting", err)
-		}
-		// Print info on response
-		utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
-		apiZipLocationPath := filepath.Join(exportDirectory, cmd.CmdExportEnvironment)
-		if resp.StatusCode() == http.StatusOK {
-			impl.WriteToZip(exportAPIName, exportAPIVersion, "", apiZipLocationPath, runningExportApiCommand, resp)
-		} else if resp.StatusCode() == http.StatusInternalServerError {
-			// 500 Internal Server Error
-			fmt.Println(string(resp.Body()))
-		} else {
-			// neither 200 nor 500
-			fmt.Println("Error exporting API:", resp.Status(), "\n", string(resp.Body()))
-		}
-	} else {
-		// error exporting Api
-		fmt.Println("Error getting OAuth tokens while exporting API:" + preCommandErr.Error())
+	client, err := apictl.NewClient(cmd.CmdExportEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting API", err)
+	}
+	exportedPath, err := client.ExportAPI(context.Background(), exportAPIName, exportAPIVersion, apictl.ExportAPIOptions{
+		Provider:       exportProvider,
+		Format:         exportAPIFormat,
+		PreserveStatus: exportAPIPreserveStatus,
+		Dir:            filepath.Join(exportDirectory, cmd.CmdExportEnvironment),
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting API", err)
 	}
+	utils.SetResultData(map[string]string{"path": exportedPath})
+	fmt.Println("Successfully exported API!")
+	fmt.Println("Find the exported API at " + exportedPath)
 }
 
 // init using Cobra
@@ -19,13 +19,14 @@
 package deprecated
 
 import (
-	"fmt"
+	"context"
+	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -40,10 +41,6 @@ NOTE: The flag (--environment (-e)) is mandatory`
 
 var exportAPIsFormat string
 
-//e.g. /home/samithac/.wso2apictl/exported/migration/production-2.5/wso2-dot-org
-var startFromBeginning bool
-var isProcessCompleted bool
-
 var ExportAPIsCmdDeprecated = &cobra.Command{
 	Use: exportAPIsCmdLiteral + " (--environment " +
 		"<environment-from-which-artifacts-should-be-exported> --format <export-format> --preserveStatus --force)",
@@ -67,27 +64,23 @@ var ExportAPIsCmdDeprecated = &cobra.Command{
 // <export_directory> is the patch defined in main_config.yaml
 // exportDirectory = <export_directory>/migration/
 func executeExportAPIsCmd(credential credentials.Credential, exportDirectory string) {
-	//create dir structure
-	apiExportDir := impl.CreateExportAPIsDirStructure(exportDirectory, cmd.CmdResourceTenantDomain, cmd.CmdExportEnvironment, cmd.CmdForceStartFromBegin)
-	exportRelatedFilesPath := filepath.Join(exportDirectory, cmd.CmdExportEnvironment,
-		utils.GetMigrationExportTenantDirName(cmd.CmdResourceTenantDomain))
-	//e.g. /home/samithac/.wso2apictl/exported/migration/production-2.5/wso2-dot-org
-	startFromBeginning = false
-	isProcessCompleted = false
-
-	fmt.Println("\nExporting APIs for the migration...")
-	if cmd.CmdForceStartFromBegin {
-		startFromBeginning = true
+	client, err := apictl.NewClient(cmd.CmdExportEnvironment, apictl.WithCredential(credential),
+		apictl.WithOutput(os.Stdout))
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting APIs", err)
 	}
-
-	if (utils.IsFileExist(filepath.Join(exportRelatedFilesPath, utils.LastSucceededApiFileName))) && !startFromBeginning {
-		impl.PrepareResumption(credential, exportRelatedFilesPath, cmd.CmdResourceTenantDomain, cmd.CmdUsername, cmd.CmdExportEnvironment)
-	} else {
-		impl.PrepareStartFromBeginning(credential, exportRelatedFilesPath, cmd.CmdResourceTenantDomain, cmd.CmdUsername, cmd.CmdExportEnvironment)
+	exportedPath, err := client.ExportAPIs(context.Background(), apictl.ExportAPIsOptions{
+		TenantDomain:   cmd.CmdResourceTenantDomain,
+		Username:       cmd.CmdUsername,
+		Format:         exportAPIsFormat,
+		PreserveStatus: exportAPIPreserveStatus,
+		Force:          cmd.CmdForceStartFromBegin,
+		Dir:            exportDirectory,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting APIs", err)
 	}
-
-	impl.ExportAPIs(credential, exportRelatedFilesPath, cmd.CmdExportEnvironment, cmd.CmdResourceTenantDomain, exportAPIsFormat, cmd.CmdUsername,
-		apiExportDir, exportAPIPreserveStatus, runningExportApiCommand, false)
+	utils.SetResultData(map[string]string{"path": exportedPath})
 }
 
 func init() {
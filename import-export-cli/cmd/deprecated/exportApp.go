@@ -19,13 +19,13 @@
 package deprecated
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"path/filepath"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
@@ -67,26 +67,21 @@ var ExportAppCmdDeprecated = &cobra.Command{
 }
 
 func executeExportAppCmd(credential credentials.Credential, appsExportDirectoryPath string) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, cmd.CmdExportEnvironment)
-
-	if preCommandErr == nil {
-		// The format flag is not supported from the deprecated command.
-		resp, err := impl.ExportAppFromEnv(accessToken, exportAppName, exportAppOwner, "", cmd.CmdExportEnvironment, exportAppWithKeys)
-		if err != nil {
-			utils.HandleErrorAndExit("Error exporting Application: "+exportAppName, err)
-		}
-
-		// Print info on response
-		utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
-		if resp.StatusCode() == http.StatusOK {
-			impl.WriteApplicationToZip(exportAppName, exportAppOwner, appsExportDirectoryPath, resp)
-		} else {
-			fmt.Println("Error " + string(resp.Body()))
-		}
-	} else {
-		// error exporting Application
-		fmt.Println("Error exporting Application:" + preCommandErr.Error())
+	client, err := apictl.NewClient(cmd.CmdExportEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting Application: "+exportAppName, err)
+	}
+	// The format flag is not supported from the deprecated command.
+	exportedPath, err := client.ExportApp(context.Background(), exportAppName, exportAppOwner, apictl.ExportAppOptions{
+		WithKeys: exportAppWithKeys,
+		Dir:      appsExportDirectoryPath,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting Application: "+exportAppName, err)
 	}
+	utils.SetResultData(map[string]string{"path": exportedPath})
+	fmt.Println("Successfully exported Application!")
+	fmt.Println("Find the exported Application at " + exportedPath)
 }
 
 //init using Cobra
@@ -19,10 +19,13 @@
 package deprecated
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -62,19 +65,28 @@ var ImportAPICmdDeprecated = &cobra.Command{
 		if err != nil {
 			utils.HandleErrorAndExit("Error getting credentials", err)
 		}
-		accessOAuthToken, err := credentials.GetOAuthAccessToken(cred, importEnvironment)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while getting an access token for importing API", err)
-		}
-		err = impl.ImportAPIToEnv(accessOAuthToken, importEnvironment, importAPIFile, importAPIParamsFile, importAPIUpdate,
-			importAPICmdPreserveProvider, importAPISkipCleanup, false, false)
-		if err != nil {
-			utils.HandleErrorAndExit("Error importing API", err)
-			return
-		}
+		executeImportAPICmd(cred)
 	},
 }
 
+func executeImportAPICmd(credential credentials.Credential) {
+	client, err := apictl.NewClient(importEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error importing API", err)
+	}
+	err = client.ImportAPI(context.Background(), importAPIFile, apictl.ImportAPIOptions{
+		ParamsFile:       importAPIParamsFile,
+		Update:           importAPIUpdate,
+		PreserveProvider: importAPICmdPreserveProvider,
+		SkipCleanup:      importAPISkipCleanup,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error importing API", err)
+	}
+	utils.SetResultData(map[string]string{"file": importAPIFile})
+	fmt.Println("Successfully imported API.")
+}
+
 // init using Cobra
 func init() {
 	cmd.RootCmd.AddCommand(ImportAPICmdDeprecated)
@@ -19,10 +19,13 @@
 package deprecated
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -65,15 +68,23 @@ var ImportAppCmdDeprecated = &cobra.Command{
 }
 
 func executeImportAppCmd(credential credentials.Credential) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, importAppEnvironment)
+	client, err := apictl.NewClient(importAppEnvironment, apictl.WithCredential(credential))
 	if err != nil {
-		utils.HandleErrorAndExit("Error getting OAuth Tokens", err)
+		utils.HandleErrorAndExit("Error importing Application", err)
 	}
-	_, err = impl.ImportApplicationToEnv(accessToken, importAppEnvironment, importAppFile, importAppOwner,
-		importAppUpdateApplication, preserveOwner, skipSubscriptions, importAppSkipKeys, importAppSkipCleanup)
+	err = client.ImportApp(context.Background(), importAppFile, apictl.ImportAppOptions{
+		Owner:             importAppOwner,
+		Update:            importAppUpdateApplication,
+		PreserveOwner:     preserveOwner,
+		SkipSubscriptions: skipSubscriptions,
+		SkipKeys:          importAppSkipKeys,
+		SkipCleanup:       importAppSkipCleanup,
+	})
 	if err != nil {
 		utils.HandleErrorAndExit("Error importing Application", err)
 	}
+	utils.SetResultData(map[string]string{"file": importAppFile})
+	fmt.Println("Successfully imported Application.")
 }
 
 func init() {
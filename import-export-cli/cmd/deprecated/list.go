@@ -21,6 +21,7 @@ package deprecated
 import (
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -55,4 +56,5 @@ var ListCmdDeprecated = &cobra.Command{
 // init using Cobra
 func init() {
 	cmd.RootCmd.AddCommand(ListCmdDeprecated)
+	formatter.AddListFlags(ListCmdDeprecated.PersistentFlags())
 }
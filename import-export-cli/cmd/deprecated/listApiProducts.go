@@ -19,6 +19,7 @@
 package deprecated
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
@@ -72,16 +73,17 @@ var apiProductsCmdDeprecated = &cobra.Command{
 }
 
 func executeApiProductsCmd(credential credentials.Credential) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, listApiProductsCmdEnvironment)
+	accessToken, err := credentials.GetOAuthAccessToken(context.Background(), credential, listApiProductsCmdEnvironment)
 	if err != nil {
 		utils.Logln(utils.LogPrefixError + "calling 'list' " + err.Error())
 		utils.HandleErrorAndExit("Error calling '"+apiProductsCmdLiteral+"'", err)
 	}
 
 	// Unified Search endpoint from the config file to search API Products
-	_, apiProducts, err := impl.GetAPIProductListFromEnv(accessToken, listApiProductsCmdEnvironment, listApiProductsCmdQuery,
-		listApiProductsCmdLimit)
+	_, apiProducts, err := impl.GetAPIProductListFromEnv(context.Background(), accessToken,
+		listApiProductsCmdEnvironment, listApiProductsCmdQuery, listApiProductsCmdLimit)
 	if err == nil {
+		utils.SetResultData(apiProducts)
 		impl.PrintAPIProducts(apiProducts, listApiProductsCmdFormat)
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of API Products", err)
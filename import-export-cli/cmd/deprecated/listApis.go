@@ -19,6 +19,7 @@
 package deprecated
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
@@ -72,14 +73,16 @@ var apisCmdDeprecated = &cobra.Command{
 }
 
 func executeApisCmd(credential credentials.Credential) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, listApisCmdEnvironment)
+	accessToken, err := credentials.GetOAuthAccessToken(context.Background(), credential, listApisCmdEnvironment)
 	if err != nil {
 		utils.Logln(utils.LogPrefixError + "calling 'list' " + err.Error())
 		utils.HandleErrorAndExit("Error calling '"+apisCmdLiteral+"'", err)
 	}
 
-	_, apis, err := impl.GetAPIListFromEnv(accessToken, listApisCmdEnvironment, listApisCmdQuery, listApisCmdLimit)
+	_, apis, err := impl.GetAPIListFromEnv(context.Background(), accessToken, listApisCmdEnvironment, listApisCmdQuery,
+		listApisCmdLimit)
 	if err == nil {
+		utils.SetResultData(apis)
 		impl.PrintAPIs(apis, listApisCmdFormat)
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of APIs", err)
@@ -19,6 +19,7 @@
 package deprecated
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/cmd"
@@ -68,16 +69,18 @@ var appsCmdDeprected = &cobra.Command{
 }
 
 func executeAppsCmd(credential credentials.Credential, appOwner string) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, listAppsCmdEnvironment)
+	accessToken, err := credentials.GetOAuthAccessToken(context.Background(), credential, listAppsCmdEnvironment)
 	if err != nil {
 		utils.Logln(utils.LogPrefixError + "calling 'list' " + err.Error())
 		utils.HandleErrorAndExit("Error calling '"+appsCmdLiteral+"'", err)
 	}
 
-	_, apps, err := impl.GetApplicationListFromEnv(accessToken, listAppsCmdEnvironment, appOwner, listAppsCmdLimit)
+	_, apps, err := impl.GetApplicationListFromEnv(context.Background(), accessToken, listAppsCmdEnvironment, appOwner,
+		listAppsCmdLimit)
 
 	if err == nil {
 		// Printing the list of available Applications
+		utils.SetResultData(apps)
 		impl.PrintApps(apps, listAppsCmdFormat)
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Applications", err)
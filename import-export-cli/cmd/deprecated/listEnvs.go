@@ -47,6 +47,7 @@ var envsCmdDeprecated = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(utils.LogPrefixInfo + envsCmdLiteral + " called")
 		envs := utils.GetMainConfigFromFile(utils.MainConfigFilePath).Environments
+		utils.SetResultData(envs)
 		impl.PrintEnvs(envs, envsCmdFormat, defaulEnvsTableFormat)
 	},
 }
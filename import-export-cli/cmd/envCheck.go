@@ -0,0 +1,107 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	mgImpl "github.com/wso2/product-apim-tooling/import-export-cli/impl/mg"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const defaultEndpointChecksTableFormat = "table {{.Component}}\t{{.Status}}\t{{.Version}}\t{{.Time}}\t{{.Endpoint}}\t{{.Message}}"
+
+var envCheckCmdEnvironment string
+var envCheckCmdMgwCluster string
+var envCheckCmdFormat string
+
+// "env check" command related usage Info
+const envCheckCmdLiteral = "check"
+const envCheckCmdShortDesc = "Check the endpoints of an environment"
+const envCheckCmdLongDesc = `Check the Publisher, DevPortal, Admin, client registration (DCR) and token endpoints of the API Manager,
+the management endpoint of the Micro Integrator of an environment and the adapter of a Microgateway cluster. The TLS
+certificates of the servers are validated and the versions of the REST APIs, API Manager and Micro Integrator are
+detected, with a warning if they are not the ones used by ` + utils.ProjectName + `. The version of the Micro Integrator is
+detected if logged in to it. The Microgateway cluster with the name of the environment is checked if --mg-cluster is
+not given.`
+const envCheckCmdExamples = utils.ProjectName + ` ` + envCmdLiteral + ` ` + envCheckCmdLiteral + ` -e dev
+` + utils.ProjectName + ` ` + envCmdLiteral + ` ` + envCheckCmdLiteral + ` -e dev --mg-cluster dev-mg
+` + utils.ProjectName + ` ` + envCmdLiteral + ` ` + envCheckCmdLiteral + ` -e dev -k`
+
+// envCheckCmd represents the env check command
+var envCheckCmd = &cobra.Command{
+	Use:     envCheckCmdLiteral,
+	Short:   envCheckCmdShortDesc,
+	Long:    envCheckCmdLongDesc,
+	Example: envCheckCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + envCheckCmdLiteral + " called")
+		executeEnvCheckCmd()
+	},
+}
+
+func executeEnvCheckCmd() {
+	mgwCluster := envCheckCmdMgwCluster
+	if mgwCluster == "" &&
+		utils.MgwAdapterEnvExistsInMainConfigFile(envCheckCmdEnvironment, utils.MainConfigFilePath) {
+		mgwCluster = envCheckCmdEnvironment
+	}
+	envExists := utils.EnvExistsInMainConfigFile(envCheckCmdEnvironment, utils.MainConfigFilePath)
+	if !envExists && mgwCluster == "" {
+		utils.HandleErrorAndExit("Error checking environment", utils.NewMissingError("environment "+
+			envCheckCmdEnvironment+" not found. Add it using add env"))
+	}
+
+	var checks []impl.EndpointCheck
+	if utils.APIMExistsInEnv(envCheckCmdEnvironment, utils.MainConfigFilePath) {
+		checks = append(checks, impl.CheckAPIMEndpoints(envCheckCmdEnvironment)...)
+	}
+	if utils.MIExistsInEnv(envCheckCmdEnvironment, utils.MainConfigFilePath) {
+		checks = append(checks, impl.CheckMIEndpoint(envCheckCmdEnvironment))
+	}
+	if mgwCluster != "" {
+		if !utils.MgwAdapterEnvExistsInMainConfigFile(mgwCluster, utils.MainConfigFilePath) {
+			utils.HandleErrorAndExit("Error checking environment", utils.NewMissingError("Microgateway cluster "+
+				mgwCluster+" not found. Add it using mg add env"))
+		}
+		checks = append(checks, mgImpl.CheckAdapterEndpoint(mgwCluster))
+	}
+
+	utils.SetResultData(checks)
+	impl.PrintEndpointChecks(checks, envCheckCmdFormat, defaultEndpointChecksTableFormat)
+	if impl.EndpointChecksFailed(checks) {
+		utils.HandleErrorAndExit("Environment check failed", errors.New("one or more endpoints of "+
+			envCheckCmdEnvironment+" failed"))
+	}
+}
+
+func init() {
+	EnvCmd.AddCommand(envCheckCmd)
+	envCheckCmd.Flags().StringVarP(&envCheckCmdEnvironment, "environment", "e", "",
+		"Environment to be checked")
+	envCheckCmd.Flags().StringVar(&envCheckCmdMgwCluster, "mg-cluster", "",
+		"Microgateway cluster to be checked")
+	envCheckCmd.Flags().StringVarP(&envCheckCmdFormat, "format", "", defaultEndpointChecksTableFormat,
+		"Pretty-print the results using go templates or as csv, markdown or wide")
+	formatter.AddListFlags(envCheckCmd.Flags())
+	_ = envCheckCmd.MarkFlagRequired("environment")
+}
@@ -19,16 +19,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
-
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 
-	"net/http"
 	"path/filepath"
 )
 
@@ -38,7 +37,6 @@ var exportRevisionNum string
 var exportProvider string
 var exportAPIPreserveStatus bool
 var exportAPIFormat string
-var runningExportApiCommand bool
 var exportAPILatestRevision bool
 
 // ExportAPI command related usage info
@@ -74,31 +72,24 @@ var ExportAPICmd = &cobra.Command{
 }
 
 func executeExportAPICmd(credential credentials.Credential, exportDirectory string) {
-	runningExportApiCommand = true
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, CmdExportEnvironment)
-
-	if preCommandErr == nil {
-		resp, err := impl.ExportAPIFromEnv(accessToken, exportAPIName, exportAPIVersion, exportRevisionNum, exportProvider,
-			exportAPIFormat, CmdExportEnvironment, exportAPIPreserveStatus, exportAPILatestRevision)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while exporting", err)
-		}
-		// Print info on response
-		utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
-		apiZipLocationPath := filepath.Join(exportDirectory, CmdExportEnvironment)
-		if resp.StatusCode() == http.StatusOK {
-			impl.WriteToZip(exportAPIName, exportAPIVersion, "", apiZipLocationPath, runningExportApiCommand, resp)
-		} else if resp.StatusCode() == http.StatusInternalServerError {
-			// 500 Internal Server Error
-			fmt.Println(string(resp.Body()))
-		} else {
-			// neither 200 nor 500
-			fmt.Println("Error exporting API:", resp.Status(), "\n", string(resp.Body()))
-		}
-	} else {
-		// error exporting Api
-		fmt.Println("Error getting OAuth tokens while exporting API:" + preCommandErr.Error())
+	client, err := apictl.NewClient(CmdExportEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting API", err)
+	}
+	exportedPath, err := client.ExportAPI(context.Background(), exportAPIName, exportAPIVersion, apictl.ExportAPIOptions{
+		Provider:       exportProvider,
+		Revision:       exportRevisionNum,
+		LatestRevision: exportAPILatestRevision,
+		Format:         exportAPIFormat,
+		PreserveStatus: exportAPIPreserveStatus,
+		Dir:            filepath.Join(exportDirectory, CmdExportEnvironment),
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting API", err)
 	}
+	utils.SetResultData(map[string]string{"path": exportedPath})
+	fmt.Println("Successfully exported API!")
+	fmt.Println("Find the exported API at " + exportedPath)
 }
 
 // init using Cobra
@@ -19,15 +19,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 
-	"net/http"
 	"path/filepath"
 )
 
@@ -36,7 +36,6 @@ var exportAPIProductVersion string
 var exportAPIProductRevisionNum string
 var exportAPIProductProvider string
 var exportAPIProductFormat string
-var runningExportAPIProductCommand bool
 var exportAPIProductLatestRevision bool
 var exportAPIProductPreserveStatus bool
 
@@ -71,36 +70,29 @@ var ExportAPIProductCmd = &cobra.Command{
 }
 
 func executeExportAPIProductCmd(credential credentials.Credential, exportDirectory string) {
-	runningExportAPIProductCommand = true
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, CmdExportEnvironment)
-
-	if preCommandErr == nil {
-		if exportAPIProductVersion == "" {
-			// Since the user cannot specify the version, use the version as 1.0.0
-			exportAPIProductVersion = utils.DefaultApiProductVersion
-		}
-		resp, err := impl.ExportAPIProductFromEnv(accessToken, exportAPIProductName, exportAPIProductVersion,
-			exportAPIProductRevisionNum, exportAPIProductProvider, exportAPIProductFormat, CmdExportEnvironment,
-			exportAPIProductLatestRevision, exportAPIProductPreserveStatus)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while exporting", err)
-		}
-		// Print info on response
-		utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
-		apiProductZipLocationPath := filepath.Join(exportDirectory, CmdExportEnvironment)
-		if resp.StatusCode() == http.StatusOK {
-			impl.WriteAPIProductToZip(exportAPIProductName, exportAPIProductVersion, apiProductZipLocationPath, runningExportAPIProductCommand, resp)
-		} else if resp.StatusCode() == http.StatusInternalServerError {
-			// 500 Internal Server Error
-			fmt.Println(string(resp.Body()))
-		} else {
-			// neither 200 nor 500
-			fmt.Println("Error exporting API Product:", resp.Status(), "\n", string(resp.Body()))
-		}
-	} else {
-		// error exporting API Product
-		fmt.Println("Error getting OAuth tokens while exporting API Product:" + preCommandErr.Error())
+	if exportAPIProductVersion == "" {
+		// Since the user cannot specify the version, use the version as 1.0.0
+		exportAPIProductVersion = utils.DefaultApiProductVersion
+	}
+	client, err := apictl.NewClient(CmdExportEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting API Product", err)
+	}
+	exportedPath, err := client.ExportAPIProduct(context.Background(), exportAPIProductName, exportAPIProductVersion,
+		apictl.ExportAPIProductOptions{
+			Provider:       exportAPIProductProvider,
+			Revision:       exportAPIProductRevisionNum,
+			LatestRevision: exportAPIProductLatestRevision,
+			Format:         exportAPIProductFormat,
+			PreserveStatus: exportAPIProductPreserveStatus,
+			Dir:            filepath.Join(exportDirectory, CmdExportEnvironment),
+		})
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting API Product", err)
 	}
+	utils.SetResultData(map[string]string{"path": exportedPath})
+	fmt.Println("Successfully exported API Product!")
+	fmt.Println("Find the exported API Product at " + exportedPath)
 }
 
 // init using Cobra
@@ -19,12 +19,13 @@
 package cmd
 
 import (
-	"fmt"
+	"context"
+	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -40,10 +41,6 @@ NOTE: The flag (--environment (-e)) is mandatory`
 var exportAPIsFormat string
 var exportAPIsAllRevisions bool
 
-//e.g. /home/samithac/.wso2apictl/exported/migration/production-2.5/wso2-dot-org
-var startFromBeginning bool
-var isProcessCompleted bool
-
 var ExportAPIsCmd = &cobra.Command{
 	Use: ExportAPIsCmdLiteral + " (--environment " +
 		"<environment-from-which-artifacts-should-be-exported> --format <export-format> --preserve-status --force)",
@@ -66,28 +63,24 @@ var ExportAPIsCmd = &cobra.Command{
 // <export_directory> is the patch defined in main_config.yaml
 // exportDirectory = <export_directory>/migration/
 func executeExportAPIsCmd(credential credentials.Credential, exportDirectory string) {
-	//create dir structure
-	apiExportDir := impl.CreateExportAPIsDirStructure(exportDirectory, CmdResourceTenantDomain, CmdExportEnvironment,
-		CmdForceStartFromBegin)
-	exportRelatedFilesPath := filepath.Join(exportDirectory, CmdExportEnvironment,
-		utils.GetMigrationExportTenantDirName(CmdResourceTenantDomain))
-	//e.g. /home/samithac/.wso2apictl/exported/migration/production-2.5/wso2-dot-org
-	startFromBeginning = false
-	isProcessCompleted = false
-
-	fmt.Println("\nExporting APIs for the migration...")
-	if CmdForceStartFromBegin {
-		startFromBeginning = true
+	client, err := apictl.NewClient(CmdExportEnvironment, apictl.WithCredential(credential),
+		apictl.WithOutput(os.Stdout))
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting APIs", err)
 	}
-
-	if (utils.IsFileExist(filepath.Join(exportRelatedFilesPath, utils.LastSucceededApiFileName))) && !startFromBeginning {
-		impl.PrepareResumption(credential, exportRelatedFilesPath, CmdResourceTenantDomain, CmdUsername, CmdExportEnvironment)
-	} else {
-		impl.PrepareStartFromBeginning(credential, exportRelatedFilesPath, CmdResourceTenantDomain, CmdUsername, CmdExportEnvironment)
+	exportedPath, err := client.ExportAPIs(context.Background(), apictl.ExportAPIsOptions{
+		TenantDomain:   CmdResourceTenantDomain,
+		Username:       CmdUsername,
+		Format:         exportAPIsFormat,
+		PreserveStatus: exportAPIPreserveStatus,
+		AllRevisions:   exportAPIsAllRevisions,
+		Force:          CmdForceStartFromBegin,
+		Dir:            exportDirectory,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting APIs", err)
 	}
-
-	impl.ExportAPIs(credential, exportRelatedFilesPath, CmdExportEnvironment, CmdResourceTenantDomain, exportAPIsFormat,
-		CmdUsername, apiExportDir, exportAPIPreserveStatus, runningExportApiCommand, exportAPIsAllRevisions)
+	utils.SetResultData(map[string]string{"path": exportedPath})
 }
 
 func init() {
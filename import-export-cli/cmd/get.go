@@ -20,6 +20,7 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -64,4 +65,5 @@ var GetCmd = &cobra.Command{
 // init using Cobra
 func init() {
 	RootCmd.AddCommand(GetCmd)
+	formatter.AddListFlags(GetCmd.PersistentFlags())
 }
@@ -56,6 +56,7 @@ func executeGetApiLoggingCmd(credential credentials.Credential) {
 	if getApiLoggingAPIId != "" {
 		api, err := impl.GetPerAPILoggingDetailsFromEnv(credential, getApiLoggingEnvironment, getApiLoggingAPIId, getApiLoggingTenantDomain)
 		if err == nil {
+			utils.SetResultData(api)
 			impl.PrintAPILoggers(api, getAPILoggingCmdFormat)
 		} else {
 			utils.Logln(utils.LogPrefixError+"Getting the log level of the API", err)
@@ -64,6 +65,7 @@ func executeGetApiLoggingCmd(credential credentials.Credential) {
 	} else {
 		apis, err := impl.GetPerAPILoggingListFromEnv(credential, getApiLoggingEnvironment, getApiLoggingTenantDomain)
 		if err == nil {
+			utils.SetResultData(apis)
 			impl.PrintAPILoggers(apis, getAPILoggingCmdFormat)
 		} else {
 			utils.Logln(utils.LogPrefixError+"Getting list of API log levels for the APIs", err)
@@ -82,6 +84,6 @@ func init() {
 	getApiLoggingCmd.Flags().StringVarP(&getApiLoggingEnvironment, "environment", "e",
 		"", "Environment of the APIs which the API loggers should be displayed")
 	getApiLoggingCmd.Flags().StringVarP(&getAPILoggingCmdFormat, "format", "", "", "Pretty-print API loggers "+
-		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+		"using Go Templates or as csv, markdown or wide. Use \"{{ jsonPretty . }}\" to list all fields")
 	_ = getApiLoggingCmd.MarkFlagRequired("environment")
 }
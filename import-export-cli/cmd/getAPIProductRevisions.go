@@ -19,6 +19,7 @@
 package cmd
 
 import (
+	"context"
 	"strings"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
@@ -62,7 +63,8 @@ var getAPIProductRevisionsCmd = &cobra.Command{
 }
 
 func executeGetAPIProductRevisionsCmd(credential credentials.Credential) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, getAPIProductRevisionsCmdEnvironment)
+	accessToken, err := credentials.GetOAuthAccessToken(context.Background(), credential,
+		getAPIProductRevisionsCmdEnvironment)
 	if err != nil {
 		utils.Logln(utils.LogPrefixError + "calling 'get revisions' " + err.Error())
 		utils.HandleErrorAndExit("Error calling '"+GetAPIProductRevisionsCmdLiteral+"'", err)
@@ -71,6 +73,7 @@ func executeGetAPIProductRevisionsCmd(credential credentials.Credential) {
 	_, revisions, err := impl.GetAPIProductRevisionListFromEnv(accessToken, getAPIProductRevisionsCmdEnvironment,
 		getRevisionsAPIProductName, getRevisionsAPIProductProvider, strings.Join(getAPIProductRevisionsCmdQuery, queryParamSeparator))
 	if err == nil {
+		utils.SetResultData(revisions)
 		impl.PrintRevisions(revisions, getAPIProductRevisionsCmdFormat)
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of Revisions", err)
@@ -88,7 +91,7 @@ func init() {
 	getAPIProductRevisionsCmd.Flags().StringVarP(&getAPIProductRevisionsCmdEnvironment, "environment", "e",
 		"", "Environment to be searched")
 	getAPIProductRevisionsCmd.Flags().StringVarP(&getAPIProductRevisionsCmdFormat, "format", "", "", "Pretty-print revisions "+
-		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+		"using Go Templates or as csv, markdown or wide. Use \"{{ jsonPretty . }}\" to list all fields")
 	_ = getAPIProductRevisionsCmd.MarkFlagRequired("name")
 	_ = getAPIProductRevisionsCmd.MarkFlagRequired("environment")
 }
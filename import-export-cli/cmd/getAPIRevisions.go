@@ -19,6 +19,7 @@
 package cmd
 
 import (
+	"context"
 	"strings"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
@@ -63,15 +64,17 @@ var getAPIRevisionsCmd = &cobra.Command{
 }
 
 func executeGetAPIRevisionsCmd(credential credentials.Credential) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, getAPIRevisionsCmdEnvironment)
+	accessToken, err := credentials.GetOAuthAccessToken(context.Background(), credential, getAPIRevisionsCmdEnvironment)
 	if err != nil {
 		utils.Logln(utils.LogPrefixError + "calling 'get revisions' " + err.Error())
 		utils.HandleErrorAndExit("Error calling '"+GetAPIRevisionsCmdLiteral+"'", err)
 	}
 
-	_, revisions, err := impl.GetRevisionListFromEnv(accessToken, getAPIRevisionsCmdEnvironment, getAPIRevisionsAPIName,
+	_, revisions, err := impl.GetRevisionListFromEnv(context.Background(), accessToken, getAPIRevisionsCmdEnvironment,
+		getAPIRevisionsAPIName,
 		getAPIRevisionsAPIVersion, getAPIRevisionsAPIProvider, strings.Join(getAPIRevisionsCmdQuery, queryParamSeparator))
 	if err == nil {
+		utils.SetResultData(revisions)
 		impl.PrintRevisions(revisions, getAPIRevisionsCmdFormat)
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of API Revisions", err)
@@ -91,7 +94,7 @@ func init() {
 	getAPIRevisionsCmd.Flags().StringVarP(&getAPIRevisionsCmdEnvironment, "environment", "e",
 		"", "Environment to be searched")
 	getAPIRevisionsCmd.Flags().StringVarP(&getAPIRevisionsCmdFormat, "format", "", "", "Pretty-print revisions "+
-		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+		"using Go Templates or as csv, markdown or wide. Use \"{{ jsonPretty . }}\" to list all fields")
 	_ = getAPIRevisionsCmd.MarkFlagRequired("name")
 	_ = getAPIRevisionsCmd.MarkFlagRequired("version")
 	_ = getAPIRevisionsCmd.MarkFlagRequired("environment")
@@ -19,10 +19,12 @@
 package cmd
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 
@@ -65,21 +67,21 @@ var getApiProductsCmd = &cobra.Command{
 }
 
 func executeGetApiProductsCmd(credential credentials.Credential) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, getApiProductsCmdEnvironment)
+	limit, err := strconv.Atoi(getApiProductsCmdLimit)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid value for --limit", err)
+	}
+	client, err := apictl.NewClient(getApiProductsCmdEnvironment, apictl.WithCredential(credential))
 	if err != nil {
-		utils.Logln(utils.LogPrefixError + "calling 'list' " + err.Error())
 		utils.HandleErrorAndExit("Error calling '"+GetApiProductsCmdLiteral+"'", err)
 	}
-
-	// Unified Search endpoint from the config file to search API Products
-	_, apiProducts, err := impl.GetAPIProductListFromEnv(accessToken, getApiProductsCmdEnvironment,
-		strings.Join(getApiProductsCmdQuery, queryParamSeparator),
-		getApiProductsCmdLimit)
-	if err == nil {
-		impl.PrintAPIProducts(apiProducts, getApiProductsCmdFormat)
-	} else {
-		utils.Logln(utils.LogPrefixError+"Getting List of API Products", err)
+	apiProducts, err := client.ListAPIProducts(context.Background(),
+		strings.Join(getApiProductsCmdQuery, queryParamSeparator), limit)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting List of API Products", err)
 	}
+	utils.SetResultData(apiProducts)
+	impl.PrintAPIProducts(apiProducts, getApiProductsCmdFormat)
 }
 
 func init() {
@@ -92,6 +94,6 @@ func init() {
 	getApiProductsCmd.Flags().StringVarP(&getApiProductsCmdLimit, "limit", "l",
 		strconv.Itoa(utils.DefaultApiProductsDisplayLimit), "Maximum number of API Products to return")
 	getApiProductsCmd.Flags().StringVarP(&getApiProductsCmdFormat, "format", "", "", "Pretty-print API Products "+
-		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+		"using Go Templates or as csv, markdown or wide. Use \"{{ jsonPretty . }}\" to list all fields")
 	_ = getApiProductsCmd.MarkFlagRequired("environment")
 }
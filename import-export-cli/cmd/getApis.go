@@ -19,11 +19,13 @@
 package cmd
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
@@ -64,19 +66,20 @@ var getApisCmd = &cobra.Command{
 }
 
 func executeGetApisCmd(credential credentials.Credential) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, getApisCmdEnvironment)
+	limit, err := strconv.Atoi(getApisCmdLimit)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid value for --limit", err)
+	}
+	client, err := apictl.NewClient(getApisCmdEnvironment, apictl.WithCredential(credential))
 	if err != nil {
-		utils.Logln(utils.LogPrefixError + "calling 'list' " + err.Error())
 		utils.HandleErrorAndExit("Error calling '"+GetApisCmdLiteral+"'", err)
 	}
-
-	_, apis, err := impl.GetAPIListFromEnv(accessToken, getApisCmdEnvironment,
-		strings.Join(getApisCmdQuery, queryParamSeparator), getApisCmdLimit)
-	if err == nil {
-		impl.PrintAPIs(apis, getApisCmdFormat)
-	} else {
-		utils.Logln(utils.LogPrefixError+"Getting List of APIs", err)
+	apis, err := client.ListAPIs(context.Background(), strings.Join(getApisCmdQuery, queryParamSeparator), limit)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting List of APIs", err)
 	}
+	utils.SetResultData(apis)
+	impl.PrintAPIs(apis, getApisCmdFormat)
 }
 
 func init() {
@@ -89,6 +92,6 @@ func init() {
 	getApisCmd.Flags().StringVarP(&getApisCmdLimit, "limit", "l",
 		strconv.Itoa(utils.DefaultApisDisplayLimit), "Maximum number of apis to return")
 	getApisCmd.Flags().StringVarP(&getApisCmdFormat, "format", "", "", "Pretty-print apis "+
-		"using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+		"using Go Templates or as csv, markdown or wide. Use \"{{ jsonPretty . }}\" to list all fields")
 	_ = getApisCmd.MarkFlagRequired("environment")
 }
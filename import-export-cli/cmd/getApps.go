@@ -19,9 +19,11 @@
 package cmd
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 
@@ -66,20 +68,21 @@ var getAppsCmd = &cobra.Command{
 }
 
 func executeGetAppsCmd(credential credentials.Credential, appOwner string) {
-	accessToken, err := credentials.GetOAuthAccessToken(credential, getAppsCmdEnvironment)
+	limit, err := strconv.Atoi(getAppsCmdLimit)
+	if err != nil {
+		utils.HandleErrorAndExit("Invalid value for --limit", err)
+	}
+	client, err := apictl.NewClient(getAppsCmdEnvironment, apictl.WithCredential(credential))
 	if err != nil {
-		utils.Logln(utils.LogPrefixError + "calling 'list' " + err.Error())
 		utils.HandleErrorAndExit("Error calling '"+GetAppsCmdLiteral+"'", err)
 	}
-
-	_, apps, err := impl.GetApplicationListFromEnv(accessToken, getAppsCmdEnvironment, appOwner, getAppsCmdLimit)
-
-	if err == nil {
-		// Printing the list of available Applications
-		impl.PrintApps(apps, getAppsCmdFormat)
-	} else {
-		utils.Logln(utils.LogPrefixError+"Getting List of Applications", err)
+	apps, err := client.ListApps(context.Background(), appOwner, limit)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting List of Applications", err)
 	}
+	// Printing the list of available Applications
+	utils.SetResultData(apps)
+	impl.PrintApps(apps, getAppsCmdFormat)
 }
 
 func init() {
@@ -92,6 +95,6 @@ func init() {
 	getAppsCmd.Flags().StringVarP(&getAppsCmdLimit, "limit", "l",
 		strconv.Itoa(utils.DefaultAppsDisplayLimit), "Maximum number of applications to return")
 	getAppsCmd.Flags().StringVarP(&getAppsCmdFormat, "format", "", "", "Pretty-print output"+
-		"using Go templates. Use \"{{jsonPretty .}}\" to list all fields")
+		"using Go templates or as csv, markdown or wide. Use \"{{jsonPretty .}}\" to list all fields")
 	_ = getAppsCmd.MarkFlagRequired("environment")
 }
@@ -45,6 +45,7 @@ var getEnvsCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(utils.LogPrefixInfo + GetEnvsCmdLiteral + " called")
 		envs := utils.GetMainConfigFromFile(utils.MainConfigFilePath).Environments
+		utils.SetResultData(envs)
 		impl.PrintEnvs(envs, envsCmdFormat, defaulEnvsTableFormat)
 	},
 }
@@ -52,5 +53,5 @@ var getEnvsCmd = &cobra.Command{
 func init() {
 	GetCmd.AddCommand(getEnvsCmd)
 	getEnvsCmd.Flags().StringVarP(&envsCmdFormat, "format", "", defaulEnvsTableFormat, "Pretty-print "+
-		"environments using go templates")
+		"environments using go templates or as csv, markdown or wide")
 }
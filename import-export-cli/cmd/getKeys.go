@@ -19,8 +19,11 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -50,14 +53,16 @@ var getKeysCmd = &cobra.Command{
 			utils.HandleErrorAndExit("Error getting credentials", err)
 		}
 		utils.Logln(utils.LogPrefixInfo + "Retrieved credentials of the environment successfully")
-		//Calling the DCR endpoint to get the credentials of the env
-		cred.ClientId, cred.ClientSecret, err = impl.CallDCREndpoint(cred, keyGenEnv)
-		//If the DCR call fails exit with the error
+		client, err := apictl.NewClient(keyGenEnv, apictl.WithCredential(cred))
+		if err != nil {
+			utils.HandleErrorAndExit("Error generating keys", err)
+		}
+		token, err := client.GetKeys(context.Background(), apiName, apiVersion, apiProvider, keyGenTokenEndpoint)
 		if err != nil {
-			utils.HandleErrorAndExit("Internal error occurred", err)
+			utils.HandleErrorAndExit("Error generating keys", err)
 		}
-		utils.Logln(utils.LogPrefixInfo + "Called DCR endpoint successfully")
-		impl.GetKeys(cred, keyGenEnv, apiName, apiVersion, apiProvider, keyGenTokenEndpoint)
+		utils.SetResultData(map[string]string{"accessToken": token})
+		fmt.Println(token)
 	},
 }
 
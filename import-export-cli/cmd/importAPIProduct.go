@@ -19,9 +19,12 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
-	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/pkg/apictl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -65,20 +68,32 @@ var ImportAPIProductCmd = &cobra.Command{
 		if err != nil {
 			utils.HandleErrorAndExit("Error getting credentials", err)
 		}
-		accessOAuthToken, err := credentials.GetOAuthAccessToken(cred, importAPIProductEnvironment)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while getting an access token for importing API Product", err)
-		}
-		err = impl.ImportAPIProductToEnv(accessOAuthToken, importAPIProductEnvironment, importAPIProductFile, importAPIProductParamsFile,
-			importAPIs, importAPIsUpdate, importAPIProductUpdate, importAPIProductCmdPreserveProvider, importAPIProductSkipCleanup,
-			importAPIProductRotateRevision, importAPIProductSkipDeployments)
-		if err != nil {
-			utils.HandleErrorAndExit("Error importing API Product", err)
-			return
-		}
+		executeImportAPIProductCmd(cred)
 	},
 }
 
+func executeImportAPIProductCmd(credential credentials.Credential) {
+	client, err := apictl.NewClient(importAPIProductEnvironment, apictl.WithCredential(credential))
+	if err != nil {
+		utils.HandleErrorAndExit("Error importing API Product", err)
+	}
+	err = client.ImportAPIProduct(context.Background(), importAPIProductFile, apictl.ImportAPIProductOptions{
+		ParamsFile:       importAPIProductParamsFile,
+		ImportAPIs:       importAPIs,
+		UpdateAPIs:       importAPIsUpdate,
+		Update:           importAPIProductUpdate,
+		PreserveProvider: importAPIProductCmdPreserveProvider,
+		RotateRevision:   importAPIProductRotateRevision,
+		SkipDeployments:  importAPIProductSkipDeployments,
+		SkipCleanup:      importAPIProductSkipCleanup,
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error importing API Product", err)
+	}
+	utils.SetResultData(map[string]string{"file": importAPIProductFile})
+	fmt.Println("Successfully imported API Product.")
+}
+
 // init using Cobra
 func init() {
 	ImportCmd.AddCommand(ImportAPIProductCmd)
@@ -19,20 +19,13 @@
 package k8s
 
 import (
-	"bytes"
+	"context"
 	"fmt"
-	"io"
-	"io/ioutil"
-	"log"
 	"os"
-	"os/exec"
-	"path/filepath"
 	"strings"
 
-	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
 	wso2v1alpha2 "github.com/wso2/k8s-api-operator/api-operator/pkg/apis/wso2/v1alpha2"
-	"github.com/wso2/product-apim-tooling/import-export-cli/box"
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -40,6 +33,7 @@ import (
 var flagApiName string
 var flagSwaggerFilePath string
 var flagNamespace string
+var flagParamsPath string
 
 const AddApiCmdLiteral = "api"
 const addApiCmdShortDesc = "Handle APIs in kubernetes cluster "
@@ -47,7 +41,10 @@ const addApiLongDesc = `Add, Update and Delete APIs in kubernetes cluster. JSON
 available modes are as follows
 * kubernetes`
 const addApiExamples = utils.ProjectName + " " + K8sCmdLiteral + " add/update " + AddApiCmdLiteral +
-	` -n petstore -f Swagger.json --namespace=wso2`
+	` -n petstore -f Swagger.json --namespace=wso2
+
+` + utils.ProjectName + " " + K8sCmdLiteral + " add " + AddApiCmdLiteral +
+	` -n petstore -f ./PetstoreAPI --params ./deployment --namespace=wso2`
 
 // addApiCmd represents the api command
 var addApiCmd = &cobra.Command{
@@ -68,35 +65,45 @@ func handleAddApi(nameSuffix string) {
 	utils.Logln(fmt.Sprintf("%sProcessing swagger  %v", utils.LogPrefixInfo, flagSwaggerFilePath))
 
 	flagApiName = strings.ToLower(flagApiName)
-	swaggerCmName := fmt.Sprintf("%v-swagger%v", flagApiName, nameSuffix)
-
-	fi, _ := os.Stat(flagSwaggerFilePath) // error already handled and ignore error
-	switch mode := fi.Mode(); {
-	//check if the swagger path is a Dir
-	case mode.IsDir():
-		//get swagger definition
-		swaggerPath := filepath.Join(flagSwaggerFilePath, filepath.FromSlash("Definitions/swagger.yaml"))
-		//creating kubernetes configmap with swagger definition
-		fmt.Println("creating configmap with swagger definition")
-		errConf := createConfigMapWithNamespace(swaggerCmName, swaggerPath, flagNamespace, k8sUtils.K8sCreate)
-		if errConf != nil {
-			utils.HandleErrorAndExit("Error creating configmap", errConf)
+	manifests, err := k8sUtils.GenerateAPIManifests(k8sUtils.APIManifestsConfig{
+		Name:            flagApiName,
+		NameSuffix:      nameSuffix,
+		Namespace:       flagNamespace,
+		SwaggerPath:     flagSwaggerFilePath,
+		ParamsPath:      flagParamsPath,
+		UpdateTimeStamp: strings.TrimPrefix(nameSuffix, "-"),
+	})
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the API", err)
+	}
+
+	//creating kubernetes configmap with swagger definition
+	fmt.Println("creating configmap with swagger definition")
+	if err := createK8sResource(manifests.SwaggerConfigMap); err != nil {
+		utils.HandleErrorAndExit("Error creating configmap", err)
+	}
+
+	//creating kubernetes configmap with API params
+	if manifests.ParamsConfigMap != nil {
+		fmt.Println("creating configmap with API params")
+		if err := createK8sResource(manifests.ParamsConfigMap); err != nil {
+			rollbackConfigs(manifests.API)
+			utils.HandleErrorAndExit("Error creating configmap", err)
 		}
+	}
 
-	//check if the swagger path is a file
-	case mode.IsRegular():
-		//creating kubernetes configmap with swagger definition
-		fmt.Println("creating configmap with swagger definition")
-		errConf := createConfigMapWithNamespace(swaggerCmName, flagSwaggerFilePath, flagNamespace,
-			k8sUtils.K8sCreate)
-		if errConf != nil {
-			utils.HandleErrorAndExit("Error creating configmap", errConf)
+	//creating kubernetes secret with certificates
+	if manifests.CertsSecret != nil {
+		fmt.Println("creating secret with certificates")
+		if err := createK8sResource(manifests.CertsSecret); err != nil {
+			rollbackConfigs(manifests.API)
+			utils.HandleErrorAndExit("Error creating secret", err)
 		}
 	}
 
 	//create API
 	fmt.Println("creating API definition")
-	createAPI(swaggerCmName, nameSuffix)
+	createAPI(manifests.API, nameSuffix != "")
 }
 
 // validateAddApiCommand validates for required flags and if invalid print error and exit
@@ -105,79 +112,29 @@ func validateAddApiCommand() {
 	if _, err := os.Stat(flagSwaggerFilePath); err != nil {
 		utils.HandleErrorAndExit("swagger file path or project not found", err)
 	}
-}
-
-//create configmap with swagger definition
-func createConfigMapWithNamespace(configMapName, filePath, namespace, operation string) error {
-	cmd := exec.Command(
-		k8sUtils.Kubectl,
-		operation,
-		"configmap",
-		configMapName,
-		"--from-file",
-		filePath,
-		"-n", namespace,
-	)
-	//print kubernetes error commands
-	var errBuf, outBuf bytes.Buffer
-	cmd.Stderr = io.MultiWriter(os.Stderr, &errBuf)
-	cmd.Stdout = io.MultiWriter(os.Stdout, &outBuf)
-	err := cmd.Run()
-	if err != nil {
-		return err
+	// validate --params flag values
+	if flagParamsPath != "" {
+		if _, err := os.Stat(flagParamsPath); err != nil {
+			utils.HandleErrorAndExit("params file or deployment directory not found", err)
+		}
 	}
-	return nil
 }
 
-func createAPI(configMapName, timestamp string) {
-	//get API definition from file
-	apiConfigMapData, _ := box.Get("/kubernetes_resources/api_cr.yaml")
-	apiCrd := &wso2v1alpha2.API{}
-	errUnmarshal := yaml.Unmarshal(apiConfigMapData, apiCrd)
-	if errUnmarshal != nil {
-		utils.HandleErrorAndExit("Error unmarshal api configmap into struct ", errUnmarshal)
-	}
-
-	apiCrd.Name = flagApiName
-	apiCrd.Namespace = flagNamespace
-	apiCrd.Spec.SwaggerConfigMapName = configMapName
-
-	k8sOperation := k8sUtils.K8sCreate
-	k8sSaveConfig := true
-	if timestamp != "" {
-		//set update timestamp
-		apiCrd.Spec.UpdateTimeStamp = strings.Split(timestamp, "-")[1]
-		k8sOperation = k8sUtils.K8sApply
-		k8sSaveConfig = false
-	}
-
-	byteVal, errMarshal := yaml.Marshal(apiCrd)
-	if errMarshal != nil {
-		utils.HandleErrorAndExit("Error marshal api configmap ", errMarshal)
-	}
-	//write configmap to a temp file
-	tmpFile, err := ioutil.TempFile(os.TempDir(), "apicr-*.yaml")
-	if err != nil {
-		log.Fatal("Cannot create temporary file", err)
-	}
-	defer os.Remove(tmpFile.Name())
-	if _, err = tmpFile.Write(byteVal); err != nil {
-		log.Fatal("Failed to write to temporary file", err)
-	}
-	// Close the file
-	if err := tmpFile.Close(); err != nil {
-		log.Fatal(err)
-	}
+// createK8sResource creates the given ConfigMap or Secret in the kubernetes cluster
+func createK8sResource(resource interface{}) error {
+	return getK8sClient().CreateObject(context.Background(), resource)
+}
 
-	k8sArgs := []string{k8sOperation, "-f", tmpFile.Name(), "-n", flagNamespace}
-	if k8sSaveConfig {
-		k8sArgs = append(k8sArgs, "--save-config")
+func createAPI(apiCrd *wso2v1alpha2.API, update bool) {
+	//create or update (server-side apply) the API
+	var errAddApi error
+	if update {
+		errAddApi = getK8sClient().ApplyObject(context.Background(), apiCrd)
+	} else {
+		errAddApi = getK8sClient().CreateObject(context.Background(), apiCrd)
 	}
-
-	//execute kubernetes command to create or update api from file
-	errAddApi := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sArgs...)
 	if errAddApi != nil {
-		fmt.Println("error configuring API")
+		fmt.Println("error configuring API:", errAddApi)
 		// delete all configs if any error
 		rollbackConfigs(apiCrd)
 	}
@@ -186,23 +143,53 @@ func createAPI(configMapName, timestamp string) {
 // rollbackConfigs deletes configs defined in the API CR given
 func rollbackConfigs(apiCr *wso2v1alpha2.API) {
 	var rollbackConfMaps []string // configmap names to be deleted
+	var rollbackSecrets []string  // secret names to be deleted
 
 	// swagger configmaps
-	rollbackConfMaps = append(rollbackConfMaps, apiCr.Spec.SwaggerConfigMapName)
+	if apiCr.Spec.SwaggerConfigMapName != "" {
+		rollbackConfMaps = append(rollbackConfMaps, apiCr.Spec.SwaggerConfigMapName)
+	}
+	// params configmaps
+	if apiCr.Spec.ParamsValues != "" {
+		rollbackConfMaps = append(rollbackConfMaps, apiCr.Spec.ParamsValues)
+	}
+	// certificate secrets
+	if apiCr.Spec.CertsValues != "" {
+		rollbackSecrets = append(rollbackSecrets, apiCr.Spec.CertsValues)
+	}
 
-	if len(rollbackConfMaps) == 0 {
+	if len(rollbackConfMaps) == 0 && len(rollbackSecrets) == 0 {
 		return
 	}
 
 	// execute kubernetes command to delete
 	fmt.Println("Deleting created configs")
-	k8sArgs := []string{k8sUtils.K8sDelete, "cm"}
-	k8sArgs = append(k8sArgs, rollbackConfMaps...)
+	if len(rollbackConfMaps) != 0 {
+		delConfErr := deleteK8sResources(k8sUtils.ConfigMaps, rollbackConfMaps...)
+		if delConfErr != nil {
+			utils.HandleErrorAndExit("error deleting configmaps of the API: "+apiCr.Name, delConfErr)
+		}
+	}
+	if len(rollbackSecrets) != 0 {
+		delSecretErr := deleteK8sResources(k8sUtils.Secrets, rollbackSecrets...)
+		if delSecretErr != nil {
+			utils.HandleErrorAndExit("error deleting secrets of the API: "+apiCr.Name, delSecretErr)
+		}
+	}
+}
+
+// deleteK8sResources deletes the resources of the given type, ignoring the resources that are not found
+func deleteK8sResources(resource string, names ...string) error {
+	return getK8sClient().Delete(context.Background(), resource, flagNamespace, true, names...)
+}
 
-	delConfErr := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sArgs...)
-	if delConfErr != nil {
-		utils.HandleErrorAndExit("error deleting configmaps of the API: "+apiCr.Name, delConfErr)
+// getK8sClient returns the client of the kubernetes cluster and exits if the client could not be created
+func getK8sClient() *k8sUtils.K8sClient {
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
 	}
+	return client
 }
 
 func init() {
@@ -211,6 +198,8 @@ func init() {
 	addApiCmd.Flags().StringVarP(&flagSwaggerFilePath, "file", "f", "",
 		"Path to swagger, zip file or API Project")
 	addApiCmd.Flags().StringVar(&flagNamespace, "namespace", "", "namespace of API")
+	addApiCmd.Flags().StringVar(&flagParamsPath, "params", "",
+		"Path to the API params file or a deployment directory with params and certificates")
 	_ = addApiCmd.MarkFlagRequired("name")
 	_ = addApiCmd.MarkFlagRequired("file")
 }
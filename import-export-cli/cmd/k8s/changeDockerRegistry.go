@@ -43,7 +43,13 @@ var changeCmd = &cobra.Command{
 
 const K8sChangeDockerRegistryCmdLiteral = "registry"
 const k8sChangeDockerRegistryCmdShortDesc = "Change the registry"
-const k8sChangeDockerRegistryCmdLongDesc = "Change the registry to be pushed the built micro-gateway image"
+const k8sChangeDockerRegistryCmdLongDesc = "Change the registry to be pushed the built micro-gateway image\n\n" +
+	amazonEcrTokenExpiryNote
+
+// amazonEcrTokenExpiryNote explains the renewal of the Amazon ECR authorization token stored in the registry secret
+const amazonEcrTokenExpiryNote = "NOTE: For the registry type AMAZON_ECR, an authorization token is minted with the " +
+	"AWS credentials and stored in the registry secret. The token expires after 12 hours, after which images cannot " +
+	"be pushed until the registry is changed again to renew the token, e.g. with a scheduled job."
 const k8sChangeDockerRegistryCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sChangeCmdLiteral + ` ` + K8sChangeDockerRegistryCmdLiteral
 
 // changeDockerRegistryCmd represents the change registry command
@@ -86,7 +92,7 @@ func init() {
 	// flags for installing api-operator in batch mode
 	// only the flag "registry-type" is required and others are registry specific flags
 	// same flags defined in 'installApiOperator'
-	changeDockerRegistryCmd.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR |GCR | HTTP")
+	changeDockerRegistryCmd.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR | GCR | HTTP | HTTPS | QUAY | ACR | GHCR | HARBOR | DOCKER_CONFIG")
 	changeDockerRegistryCmd.Flags().StringVarP(&flagBmRepository, k8sUtils.FlagBmRepository, "r", "", "Repository name or URI")
 	changeDockerRegistryCmd.Flags().StringVarP(&flagBmUsername, k8sUtils.FlagBmUsername, "u", "", "Username of the repository")
 	changeDockerRegistryCmd.Flags().StringVarP(&flagBmPassword, k8sUtils.FlagBmPassword, "p", "", "Password of the given user")
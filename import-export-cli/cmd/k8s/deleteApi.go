@@ -19,6 +19,7 @@
 package k8s
 
 import (
+	"context"
 	"fmt"
 	"github.com/spf13/cobra"
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
@@ -48,9 +49,11 @@ var deleteAPICmd = &cobra.Command{
 func handleDeleteApi() {
 	flagApiName = strings.ToLower(flagApiName)
 	var errMsg string
-	deleteApiErr := k8sUtils.ExecuteCommand(
-		k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ApiOpCrdApi, flagApiName, "-n", flagNamespace)
+	deleteApiErr := getK8sClient().Delete(context.Background(), k8sUtils.ApiOpCrdApi, flagNamespace, false, flagApiName)
 	if deleteApiErr != nil {
+		if !k8sUtils.IsK8sNotFound(deleteApiErr) {
+			utils.HandleErrorAndExit("Error deleting the API", deleteApiErr)
+		}
 		if flagNamespace != "" {
 			errMsg = fmt.Sprintf("Could not find the API \"%s\" in the namespace \"%s\"",
 				flagApiName, flagNamespace)
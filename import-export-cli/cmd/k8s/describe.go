@@ -0,0 +1,51 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const k8sDescribeCmdLiteral = "describe"
+const k8sDescribeCmdShortDesc = "Show details of a resource in the kubernetes cluster"
+const k8sDescribeCmdLongDesc = `Show details of an API deployed with the API Operator. Other resources are described
+with kubectl`
+
+const k8sDescribeCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sDescribeCmdLiteral + ` ` +
+	k8sDescribeAPICmdLiteral + ` petstore --namespace=wso2
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sDescribeCmdLiteral + ` pods -n wso2`
+
+// DescribeCmd represents the describe command
+var DescribeCmd = &cobra.Command{
+	Use:     k8sDescribeCmdLiteral,
+	Short:   k8sDescribeCmdShortDesc,
+	Long:    k8sDescribeCmdLongDesc,
+	Example: k8sDescribeCmdExamples,
+	// flags of other resources are passed to kubectl
+	DisableFlagParsing: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + k8sDescribeCmdLiteral + " called")
+		if len(args) == 0 || args[0] == "-h" || args[0] == "--help" {
+			_ = cmd.Help()
+			return
+		}
+		ExecuteKubernetes(append([]string{k8sDescribeCmdLiteral}, args...)...)
+	},
+}
@@ -0,0 +1,63 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	k8sImpl "github.com/wso2/product-apim-tooling/import-export-cli/impl/k8s"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var describeAPICmdFormat string
+
+const k8sDescribeAPICmdLiteral = "api"
+const k8sDescribeAPICmdShortDesc = "Show details of an API in the kubernetes cluster"
+const k8sDescribeAPICmdLongDesc = `Show details of an API deployed with the API Operator along with its status, recent warning
+events, swagger definition and params`
+
+const k8sDescribeAPICmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sDescribeCmdLiteral + ` ` +
+	k8sDescribeAPICmdLiteral + ` petstore
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sDescribeCmdLiteral + ` ` + k8sDescribeAPICmdLiteral +
+	` petstore --namespace=wso2 --format "{{.Status}}"`
+
+// describeAPICmd represents the describe api command
+var describeAPICmd = &cobra.Command{
+	Use:     k8sDescribeAPICmdLiteral + " [api-name]",
+	Short:   k8sDescribeAPICmdShortDesc,
+	Long:    k8sDescribeAPICmdLongDesc,
+	Example: k8sDescribeAPICmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + k8sDescribeCmdLiteral + " " + k8sDescribeAPICmdLiteral + " called")
+		api, err := k8sImpl.GetAPIDetails(getK8sClient(), flagNamespace, strings.ToLower(args[0]))
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting the API "+args[0], err)
+		}
+		k8sImpl.PrintAPIDetails(api, describeAPICmdFormat)
+	},
+}
+
+func init() {
+	DescribeCmd.AddCommand(describeAPICmd)
+	describeAPICmd.Flags().StringVar(&flagNamespace, "namespace", "", "namespace of API")
+	describeAPICmd.Flags().StringVarP(&describeAPICmdFormat, "format", "", "", "Pretty-print details of the API "+
+		"using go templates")
+}
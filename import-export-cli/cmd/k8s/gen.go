@@ -7,7 +7,7 @@ import (
 
 // Get command related usage Info
 const K8sGenCmdLiteral = "gen"
-const k8sGenCmdShortDesc = "Generate deployment directory for K8S operator"
+const k8sGenCmdShortDesc = "Generate deployment directory or manifests for K8S operator"
 
 const k8sGenCmdLongDesc = `Generate sample directory with all the contents to use as the deployment directory` +
 	`  when performing CI/CD pipeline tasks `
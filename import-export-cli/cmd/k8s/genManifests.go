@@ -0,0 +1,114 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var genManifestsApiName string
+var genManifestsFilePath string
+var genManifestsNamespace string
+var genManifestsParamsFile string
+var genManifestsCertsDir string
+var genManifestsOutput string
+var genManifestsKustomize bool
+
+const GenManifestsCmdLiteral = "manifests"
+const genManifestsCmdShortDesc = "Generate Kubernetes manifests of an API"
+
+const genManifestsCmdLongDesc = `Generate the swagger ConfigMap, params ConfigMap, certificates Secret and the API ` +
+	`custom resource of an API without connecting to a cluster. The manifests are generated as a single ` +
+	`multi-document YAML or as a Kustomize base, which can be applied by GitOps tools`
+
+const genManifestsCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sGenCmdLiteral + ` ` +
+	GenManifestsCmdLiteral + ` -n petstore -f Swagger.json --namespace=wso2
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sGenCmdLiteral + ` ` + GenManifestsCmdLiteral +
+	` -n petstore -f PetstoreAPI --params api_params.yaml --certs certificates -o petstore.yaml
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sGenCmdLiteral + ` ` + GenManifestsCmdLiteral +
+	` -n petstore -f PetstoreAPI --kustomize -o petstore-base`
+
+// genManifestsCmd represents the gen manifests command
+var genManifestsCmd = &cobra.Command{
+	Use:     GenManifestsCmdLiteral,
+	Short:   genManifestsCmdShortDesc,
+	Long:    genManifestsCmdLongDesc,
+	Example: genManifestsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + GenManifestsCmdLiteral + " called")
+		if genManifestsKustomize && genManifestsOutput == "" {
+			utils.HandleErrorAndExit("The flag --output (-o) is required with --kustomize", nil)
+		}
+
+		manifests, err := k8sUtils.GenerateAPIManifests(k8sUtils.APIManifestsConfig{
+			Name:        genManifestsApiName,
+			Namespace:   genManifestsNamespace,
+			SwaggerPath: genManifestsFilePath,
+			ParamsPath:  genManifestsParamsFile,
+			CertsPath:   genManifestsCertsDir,
+		})
+		if err != nil {
+			utils.HandleErrorAndExit("Error generating manifests of the API", err)
+		}
+
+		if genManifestsKustomize {
+			if err = manifests.WriteKustomizeBase(genManifestsOutput); err != nil {
+				utils.HandleErrorAndExit("Error writing the Kustomize base", err)
+			}
+			fmt.Println("Kustomize base of the API is generated at " + genManifestsOutput)
+			return
+		}
+
+		content, err := manifests.ToMultiDocYAML()
+		if err != nil {
+			utils.HandleErrorAndExit("Error generating manifests of the API", err)
+		}
+		if genManifestsOutput == "" {
+			_, _ = os.Stdout.Write(content)
+			return
+		}
+		if err = ioutil.WriteFile(genManifestsOutput, content, 0644); err != nil {
+			utils.HandleErrorAndExit("Error writing the manifests to "+genManifestsOutput, err)
+		}
+		fmt.Println("Manifests of the API are generated at " + genManifestsOutput)
+	},
+}
+
+func init() {
+	GenCmd.AddCommand(genManifestsCmd)
+	genManifestsCmd.Flags().StringVarP(&genManifestsApiName, "name", "n", "", "Name of the API")
+	genManifestsCmd.Flags().StringVarP(&genManifestsFilePath, "file", "f", "",
+		"Path to swagger, zip file or API Project")
+	genManifestsCmd.Flags().StringVar(&genManifestsNamespace, "namespace", "", "namespace of API")
+	genManifestsCmd.Flags().StringVar(&genManifestsParamsFile, "params", "", "Path to the params file of the API")
+	genManifestsCmd.Flags().StringVar(&genManifestsCertsDir, "certs", "",
+		"Path to the directory with the certificates of the API")
+	genManifestsCmd.Flags().StringVarP(&genManifestsOutput, "output", "o", "", "File to write the manifests, "+
+		"or the directory to write the Kustomize base. Manifests are printed to the standard output if not provided")
+	genManifestsCmd.Flags().BoolVar(&genManifestsKustomize, "kustomize", false,
+		"Generate a Kustomize base instead of a multi-document YAML")
+	_ = genManifestsCmd.MarkFlagRequired("name")
+	_ = genManifestsCmd.MarkFlagRequired("file")
+}
@@ -17,3 +17,34 @@
  */
 
 package k8s
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const k8sGetCmdLiteral = "get"
+const k8sGetCmdShortDesc = "Display resources in the kubernetes cluster"
+const k8sGetCmdLongDesc = `Display APIs deployed with the API Operator along with their status. Other resources are displayed
+with kubectl`
+
+const k8sGetCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sGetCmdLiteral + ` ` + k8sGetAPIsCmdLiteral + `
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sGetCmdLiteral + ` pods -n wso2`
+
+// GetCmd represents the get command
+var GetCmd = &cobra.Command{
+	Use:     k8sGetCmdLiteral,
+	Short:   k8sGetCmdShortDesc,
+	Long:    k8sGetCmdLongDesc,
+	Example: k8sGetCmdExamples,
+	// flags of other resources are passed to kubectl
+	DisableFlagParsing: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + k8sGetCmdLiteral + " called")
+		if len(args) == 0 || args[0] == "-h" || args[0] == "--help" {
+			_ = cmd.Help()
+			return
+		}
+		ExecuteKubernetes(append([]string{k8sGetCmdLiteral}, args...)...)
+	},
+}
@@ -17,3 +17,45 @@
  */
 
 package k8s
+
+import (
+	"github.com/spf13/cobra"
+	k8sImpl "github.com/wso2/product-apim-tooling/import-export-cli/impl/k8s"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getAPIsCmdFormat string
+var getAPIsCmdAllNamespaces bool
+
+const k8sGetAPIsCmdLiteral = "apis"
+const k8sGetAPIsCmdShortDesc = "Display a list of APIs in the kubernetes cluster"
+const k8sGetAPIsCmdLongDesc = `Display a list of APIs deployed with the API Operator along with their readiness, replicas,
+image, external URL and recent warning events`
+
+const k8sGetAPIsCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sGetCmdLiteral + ` ` + k8sGetAPIsCmdLiteral + `
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sGetCmdLiteral + ` ` + k8sGetAPIsCmdLiteral + ` --namespace=wso2
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + k8sGetCmdLiteral + ` ` + k8sGetAPIsCmdLiteral + ` --all-namespaces --format "{{.Name}}\t{{.URL}}"`
+
+// getAPIsCmd represents the get apis command
+var getAPIsCmd = &cobra.Command{
+	Use:     k8sGetAPIsCmdLiteral,
+	Short:   k8sGetAPIsCmdShortDesc,
+	Long:    k8sGetAPIsCmdLongDesc,
+	Example: k8sGetAPIsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + k8sGetCmdLiteral + " " + k8sGetAPIsCmdLiteral + " called")
+		apis, err := k8sImpl.GetAPIs(getK8sClient(), flagNamespace, getAPIsCmdAllNamespaces)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting APIs", err)
+		}
+		k8sImpl.PrintAPIs(apis, getAPIsCmdFormat)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(getAPIsCmd)
+	getAPIsCmd.Flags().StringVar(&flagNamespace, "namespace", "", "namespace of APIs")
+	getAPIsCmd.Flags().BoolVarP(&getAPIsCmdAllNamespaces, "all-namespaces", "A", false, "List APIs in all namespaces")
+	getAPIsCmd.Flags().StringVarP(&getAPIsCmdFormat, "format", "", k8sImpl.DefaultAPIsTableFormat, "Pretty-print "+
+		"APIs using go templates")
+}
@@ -19,14 +19,22 @@
 package k8s
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/spf13/cobra"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 const K8sInstallCmdLiteral = "install"
 const k8sInstallCmdShortDesc = "Install an operator in the configured K8s cluster"
 const k8sInstallCmdLongDesc = "Install an operator in the configured K8s cluster"
-const k8sInstallCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral
+const k8sInstallCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + `
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` --bundle path/to/api-operator-bundle.tar.gz --dry-run`
+
+// flags
+var flagInstallDryRun bool
 
 // installCmd represents the install command
 var installCmd = &cobra.Command{
@@ -36,7 +44,41 @@ var installCmd = &cobra.Command{
 	Example: k8sInstallCmdExamples,
 }
 
-func init() {
+// setDryRun prints the resources that would be applied instead of applying them, if the flag "dry-run" is given
+func setDryRun(dryRun bool) {
+	if dryRun {
+		k8sUtils.K8sDryRunOut = os.Stdout
+	}
+}
 
+// openOperatorBundle opens the operator bundle in the path and validates the bundle is of the operator
+func openOperatorBundle(path, operator string) *k8sUtils.OperatorBundle {
+	bundle, err := k8sUtils.OpenOperatorBundle(path)
+	if err != nil {
+		utils.HandleErrorAndExit("Error opening operator bundle", err)
+	}
+	if bundle.Operator != operator {
+		bundle.Close()
+		utils.HandleErrorAndExit(fmt.Sprintf("Error opening operator bundle: bundle of the operator \"%s\" "+
+			"is given instead of \"%s\"", bundle.Operator, operator), nil)
+	}
+	utils.Logln(fmt.Sprintf("%sUsing bundle of %s %s", utils.LogPrefixInfo, bundle.Operator, bundle.Version))
+	return bundle
+}
+
+// recordOperatorVersion records the version of the operator installed in the K8s cluster
+func recordOperatorVersion(operator, version string) {
+	if version == "" {
+		// version of an operator installed from local configs is not known
+		return
+	}
+	if err := k8sUtils.SetInstalledOperatorVersion(operator, version); err != nil {
+		utils.HandleErrorAndExit("Error recording version of the "+operator, err)
+	}
+}
+
+func init() {
+	installCmd.PersistentFlags().BoolVar(&flagInstallDryRun, "dry-run", false,
+		"Print the resources that would be created without installing")
 }
 
@@ -29,13 +29,16 @@ import (
 
 const K8sInstallApiOperatorCmdLiteral = "api-operator"
 const k8sInstallApiOperatorCmdShortDesc = "Install API Operator"
-const k8sInstallApiOperatorCmdLongDesc = "Install API Operator in the configured K8s cluster"
+const k8sInstallApiOperatorCmdLongDesc = "Install API Operator in the configured K8s cluster\n\n" +
+	amazonEcrTokenExpiryNote
 const k8sInstallApiOperatorCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + `
 ` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` -f path/to/operator/configs
-` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` -f path/to/operator/config/file.yaml`
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` -f path/to/operator/config/file.yaml
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` --bundle path/to/api-operator-bundle.tar.gz`
 
 // flags
 var flagApiOperatorFile string
+var flagApiOperatorBundle string
 
 // flags for installing api-operator in batch mode
 var flagBmRegistryType string
@@ -54,14 +57,27 @@ var installApiOperatorCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(fmt.Sprintf("%s%s %s called", utils.LogPrefixInfo, K8sInstallCmdLiteral, K8sInstallApiOperatorCmdLiteral))
 
+		if flagApiOperatorFile != "" && flagApiOperatorBundle != "" {
+			utils.HandleErrorAndExit("Only one of the flags \"from-file\" and \"bundle\" should be given", nil)
+		}
+		setDryRun(flagInstallDryRun)
+
 		// is -f or --from-file flag specified
 		isLocalInstallation := flagApiOperatorFile != ""
 		configFile := flagApiOperatorFile
+		operatorVersion := ""
 
 		// check version before getting inputs (in interactive mode)
-		if !isLocalInstallation {
+		if flagApiOperatorBundle != "" {
+			// installing from the bundle in air-gapped clusters
+			bundle := openOperatorBundle(flagApiOperatorBundle, k8sUtils.ApiOperator)
+			defer bundle.Close()
+			configFile = bundle.ConfigsPath()
+			operatorVersion = bundle.Version
+		} else if !isLocalInstallation {
 			// getting API Operator version
-			operatorVersion, err := k8sUtils.GetVersion(
+			var err error
+			operatorVersion, err = k8sUtils.GetVersion(
 				"API Operator",
 				k8sUtils.ApiOperatorVersionEnvVariable,
 				k8sUtils.DefaultApiOperatorVersion,
@@ -95,7 +111,11 @@ var installApiOperatorCmd = &cobra.Command{
 		// otherwise settings configs only
 		k8sUtils.CreateControllerConfigs(configFile, 20, k8sUtils.ApiOpCrdSecurity)
 		registry.UpdateConfigsSecrets()
+		recordOperatorVersion(k8sUtils.ApiOperator, operatorVersion)
 
+		if flagInstallDryRun {
+			return
+		}
 		fmt.Println("[Setting to K8s Mode]")
 		utils.SetToK8sMode()
 	},
@@ -116,10 +136,12 @@ func getGivenFlagsValues() *map[string]registry.FlagValue {
 func init() {
 	installCmd.AddCommand(installApiOperatorCmd)
 	installApiOperatorCmd.Flags().StringVarP(&flagApiOperatorFile, "from-file", "f", "", "Path to API Operator directory")
+	installApiOperatorCmd.Flags().StringVarP(&flagApiOperatorBundle, "bundle", "b", "",
+		"Path to API Operator bundle directory, tar archive or OCI image archive for air-gapped installation")
 
 	// flags for installing api-operator in batch mode
 	// only the flag "registry-type" is required and others are registry specific flags
-	installApiOperatorCmd.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR |GCR | HTTP")
+	installApiOperatorCmd.Flags().StringVarP(&flagBmRegistryType, "registry-type", "R", "", "Registry type: DOCKER_HUB | AMAZON_ECR | GCR | HTTP | HTTPS | QUAY | ACR | GHCR | HARBOR | DOCKER_CONFIG")
 	installApiOperatorCmd.Flags().StringVarP(&flagBmRepository, k8sUtils.FlagBmRepository, "r", "", "Repository name or URI")
 	installApiOperatorCmd.Flags().StringVarP(&flagBmUsername, k8sUtils.FlagBmUsername, "u", "", "Username of the repository")
 	installApiOperatorCmd.Flags().StringVarP(&flagBmPassword, k8sUtils.FlagBmPassword, "p", "", "Password of the given user")
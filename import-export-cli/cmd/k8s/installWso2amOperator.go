@@ -32,10 +32,12 @@ const k8sInstallWso2amOperatorCmdShortDesc = "Install WSO2AM Operator"
 const k8sInstallWso2amOperatorCmdLongDesc = "Install WSO2AM Operator in the configured K8s cluster"
 const k8sInstallWso2amOperatorCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallWso2amOperatorCmdLiteral + `
 ` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallWso2amOperatorCmdLiteral + ` -f path/to/operator/configs
-` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallWso2amOperatorCmdLiteral + ` -f path/to/operator/config/file.yaml`
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallWso2amOperatorCmdLiteral + ` -f path/to/operator/config/file.yaml
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sInstallCmdLiteral + ` ` + K8sInstallWso2amOperatorCmdLiteral + ` --bundle path/to/wso2am-operator-bundle.tar.gz`
 
 // flags
 var flagWso2AmOperatorFile string
+var flagWso2AmOperatorBundle string
 
 // installWso2amOperatorCmd represents the 'install wso2am-operator' command
 var installWso2amOperatorCmd = &cobra.Command{
@@ -46,13 +48,26 @@ var installWso2amOperatorCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(fmt.Sprintf("%s%s %s called", utils.LogPrefixInfo, K8sInstallCmdLiteral, K8sInstallWso2amOperatorCmdLiteral))
 
+		if flagWso2AmOperatorFile != "" && flagWso2AmOperatorBundle != "" {
+			utils.HandleErrorAndExit("Only one of the flags \"from-file\" and \"bundle\" should be given", nil)
+		}
+		setDryRun(flagInstallDryRun)
+
 		// is -f or --from-file flag specified
 		isLocalInstallation := flagWso2AmOperatorFile != ""
 		configFile := flagWso2AmOperatorFile
+		operatorVersion := ""
 
-		if !isLocalInstallation {
+		if flagWso2AmOperatorBundle != "" {
+			// installing from the bundle in air-gapped clusters
+			bundle := openOperatorBundle(flagWso2AmOperatorBundle, k8sUtils.Wso2amOperator)
+			defer bundle.Close()
+			configFile = bundle.ConfigsPath()
+			operatorVersion = bundle.Version
+		} else if !isLocalInstallation {
 			// getting API Operator version
-			operatorVersion, err := k8sUtils.GetVersion(
+			var err error
+			operatorVersion, err = k8sUtils.GetVersion(
 				"WSO2AM Operator",
 				k8sUtils.Wso2AmOperatorVersionEnvVariable,
 				k8sUtils.DefaultWso2AmOperatorVersion,
@@ -68,7 +83,11 @@ var installWso2amOperatorCmd = &cobra.Command{
 		// installing operator and configs if -f flag given
 		// otherwise settings configs only
 		k8sUtils.CreateControllerConfigs(configFile, 20, k8sUtils.Wso2amOpCrdApimanager)
+		recordOperatorVersion(k8sUtils.Wso2amOperator, operatorVersion)
 
+		if flagInstallDryRun {
+			return
+		}
 		fmt.Println("[Setting to K8s Mode]")
 		utils.SetToK8sMode()
 	},
@@ -77,5 +96,7 @@ var installWso2amOperatorCmd = &cobra.Command{
 func init() {
 	installCmd.AddCommand(installWso2amOperatorCmd)
 	installWso2amOperatorCmd.Flags().StringVarP(&flagWso2AmOperatorFile, "from-file", "f", "", "Path to wso2am-operator directory")
+	installWso2amOperatorCmd.Flags().StringVarP(&flagWso2AmOperatorBundle, "bundle", "b", "",
+		"Path to wso2am-operator bundle directory, tar archive or OCI image archive for air-gapped installation")
 }
 
@@ -55,6 +55,13 @@ var Cmd = &cobra.Command{
 
 //execute kubernetes commands
 func ExecuteKubernetes(arg ...string) {
+	// use the same kubeconfig and context as the kubernetes client
+	if k8sUtils.KubeContext != "" {
+		arg = append([]string{"--context", k8sUtils.KubeContext}, arg...)
+	}
+	if k8sUtils.KubeConfig != "" {
+		arg = append([]string{"--kubeconfig", k8sUtils.KubeConfig}, arg...)
+	}
 	cmd := exec.Command(
 		k8sUtils.Kubectl,
 		arg...,
@@ -74,4 +81,14 @@ func init() {
 	Cmd.AddCommand(GenCmd)
 	Cmd.AddCommand(DeleteCmd)
 	Cmd.AddCommand(UpdateCmd)
+	Cmd.AddCommand(GetCmd)
+	Cmd.AddCommand(DescribeCmd)
+	Cmd.AddCommand(installCmd)
+	Cmd.AddCommand(uninstallCmd)
+	Cmd.AddCommand(upgradeCmd)
+	Cmd.AddCommand(changeCmd)
+	Cmd.PersistentFlags().StringVar(&k8sUtils.KubeConfig, "kubeconfig", "",
+		"Path to the kubeconfig file, default loading rules of kubectl are used if not specified")
+	Cmd.PersistentFlags().StringVar(&k8sUtils.KubeContext, "kube-context", "",
+		"Name of the kubeconfig context to use, the current context is used if not specified")
 }
@@ -19,6 +19,7 @@
 package k8s
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -70,15 +71,20 @@ var uninstallApiOperatorCmd = &cobra.Command{
 			// deleting the namespace: "wso2-system", will remove all the artifacts and configs
 			fmt.Printf("Removing namespace: %s\nThis operation will take some minutes...\n", k8sUtils.ApiOpWso2Namespace)
 
+			client, err := k8sUtils.GetK8sClient()
+			if err != nil {
+				utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
+			}
+			ctx := context.Background()
 			deleteErrors := []error{
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.Namespace, k8sUtils.ApiOpWso2Namespace),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ClusterRole, k8sUtils.ApiOperator),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ClusterRoleBinding, k8sUtils.ApiOperator),
+				client.Delete(ctx, k8sUtils.Namespace, "", false, k8sUtils.ApiOpWso2Namespace),
+				client.Delete(ctx, k8sUtils.ClusterRole, "", false, k8sUtils.ApiOperator),
+				client.Delete(ctx, k8sUtils.ClusterRoleBinding, "", false, k8sUtils.ApiOperator),
 
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.ApiOpCrdApi),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.ApiOpCrdSecurity),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.ApiOpCrdRateLimiting),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.ApiOpCrdTargetEndpoint),
+				client.Delete(ctx, k8sUtils.CrdKind, "", false, k8sUtils.ApiOpCrdApi),
+				client.Delete(ctx, k8sUtils.CrdKind, "", false, k8sUtils.ApiOpCrdSecurity),
+				client.Delete(ctx, k8sUtils.CrdKind, "", false, k8sUtils.ApiOpCrdRateLimiting),
+				client.Delete(ctx, k8sUtils.CrdKind, "", false, k8sUtils.ApiOpCrdTargetEndpoint),
 			}
 
 			for _, err := range deleteErrors {
@@ -19,6 +19,7 @@
 package k8s
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -71,11 +72,16 @@ var uninstallWso2amOperatorCmd = &cobra.Command{
 			// deleting the namespace: "wso2-system", will remove all the artifacts and configs
 			fmt.Printf("Removing namespace: %s\nThis operation will take some minutes...\n", k8sUtils.ApiOpWso2Namespace)
 
+			client, err := k8sUtils.GetK8sClient()
+			if err != nil {
+				utils.HandleErrorAndExit("Error connecting to the kubernetes cluster", err)
+			}
+			ctx := context.Background()
 			deleteErrors := []error{
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.Namespace, k8sUtils.ApiOpWso2Namespace),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ClusterRole, k8sUtils.Wso2amRole),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.ClusterRoleBinding, k8sUtils.Wso2amRoleBinding),
-				k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sDelete, k8sUtils.CrdKind, k8sUtils.Wso2amOpCrdApimanager),
+				client.Delete(ctx, k8sUtils.Namespace, "", false, k8sUtils.ApiOpWso2Namespace),
+				client.Delete(ctx, k8sUtils.ClusterRole, "", false, k8sUtils.Wso2amRole),
+				client.Delete(ctx, k8sUtils.ClusterRoleBinding, "", false, k8sUtils.Wso2amRoleBinding),
+				client.Delete(ctx, k8sUtils.CrdKind, "", false, k8sUtils.Wso2amOpCrdApimanager),
 			}
 
 			for _, err := range deleteErrors {
@@ -19,7 +19,7 @@
 package k8s
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
@@ -27,6 +27,8 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	wso2v1alpha2 "github.com/wso2/k8s-api-operator/api-operator/pkg/apis/wso2/v1alpha2"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 const K8sUpdateCmdLiteral = "update"
@@ -63,10 +65,11 @@ var updateApiCmd = &cobra.Command{
 func handleUpdateApi() {
 	var errMsg string
 	flagApiName = strings.ToLower(flagApiName)
-	getApiCr, getApiErr:= k8sUtils.GetCommandOutput(
-		k8sUtils.Kubectl, k8sUtils.K8sGet, k8sUtils.ApiOpCrdApi, flagApiName, "-n", flagNamespace, "-o", "json")
+	apiCrObj, getApiErr := getK8sClient().Get(context.Background(), k8sUtils.ApiOpCrdApi, flagNamespace, flagApiName)
 	if getApiErr != nil {
-
+		if !k8sUtils.IsK8sNotFound(getApiErr) {
+			utils.HandleErrorAndExit("Error getting the API", getApiErr)
+		}
 		if flagNamespace != "" {
 			errMsg = fmt.Sprintf("Could not find the API \"%s\" in the namespace \"%s\"",
 				flagApiName, flagNamespace)
@@ -75,13 +78,15 @@ func handleUpdateApi() {
 		}
 		utils.HandleErrorAndExit(errMsg, nil)
 	}
-	var apiCr map[string]interface{}
-	_ = json.Unmarshal([]byte(getApiCr), &apiCr)
-	swaggerCmName := apiCr["spec"].(map[string]interface{})["swaggerConfigMapName"].(string)
+	var apiCr wso2v1alpha2.API
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(apiCrObj.Object, &apiCr); err != nil {
+		utils.HandleErrorAndExit("Error reading the API", err)
+	}
 	timestampSuffix := fmt.Sprint(time.Now().Unix())
 	handleAddApi("-" + strings.ToLower(timestampSuffix))
-	deleteApiErr := k8sUtils.ExecuteCommand(
-		k8sUtils.Kubectl, k8sUtils.K8sDelete, "cm", swaggerCmName, "-n", flagNamespace)
+
+	// delete the configs of the previous version of the API
+	deleteApiErr := deleteK8sResources(k8sUtils.ConfigMaps, apiCr.Spec.SwaggerConfigMapName)
 	if deleteApiErr != nil {
 		if flagNamespace != "" {
 			errMsg = fmt.Sprintf("Could not find the config map \"%s\" in the namespace \"%s\"",
@@ -91,7 +96,16 @@ func handleUpdateApi() {
 		}
 		utils.HandleErrorAndExit(errMsg, nil)
 	}
-
+	if apiCr.Spec.ParamsValues != "" {
+		if err := deleteK8sResources(k8sUtils.ConfigMaps, apiCr.Spec.ParamsValues); err != nil {
+			utils.HandleErrorAndExit("Error deleting the params configmap of the previous version of the API", err)
+		}
+	}
+	if apiCr.Spec.CertsValues != "" {
+		if err := deleteK8sResources(k8sUtils.Secrets, apiCr.Spec.CertsValues); err != nil {
+			utils.HandleErrorAndExit("Error deleting the certificates of the previous version of the API", err)
+		}
+	}
 }
 
 func init() {
@@ -100,6 +114,8 @@ func init() {
 	updateApiCmd.Flags().StringVarP(&flagSwaggerFilePath, "file", "f", "",
 		"Path to swagger, zip file or API project")
 	updateApiCmd.Flags().StringVar(&flagNamespace, "namespace", "", "namespace of API")
+	updateApiCmd.Flags().StringVar(&flagParamsPath, "params", "",
+		"Path to the API params file or a deployment directory with params and certificates")
 	_ = updateApiCmd.MarkFlagRequired("name")
 	_ = updateApiCmd.MarkFlagRequired("file")
 }
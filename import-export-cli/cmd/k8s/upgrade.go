@@ -0,0 +1,117 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const K8sUpgradeCmdLiteral = "upgrade"
+const k8sUpgradeCmdShortDesc = "Upgrade an operator in the configured K8s cluster"
+const k8sUpgradeCmdLongDesc = "Upgrade an operator in the configured K8s cluster to the given version or the " +
+	"version of the given bundle, applying the migrations in the bundle between the installed and the target versions"
+const k8sUpgradeCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sUpgradeCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + `
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sUpgradeCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` --bundle path/to/api-operator-bundle.tar.gz --dry-run`
+
+// flags
+var flagUpgradeDryRun bool
+
+// upgradeCmd represents the upgrade command
+var upgradeCmd = &cobra.Command{
+	Use:     K8sUpgradeCmdLiteral,
+	Short:   k8sUpgradeCmdShortDesc,
+	Long:    k8sUpgradeCmdLongDesc,
+	Example: k8sUpgradeCmdExamples,
+}
+
+// operatorUpgrade represents an operator to be upgraded
+type operatorUpgrade struct {
+	operator           string                 // operator name, e.g. "api-operator"
+	displayName        string                 // operator name to display, e.g. "API Operator"
+	bundlePath         string                 // path to the bundle, the operator configs are downloaded if empty
+	configsUrlTemplate string                 // URL template of the operator configs of a version
+	onlineVersion      func() (string, error) // target version if the bundle is not given
+	resourceTypes      []string               // CRDs to wait before applying other operator configs
+}
+
+// upgradeOperator upgrades the operator to the target version and returns false if the operator is up to date
+func upgradeOperator(upgrade operatorUpgrade) bool {
+	setDryRun(flagUpgradeDryRun)
+
+	installedVersion, err := k8sUtils.GetInstalledOperatorVersion(upgrade.operator)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting installed version of the "+upgrade.displayName, err)
+	}
+	if installedVersion == "" {
+		utils.HandleErrorAndExit(fmt.Sprintf("%s is not installed, install it with \"%s %s %s %s\"",
+			upgrade.displayName, utils.ProjectName, K8sCmdLiteral, K8sInstallCmdLiteral, upgrade.operator), nil)
+	}
+
+	var bundle *k8sUtils.OperatorBundle
+	var targetVersion, configFile string
+	if upgrade.bundlePath != "" {
+		bundle = openOperatorBundle(upgrade.bundlePath, upgrade.operator)
+		defer bundle.Close()
+		targetVersion = bundle.Version
+		configFile = bundle.ConfigsPath()
+	} else {
+		if targetVersion, err = upgrade.onlineVersion(); err != nil {
+			utils.HandleErrorAndExit("Error in "+upgrade.displayName+" version", err)
+		}
+		configFile = fmt.Sprintf(upgrade.configsUrlTemplate, targetVersion)
+	}
+
+	diff := k8sUtils.CompareVersions(targetVersion, installedVersion)
+	if diff == 0 {
+		fmt.Printf("%s is up to date with the version %s\n", upgrade.displayName, installedVersion)
+		return false
+	}
+	if diff < 0 {
+		utils.HandleErrorAndExit(fmt.Sprintf("Downgrading %s from %s to %s is not supported",
+			upgrade.displayName, installedVersion, targetVersion), nil)
+	}
+
+	var migrations []string
+	if bundle != nil {
+		if migrations, err = bundle.MigrationPaths(installedVersion, targetVersion); err != nil {
+			utils.HandleErrorAndExit("Error reading migrations of the operator bundle", err)
+		}
+	}
+	fmt.Printf("Upgrading %s from %s to %s\n", upgrade.displayName, installedVersion, targetVersion)
+
+	k8sUtils.CreateControllerConfigs(configFile, 20, upgrade.resourceTypes...)
+	for _, migration := range migrations {
+		fmt.Printf("Applying migrations of the version %s\n", filepath.Base(migration))
+		if err := k8sUtils.K8sApplyFromFile(migration); err != nil {
+			utils.HandleErrorAndExit("Error applying migrations of the version "+filepath.Base(migration), err)
+		}
+	}
+	recordOperatorVersion(upgrade.operator, targetVersion)
+	return true
+}
+
+func init() {
+	upgradeCmd.PersistentFlags().BoolVar(&flagUpgradeDryRun, "dry-run", false,
+		"Print the resources that would be applied without upgrading")
+}
@@ -0,0 +1,81 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/operator/registry"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const k8sUpgradeApiOperatorCmdShortDesc = "Upgrade API Operator"
+const k8sUpgradeApiOperatorCmdLongDesc = "Upgrade API Operator in the configured K8s cluster keeping the registry " +
+	"configurations. Set the target version with the environment variable \"" +
+	k8sUtils.ApiOperatorVersionEnvVariable + "\" or give a bundle for air-gapped clusters"
+const k8sUpgradeApiOperatorCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sUpgradeCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + `
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sUpgradeCmdLiteral + ` ` + K8sInstallApiOperatorCmdLiteral + ` --bundle path/to/api-operator-bundle.tar.gz`
+
+// flags
+var flagUpgradeApiOperatorBundle string
+
+// upgradeApiOperatorCmd represents the upgrade api-operator command
+var upgradeApiOperatorCmd = &cobra.Command{
+	Use:     K8sInstallApiOperatorCmdLiteral,
+	Short:   k8sUpgradeApiOperatorCmdShortDesc,
+	Long:    k8sUpgradeApiOperatorCmdLongDesc,
+	Example: k8sUpgradeApiOperatorCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(fmt.Sprintf("%s%s %s called", utils.LogPrefixInfo, K8sUpgradeCmdLiteral, K8sInstallApiOperatorCmdLiteral))
+
+		// operator configs override the registry configs in the controller-config
+		registryType, repository, err := registry.GetRegistryConfig()
+		if err != nil && !k8sUtils.IsK8sNotFound(err) {
+			utils.HandleErrorAndExit("Error reading registry configs of API Operator", err)
+		}
+
+		upgraded := upgradeOperator(operatorUpgrade{
+			operator:           k8sUtils.ApiOperator,
+			displayName:        "API Operator",
+			bundlePath:         flagUpgradeApiOperatorBundle,
+			configsUrlTemplate: k8sUtils.ApiOperatorConfigsUrlTemplate,
+			onlineVersion: func() (string, error) {
+				return k8sUtils.GetVersion(
+					"API Operator",
+					k8sUtils.ApiOperatorVersionEnvVariable,
+					k8sUtils.DefaultApiOperatorVersion,
+					k8sUtils.ApiOperatorVersionValidationUrlTemplate,
+					k8sUtils.ApiOperatorFindVersionUrl,
+				)
+			},
+			resourceTypes: []string{k8sUtils.ApiOpCrdSecurity},
+		})
+		if upgraded && registryType != "" {
+			registry.SetRegistryConfig(registryType, repository)
+		}
+	},
+}
+
+func init() {
+	upgradeCmd.AddCommand(upgradeApiOperatorCmd)
+	upgradeApiOperatorCmd.Flags().StringVarP(&flagUpgradeApiOperatorBundle, "bundle", "b", "",
+		"Path to API Operator bundle directory, tar archive or OCI image archive for air-gapped clusters")
+}
@@ -0,0 +1,71 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const k8sUpgradeWso2amOperatorCmdShortDesc = "Upgrade WSO2AM Operator"
+const k8sUpgradeWso2amOperatorCmdLongDesc = "Upgrade WSO2AM Operator in the configured K8s cluster. Set the target " +
+	"version with the environment variable \"" + k8sUtils.Wso2AmOperatorVersionEnvVariable + "\" or give a bundle " +
+	"for air-gapped clusters"
+const k8sUpgradeWso2amOperatorCmdExamples = utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sUpgradeCmdLiteral + ` ` + K8sInstallWso2amOperatorCmdLiteral + `
+` + utils.ProjectName + ` ` + K8sCmdLiteral + ` ` + K8sUpgradeCmdLiteral + ` ` + K8sInstallWso2amOperatorCmdLiteral + ` --bundle path/to/wso2am-operator-bundle.tar.gz`
+
+// flags
+var flagUpgradeWso2amOperatorBundle string
+
+// upgradeWso2amOperatorCmd represents the upgrade wso2am-operator command
+var upgradeWso2amOperatorCmd = &cobra.Command{
+	Use:     K8sInstallWso2amOperatorCmdLiteral,
+	Short:   k8sUpgradeWso2amOperatorCmdShortDesc,
+	Long:    k8sUpgradeWso2amOperatorCmdLongDesc,
+	Example: k8sUpgradeWso2amOperatorCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(fmt.Sprintf("%s%s %s called", utils.LogPrefixInfo, K8sUpgradeCmdLiteral, K8sInstallWso2amOperatorCmdLiteral))
+
+		upgradeOperator(operatorUpgrade{
+			operator:           k8sUtils.Wso2amOperator,
+			displayName:        "WSO2AM Operator",
+			bundlePath:         flagUpgradeWso2amOperatorBundle,
+			configsUrlTemplate: k8sUtils.Wso2AmOperatorConfigsUrlTemplate,
+			onlineVersion: func() (string, error) {
+				return k8sUtils.GetVersion(
+					"WSO2AM Operator",
+					k8sUtils.Wso2AmOperatorVersionEnvVariable,
+					k8sUtils.DefaultWso2AmOperatorVersion,
+					k8sUtils.Wso2AmOperatorVersionValidationUrlTemplate,
+					k8sUtils.Wso2AmOperatorFindVersionUrl,
+				)
+			},
+			resourceTypes: []string{k8sUtils.Wso2amOpCrdApimanager},
+		})
+	},
+}
+
+func init() {
+	upgradeCmd.AddCommand(upgradeWso2amOperatorCmd)
+	upgradeWso2amOperatorCmd.Flags().StringVarP(&flagUpgradeWso2amOperatorBundle, "bundle", "b", "",
+		"Path to wso2am-operator bundle directory, tar archive or OCI image archive for air-gapped clusters")
+}
@@ -91,8 +91,7 @@ var loginCmd = &cobra.Command{
 
 func runLogin(store credentials.Store, environment, username, password string) error {
 	if !utils.APIMExistsInEnv(environment, utils.MainConfigFilePath) {
-		fmt.Println("APIM does not exists in", environment, "Add it using add env")
-		os.Exit(1)
+		utils.HandleMissingEnvAndExit("APIM", environment)
 	}
 
 	if username == "" {
@@ -137,8 +136,7 @@ func GetCredentials(env string) (credentials.Credential, error) {
 	}
 
 	if !utils.APIMExistsInEnv(env, utils.MainConfigFilePath) {
-		fmt.Println("APIM does not exists in", env, "Add it using add env")
-		os.Exit(1)
+		utils.HandleMissingEnvAndExit("APIM", env)
 	}
 
 	// check for creds
@@ -19,6 +19,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -51,8 +52,8 @@ var logoutCmd = &cobra.Command{
 func runLogout(environment string) error {
 	cred, err := GetCredentials(environment)
 	//Get current access token for
-	accessToken, err := credentials.GetOAuthAccessToken(cred, environment)
-	error := credentials.RevokeAccessToken(cred, environment, accessToken)
+	accessToken, err := credentials.GetOAuthAccessToken(context.Background(), cred, environment)
+	error := credentials.RevokeAccessToken(context.Background(), cred, environment, accessToken)
 	if error != nil {
 		return err
 	}
@@ -30,10 +30,15 @@ const (
 )
 const addEnvCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + addCmdLiteral + " " + envCmdLiteral +
 	" prod --adapter https://localhost:9843 " +
+	"\n" + utils.ProjectName + " " + mgCmdLiteral + " " + addCmdLiteral + " " + envCmdLiteral +
+	" prod --adapter https://mg.corp.com:9843 --ca-cert /path/to/ca.pem --proxy http://proxy.corp.com:3128" +
 
 	"\n\nNOTE: The flag --adapter (-a) is mandatory and it has to specify the microgateway adapter" +
 	" url."
 
+// TLS and proxy settings of the environment to be added
+var mgwEnvConnection utils.ConnectionConfig
+
 // addEnvCmd represents the addEnv command
 var AddEnvCmd = &cobra.Command{
 	Use:     envCmdLiteral,
@@ -48,6 +53,7 @@ var AddEnvCmd = &cobra.Command{
 
 		envEndpoints := new(utils.MgwEndpoints)
 		envEndpoints.AdapterEndpoint = mgwAdapterHost + impl.DefaultMgwAdapterEndpointSuffix
+		envEndpoints.Connection = mgwEnvConnection
 		err := impl.AddEnv(envToBeAdded, envEndpoints)
 		if err != nil {
 			utils.HandleErrorAndExit("Error adding environment", err)
@@ -61,5 +67,22 @@ func init() {
 
 	AddEnvCmd.Flags().StringVarP(&mgwAdapterHost, "adapter", "a", "", "The adapter host url with port")
 
+	// TLS and proxy settings
+	AddEnvCmd.Flags().StringVar(&mgwEnvConnection.CACertFile, "ca-cert", "",
+		"PEM CA bundle to trust for the adapter in addition to the certificates in the certs directory")
+	AddEnvCmd.Flags().StringVar(&mgwEnvConnection.ClientCertFile, "client-cert", "",
+		"Client certificate for mutual TLS, PEM or PKCS#12 (.p12 or .pfx)")
+	AddEnvCmd.Flags().StringVar(&mgwEnvConnection.ClientKeyFile, "client-key", "",
+		"PEM private key of the client certificate")
+	AddEnvCmd.Flags().StringVar(&mgwEnvConnection.ClientCertPassword, "client-cert-password", "",
+		"Password of the PKCS#12 client certificate")
+	AddEnvCmd.Flags().StringVar(&mgwEnvConnection.ServerName, "server-name", "",
+		"Server name to verify the certificate of the adapter instead of the host name")
+	AddEnvCmd.Flags().BoolVar(&mgwEnvConnection.Insecure, "insecure-skip-tls-verify", false,
+		"Allow connections to the adapter without verifying the server certificate")
+	AddEnvCmd.Flags().StringVar(&mgwEnvConnection.Proxy, "proxy", "", "HTTP proxy URL to connect to the adapter")
+	AddEnvCmd.Flags().StringVar(&mgwEnvConnection.NoProxy, "no-proxy", "",
+		"Comma separated hosts and domains of the adapter to connect without the proxy")
+
 	_ = AddEnvCmd.MarkFlagRequired("adapter")
 }
@@ -19,7 +19,11 @@
 package mg
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/impl/mg"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -29,6 +33,17 @@ var (
 	deployAPIOverride    bool
 	deployAPIEnv         string
 	deployAPISkipCleanup bool
+	deployAPIParamsFile  string
+
+	deployAPIFromEnv     string
+	deployAPIName        string
+	deployAPIVersion     string
+	deployAPIProvider    string
+	deployAPIRevisionNum string
+	deployAPIGatewayEnvs []string
+	deployAPIVHost       string
+	deployAPITag         string
+	deployAPILabel       string
 )
 
 const (
@@ -40,9 +55,25 @@ const (
 const deployAPICmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " +
 	deployCmdLiteral + " " + apiCmdLiteral + " -e dev " +
 	"-f petstore" +
+	"\n" + utils.ProjectName + " " + mgCmdLiteral + " " +
+	deployCmdLiteral + " " + apiCmdLiteral + " -e dev " +
+	"-f petstore --params api_params.yaml" +
+	"\n" + utils.ProjectName + " " + mgCmdLiteral + " " +
+	deployCmdLiteral + " " + apiCmdLiteral + " -e dev " +
+	"--from-env production -n PizzaShackAPI -v 1.0.0 -r admin" +
+	"\n" + utils.ProjectName + " " + mgCmdLiteral + " " +
+	deployCmdLiteral + " " + apiCmdLiteral + " -e dev " +
+	"--from-env production -n PizzaShackAPI -v 1.0.0 -g Default --vhost www.pizza.com -o" +
+	"\n" + utils.ProjectName + " " + mgCmdLiteral + " " +
+	deployCmdLiteral + " " + apiCmdLiteral + " -e dev " +
+	"--from-env production --tag pizza -o" +
 
-	"\n\nNote: The flags --environment (-e), --file (-f) are mandatory. " +
-	"The user needs to be logged in to use this command."
+	"\n\nNote: The flag --environment (-e) is mandatory. Either --file (-f) or --from-env should be provided. " +
+	"When --from-env is provided, either --name (-n) and --version (-v), or one of --tag and --label should be " +
+	"provided. With --tag or --label, the params file provided with --params is applied to every API and " +
+	"--provider (-r) and --rev are not allowed. Only the endpoints, security, certs and deploymentEnvironments " +
+	"configured for the environment in the params file are applied to the API. The user needs to be logged in to the microgateway and to the API Manager environment " +
+	"(for --from-env) to use this command."
 
 var DeployAPICmd = &cobra.Command{
 	Use:     apiCmdLiteral,
@@ -53,11 +84,103 @@ var DeployAPICmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		tempMap := make(map[string]string)
 
-		impl.DeployAPI(deployAPIEnv, deployAPIDir, tempMap,
-			deployAPISkipCleanup, deployAPIOverride)
+		if deployAPIFromEnv == "" {
+			if deployAPIDir == "" {
+				utils.HandleErrorAndExit("Either --file (-f) or --from-env should be provided", nil)
+			}
+			if err := deployAPIToMgw(deployAPIDir, tempMap); err != nil {
+				utils.HandleErrorAndExit("Error deploying API to microgateway", err)
+			}
+			return
+		}
+		executeDeployAPIFromEnvCmd(tempMap)
 	},
 }
 
+func executeDeployAPIFromEnvCmd(extraParams map[string]string) {
+	if deployAPIDir != "" {
+		utils.HandleErrorAndExit("Only one of --file (-f) and --from-env can be provided", nil)
+	}
+	accessToken, err := getAPIMAccessToken(deployAPIFromEnv)
+	if err != nil {
+		utils.HandleErrorAndExit("Error getting OAuth tokens of "+deployAPIFromEnv, err)
+	}
+
+	if deployAPITag != "" || deployAPILabel != "" {
+		if deployAPIName != "" || deployAPITag != "" && deployAPILabel != "" {
+			utils.HandleErrorAndExit("Only one of --name (-n), --tag and --label can be provided", nil)
+		}
+		if deployAPIProvider != "" || deployAPIRevisionNum != "" {
+			utils.HandleErrorAndExit("Invalid usage", fmt.Errorf("%w: --provider (-r) and --rev can be provided "+
+				"only with --name (-n)", utils.ErrInvalidUsage))
+		}
+		query := "tag:" + deployAPITag
+		if deployAPILabel != "" {
+			query = "label:" + deployAPILabel
+		}
+		apiCount, failedCount, err := impl.PromoteAPIs(accessToken, deployAPIFromEnv, deployAPIEnv, query,
+			deployAPIGatewayEnvs, deployAPIVHost, deployAPIParamsFile, deployAPISkipCleanup, deployAPIOverride)
+		if err != nil {
+			utils.HandleErrorAndExit("Error deploying APIs from "+deployAPIFromEnv, err)
+		}
+		if failedCount == apiCount && failedCount > 0 {
+			utils.HandleErrorAndExit("Error deploying the APIs from "+deployAPIFromEnv, nil)
+		} else if failedCount > 0 {
+			utils.HandleErrorAndExit("Error deploying some of the APIs from "+deployAPIFromEnv, utils.ErrPartialFailure)
+		}
+		return
+	}
+
+	if deployAPIName == "" || deployAPIVersion == "" {
+		utils.HandleErrorAndExit("Both --name (-n) and --version (-v) should be provided with --from-env", nil)
+	}
+	projectPath, cleanupFunc, err := impl.ExportAPIProjectFromEnv(accessToken, deployAPIFromEnv, deployAPIName,
+		deployAPIVersion, deployAPIProvider, deployAPIRevisionNum, deployAPIGatewayEnvs, deployAPIVHost)
+	if err != nil {
+		utils.HandleErrorAndExit("Error exporting API from "+deployAPIFromEnv, err)
+	}
+	err = deployAPIToMgw(projectPath, extraParams)
+	// cleanup before exiting on errors, since deferred functions do not run on exit
+	if !deployAPISkipCleanup {
+		cleanupFunc()
+	}
+	if err != nil {
+		utils.HandleErrorAndExit("Error deploying API to microgateway", err)
+	}
+}
+
+// deployAPIToMgw deploys the API project in the path to the microgateway and prints the result
+func deployAPIToMgw(path string, extraParams map[string]string) error {
+	err := impl.DeployAPI(context.Background(), deployAPIEnv, path, deployAPIParamsFile, extraParams,
+		deployAPISkipCleanup, deployAPIOverride)
+	if err != nil {
+		return err
+	}
+	utils.SetResultData(map[string]interface{}{"environment": deployAPIEnv, "override": deployAPIOverride})
+	if deployAPIOverride {
+		fmt.Println("Successfully deployed/updated the API in microgateway.")
+	} else {
+		fmt.Println("Successfully deployed API to microgateway.")
+	}
+	return nil
+}
+
+// getAPIMAccessToken returns an access token of the API Manager environment the user has logged in
+func getAPIMAccessToken(env string) (string, error) {
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil {
+		return "", err
+	}
+	if !store.HasAPIM(env) {
+		utils.HandleErrorAndExit("Login to APIM in "+env+" using \""+utils.ProjectName+" login "+env+"\"", nil)
+	}
+	cred, err := store.GetAPIMCredentials(env)
+	if err != nil {
+		return "", err
+	}
+	return credentials.GetOAuthAccessToken(context.Background(), cred, env)
+}
+
 func init() {
 	DeployCmd.AddCommand(DeployAPICmd)
 	DeployAPICmd.Flags().StringVarP(&deployAPIDir, "file", "f", "", "Filepath of the apictl project to be deployed")
@@ -65,7 +188,26 @@ func init() {
 	DeployAPICmd.Flags().BoolVarP(&deployAPIOverride, "override", "o", false, "Whether to deploy an API irrespective of its existance. Overrides when exists.")
 	DeployAPICmd.Flags().BoolVarP(&deployAPISkipCleanup, "skip-cleanup", "", false, "Whether to keep "+
 		"all temporary files created during deploy process")
+	DeployAPICmd.Flags().StringVarP(&deployAPIParamsFile, "params", "", "", "Provide a params file "+
+		"or a directory generated using \"gen deployment-dir\" command with the configurations of the microgateway "+
+		"environment")
+	DeployAPICmd.Flags().StringVarP(&deployAPIFromEnv, "from-env", "", "", "API Manager environment to "+
+		"export the API from")
+	DeployAPICmd.Flags().StringVarP(&deployAPIName, "name", "n", "", "Name of the API to be exported from "+
+		"the API Manager environment")
+	DeployAPICmd.Flags().StringVarP(&deployAPIVersion, "version", "v", "", "Version of the API to be exported "+
+		"from the API Manager environment")
+	DeployAPICmd.Flags().StringVarP(&deployAPIProvider, "provider", "r", "", "Provider of the API")
+	DeployAPICmd.Flags().StringVarP(&deployAPIRevisionNum, "rev", "", "", "Revision number of the API to be "+
+		"exported from the API Manager environment")
+	DeployAPICmd.Flags().StringSliceVarP(&deployAPIGatewayEnvs, "gateway-env", "g", []string{}, "Gateway "+
+		"environments to deploy the API exported from the API Manager environment")
+	DeployAPICmd.Flags().StringVarP(&deployAPIVHost, "vhost", "t", "", "Virtual host to deploy the API "+
+		"exported from the API Manager environment")
+	DeployAPICmd.Flags().StringVarP(&deployAPITag, "tag", "", "", "Deploy all the APIs with the tag "+
+		"from the API Manager environment")
+	DeployAPICmd.Flags().StringVarP(&deployAPILabel, "label", "", "", "Deploy all the APIs with the label "+
+		"from the API Manager environment")
 
 	_ = DeployAPICmd.MarkFlagRequired("environment")
-	_ = DeployAPICmd.MarkFlagRequired("file")
 }
@@ -20,6 +20,7 @@ package mg
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -49,4 +50,5 @@ var GetCmd = &cobra.Command{
 // init using Cobra
 func init() {
 	MgCmd.AddCommand(GetCmd)
+	formatter.AddListFlags(GetCmd.PersistentFlags())
 }
@@ -19,6 +19,7 @@
 package mg
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -29,9 +30,10 @@ import (
 )
 
 var (
-	getAPIsQuery string
-	getAPIsLimit string
-	getAPIsEnv   string
+	getAPIsQuery  string
+	getAPIsLimit  string
+	getAPIsEnv    string
+	getAPIsFormat string
 )
 
 const getAPIsCmdShortDesc = "List APIs in Microgateway"
@@ -62,12 +64,13 @@ var GetAPIsCmd = &cobra.Command{
 		queryParams := make(map[string]string)
 		queryParams["limit"] = getAPIsLimit
 		queryParams["query"] = getAPIsQuery
-		total, count, apis, err := mgImpl.GetAPIsList(getAPIsEnv, queryParams)
+		total, count, apis, err := mgImpl.GetAPIsList(context.Background(), getAPIsEnv, queryParams)
 		if err != nil {
 			utils.HandleErrorAndExit("Error while retrieving or processing received APIs", err)
 		}
 		fmt.Fprintf(os.Stderr, "APIs total: %v received: %v\n", total, count)
-		mgImpl.PrintAPIs(apis)
+		utils.SetResultData(apis)
+		mgImpl.PrintAPIs(apis, getAPIsFormat)
 	},
 }
 
@@ -77,6 +80,8 @@ func init() {
 	GetAPIsCmd.Flags().StringVarP(&getAPIsEnv, "environment", "e", "", "Microgateway adapter environment to list APIs from")
 	GetAPIsCmd.Flags().StringVarP(&getAPIsQuery, "query", "q", "", "Query to filter the APIs")
 	GetAPIsCmd.Flags().StringVarP(&getAPIsLimit, "limit", "l", "", "Maximum number of APIs to return")
+	GetAPIsCmd.Flags().StringVarP(&getAPIsFormat, "format", "", "", "Pretty-print APIs using Go Templates "+
+		"or as csv, markdown or wide. Use \"{{ jsonPretty . }}\" to list all fields")
 
 	_ = GetAPIsCmd.MarkFlagRequired("environment")
 }
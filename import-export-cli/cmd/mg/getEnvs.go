@@ -0,0 +1,59 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"github.com/spf13/cobra"
+	mgImpl "github.com/wso2/product-apim-tooling/import-export-cli/impl/mg"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getEnvsCmdFormat string
+
+const (
+	envsCmdLiteral      = "envs"
+	getEnvsCmdShortDesc = "Display the list of Microgateway Adapter environments"
+	getEnvsCmdLongDesc  = "Display a list of Microgateway Adapter environments defined in '" +
+		utils.MainConfigFileName + "' file along with their login states and access token expiry times"
+)
+
+const getEnvsCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + getCmdLiteral + " " + envsCmdLiteral
+
+// GetEnvsCmd represents the mg get envs command
+var GetEnvsCmd = &cobra.Command{
+	Use:     envsCmdLiteral,
+	Short:   getEnvsCmdShortDesc,
+	Long:    getEnvsCmdLongDesc,
+	Example: getEnvsCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + envsCmdLiteral + " called")
+		envs, err := mgImpl.GetMgwAdapterEnvs()
+		if err != nil {
+			utils.HandleErrorAndExit("Error while retrieving Microgateway Adapter environments", err)
+		}
+		utils.SetResultData(envs)
+		mgImpl.PrintMgwAdapterEnvs(envs, getEnvsCmdFormat)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(GetEnvsCmd)
+	GetEnvsCmd.Flags().StringVarP(&getEnvsCmdFormat, "format", "", mgImpl.DefaultEnvsTableFormat, "Pretty-print "+
+		"environments using go templates or as csv, markdown or wide")
+}
@@ -27,13 +27,23 @@ import (
 var loginUsername string
 var loginPassword string
 var loginPasswordStdin bool
+var loginAll bool
+var loginCredentialsFile string
 
 const loginCmdLiteral = "login [environment]"
 const loginCmdShortDesc = "Login to a Microgateway Adapter environment"
-const loginCmdLongDesc = `Login to a Microgateway Adapter environment using username and password`
+const loginCmdLongDesc = `Login to a Microgateway Adapter environment using username and password. ` +
+	`Use --all with --credentials-file to login to all the environments in a file of credentials.`
 const loginCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " login dev -u admin -p admin\n" +
 	utils.ProjectName + " " + mgCmdLiteral + " login dev -u admin\n" +
-	"cat ~/.mypassword | " + utils.ProjectName + " " + mgCmdLiteral + " login dev -u admin --password-stdin"
+	"cat ~/.mypassword | " + utils.ProjectName + " " + mgCmdLiteral + " login dev -u admin --password-stdin\n" +
+	utils.ProjectName + " " + mgCmdLiteral + " login --all --credentials-file mgw-credentials.yaml\n\n" +
+	"Note: The credentials file used with --all should be in the following format. Environment variables " +
+	"in the file are substituted.\n" +
+	"mgw-clusters:\n" +
+	"  dev:\n" +
+	"    username: admin\n" +
+	"    password: ${DEV_PASSWORD}"
 
 // loginCmd represents the login command
 var loginCmd = &cobra.Command{
@@ -41,8 +51,17 @@ var loginCmd = &cobra.Command{
 	Short:   loginCmdShortDesc,
 	Long:    loginCmdLongDesc,
 	Example: loginCmdExamples,
-	Args:    cobra.ExactArgs(1),
+	Args: func(cmd *cobra.Command, args []string) error {
+		if loginAll {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
+		if loginAll {
+			executeLoginAllCmd()
+			return
+		}
 		environment := args[0]
 
 		err := impl.RunLogin(environment, loginUsername, loginPassword,
@@ -53,6 +72,19 @@ var loginCmd = &cobra.Command{
 	},
 }
 
+func executeLoginAllCmd() {
+	if loginCredentialsFile == "" {
+		utils.HandleErrorAndExit("The flag --credentials-file is required with --all", nil)
+	}
+	failedCount, err := impl.LoginToAllMgwAdapters(loginCredentialsFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error occurred while login : ", err)
+	}
+	if failedCount > 0 {
+		utils.HandleErrorAndExit("Error occurred while login to some of the environments", nil)
+	}
+}
+
 // init using Cobra
 func init() {
 	MgCmd.AddCommand(loginCmd)
@@ -60,4 +92,7 @@ func init() {
 	loginCmd.Flags().StringVarP(&loginUsername, "username", "u", "", "Username for login")
 	loginCmd.Flags().StringVarP(&loginPassword, "password", "p", "", "Password for login")
 	loginCmd.Flags().BoolVarP(&loginPasswordStdin, "password-stdin", "", false, "Get password from stdin")
+	loginCmd.Flags().BoolVarP(&loginAll, "all", "", false, "Login to all the environments in the credentials file")
+	loginCmd.Flags().StringVarP(&loginCredentialsFile, "credentials-file", "", "", "File with the "+
+		"credentials of the environments to be used with --all")
 }
@@ -31,7 +31,7 @@ var envToBeRemoved string // name of the environment to be removed
 const (
 	removeEnvCmdShortDesc = "Remove an environment for the Microgateway Adapter(s)"
 	removeEnvCmdLongDesc  = "Remove Environment and its configurations for the Microgateway " +
-		"Adapter(s) from the config file."
+		"Adapter(s) from the config file. Contexts using the environment as the microgateway cluster no longer use it."
 )
 
 const removeEnvCmdExamples = utils.ProjectName + " " + removeCmdLiteral + " " +
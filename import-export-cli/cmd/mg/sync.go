@@ -0,0 +1,95 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"github.com/spf13/cobra"
+	mgImpl "github.com/wso2/product-apim-tooling/import-export-cli/impl/mg"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	syncEnv             string
+	syncSourceDir       string
+	syncParamsPath      string
+	syncUndeployRemoved bool
+	syncVCS             bool
+)
+
+const (
+	syncCmdLiteral   = "sync"
+	syncCmdShortDesc = "Sync the APIs in a directory with Microgateway"
+	syncCmdLongDesc  = "Deploy every API project (apictl project) in a directory to a Microgateway adapter environment. " +
+		"APIs which are not changed since the last sync are skipped and APIs deployed in the adapter but absent " +
+		"in the directory can be undeployed. The added, updated, unchanged and removed APIs are reported."
+)
+
+const syncCmdExamples = utils.ProjectName + " " + mgCmdLiteral + " " + syncCmdLiteral + " -e dev --source apis\n" +
+	utils.ProjectName + " " + mgCmdLiteral + " " + syncCmdLiteral + " -e dev --source apis --undeploy-removed\n" +
+	utils.ProjectName + " " + mgCmdLiteral + " " + syncCmdLiteral + " -e dev --source apis --params mg_params.yaml\n" +
+	utils.ProjectName + " " + mgCmdLiteral + " " + syncCmdLiteral + " -e dev --source apis --vcs" +
+
+	"\n\nNote: The flags --environment (-e), --source are mandatory. " +
+	"If --vcs is provided, the source directory should be inside a git repository initialized with 'vcs init'. " +
+	"The user needs to be logged in to use this command."
+
+// SyncCmd represents the mg sync command
+var SyncCmd = &cobra.Command{
+	Use:     syncCmdLiteral,
+	Short:   syncCmdShortDesc,
+	Long:    syncCmdLongDesc,
+	Example: syncCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + syncCmdLiteral + " called")
+		results, err := mgImpl.SyncAPIs(syncEnv, syncSourceDir, syncParamsPath, syncUndeployRemoved,
+			syncVCS)
+		if err != nil {
+			utils.HandleErrorAndExit("Error syncing APIs with microgateway", err)
+		}
+		utils.SetResultData(results)
+		mgImpl.PrintSyncResult(results)
+		failed := 0
+		for _, result := range results {
+			if result.Status == mgImpl.SyncStatusFailed {
+				failed++
+			}
+		}
+		if failed == len(results) && failed > 0 {
+			utils.HandleErrorAndExit("Error syncing some of the APIs with microgateway", nil)
+		} else if failed > 0 {
+			utils.HandleErrorAndExit("Error syncing some of the APIs with microgateway", utils.ErrPartialFailure)
+		}
+	},
+}
+
+func init() {
+	MgCmd.AddCommand(SyncCmd)
+	SyncCmd.Flags().StringVarP(&syncEnv, "environment", "e", "", "Microgateway adapter environment to sync the APIs with")
+	SyncCmd.Flags().StringVarP(&syncSourceDir, "source", "", "", "Directory containing the apictl projects of the APIs")
+	SyncCmd.Flags().StringVarP(&syncParamsPath, "params", "", "", "Provide a params file or a directory "+
+		"generated using \"gen deployment-dir\" command with the configurations of the microgateway environment, "+
+		"applied to every API")
+	SyncCmd.Flags().BoolVarP(&syncUndeployRemoved, "undeploy-removed", "", false, "Undeploy the APIs "+
+		"which are deployed in the adapter but not available in the source directory")
+	SyncCmd.Flags().BoolVarP(&syncVCS, "vcs", "", false, "Use the changes in the git repository since the "+
+		"last sync to detect the APIs to be updated")
+
+	_ = SyncCmd.MarkFlagRequired("environment")
+	_ = SyncCmd.MarkFlagRequired("source")
+}
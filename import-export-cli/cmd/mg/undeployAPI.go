@@ -19,6 +19,7 @@
 package mg
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -69,10 +70,11 @@ var UndeployAPICmd = &cobra.Command{
 		queryParams["version"] = undeployAPICmdAPIVersion
 		queryParams["vhost"] = undeployAPICmdAPIVHost
 		queryParams["environments"] = strings.Join(undeployAPICmdAPIGatewayEnvs, gatewayNameSeparator)
-		err := mgImpl.UndeployAPI(undeployAPIEnv, queryParams)
+		err := mgImpl.UndeployAPI(context.Background(), undeployAPIEnv, queryParams)
 		if err != nil {
 			utils.HandleErrorAndExit("Error undeploying API", err)
 		}
+		utils.SetResultData(queryParams)
 		fmt.Println("API undeployed from microgateway successfully!")
 	},
 }
@@ -24,9 +24,9 @@ import (
 )
 
 const deleteCmdLiteral = "delete"
-const deleteCmdShortDesc = "Delete users from a Micro Integrator instance"
+const deleteCmdShortDesc = "Delete users, roles or stored messages from a Micro Integrator instance"
 
-const deleteCmdLongDesc = "Delete users from a Micro Integrator instance in the environment specified by the flag (--environment, -e)"
+const deleteCmdLongDesc = "Delete users, roles or stored messages from a Micro Integrator instance in the environment specified by the flag (--environment, -e)"
 
 const deleteCmdExamples = utils.ProjectName + " " + utils.MiCmdLiteral + " " + deleteCmdLiteral + " " + "user" + " capp-tester -e dev"
 
@@ -0,0 +1,84 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var deleteMessagesCmdEnvironment string
+var deleteMessagesCmdForce bool
+
+const deleteMessagesCmdLiteral = "messages [messagestore-name]"
+const deleteMessagesCmdShortDesc = "Purge all the messages in a message store of the Micro Integrator"
+
+const deleteMessagesCmdLongDesc = "Delete all the messages in the message store specified by the command line argument [messagestore-name] from a Micro Integrator in the environment specified by the flag --environment, -e"
+
+var deleteMessagesCmdExamples = "To purge a message store\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + deleteCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(deleteMessagesCmdLiteral) + " TestMessageStore -e dev\n" +
+	"To purge a message store without confirming\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + deleteCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(deleteMessagesCmdLiteral) + " TestMessageStore --force -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var deleteMessagesCmd = &cobra.Command{
+	Use:     deleteMessagesCmdLiteral,
+	Short:   deleteMessagesCmdShortDesc,
+	Long:    deleteMessagesCmdLongDesc,
+	Example: deleteMessagesCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		handleDeleteMessagesCmdArguments(args)
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(deleteMessagesCmd)
+	deleteMessagesCmd.Flags().StringVarP(&deleteMessagesCmdEnvironment, "environment", "e", "", "Environment of the Micro Integrator from which the messages should be deleted")
+	deleteMessagesCmd.Flags().BoolVarP(&deleteMessagesCmdForce, "force", "", false, "Delete the messages without asking for confirmation")
+	deleteMessagesCmd.MarkFlagRequired("environment")
+}
+
+func handleDeleteMessagesCmdArguments(args []string) {
+	printDeleteCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(deleteMessagesCmdLiteral))
+	credentials.HandleMissingCredentials(deleteMessagesCmdEnvironment)
+	if !deleteMessagesCmdForce {
+		confirmation, _ := utils.ReadInputString("All the messages in message store [ "+args[0]+" ] will be deleted. Continue? (y/N)",
+			utils.Default{Value: "N", IsDefault: true}, "", false)
+		if confirmation != "y" && confirmation != "Y" {
+			fmt.Println("Purging message store [ " + args[0] + " ] cancelled")
+			return
+		}
+	}
+	executeDeleteMessages(args[0])
+}
+
+func executeDeleteMessages(messageStoreName string) {
+	resp, err := impl.PurgeMessageStore(deleteMessagesCmdEnvironment, messageStoreName)
+	if err != nil {
+		fmt.Println(utils.LogPrefixError+"deleting messages of message store [ "+messageStoreName+" ]", err)
+	} else {
+		fmt.Println(resp)
+	}
+}
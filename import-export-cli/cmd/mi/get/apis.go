@@ -19,10 +19,13 @@
 package get
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getIntegrationAPICmdEnvironment string
@@ -60,8 +63,9 @@ func handleGetIntegrationAPICmdArguments(args []string) {
 }
 
 func executeListIntegrationAPIs() {
-	apiList, err := impl.GetIntegrationAPIList(getIntegrationAPICmdEnvironment)
+	apiList, err := impl.GetIntegrationAPIList(context.Background(), getIntegrationAPICmdEnvironment)
 	if err == nil {
+		utils.SetResultData(apiList)
 		impl.PrintIntegrationAPIList(apiList, getIntegrationAPICmdFormat)
 	} else {
 		printErrorForArtifactList(artifactAPIs, err)
@@ -69,8 +73,9 @@ func executeListIntegrationAPIs() {
 }
 
 func executeShowIntegrationAPI(apiName string) {
-	integrationAPI, err := impl.GetIntegrationAPI(getIntegrationAPICmdEnvironment, apiName)
+	integrationAPI, err := impl.GetIntegrationAPI(context.Background(), getIntegrationAPICmdEnvironment, apiName)
 	if err == nil {
+		utils.SetResultData(integrationAPI)
 		impl.PrintIntegrationAPIDetails(integrationAPI, getIntegrationAPICmdFormat)
 	} else {
 		printErrorForArtifact(artifactAPIs, apiName, err)
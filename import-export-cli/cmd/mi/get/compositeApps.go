@@ -19,10 +19,13 @@
 package get
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getApplicationCmdEnvironment string
@@ -60,8 +63,9 @@ func handleGetApplicationCmdArguments(args []string) {
 }
 
 func executeListCarbonApps() {
-	appList, err := impl.GetCompositeAppList(getApplicationCmdEnvironment)
+	appList, err := impl.GetCompositeAppList(context.Background(), getApplicationCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(appList)
 		impl.PrintCompositeAppList(appList, getApplicationCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactCompositeApps, err)
@@ -71,6 +75,7 @@ func executeListCarbonApps() {
 func executeShowCarbonApp(appname string) {
 	app, err := impl.GetCompositeApp(getApplicationCmdEnvironment, appname)
 	if err == nil {
+		utils.SetResultData(app)
 		impl.PrintCompositeAppDetails(app, getApplicationCmdFormat)
 	} else {
 		printErrorForArtifact(artifactCompositeApps, appname, err)
@@ -63,6 +63,7 @@ func handleGetConnectorCmdArguments(args []string) {
 func executeListConnectors() {
 	connectorList, err := impl.GetConnectorList(getConnectorCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(connectorList)
 		impl.PrintConnectorList(connectorList, getConnectorCmdFormat)
 	} else {
 		printErrorForArtifactList(getConnectorCmdLiteral, err)
@@ -19,10 +19,13 @@
 package get
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getDataServiceCmdEnvironment string
@@ -60,8 +63,9 @@ func handleGetDataServiceCmdArguments(args []string) {
 }
 
 func executeListDataServices() {
-	dataServiceList, err := impl.GetDataServiceList(getDataServiceCmdEnvironment)
+	dataServiceList, err := impl.GetDataServiceList(context.Background(), getDataServiceCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(dataServiceList)
 		impl.PrintDataServiceList(dataServiceList, getDataServiceCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactDataServices, err)
@@ -71,6 +75,7 @@ func executeListDataServices() {
 func executeShowDataService(dataserviceName string) {
 	dataservice, err := impl.GetDataService(getDataServiceCmdEnvironment, dataserviceName)
 	if err == nil {
+		utils.SetResultData(dataservice)
 		impl.PrintDataServiceDetails(dataservice, getDataServiceCmdFormat)
 	} else {
 		printErrorForArtifact(artifactDataServices, dataserviceName, err)
@@ -19,10 +19,13 @@
 package get
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getEndpointCmdEnvironment string
@@ -60,8 +63,9 @@ func handleGetEndpointCmdArguments(args []string) {
 }
 
 func executeListEndpoints() {
-	epList, err := impl.GetEndpointList(getEndpointCmdEnvironment)
+	epList, err := impl.GetEndpointList(context.Background(), getEndpointCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(epList)
 		impl.PrintEndpointList(epList, getEndpointCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactEndpoints, err)
@@ -71,6 +75,7 @@ func executeListEndpoints() {
 func executeShowEndpoint(epName string) {
 	endpoint, err := impl.GetEndpoint(getEndpointCmdEnvironment, epName)
 	if err == nil {
+		utils.SetResultData(endpoint)
 		impl.PrintEndpointDetails(endpoint, getEndpointCmdFormat)
 	} else {
 		printErrorForArtifact(artifactEndpoints, epName, err)
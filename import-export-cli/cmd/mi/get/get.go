@@ -20,6 +20,7 @@ package get
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -43,3 +44,7 @@ var GetCmd = &cobra.Command{
 		cmd.Help()
 	},
 }
+
+func init() {
+	formatter.AddListFlags(GetCmd.PersistentFlags())
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getInboundEndpointCmdEnvironment string
@@ -62,6 +63,7 @@ func handleGetInboundEndpointCmdArguments(args []string) {
 func executeListInboundEndpoints() {
 	inboundEpList, err := impl.GetInboundEndpointList(getInboundEndpointCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(inboundEpList)
 		impl.PrintInboundEndpointList(inboundEpList, getInboundEndpointCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactInboundEndpoints, err)
@@ -71,6 +73,7 @@ func executeListInboundEndpoints() {
 func executeShowInboundEndpoint(inboundEpName string) {
 	inboundEndpoint, err := impl.GetInboundEndpoint(getInboundEndpointCmdEnvironment, inboundEpName)
 	if err == nil {
+		utils.SetResultData(inboundEndpoint)
 		impl.PrintInboundEndpointDetails(inboundEndpoint, getInboundEndpointCmdFormat)
 	} else {
 		printErrorForArtifact(artifactInboundEndpoints, inboundEpName, err)
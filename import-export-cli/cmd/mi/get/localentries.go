@@ -23,6 +23,7 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getLocalEntryCmdEnvironment string
@@ -62,6 +63,7 @@ func handleGetLocalEntryCmdArguments(args []string) {
 func executeListLocalEntrys() {
 	localEntryList, err := impl.GetLocalEntryList(getLocalEntryCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(localEntryList)
 		impl.PrintLocalEntryList(localEntryList, getLocalEntryCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactLocalEntries, err)
@@ -71,6 +73,7 @@ func executeListLocalEntrys() {
 func executeShowLocalEntry(localEntryName string) {
 	localEntry, err := impl.GetLocalEntry(getLocalEntryCmdEnvironment, localEntryName)
 	if err == nil {
+		utils.SetResultData(localEntry)
 		impl.PrintLocalEntryDetails(localEntry, getLocalEntryCmdFormat)
 	} else {
 		printErrorForArtifact(artifactLocalEntries, localEntryName, err)
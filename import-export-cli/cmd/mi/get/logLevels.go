@@ -65,6 +65,7 @@ func handleGetLogLevelCmdArguments(args []string) {
 func executeShowLogLevel(loggerName string) {
 	LogLevelList, err := impl.GetLoggerInfo(getLogLevelCmdEnvironment, loggerName)
 	if err == nil {
+		utils.SetResultData(LogLevelList)
 		impl.PrintLoggerInfo(LogLevelList, getLogLevelCmdFormat)
 	} else {
 		printErrorForArtifact("logger", loggerName, err)
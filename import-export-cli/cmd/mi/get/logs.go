@@ -81,6 +81,7 @@ func executeListLogFiles() {
 	fileList, err := impl.GetLogFileList(getLogCmdEnvironment)
 	if err == nil {
 		logFileList := impl.FilterOnlyLogFiles(fileList)
+		utils.SetResultData(logFileList)
 		impl.PrintLogFileList(logFileList, getLogCmdFormat)
 	} else {
 		printErrorForArtifactList("log files", err)
@@ -0,0 +1,72 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getMessageCountCmdEnvironment string
+
+const artifactMessageCount = "message count"
+const getMessageCountCmdLiteral = "message-count [messagestore-name]"
+const getMessageCountCmdShortDesc = "Get the number of messages in a message store deployed in a Micro Integrator"
+const getMessageCountCmdLongDesc = "Get the number of messages in the message store specified by the command line argument [messagestore-name] " +
+	"in a Micro Integrator in the environment specified by the flag --environment, -e"
+
+var getMessageCountCmdExamples = "To get the number of messages in a message store\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getMessageCountCmdLiteral) + " TestMessageStore -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var getMessageCountCmd = &cobra.Command{
+	Use:     getMessageCountCmdLiteral,
+	Short:   getMessageCountCmdShortDesc,
+	Long:    getMessageCountCmdLongDesc,
+	Example: getMessageCountCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		handleGetMessageCountCmdArguments(args)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(getMessageCountCmd)
+	setEnvFlag(getMessageCountCmd, &getMessageCountCmdEnvironment)
+}
+
+func handleGetMessageCountCmdArguments(args []string) {
+	printGetCmdVerboseLogForArtifact(miUtils.GetTrimmedCmdLiteral(getMessageCountCmdLiteral))
+	credentials.HandleMissingCredentials(getMessageCountCmdEnvironment)
+	executeGetMessageCount(args[0])
+}
+
+func executeGetMessageCount(messageStoreName string) {
+	count, err := impl.GetMessageStoreMessageCount(getMessageCountCmdEnvironment, messageStoreName)
+	if err == nil {
+		fmt.Println(count)
+	} else {
+		printErrorForArtifact(artifactMessageCount, messageStoreName, err)
+	}
+}
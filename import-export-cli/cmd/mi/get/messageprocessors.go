@@ -23,6 +23,7 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getMessageProcessorCmdEnvironment string
@@ -62,6 +63,7 @@ func handleGetMessageProcessorCmdArguments(args []string) {
 func executeListMessageProcessors() {
 	msgProcessorList, err := impl.GetMessageProcessorList(getMessageProcessorCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(msgProcessorList)
 		impl.PrintMessageProcessorList(msgProcessorList, getMessageProcessorCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactMessageProcessors, err)
@@ -71,6 +73,7 @@ func executeListMessageProcessors() {
 func executeShowMessageProcessor(msgProcessorName string) {
 	msgProcessor, err := impl.GetMessageProcessor(getMessageProcessorCmdEnvironment, msgProcessorName)
 	if err == nil {
+		utils.SetResultData(msgProcessor)
 		impl.PrintMessageProcessorDetails(msgProcessor, getMessageProcessorCmdFormat)
 	} else {
 		printErrorForArtifact(artifactMessageProcessors, msgProcessorName, err)
@@ -0,0 +1,80 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package get
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var getMessagesCmdEnvironment string
+var getMessagesCmdFormat string
+var getMessagesCmdCount int
+
+const artifactMessages = "messages"
+const getMessagesCmdLiteral = "messages [messagestore-name]"
+const getMessagesCmdShortDesc = "Peek at the messages in a message store deployed in a Micro Integrator"
+const getMessagesCmdLongDesc = "Get the first messages in the message store specified by the command line argument [messagestore-name] " +
+	"without removing them from the store, in a Micro Integrator in the environment specified by the flag --environment, -e"
+
+var getMessagesCmdExamples = "To peek at the first 10 messages in a message store\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getMessagesCmdLiteral) + " TestMessageStore -e dev\n" +
+	"To peek at the first 50 messages in a message store\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getMessagesCmdLiteral) + " TestMessageStore --count 50 -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var getMessagesCmd = &cobra.Command{
+	Use:     getMessagesCmdLiteral,
+	Short:   getMessagesCmdShortDesc,
+	Long:    getMessagesCmdLongDesc,
+	Example: getMessagesCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		handleGetMessagesCmdArguments(args)
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(getMessagesCmd)
+	setEnvFlag(getMessagesCmd, &getMessagesCmdEnvironment)
+	setFormatFlag(getMessagesCmd, &getMessagesCmdFormat)
+	getMessagesCmd.Flags().IntVarP(&getMessagesCmdCount, "count", "n", 10, "Maximum number of messages to retrieve")
+}
+
+func handleGetMessagesCmdArguments(args []string) {
+	printGetCmdVerboseLogForArtifact(miUtils.GetTrimmedCmdLiteral(getMessagesCmdLiteral))
+	credentials.HandleMissingCredentials(getMessagesCmdEnvironment)
+	if getMessagesCmdCount <= 0 {
+		utils.HandleErrorAndExit("Invalid value for --count. The count should be a positive number", nil)
+	}
+	executeGetMessages(args[0])
+}
+
+func executeGetMessages(messageStoreName string) {
+	messageList, err := impl.GetMessageStoreMessages(getMessagesCmdEnvironment, messageStoreName, getMessagesCmdCount)
+	if err == nil {
+		utils.SetResultData(messageList)
+		impl.PrintMessageStoreMessages(messageList, getMessagesCmdFormat)
+	} else {
+		printErrorForArtifact(artifactMessages, messageStoreName, err)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getMessageStoreCmdEnvironment string
@@ -62,6 +63,7 @@ func handleGetMessageStoreCmdArguments(args []string) {
 func executeListMessageStores() {
 	messageStoreList, err := impl.GetMessageStoreList(getMessageStoreCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(messageStoreList)
 		impl.PrintMessageStoreList(messageStoreList, getMessageStoreCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactMessageStores, err)
@@ -71,6 +73,7 @@ func executeListMessageStores() {
 func executeShowMessageStore(messageStoreName string) {
 	messageStore, err := impl.GetMessageStore(getMessageStoreCmdEnvironment, messageStoreName)
 	if err == nil {
+		utils.SetResultData(messageStore)
 		impl.PrintMessageStoreDetails(messageStore, getMessageStoreCmdFormat)
 	} else {
 		printErrorForArtifact(artifactMessageStores, messageStoreName, err)
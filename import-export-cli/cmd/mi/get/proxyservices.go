@@ -19,10 +19,13 @@
 package get
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getProxyServiceCmdEnvironment string
@@ -60,8 +63,9 @@ func handleGetProxyServiceCmdArguments(args []string) {
 }
 
 func executeListProxyServices() {
-	proxyList, err := impl.GetProxyServiceList(getProxyServiceCmdEnvironment)
+	proxyList, err := impl.GetProxyServiceList(context.Background(), getProxyServiceCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(proxyList)
 		impl.PrintProxyServiceList(proxyList, getProxyServiceCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactProxyServices, err)
@@ -69,8 +73,9 @@ func executeListProxyServices() {
 }
 
 func executeShowProxyService(proxyName string) {
-	proxyService, err := impl.GetProxyService(getProxyServiceCmdEnvironment, proxyName)
+	proxyService, err := impl.GetProxyService(context.Background(), getProxyServiceCmdEnvironment, proxyName)
 	if err == nil {
+		utils.SetResultData(proxyService)
 		impl.PrintProxyServiceDetails(proxyService, getProxyServiceCmdFormat)
 	} else {
 		printErrorForArtifact(artifactProxyServices, proxyName, err)
@@ -85,6 +85,7 @@ func handleGetRoleCmdArguments(args []string) {
 func executeShowRole(role string) {
 	roleInfo, err := impl.GetRoleInfo(getRoleCmdEnvironment, role, getRoleCmdDomain)
 	if err == nil {
+		utils.SetResultData(roleInfo)
 		impl.PrintRoleDetails(roleInfo, getRoleCmdFormat)
 	} else {
 		printErrorForArtifact("roles", role, err)
@@ -94,6 +95,7 @@ func executeShowRole(role string) {
 func executeListRoles() {
 	roleList, err := impl.GetRoleList(getRoleCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(roleList)
 		impl.PrintRoleList(roleList, getRoleCmdFormat)
 	} else {
 		printErrorForArtifactList("roles", err)
@@ -19,10 +19,13 @@
 package get
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getSequenceCmdEnvironment string
@@ -60,8 +63,9 @@ func handleGetSequenceCmdArguments(args []string) {
 }
 
 func executeListSequences() {
-	sequenceList, err := impl.GetSequenceList(getSequenceCmdEnvironment)
+	sequenceList, err := impl.GetSequenceList(context.Background(), getSequenceCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(sequenceList)
 		impl.PrintSequenceList(sequenceList, getSequenceCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactSequences, err)
@@ -71,6 +75,7 @@ func executeListSequences() {
 func executeShowSequence(sequenceName string) {
 	sequence, err := impl.GetSequence(getSequenceCmdEnvironment, sequenceName)
 	if err == nil {
+		utils.SetResultData(sequence)
 		impl.PrintSequenceDetails(sequence, getSequenceCmdFormat)
 	} else {
 		printErrorForArtifact(artifactSequences, sequenceName, err)
@@ -23,6 +23,7 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var getTaskCmdEnvironment string
@@ -62,6 +63,7 @@ func handleGetTaskCmdArguments(args []string) {
 func executeListTasks() {
 	taskList, err := impl.GetTaskList(getTaskCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(taskList)
 		impl.PrintTaskList(taskList, getTaskCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactTasks, err)
@@ -71,6 +73,7 @@ func executeListTasks() {
 func executeShowTask(taskName string) {
 	task, err := impl.GetTask(getTaskCmdEnvironment, taskName)
 	if err == nil {
+		utils.SetResultData(task)
 		impl.PrintTaskDetails(task, getTaskCmdFormat)
 	} else {
 		printErrorForArtifact(artifactTasks, taskName, err)
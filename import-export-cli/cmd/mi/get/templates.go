@@ -98,6 +98,7 @@ func handleGetTemplateCmdArguments(args []string) {
 func executeListTemplates() {
 	templateList, err := impl.GetTemplateList(getTemplateCmdEnvironment)
 	if err == nil {
+		utils.SetResultData(templateList)
 		impl.PrintTemplateList(templateList, getTemplateCmdFormat)
 	} else {
 		printErrorForArtifactList(artifactTemplates, err)
@@ -107,6 +108,7 @@ func executeListTemplates() {
 func executeGetTemplateByTypeCmd(templateType string) {
 	templateList, err := impl.GetTemplatesByType(getTemplateCmdEnvironment, templateType)
 	if err == nil {
+		utils.SetResultData(templateList)
 		impl.PrintTemplatesByType(templateList, getTemplateCmdFormat)
 	} else {
 		printErrorForArtifact(artifactTemplates, templateType, err)
@@ -117,6 +119,7 @@ func executeGetTemplateByNameCmd(templateType, templateName string) {
 	if templateType == sequenceKey {
 		sequenceTemplate, err := impl.GetSequenceTemplate(getTemplateCmdEnvironment, templateName)
 		if err == nil {
+			utils.SetResultData(sequenceTemplate)
 			impl.PrintSequenceTemplateDetails(sequenceTemplate, getTemplateCmdFormat)
 		} else {
 			printErrorForArtifact(artifactTemplates, templateName, err)
@@ -125,6 +128,7 @@ func executeGetTemplateByNameCmd(templateType, templateName string) {
 	if templateType == endpointKey {
 		endpointTemplate, err := impl.GetEndpointTemplate(getTemplateCmdEnvironment, templateName)
 		if err == nil {
+			utils.SetResultData(endpointTemplate)
 			impl.PrintEndpointTemplateDetails(endpointTemplate, getTemplateCmdFormat)
 		} else {
 			printErrorForArtifact(artifactTemplates, templateName, err)
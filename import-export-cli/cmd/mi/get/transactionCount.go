@@ -82,6 +82,7 @@ func handleGetTransactionCountCmdArguments(args []string) {
 func executeGetTransactionCountForMonth(period ...string) {
 	transactionCount, err := impl.GetTransactionCount(getTransactionCountCmdEnvironment, period)
 	if err == nil {
+		utils.SetResultData(transactionCount)
 		impl.PrintTransactionCount(transactionCount, getTransactionCountCmdFormat)
 	} else {
 		fmt.Println(utils.LogPrefixError+"Retrieving transactions count.", err)
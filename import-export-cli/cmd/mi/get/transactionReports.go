@@ -19,25 +19,36 @@
 package get
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
 	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
-var getTransactionReportCmdEnvironment string
+var getTransactionReportCmdEnvironments []string
 var transactionReportPath string
+var transactionReportGroupBy string
+var transactionReportFormat string
+var transactionReportLastMonth bool
+var transactionReportLastQuarter bool
+var transactionReportLastYear bool
 
 const getTransactionReportCmdLiteral = "transaction-reports [start] [end]"
 
 const getTransactionReportCmdShortDesc = "Generate transaction count summary report"
 const getTransactionReportCmdLongDesc = "Generate the transaction count summary report at the given location for the " +
 	"given period of time.\nIf a location not provided, generate the report in current directory.\nIf an end date " +
-	"not provided, generate the report with values upto current date of the Micro Integrator in the environment specified by the flag --environment, -e"
+	"not provided, generate the report with values upto current date of the Micro Integrator in the environment specified by the flag --environment, -e\n" +
+	"Instead of the [start] and [end] arguments, a period preset (--last-month, --last-quarter, --last-year) can be used.\n" +
+	"When more than one environment is given, or the flags --group-by or --format are used, the transaction counts are " +
+	"aggregated per environment and period, and written as csv, json, yaml or in the Prometheus textfile exporter format"
 
 var getTransactionReportCmdExamples = "Example:\n" +
 	"To generate transaction count report consisting data within a specified time period at a specified location\n" +
@@ -46,14 +57,30 @@ var getTransactionReportCmdExamples = "Example:\n" +
 	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral) + " 2020-01 -p </dir_path> -e dev\n" +
 	"To generate transaction count report at the current location with data between 2020-01 and 2020-05\n" +
 	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral) + " 2020-01 2020-05 -e dev\n" +
-	"NOTE: The [start] argument and the flag (--environment (-e)) is mandatory"
+	"To generate a json report of the last quarter aggregated by month over two environments\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral) + " --last-quarter --group-by month --format json -e dev -e prod\n" +
+	"To generate the yearly transaction counts for the Prometheus node exporter textfile collector\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + GetCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral) + " 2020-01 --group-by year --format prometheus -p </textfile_collector_dir> -e dev\n" +
+	"NOTE: The [start] argument or a period preset, and the flag (--environment (-e)) are mandatory"
 
 var getTransactionReportCmd = &cobra.Command{
 	Use:     getTransactionReportCmdLiteral,
 	Short:   getTransactionReportCmdShortDesc,
 	Long:    getTransactionReportCmdLongDesc,
 	Example: getTransactionReportCmdExamples,
-	Args:    cobra.RangeArgs(1, 2),
+	Args: func(cmd *cobra.Command, args []string) error {
+		presetCount := countSelectedTransactionReportPresets()
+		if presetCount > 1 {
+			return errors.New("only one of the flags --last-month, --last-quarter and --last-year can be used")
+		}
+		if presetCount == 1 {
+			if len(args) != 0 {
+				return errors.New("accepts no arg(s) with a period preset, received " + fmt.Sprint(len(args)))
+			}
+			return nil
+		}
+		return cobra.RangeArgs(1, 2)(cmd, args)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		handleGetTransactionReportCmdArguments(args)
 	},
@@ -61,29 +88,111 @@ var getTransactionReportCmd = &cobra.Command{
 
 func init() {
 	GetCmd.AddCommand(getTransactionReportCmd)
-	setEnvFlag(getTransactionReportCmd, &getTransactionReportCmdEnvironment)
+	getTransactionReportCmd.Flags().StringSliceVarP(&getTransactionReportCmdEnvironments, "environment", "e", []string{},
+		"Environment(s) to be searched")
+	getTransactionReportCmd.MarkFlagRequired("environment")
 	getTransactionReportCmd.Flags().StringVarP(&transactionReportPath, "path", "p", "", "destination file location")
+	getTransactionReportCmd.Flags().StringVarP(&transactionReportGroupBy, "group-by", "", "",
+		"Aggregate the transaction counts by month, quarter or year")
+	getTransactionReportCmd.Flags().StringVarP(&transactionReportFormat, "format", "", "",
+		"Format of the aggregated report (csv, json, yaml or prometheus)")
+	getTransactionReportCmd.Flags().BoolVarP(&transactionReportLastMonth, "last-month", "", false,
+		"Generate the report for the previous month")
+	getTransactionReportCmd.Flags().BoolVarP(&transactionReportLastQuarter, "last-quarter", "", false,
+		"Generate the report for the previous quarter")
+	getTransactionReportCmd.Flags().BoolVarP(&transactionReportLastYear, "last-year", "", false,
+		"Generate the report for the previous year")
 }
 
 func handleGetTransactionReportCmdArguments(args []string) {
 	printGetCmdVerboseLogForArtifact(miUtils.GetTrimmedCmdLiteral(getTransactionReportCmdLiteral))
-	credentials.HandleMissingCredentials(getTransactionReportCmdEnvironment)
-	var start = args[0]
-	var end = ""
-	if len(args) == 2 {
-		end = args[1]
+	for _, env := range getTransactionReportCmdEnvironments {
+		credentials.HandleMissingCredentials(env)
 	}
+	period := getTransactionReportPeriod(args)
 	if isEmptyOrCurrentDir(transactionReportPath) {
 		transactionReportPath, _ = os.Getwd()
 	}
-	executeGetTransactionReport(transactionReportPath, start, end)
+	if len(getTransactionReportCmdEnvironments) == 1 && transactionReportGroupBy == "" && transactionReportFormat == "" {
+		executeGetTransactionReport(getTransactionReportCmdEnvironments[0], transactionReportPath, period...)
+	} else {
+		executeGetAggregatedTransactionReport(transactionReportPath, period)
+	}
+}
+
+func countSelectedTransactionReportPresets() int {
+	count := 0
+	for _, selected := range []bool{transactionReportLastMonth, transactionReportLastQuarter, transactionReportLastYear} {
+		if selected {
+			count++
+		}
+	}
+	return count
+}
+
+func getTransactionReportPeriod(args []string) []string {
+	var preset string
+	switch {
+	case transactionReportLastMonth:
+		preset = impl.TransactionReportPresetLastMonth
+	case transactionReportLastQuarter:
+		preset = impl.TransactionReportPresetLastQuarter
+	case transactionReportLastYear:
+		preset = impl.TransactionReportPresetLastYear
+	default:
+		var end = ""
+		if len(args) == 2 {
+			end = args[1]
+		}
+		return []string{args[0], end}
+	}
+	period, err := impl.GetTransactionReportPeriodForPreset(preset, time.Now())
+	if err != nil {
+		utils.HandleErrorAndExit("Error resolving the transaction report period", err)
+	}
+	return period
 }
 
-func executeGetTransactionReport(targetDirectory string, period ...string) {
-	transactionReport, err := impl.GetTransactionReport(getTransactionReportCmdEnvironment, period)
+func executeGetTransactionReport(env, targetDirectory string, period ...string) {
+	transactionReport, err := impl.GetTransactionReport(env, period)
 	if err == nil {
 		impl.WriteTransactionReportAsCSV(transactionReport, targetDirectory)
 	} else {
 		fmt.Println(utils.LogPrefixError+"Retrieving Transaction Reports.", err)
 	}
 }
+
+func executeGetAggregatedTransactionReport(targetDirectory string, period []string) {
+	groupBy := transactionReportGroupBy
+	if groupBy == "" {
+		groupBy = impl.TransactionReportGroupByMonth
+	}
+	if groupBy != impl.TransactionReportGroupByMonth && groupBy != impl.TransactionReportGroupByQuarter &&
+		groupBy != impl.TransactionReportGroupByYear {
+		utils.HandleErrorAndExit("Invalid value for --group-by: "+groupBy+". Expected month, quarter or year", nil)
+	}
+	format := transactionReportFormat
+	if format == "" {
+		format = impl.TransactionReportFormatCSV
+	}
+	if format != impl.TransactionReportFormatCSV && format != impl.TransactionReportFormatJSON &&
+		format != impl.TransactionReportFormatYAML && format != impl.TransactionReportFormatPrometheus {
+		utils.HandleErrorAndExit("Invalid value for --format: "+format+". Expected csv, json, yaml or prometheus", nil)
+	}
+
+	reports := make(map[string]*artifactutils.TransactionCountInfo)
+	for _, env := range getTransactionReportCmdEnvironments {
+		transactionReport, err := impl.GetTransactionReport(env, period)
+		if err != nil {
+			fmt.Println(utils.LogPrefixError+"Retrieving Transaction Reports of environment "+env+".", err)
+			return
+		}
+		reports[env] = transactionReport
+	}
+	summary, err := impl.AggregateTransactionReports(reports, groupBy, period)
+	if err != nil {
+		fmt.Println(utils.LogPrefixError+"Aggregating Transaction Reports.", err)
+		return
+	}
+	impl.WriteTransactionReportSummary(summary, format, targetDirectory)
+}
@@ -99,6 +99,7 @@ func handleGetUserCmdArguments(args []string) {
 func executeShowUser(userID string) {
 	userInfo, err := impl.GetUserInfo(getUserCmdEnvironment, userID, getUserCmdDomain)
 	if err == nil {
+		utils.SetResultData(userInfo)
 		impl.PrintUserDetails(userInfo, getUserCmdFormat)
 	} else {
 		printErrorForArtifact("users", userID, err)
@@ -108,6 +109,7 @@ func executeShowUser(userID string) {
 func executeListUsers() {
 	userList, err := impl.GetUserList(getUserCmdEnvironment, getUserCmdRole, getUserCmdPattern)
 	if err == nil {
+		utils.SetResultData(userList)
 		impl.PrintUserList(userList, getUserCmdFormat)
 	} else {
 		printErrorForArtifactList("users", err)
@@ -42,10 +42,16 @@ func generateGetCmdExamplesForArtifact(resourceType, cmdLiteral, sampleResourceN
 }
 
 func printErrorForArtifact(artifactType, artifactName string, err error) {
+	if utils.OutputFormat != "" {
+		utils.HandleErrorAndExit("Getting Information of "+artifactType+" [ "+artifactName+" ]", err)
+	}
 	fmt.Println(utils.LogPrefixError+"Getting Information of "+artifactType+" [ "+artifactName+" ] ", err)
 }
 
 func printErrorForArtifactList(artifactType string, err error) {
+	if utils.OutputFormat != "" {
+		utils.HandleErrorAndExit("Getting List of "+artifactType, err)
+	}
 	fmt.Println(utils.LogPrefixError+"Getting List of "+artifactType, err)
 }
 
@@ -64,5 +70,5 @@ func setEnvFlag(cmd *cobra.Command, param *string) {
 
 func setFormatFlag(cmd *cobra.Command, param *string) {
 	cmd.Flags().StringVarP(param, "format", "", "",
-		"Pretty-print using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+		"Pretty-print using Go Templates or as csv, markdown or wide. Use \"{{ jsonPretty . }}\" to list all fields")
 }
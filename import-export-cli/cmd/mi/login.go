@@ -19,6 +19,7 @@
 package mi
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -78,7 +79,7 @@ var loginCmd = &cobra.Command{
 			fmt.Println("Error occurred while loading credential store : ", err)
 			os.Exit(1)
 		}
-		err = credentials.RunMILogin(store, environment, loginUsername, loginPassword)
+		err = credentials.RunMILogin(context.Background(), store, environment, loginUsername, loginPassword)
 		if err != nil {
 			fmt.Println("Error occurred while login : ", err)
 			os.Exit(1)
@@ -19,6 +19,7 @@
 package mi
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -40,7 +41,7 @@ var logoutCmd = &cobra.Command{
 	Example: logoutCmdExamples,
 	Args:    cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		err := credentials.RunMILogout(args[0])
+		err := credentials.RunMILogout(context.Background(), args[0])
 		if err != nil {
 			fmt.Println(err.Error())
 			os.Exit(1)
@@ -25,13 +25,17 @@ import (
 	miDeactivateCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/deactivate"
 	miDeleteCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/delete"
 	miGetCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/get"
+	miObservabilityCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/observability"
 	miUpdateCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/update"
+	miUsersCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/users"
+	miVaultCmd "github.com/wso2/product-apim-tooling/import-export-cli/cmd/mi/vault"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 const miCmdShortDesc = "Micro Integrator related commands"
 
-const miCmdLongDesc = `Micro Integrator related commands such as login, logout, get, add, update, delete, activate, deactivate.`
+const miCmdLongDesc = `Micro Integrator related commands such as login, logout, get, add, update, delete, activate, deactivate,
+enable-stats, disable-stats, enable-tracing, disable-tracing, users, vault.`
 
 // MICmd represents the mi command
 var MICmd = &cobra.Command{
@@ -52,4 +56,10 @@ func init() {
 	MICmd.AddCommand(miUpdateCmd.UpdateCmd)
 	MICmd.AddCommand(miActivateCmd.ActivateCmd)
 	MICmd.AddCommand(miDeactivateCmd.DeactivateCmd)
+	MICmd.AddCommand(miObservabilityCmd.EnableStatsCmd)
+	MICmd.AddCommand(miObservabilityCmd.DisableStatsCmd)
+	MICmd.AddCommand(miObservabilityCmd.EnableTracingCmd)
+	MICmd.AddCommand(miObservabilityCmd.DisableTracingCmd)
+	MICmd.AddCommand(miUsersCmd.UsersCmd)
+	MICmd.AddCommand(miVaultCmd.VaultCmd)
 }
@@ -0,0 +1,173 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package observability
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// updateFunc changes the statistics or tracing state of a single artifact
+type updateFunc func(env, artifactType, artifactName string) (interface{}, error)
+
+// cmdFlags holds the flag values of a statistics or tracing command
+type cmdFlags struct {
+	environment      string
+	pattern          string
+	label            string
+	compositeAppName string
+}
+
+func generateCmdShortDesc(action string) string {
+	return capitalize(action) + " of artifacts deployed in a Micro Integrator"
+}
+
+func generateCmdLongDesc(action string) string {
+	return capitalize(action) + " of the artifact of type [artifact-type] specified by the command line argument [artifact-name] " +
+		"deployed in a Micro Integrator in the environment specified by the flag --environment, -e\n" +
+		"Instead of [artifact-name], the flag --pattern, --label or --capp can be used to " + action + " of all the matching artifacts. " +
+		"The labels of an artifact are given as a comma separated list following \"labels:\" in its description, " +
+		"i.e. <description>labels: payments, critical</description>\n" +
+		"Supported artifact types: " + strings.Join(impl.GetStatsAndTracingArtifactTypes(), ", ")
+}
+
+func generateCmdExamples(action, cmdLiteral string) string {
+	return "To " + action + " of a proxy service\n" +
+		"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + cmdLiteral + " " + impl.ArtifactTypeProxyService + " TestProxy -e dev\n" +
+		"To " + action + " of all the APIs whose names start with Order\n" +
+		"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + cmdLiteral + " " + impl.ArtifactTypeAPI + " --pattern 'Order*' -e dev\n" +
+		"To " + action + " of all the endpoints labelled payments\n" +
+		"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + cmdLiteral + " " + impl.ArtifactTypeEndpoint + " --label payments -e dev\n" +
+		"To " + action + " of all the sequences packed in a composite app\n" +
+		"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + cmdLiteral + " " + impl.ArtifactTypeSequence + " --capp SampleServicesCompositeApplication -e dev\n" +
+		"NOTE: The flag (--environment (-e)) is mandatory"
+}
+
+// newCmd creates a command that applies the given update to a single artifact or to a set of artifacts
+func newCmd(cmdLiteral, action string, flags *cmdFlags, update updateFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     cmdLiteral + " [artifact-type] [artifact-name]",
+		Short:   generateCmdShortDesc(action),
+		Long:    generateCmdLongDesc(action),
+		Example: generateCmdExamples(action, cmdLiteral),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if err := cobra.RangeArgs(1, 2)(cmd, args); err != nil {
+				return err
+			}
+			return validateSelectors(flags, args)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			utils.Logln(utils.LogPrefixInfo + cmdLiteral + " called")
+			credentials.HandleMissingCredentials(flags.environment)
+			executeUpdate(action, flags, update, args)
+		},
+	}
+	cmd.Flags().StringVarP(&flags.environment, "environment", "e", "",
+		"Environment of the micro integrator in which the artifacts are deployed")
+	cmd.Flags().StringVarP(&flags.pattern, "pattern", "", "",
+		"Shell file name pattern to select the artifacts by name")
+	cmd.Flags().StringVarP(&flags.label, "label", "", "",
+		"Label to select the artifacts by")
+	cmd.Flags().StringVarP(&flags.compositeAppName, "capp", "", "",
+		"Name of the composite app of which the artifacts should be selected")
+	cmd.MarkFlagRequired("environment")
+	return cmd
+}
+
+// validateSelectors checks that the artifacts are selected by exactly one of the name, pattern, label or composite app
+func validateSelectors(flags *cmdFlags, args []string) error {
+	selectors := 0
+	for _, selector := range []string{flags.pattern, flags.label, flags.compositeAppName} {
+		if selector != "" {
+			selectors++
+		}
+	}
+	if len(args) == 2 {
+		selectors++
+	}
+	if selectors != 1 {
+		return errors.New("exactly one of [artifact-name], --pattern, --label or --capp should be provided")
+	}
+	return nil
+}
+
+func executeUpdate(action string, flags *cmdFlags, update updateFunc, args []string) {
+	artifactType := args[0]
+	var artifactNames []string
+	var err error
+	switch {
+	case len(args) == 2:
+		artifactNames = []string{args[1]}
+	case flags.pattern != "":
+		artifactNames, err = impl.GetArtifactNamesMatchingPattern(flags.environment, artifactType, flags.pattern)
+	case flags.label != "":
+		artifactNames, err = impl.GetArtifactNamesWithLabel(flags.environment, artifactType, flags.label)
+	default:
+		artifactNames, err = impl.GetArtifactNamesOfCompositeApp(flags.environment, artifactType, flags.compositeAppName)
+	}
+	if err != nil {
+		utils.HandleErrorAndExit("Error finding the artifacts of type "+artifactType, err)
+	}
+	if len(artifactNames) == 0 {
+		fmt.Println("No matching artifacts of type " + artifactType + " found")
+		return
+	}
+	if err = updateArtifacts(action, flags.environment, artifactType, artifactNames, update); err != nil {
+		utils.HandleErrorAndExit("Failed to "+action+" of "+artifactType, err)
+	}
+}
+
+// updateArtifacts applies the update to each of the artifacts and prints the result of each. Returns the error of
+// the update if a single artifact is updated, or an error listing the failed artifacts otherwise
+func updateArtifacts(action, env, artifactType string, artifactNames []string, update updateFunc) error {
+	var failedNames []string
+	var lastErr error
+	for _, artifactName := range artifactNames {
+		resp, err := update(env, artifactType, artifactName)
+		if err != nil {
+			failedNames = append(failedNames, artifactName)
+			lastErr = err
+			fmt.Println(utils.LogPrefixError+"Failed to "+action+" of "+artifactType+" [ "+artifactName+" ]", err)
+		} else {
+			fmt.Println(resp)
+		}
+	}
+	if len(artifactNames) > 1 {
+		fmt.Printf("Updated %d of %d artifacts\n", len(artifactNames)-len(failedNames), len(artifactNames))
+	}
+	switch {
+	case len(failedNames) == 0:
+		return nil
+	case len(artifactNames) == 1:
+		return lastErr
+	case len(failedNames) < len(artifactNames):
+		return fmt.Errorf("%w: failed to %s of %s", utils.ErrPartialFailure, action, strings.Join(failedNames, ", "))
+	}
+	return errors.New("failed to " + action + " of " + strings.Join(failedNames, ", "))
+}
+
+func capitalize(text string) string {
+	return strings.ToUpper(text[:1]) + text[1:]
+}
@@ -0,0 +1,80 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package observability
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestValidateSelectors(t *testing.T) {
+	tests := []struct {
+		flags cmdFlags
+		args  []string
+		valid bool
+	}{
+		{args: []string{"api", "Orders"}, valid: true},
+		{flags: cmdFlags{pattern: "Order*"}, args: []string{"api"}, valid: true},
+		{flags: cmdFlags{label: "payments"}, args: []string{"api"}, valid: true},
+		{flags: cmdFlags{compositeAppName: "OrdersCApp"}, args: []string{"api"}, valid: true},
+		{args: []string{"api"}},
+		{flags: cmdFlags{label: "payments"}, args: []string{"api", "Orders"}},
+		{flags: cmdFlags{pattern: "Order*", label: "payments"}, args: []string{"api"}},
+	}
+	for _, test := range tests {
+		err := validateSelectors(&test.flags, test.args)
+		assert.Equal(t, test.valid, err == nil, "%+v %v", test.flags, test.args)
+	}
+}
+
+func TestUpdateArtifacts(t *testing.T) {
+	errUpdate := errors.New("update failed")
+	var updated []string
+	update := func(env, artifactType, artifactName string) (interface{}, error) {
+		assert.Equal(t, "dev", env)
+		assert.Equal(t, "sequence", artifactType)
+		updated = append(updated, artifactName)
+		if artifactName == "Broken" || artifactName == "Missing" {
+			return nil, errUpdate
+		}
+		return "Updated " + artifactName, nil
+	}
+
+	err := updateArtifacts("enable statistics", "dev", "sequence", []string{"OrderIn", "OrderOut"}, update)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"OrderIn", "OrderOut"}, updated)
+
+	// the error of a single artifact is returned as it is
+	err = updateArtifacts("enable statistics", "dev", "sequence", []string{"Broken"}, update)
+	assert.Equal(t, errUpdate, err)
+
+	// failures of some of the artifacts do not stop the update of the rest
+	updated = nil
+	err = updateArtifacts("enable statistics", "dev", "sequence", []string{"Broken", "OrderIn", "Missing"}, update)
+	assert.Equal(t, []string{"Broken", "OrderIn", "Missing"}, updated)
+	assert.True(t, errors.Is(err, utils.ErrPartialFailure))
+	assert.Contains(t, err.Error(), "Broken, Missing")
+
+	err = updateArtifacts("enable statistics", "dev", "sequence", []string{"Broken", "Missing"}, update)
+	assert.False(t, errors.Is(err, utils.ErrPartialFailure))
+	assert.Contains(t, err.Error(), "Broken, Missing")
+}
@@ -0,0 +1,96 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package update
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	miUtils "github.com/wso2/product-apim-tooling/import-export-cli/mi/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var updateMessageProcessorCmdEnvironment string
+var updateMessageProcessorCmdRetryHead bool
+var updateMessageProcessorCmdDropHead bool
+
+const updateMessageProcessorCmdLiteral = "message-processor [messageprocessor-name]"
+const updateMessageProcessorCmdShortDesc = "Retry or drop the head message of a message processor in a Micro Integrator"
+
+const updateMessageProcessorCmdLongDesc = "Retry or drop the first message in the message store of the deactivated message processor " +
+	"specified by the command line argument [messageprocessor-name] in a Micro Integrator in the environment specified by the flag --environment, -e"
+
+var updateMessageProcessorCmdExamples = "To retry the head message of a deactivated message processor\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(updateMessageProcessorCmdLiteral) + " TestMessageProcessor --retry-head -e dev\n" +
+	"To drop the head message of a deactivated message processor\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + updateCmdLiteral + " " + miUtils.GetTrimmedCmdLiteral(updateMessageProcessorCmdLiteral) + " TestMessageProcessor --drop-head -e dev\n" +
+	"NOTE: The flag (--environment (-e)) and one of the flags (--retry-head) or (--drop-head) are mandatory"
+
+var updateMessageProcessorCmd = &cobra.Command{
+	Use:     updateMessageProcessorCmdLiteral,
+	Short:   updateMessageProcessorCmdShortDesc,
+	Long:    updateMessageProcessorCmdLongDesc,
+	Example: updateMessageProcessorCmdExamples,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		handleUpdateMessageProcessorCmdArguments(args)
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(updateMessageProcessorCmd)
+	updateMessageProcessorCmd.Flags().StringVarP(&updateMessageProcessorCmdEnvironment, "environment", "e", "", "Environment of the Micro Integrator in which the message processor is deployed")
+	updateMessageProcessorCmd.Flags().BoolVarP(&updateMessageProcessorCmdRetryHead, "retry-head", "", false, "Dispatch the head message of the message processor once more")
+	updateMessageProcessorCmd.Flags().BoolVarP(&updateMessageProcessorCmdDropHead, "drop-head", "", false, "Remove the head message of the message processor from the message store")
+	updateMessageProcessorCmd.MarkFlagRequired("environment")
+}
+
+func handleUpdateMessageProcessorCmdArguments(args []string) {
+	printUpdateCmdVerboseLog(miUtils.GetTrimmedCmdLiteral(updateMessageProcessorCmdLiteral))
+	if _, err := getHeadMessageHandler(updateMessageProcessorCmdRetryHead, updateMessageProcessorCmdDropHead); err != nil {
+		utils.HandleErrorAndExit("Invalid usage", err)
+	}
+	credentials.HandleMissingCredentials(updateMessageProcessorCmdEnvironment)
+	executeUpdateMessageProcessor(args[0])
+}
+
+// getHeadMessageHandler returns the function handling the head message according to the --retry-head and
+// --drop-head flags, exactly one of which should be set
+func getHeadMessageHandler(retryHead, dropHead bool) (func(env, messageProcessorName string) (interface{}, error), error) {
+	if retryHead == dropHead {
+		return nil, fmt.Errorf("%w: exactly one of the flags --retry-head or --drop-head should be provided",
+			utils.ErrInvalidUsage)
+	}
+	if retryHead {
+		return impl.RetryMessageProcessorHeadMessage, nil
+	}
+	return impl.DropMessageProcessorHeadMessage, nil
+}
+
+func executeUpdateMessageProcessor(messageProcessorName string) {
+	handleHeadMessage, _ := getHeadMessageHandler(updateMessageProcessorCmdRetryHead, updateMessageProcessorCmdDropHead)
+	resp, err := handleHeadMessage(updateMessageProcessorCmdEnvironment, messageProcessorName)
+	if err != nil {
+		fmt.Println(utils.LogPrefixError+"updating head message of message processor [ "+messageProcessorName+" ]", err)
+	} else {
+		fmt.Println(resp)
+	}
+}
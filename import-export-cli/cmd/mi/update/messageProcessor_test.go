@@ -0,0 +1,44 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package update
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestGetHeadMessageHandler(t *testing.T) {
+	handler, err := getHeadMessageHandler(true, false)
+	assert.Nil(t, err)
+	assert.Equal(t, reflect.ValueOf(impl.RetryMessageProcessorHeadMessage).Pointer(), reflect.ValueOf(handler).Pointer())
+
+	handler, err = getHeadMessageHandler(false, true)
+	assert.Nil(t, err)
+	assert.Equal(t, reflect.ValueOf(impl.DropMessageProcessorHeadMessage).Pointer(), reflect.ValueOf(handler).Pointer())
+
+	for _, flags := range [][2]bool{{true, true}, {false, false}} {
+		_, err = getHeadMessageHandler(flags[0], flags[1])
+		assert.True(t, errors.Is(err, utils.ErrInvalidUsage))
+	}
+}
@@ -0,0 +1,150 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package users
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var importUsersCmdEnvironment string
+var importUsersCmdFile string
+var importUsersCmdReconcileRoles bool
+var importUsersCmdCreateRoles bool
+var importUsersCmdPasswordsFile string
+var importUsersCmdCipher string
+
+const importUsersCmdLiteral = "import"
+const importUsersCmdShortDesc = "Import users to a Micro Integrator from a CSV or LDIF file"
+
+const importUsersCmdLongDesc = "Create the users listed in a CSV or LDIF file specified by the flag --file, -f in a Micro Integrator in the environment specified by the flag --environment, -e and assign their roles.\n" +
+	"The CSV file should have a header line with the columns username, password, admin, domain and roles, of which only username is mandatory. Multiple roles are separated by semicolons.\n" +
+	"In an LDIF file, the uid (or cn), userPassword, memberOf and role attributes of each entry are used.\n" +
+	"Each user should be listed only once. Roles of users that already exist are updated, and users listed as admins are assigned the admin role. Passwords are generated for new users without one, and written " +
+	"to a properties file encrypted with the key store initialized with '" + utils.ProjectName + " secret init'"
+
+var importUsersCmdExamples = "To import users from a CSV file\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + usersCmdLiteral + " " + importUsersCmdLiteral + " -f users.csv -e dev\n" +
+	"To import users from an LDIF file, create missing roles and revoke roles not listed in the file\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + usersCmdLiteral + " " + importUsersCmdLiteral + " -f users.ldif --create-roles --reconcile-roles -e dev\n" +
+	"NOTE: The flags (--file (-f)) and (--environment (-e)) are mandatory"
+
+var importUsersCmd = &cobra.Command{
+	Use:     importUsersCmdLiteral,
+	Short:   importUsersCmdShortDesc,
+	Long:    importUsersCmdLongDesc,
+	Example: importUsersCmdExamples,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleImportUsersCmdArguments()
+	},
+}
+
+func init() {
+	UsersCmd.AddCommand(importUsersCmd)
+	importUsersCmd.Flags().StringVarP(&importUsersCmdEnvironment, "environment", "e", "", "Environment of the micro integrator to which the users should be imported")
+	importUsersCmd.Flags().StringVarP(&importUsersCmdFile, "file", "f", "", "Path to the CSV or LDIF file with the users")
+	importUsersCmd.Flags().BoolVarP(&importUsersCmdReconcileRoles, "reconcile-roles", "", false, "Revoke the roles of existing users that are not listed in the file")
+	importUsersCmd.Flags().BoolVarP(&importUsersCmdCreateRoles, "create-roles", "", false, "Create the roles listed in the file that do not exist")
+	importUsersCmd.Flags().StringVarP(&importUsersCmdPasswordsFile, "passwords-file", "", "generated-passwords.properties", "Path to the file to which the encrypted generated passwords should be written")
+	importUsersCmd.Flags().StringVarP(&importUsersCmdCipher, "cipher", "c", "RSA/ECB/OAEPWithSHA1AndMGF1Padding", "Algorithm to encrypt the generated passwords")
+	importUsersCmd.MarkFlagRequired("environment")
+	importUsersCmd.MarkFlagRequired("file")
+}
+
+func handleImportUsersCmdArguments() {
+	printUsersCmdVerboseLog(importUsersCmdLiteral)
+	credentials.HandleMissingCredentials(importUsersCmdEnvironment)
+	if !(utils.IsOAEPEncryption(importUsersCmdCipher) || utils.IsPKCS1Encryption(importUsersCmdCipher)) {
+		utils.HandleErrorAndExit("Invalid flag", errors.New("Accepts RSA/ECB/OAEPWithSHA1AndMGF1Padding or RSA/ECB/PKCS1Padding as encryption algorithms (-c)"))
+	}
+	users, err := impl.ReadUsersFromFile(importUsersCmdFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading users from "+importUsersCmdFile, err)
+	}
+	var keyStoreConfig *utils.KeyStoreConfig
+	if requiresGeneratedPasswords(users) {
+		// fail before changing any user if the generated passwords cannot be stored
+		keyStoreConfig, err = utils.GetKeyStoreConfigFromFile(utils.GetKeyStoreConfigFilePath())
+		if err != nil {
+			utils.HandleErrorAndExit("Key Store has not been initialized. It is required to encrypt the generated passwords.", err)
+		}
+	}
+	executeImportUsers(users, keyStoreConfig)
+}
+
+func requiresGeneratedPasswords(users []impl.UserImportEntry) bool {
+	for _, user := range users {
+		if user.Password == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func executeImportUsers(users []impl.UserImportEntry, keyStoreConfig *utils.KeyStoreConfig) {
+	result, err := impl.ImportMIUsers(importUsersCmdEnvironment, users, importUsersCmdReconcileRoles, importUsersCmdCreateRoles)
+	if err != nil {
+		utils.HandleErrorAndExit("Error importing users", err)
+	}
+	if len(result.GeneratedPasswords) > 0 {
+		encryptedPasswords, err := utils.EncryptPlainTextSecrets(keyStoreConfig, result.GeneratedPasswords, importUsersCmdCipher)
+		if err != nil {
+			utils.HandleErrorAndExit("Error encrypting the generated passwords", err)
+		}
+		utils.WritePropertiesToFile(encryptedPasswords, importUsersCmdPasswordsFile)
+		fmt.Println("Encrypted passwords of the created users written to", importUsersCmdPasswordsFile)
+	}
+
+	printUserIDs("Created users", result.Created)
+	printUserIDs("Updated users", result.Updated)
+	printUserIDs("Unchanged users", result.Unchanged)
+	if len(result.Failed) > 0 {
+		fmt.Println("Failed users:")
+		failedUserIDs := make([]string, 0, len(result.Failed))
+		for userID := range result.Failed {
+			failedUserIDs = append(failedUserIDs, userID)
+		}
+		sort.Strings(failedUserIDs)
+		for _, userID := range failedUserIDs {
+			fmt.Println(" ", userID+":", result.Failed[userID])
+		}
+		var err error
+		if len(result.Failed) < len(users) {
+			err = utils.ErrPartialFailure
+		}
+		utils.HandleErrorAndExit(fmt.Sprintf("Importing %d of %d users failed", len(result.Failed), len(users)), err)
+	}
+}
+
+func printUserIDs(title string, userIDs []string) {
+	if len(userIDs) == 0 {
+		return
+	}
+	fmt.Println(title + ":")
+	for _, userID := range userIDs {
+		fmt.Println(" ", userID)
+	}
+}
@@ -0,0 +1,47 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package users
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const usersCmdLiteral = "users"
+const usersCmdShortDesc = "Manage users of a Micro Integrator instance in bulk"
+
+const usersCmdLongDesc = "Manage users of a Micro Integrator instance in bulk in the environment specified by the flag (--environment, -e)"
+
+const usersCmdExamples = utils.ProjectName + " " + utils.MiCmdLiteral + " " + usersCmdLiteral + " " + "import" + " -f users.csv -e dev"
+
+// UsersCmd represents the users command
+var UsersCmd = &cobra.Command{
+	Use:     usersCmdLiteral,
+	Short:   usersCmdShortDesc,
+	Long:    usersCmdLongDesc,
+	Example: usersCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + usersCmdLiteral + " called")
+		cmd.Help()
+	},
+}
+
+func printUsersCmdVerboseLog(cmd string) {
+	utils.Logln(utils.LogPrefixInfo + usersCmdLiteral + " " + cmd + " called")
+}
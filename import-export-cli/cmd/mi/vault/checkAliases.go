@@ -0,0 +1,82 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package vault
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var checkAliasesCmdEnvironment string
+var checkAliasesCmdSecretsFile string
+var checkAliasesCmdFormat string
+
+const checkAliasesCmdLiteral = "check-aliases"
+const checkAliasesCmdShortDesc = "Check that the vault aliases used in deployed artifacts resolve"
+
+const checkAliasesCmdLongDesc = "Find the vault lookups in the APIs, proxy services, sequences, endpoints and inbound endpoints deployed in a Micro Integrator in the environment specified by the flag --environment, -e, " +
+	"and check that each secure vault alias is defined in the file specified by the flag --secrets-file.\n" +
+	"The secrets file can be the deployment.toml of the Micro Integrator or a properties file created by '" + utils.ProjectName + " secret create -o file'. Aliases of external vaults are listed without being checked"
+
+var checkAliasesCmdExamples = "To check the aliases against the deployment.toml of the Micro Integrator\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + vaultCmdLiteral + " " + checkAliasesCmdLiteral + " --secrets-file <MI_HOME>/conf/deployment.toml -e dev\n" +
+	"NOTE: The flags (--secrets-file) and (--environment (-e)) are mandatory"
+
+var checkAliasesCmd = &cobra.Command{
+	Use:     checkAliasesCmdLiteral,
+	Short:   checkAliasesCmdShortDesc,
+	Long:    checkAliasesCmdLongDesc,
+	Example: checkAliasesCmdExamples,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleCheckAliasesCmdArguments()
+	},
+}
+
+func init() {
+	VaultCmd.AddCommand(checkAliasesCmd)
+	checkAliasesCmd.Flags().StringVarP(&checkAliasesCmdEnvironment, "environment", "e", "", "Environment of the micro integrator of which the artifacts should be checked")
+	checkAliasesCmd.Flags().StringVarP(&checkAliasesCmdSecretsFile, "secrets-file", "", "", "Path to the deployment.toml or properties file with the secure vault aliases")
+	checkAliasesCmd.Flags().StringVarP(&checkAliasesCmdFormat, "format", "", "", "Pretty-print using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	checkAliasesCmd.MarkFlagRequired("environment")
+	checkAliasesCmd.MarkFlagRequired("secrets-file")
+}
+
+func handleCheckAliasesCmdArguments() {
+	printVaultCmdVerboseLog(checkAliasesCmdLiteral)
+	credentials.HandleMissingCredentials(checkAliasesCmdEnvironment)
+	knownAliases, err := impl.ReadSecureVaultAliases(checkAliasesCmdSecretsFile)
+	if err != nil {
+		utils.HandleErrorAndExit("Error reading the secure vault aliases from "+checkAliasesCmdSecretsFile, err)
+	}
+	usages, err := impl.GetVaultAliasUsages(checkAliasesCmdEnvironment)
+	if err != nil {
+		utils.HandleErrorAndExit("Error finding the vault aliases used in the deployed artifacts", err)
+	}
+	unresolved := impl.ValidateVaultAliases(usages, knownAliases)
+	utils.SetResultData(usages)
+	impl.PrintVaultAliasUsages(usages, checkAliasesCmdFormat)
+	if unresolved > 0 {
+		utils.HandleErrorAndExit(fmt.Sprintf("%d vault alias lookup(s) do not resolve", unresolved), nil)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package vault
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var encryptCmdInputFile string
+var encryptCmdOutputType string
+var encryptCmdCipher string
+
+const encryptCmdLiteral = "encrypt"
+const encryptCmdShortDesc = "Encrypt secrets for the secure vault of a Micro Integrator"
+
+const encryptCmdLongDesc = "Encrypt secrets for the secure vault of a Micro Integrator using the key store initialized with '" + utils.ProjectName + " secret init'.\n" +
+	"The key store should be the one configured as the primary key store of the Micro Integrator"
+
+var encryptCmdExamples = "To encrypt a secret and get the output on the console\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + vaultCmdLiteral + " " + encryptCmdLiteral + "\n" +
+	"To bulk encrypt secrets defined in a properties file and get the output as a .properties file\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + vaultCmdLiteral + " " + encryptCmdLiteral + " -f <file_path> -o file"
+
+var encryptCmd = &cobra.Command{
+	Use:     encryptCmdLiteral,
+	Short:   encryptCmdShortDesc,
+	Long:    encryptCmdLongDesc,
+	Example: encryptCmdExamples,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleEncryptCmdArguments()
+	},
+}
+
+func init() {
+	VaultCmd.AddCommand(encryptCmd)
+	encryptCmd.Flags().StringVarP(&encryptCmdInputFile, "from-file", "f", "", "Path to the properties file which contains secrets to be encrypted")
+	encryptCmd.Flags().StringVarP(&encryptCmdOutputType, "output", "o", "console", "Get the output in yaml (k8) or properties (file) format. By default the output is printed to the console")
+	encryptCmd.Flags().StringVarP(&encryptCmdCipher, "cipher", "c", "RSA/ECB/OAEPWithSHA1AndMGF1Padding", "Encryption algorithm")
+}
+
+func handleEncryptCmdArguments() {
+	printVaultCmdVerboseLog(encryptCmdLiteral)
+	if !(utils.IsOAEPEncryption(encryptCmdCipher) || utils.IsPKCS1Encryption(encryptCmdCipher)) {
+		utils.HandleErrorAndExit("Invalid flag", errors.New("Accepts RSA/ECB/OAEPWithSHA1AndMGF1Padding or RSA/ECB/PKCS1Padding as encryption algorithms (-c)"))
+	}
+	if !(utils.IsConsole(encryptCmdOutputType) || utils.IsFile(encryptCmdOutputType) || utils.IsK8(encryptCmdOutputType)) {
+		utils.HandleErrorAndExit("Invalid flag", errors.New("Accepts k8, file or console as output formats (-o)"))
+	}
+	secretConfig := utils.SecretConfig{
+		OutputType: encryptCmdOutputType,
+		Algorithm:  encryptCmdCipher,
+	}
+	if utils.IsNonEmptyString(encryptCmdInputFile) {
+		secretConfig.InputType = "file"
+		secretConfig.InputFile = encryptCmdInputFile
+	} else {
+		secretConfig.InputType = "console"
+		if err := utils.ReadSecretFromConsole(&secretConfig); err != nil {
+			utils.HandleErrorAndExit("Error reading the secret", err)
+		}
+	}
+	if err := impl.EncryptSecureVaultSecrets(secretConfig); err != nil {
+		utils.HandleErrorAndExit("Error encrypting secrets.", err)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package vault
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var rotateAppRoleCmdEnvironments []string
+var rotateAppRoleCmdRoleID string
+var rotateAppRoleCmdSecretID string
+
+const rotateAppRoleCmdLiteral = "rotate-approle"
+const rotateAppRoleCmdShortDesc = "Rotate the HashiCorp AppRole credentials of Micro Integrators"
+
+const rotateAppRoleCmdLongDesc = "Update both the role ID and the secret ID of the HashiCorp AppRole authentication of the Micro Integrators in the environments specified by the flag --environment, -e.\n" +
+	"Provide the environment of each node of a Micro Integrator cluster, so that all the nodes use the new credentials"
+
+var rotateAppRoleCmdExamples = "To rotate the AppRole credentials of a Micro Integrator\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + vaultCmdLiteral + " " + rotateAppRoleCmdLiteral + " --role-id <role-id> --secret-id <secret-id> -e dev\n" +
+	"To rotate the AppRole credentials of all the nodes of a cluster\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + vaultCmdLiteral + " " + rotateAppRoleCmdLiteral + " --role-id <role-id> --secret-id <secret-id> -e prod-node1,prod-node2\n" +
+	"NOTE: The flags (--role-id), (--secret-id) and (--environment (-e)) are mandatory"
+
+var rotateAppRoleCmd = &cobra.Command{
+	Use:     rotateAppRoleCmdLiteral,
+	Short:   rotateAppRoleCmdShortDesc,
+	Long:    rotateAppRoleCmdLongDesc,
+	Example: rotateAppRoleCmdExamples,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleRotateAppRoleCmdArguments()
+	},
+}
+
+func init() {
+	VaultCmd.AddCommand(rotateAppRoleCmd)
+	rotateAppRoleCmd.Flags().StringSliceVarP(&rotateAppRoleCmdEnvironments, "environment", "e", []string{}, "Environments of the micro integrator nodes of which the AppRole credentials should be rotated")
+	rotateAppRoleCmd.Flags().StringVarP(&rotateAppRoleCmdRoleID, "role-id", "", "", "New role ID of the AppRole")
+	rotateAppRoleCmd.Flags().StringVarP(&rotateAppRoleCmdSecretID, "secret-id", "", "", "New secret ID of the AppRole")
+	rotateAppRoleCmd.MarkFlagRequired("environment")
+	rotateAppRoleCmd.MarkFlagRequired("role-id")
+	rotateAppRoleCmd.MarkFlagRequired("secret-id")
+}
+
+func handleRotateAppRoleCmdArguments() {
+	printVaultCmdVerboseLog(rotateAppRoleCmdLiteral)
+	for _, env := range rotateAppRoleCmdEnvironments {
+		credentials.HandleMissingCredentials(env)
+	}
+	failed := 0
+	for _, env := range rotateAppRoleCmdEnvironments {
+		resp, err := impl.UpdateHashiCorpAppRole(env, rotateAppRoleCmdRoleID, rotateAppRoleCmdSecretID)
+		if err != nil {
+			failed++
+			fmt.Println(utils.LogPrefixError+"rotating AppRole credentials in environment [ "+env+" ]", err)
+		} else {
+			fmt.Println("Rotating AppRole credentials in environment [ "+env+" ] status:", resp)
+		}
+	}
+	if failed > 0 {
+		utils.HandleErrorAndExit(fmt.Sprintf("Rotating AppRole credentials failed in %d of %d environments", failed,
+			len(rotateAppRoleCmdEnvironments)), nil)
+	}
+}
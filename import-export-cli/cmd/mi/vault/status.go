@@ -0,0 +1,70 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package vault
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	impl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var vaultStatusCmdEnvironment string
+var vaultStatusCmdFormat string
+
+const vaultStatusCmdLiteral = "status"
+const vaultStatusCmdShortDesc = "Show the HashiCorp vault configuration of a Micro Integrator"
+
+const vaultStatusCmdLongDesc = "Show the HashiCorp vault configuration of a Micro Integrator in the environment specified by the flag --environment, -e"
+
+var vaultStatusCmdExamples = "To show the HashiCorp vault configuration\n" +
+	"  " + utils.ProjectName + " " + utils.MiCmdLiteral + " " + vaultCmdLiteral + " " + vaultStatusCmdLiteral + " -e dev\n" +
+	"NOTE: The flag (--environment (-e)) is mandatory"
+
+var vaultStatusCmd = &cobra.Command{
+	Use:     vaultStatusCmdLiteral,
+	Short:   vaultStatusCmdShortDesc,
+	Long:    vaultStatusCmdLongDesc,
+	Example: vaultStatusCmdExamples,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		handleVaultStatusCmdArguments()
+	},
+}
+
+func init() {
+	VaultCmd.AddCommand(vaultStatusCmd)
+	vaultStatusCmd.Flags().StringVarP(&vaultStatusCmdEnvironment, "environment", "e", "", "Environment of the micro integrator of which the vault configuration should be shown")
+	vaultStatusCmd.Flags().StringVarP(&vaultStatusCmdFormat, "format", "", "", "Pretty-print using Go Templates. Use \"{{ jsonPretty . }}\" to list all fields")
+	vaultStatusCmd.MarkFlagRequired("environment")
+}
+
+func handleVaultStatusCmdArguments() {
+	printVaultCmdVerboseLog(vaultStatusCmdLiteral)
+	credentials.HandleMissingCredentials(vaultStatusCmdEnvironment)
+	vaultStatus, err := impl.GetHashiCorpVaultStatus(vaultStatusCmdEnvironment)
+	if err != nil {
+		fmt.Println(utils.LogPrefixError+"Getting the HashiCorp vault configuration.", err)
+	} else {
+		utils.SetResultData(vaultStatus)
+		impl.PrintHashiCorpVaultStatus(vaultStatus, vaultStatusCmdFormat)
+	}
+}
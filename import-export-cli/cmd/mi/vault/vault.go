@@ -0,0 +1,48 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package vault
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const vaultCmdLiteral = "vault"
+const vaultCmdShortDesc = "Manage the secret vaults of a Micro Integrator instance"
+
+const vaultCmdLongDesc = "Manage the HashiCorp vault configuration and the secure vault secrets of a Micro Integrator instance in the environment specified by the flag (--environment, -e)"
+
+const vaultCmdExamples = utils.ProjectName + " " + utils.MiCmdLiteral + " " + vaultCmdLiteral + " " + "status" + " -e dev\n" +
+	utils.ProjectName + " " + utils.MiCmdLiteral + " " + vaultCmdLiteral + " " + "check-aliases" + " --secrets-file deployment.toml -e dev"
+
+// VaultCmd represents the vault command
+var VaultCmd = &cobra.Command{
+	Use:     vaultCmdLiteral,
+	Short:   vaultCmdShortDesc,
+	Long:    vaultCmdLongDesc,
+	Example: vaultCmdExamples,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.Logln(utils.LogPrefixInfo + vaultCmdLiteral + " called")
+		cmd.Help()
+	},
+}
+
+func printVaultCmdVerboseLog(cmd string) {
+	utils.Logln(utils.LogPrefixInfo + vaultCmdLiteral + " " + cmd + " called")
+}
@@ -19,11 +19,11 @@
 package cmd
 
 import (
-	"errors"
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -34,7 +34,8 @@ const removeEnvCmdLiteral = "env [environment]"
 const removeEnvCmdLiteralTrimmed = "env"
 const removeEnvCmdShortDesc = "Remove Environment from Config file"
 
-const removeEnvCmdLongDesc = `Remove Environment and its related endpoints from the config file`
+const removeEnvCmdLongDesc = `Remove Environment and its related endpoints from the config file. Contexts using the ` +
+	`environment no longer use it`
 
 const removeEnvCmdExamples = utils.ProjectName + ` ` + removeCmdLiteral + ` ` + removeEnvCmdLiteralTrimmed + ` production`
 
@@ -54,63 +55,12 @@ var removeEnvCmd = &cobra.Command{
 }
 
 func executeRemoveEnvCmd(environment, mainConfigFilePath, envKeysAllFilePath string) {
-	err := removeEnv(environment, mainConfigFilePath, envKeysAllFilePath)
+	err := impl.RemoveEnv(context.Background(), environment, mainConfigFilePath, envKeysAllFilePath)
 	if err != nil {
 		utils.HandleErrorAndExit("Error removing environment", err)
 	}
-}
-
-// removeEnv
-// to be used with 'remove-env' command
-// @param envName : Name of the environment to be removed from the
-// @param mainConfigFilePath : Path to file where env endpoints are stored
-// @param envKeysFilePath : Path to file where env keys are stored
-// @return error
-func removeEnv(envName, mainConfigFilePath, envKeysFilePath string) error {
-	if envName == "" {
-		return errors.New("name of the environment cannot be blank")
-	}
-	if utils.EnvExistsInMainConfigFile(envName, mainConfigFilePath) {
-		var err error
-		if utils.EnvExistsInKeysFile(envName, utils.EnvKeysAllFilePath) {
-			// environment exists in keys file, it has to be cleared first
-			err = utils.RemoveEnvFromKeysFile(envName, envKeysFilePath, mainConfigFilePath)
-			if err != nil {
-				return err
-			}
-		}
-
-		// remove keys also if user has already logged into this environment
-		store, err := credentials.GetDefaultCredentialStore()
-		if store.HasAPIM(envName) {
-			err = runLogout(envName)
-			if err != nil {
-				utils.Logln("Log out is unsuccessful for APIM.", err)
-			}
-		}
-
-		if store.HasMI(envName) {
-			err = credentials.RunMILogout(envName)
-			if err != nil {
-				utils.Logln("Log out is unsuccessful for MI.", err)
-			}
-		}
-
-		// remove env from mainConfig file (endpoints file)
-		err = utils.RemoveEnvFromMainConfigFile(envName, mainConfigFilePath)
-		if err != nil {
-			return err
-		}
-
-	} else {
-		// environment does not exist in mainConfig file (endpoints file). Nothing to remove
-		return errors.New("environment '" + envName + "' not found in " + mainConfigFilePath)
-	}
-
-	fmt.Println("Successfully removed environment '" + envName + "'")
+	fmt.Println("Successfully removed environment '" + environment + "'")
 	fmt.Println("Execute '" + utils.ProjectName + " " + AddCmdLiteral + " " + AddEnvCmdLiteralTrimmed + " --help' to see how to add a new environment")
-
-	return nil
 }
 
 // init using Cobra
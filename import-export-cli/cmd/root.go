@@ -39,11 +39,17 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var verbose bool
+var outputFormat string
 var cfgFile string
+var traceHttp bool
+var harFile string
+var recordDir string
+var includeBinaryBodies bool
 var insecure bool
 var cmdPassword string
 var CmdUsername string
@@ -51,10 +57,39 @@ var CmdExportEnvironment string
 var CmdResourceTenantDomain string
 var CmdForceStartFromBegin bool
 
+// executedCmd is the command found from the arguments, used to apply the defaults of the current context
+var executedCmd *cobra.Command
+
+// globalOutputFlags contains the flags bound to the global --output
+var globalOutputFlags = map[*pflag.Flag]bool{}
+
+// outputFlagsAdded is set once the global --output flag is added to the commands
+var outputFlagsAdded bool
+
+// NoContextAnnotation marks the commands whose flags do not refer to existing environments, e.g. --environment (-e)
+// of the environment to be added, so that they are not taken from the current context
+const NoContextAnnotation = "apictl/no-context"
+
+const outputFlagUsage = "Print the result as a json or yaml document"
+
 // RootCmd related info
 const rootCmdShortDesc = "CLI for Importing and Exporting APIs and Applications and Managing WSO2 Micro Integrator"
 const rootCmdLongDesc = utils.ProjectName + ` is a Command Line Tool for Importing and Exporting APIs and Applications between different environments of WSO2 API Manager
-(Dev, Production, Staging, QA etc.) and Managing WSO2 Micro Integrator`
+(Dev, Production, Staging, QA etc.) and Managing WSO2 Micro Integrator
+
+Use --output (-o) json|yaml with any command to print a single result document to the standard output instead of the
+messages meant for humans, which are then printed to the standard error. -o is not available in the commands which
+already use it for another flag.
+
+Exit codes:
+  0  Success
+  1  Error
+  2  Invalid command, flag or argument
+  3  Resource not found
+  4  Conflict with an existing resource
+  5  Authentication or authorization failure
+  6  Validation failure
+  7  Partial failure`
 
 // This represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
@@ -81,9 +116,41 @@ var RootCmd = &cobra.Command{
 // Execute adds all child commands to the root command sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
+	AddOutputFlags()
+	if c, _, err := RootCmd.Find(os.Args[1:]); err == nil {
+		executedCmd = c
+		utils.SetResultCommand(c.CommandPath())
+	} else {
+		utils.SetResultCommand(RootCmd.CommandPath())
+	}
 	if err := RootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(-1)
+		utils.HandleErrorAndExit("Invalid usage", fmt.Errorf("%w: %v", utils.ErrInvalidUsage, err))
+	}
+	utils.WriteSuccessResult()
+}
+
+// AddOutputFlags adds the global --output flag to the commands which do not own an --output flag with another
+// meaning, e.g. secret create. The flag is added to each command instead of being a persistent flag, since a
+// persistent flag would shadow the flags of those commands. -o is used as its shorthand where it is not taken.
+func AddOutputFlags() {
+	if outputFlagsAdded {
+		return
+	}
+	outputFlagsAdded = true
+	addOutputFlag(RootCmd)
+}
+
+func addOutputFlag(c *cobra.Command) {
+	if c.Flags().Lookup("output") == nil {
+		shorthand := "o"
+		if c.Flags().ShorthandLookup(shorthand) != nil {
+			shorthand = ""
+		}
+		c.Flags().StringVarP(&outputFormat, "output", shorthand, "", outputFlagUsage)
+		globalOutputFlags[c.Flags().Lookup("output")] = true
+	}
+	for _, child := range c.Commands() {
+		addOutputFlag(child)
 	}
 }
 
@@ -97,6 +164,19 @@ func init() {
 	RootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "Enable verbose mode")
 	RootCmd.PersistentFlags().BoolVarP(&insecure, "insecure", "k", false,
 		"Allow connections to SSL endpoints without certs")
+	// read by utils on start up, the flag is defined to be accepted by all the commands
+	RootCmd.PersistentFlags().StringVar(&cfgFile, utils.ConfigDirFlag, "",
+		"Directory to keep the configuration in instead of the home directory (overrides "+utils.ConfigDirEnvVar+")")
+	RootCmd.PersistentFlags().BoolVar(&traceHttp, "trace-http", false,
+		"Print the method, URL, status, time and headers of each HTTP request and response with the credentials "+
+			"redacted")
+	RootCmd.PersistentFlags().StringVar(&harFile, "har", "",
+		"Write the HTTP requests and responses including the bodies to a HAR file with the credentials redacted")
+	RootCmd.PersistentFlags().StringVar(&recordDir, "record", "",
+		"Write the HTTP requests and responses to a directory as replayable fixtures with the credentials redacted")
+	RootCmd.PersistentFlags().BoolVar(&includeBinaryBodies, "include-binary-bodies", false,
+		"Include the binary bodies, such as exported projects, in the HAR file and the fixtures. The credentials in "+
+			"them are not redacted")
 	//RootCmd.PersistentFlags().StringP("author", "a", "", "WSO2")
 
 	//viper.BindPFlag("author", RootCmd.PersistentFlags().Lookup("author"))
@@ -183,6 +263,22 @@ func initConfig() {
 		utils.Insecure = true
 	}
 
+	if traceHttp || harFile != "" || recordDir != "" {
+		err := utils.EnableHttpTrace(utils.HttpTraceConfig{Log: traceHttp, HarFile: harFile, RecordDir: recordDir,
+			IncludeBinaryBodies: includeBinaryBodies, Version: Version})
+		if err != nil {
+			utils.HandleErrorAndExit("Error enabling HTTP tracing", err)
+		}
+	}
+
+	if executedCmd != nil {
+		applyContextDefaults(executedCmd)
+	}
+
+	if err := utils.SetOutputFormat(outputFormat); err != nil {
+		utils.HandleErrorAndExit("Invalid output format", err)
+	}
+
 	/*
 		if cfgFile != "" { // enable ability to specify config file via flag
 			viper.SetConfigFile(cfgFile)
@@ -199,6 +295,56 @@ func initConfig() {
 	*/
 }
 
+// applyContextDefaults sets the flags which were not given in the command line from the current context. The
+// environment is taken from the MI environment, the Microgateway cluster or the APIM environment of the context
+// depending on the command.
+func applyContextDefaults(c *cobra.Command) {
+	if c.Annotations[NoContextAnnotation] != "" {
+		return
+	}
+	envFlag := c.Flags().Lookup("environment")
+	needsEnv := envFlag != nil && !envFlag.Changed
+
+	name, context, err := utils.GetCurrentContext(utils.MainConfigFilePath)
+	if err != nil {
+		if needsEnv {
+			utils.HandleErrorAndExit("Error reading the current context", err)
+		}
+		utils.Logln(utils.LogPrefixWarning+"Ignoring the current context:", err)
+		return
+	}
+	if context == nil {
+		return
+	}
+	utils.Logln(utils.LogPrefixInfo + "Using context '" + name + "'")
+
+	if needsEnv {
+		env := context.APIMEnvironment
+		for p := c; p != nil; p = p.Parent() {
+			if p == mi.MICmd {
+				env = context.MIEnvironment
+			} else if p == mg.MgCmd {
+				env = context.MgwCluster
+			}
+		}
+		if env != "" {
+			_ = c.Flags().Set("environment", env)
+		}
+	}
+	if mgwClusterFlag := c.Flags().Lookup("mg-cluster"); mgwClusterFlag != nil && !mgwClusterFlag.Changed &&
+		context.MgwCluster != "" {
+		_ = c.Flags().Set("mg-cluster", context.MgwCluster)
+	}
+	if tenantFlag := c.Flags().Lookup("tenant-domain"); tenantFlag != nil && !tenantFlag.Changed &&
+		context.Tenant != "" {
+		_ = c.Flags().Set("tenant-domain", context.Tenant)
+	}
+	if outputFlag := c.Flags().Lookup("output"); outputFlag != nil && globalOutputFlags[outputFlag] &&
+		!outputFlag.Changed && context.Output != "" {
+		outputFormat = context.Output
+	}
+}
+
 //disable flags when the mode set to kubernetes
 func isK8sEnabled() bool {
 	//Get config to check mode
@@ -0,0 +1,72 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputFlagDoesNotShadowCommandFlags(t *testing.T) {
+	AddOutputFlags()
+	tests := []struct {
+		args  []string
+		usage string
+	}{
+		{[]string{"secret", "create"}, "Get the output in yaml (k8) or properties (file) format"},
+		{[]string{"mi", "vault", "encrypt"}, "Get the output in yaml (k8) or properties (file) format"},
+		{[]string{"k8s", "gen", "manifests"}, "File to write the manifests"},
+	}
+	for _, test := range tests {
+		t.Run(strings.Join(test.args, " "), func(t *testing.T) {
+			c, _, err := RootCmd.Find(test.args)
+			assert.Nil(t, err)
+			help := c.UsageString()
+			assert.Contains(t, help, "-o, --output string")
+			assert.Contains(t, help, test.usage)
+			assert.NotContains(t, help, outputFlagUsage)
+		})
+	}
+}
+
+func TestOutputFlagAddedToCommands(t *testing.T) {
+	AddOutputFlags()
+	tests := []struct {
+		args      []string
+		shorthand string
+	}{
+		{[]string{"get", "envs"}, "o"},
+		{[]string{"version"}, "o"},
+		// -o is the shorthand of --override
+		{[]string{"mg", "deploy", "api"}, ""},
+	}
+	for _, test := range tests {
+		t.Run(strings.Join(test.args, " "), func(t *testing.T) {
+			c, _, err := RootCmd.Find(test.args)
+			assert.Nil(t, err)
+			flag := c.Flags().Lookup("output")
+			if assert.NotNil(t, flag) {
+				assert.Equal(t, outputFlagUsage, flag.Usage)
+				assert.Equal(t, test.shorthand, flag.Shorthand)
+			}
+		})
+	}
+}
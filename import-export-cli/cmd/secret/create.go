@@ -19,16 +19,11 @@
 package secret
 
 import (
-	"bufio"
 	"errors"
-	"fmt"
-	"os"
 	"strings"
-	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
-	"golang.org/x/crypto/ssh/terminal"
 )
 
 var inputPropertiesfile string
@@ -87,7 +82,9 @@ func initSecretInformation(keyStoreConfig *utils.KeyStoreConfig) {
 		secretConfig.InputFile = inputPropertiesfile
 	} else {
 		secretConfig.InputType = "console"
-		startConsoleForSecretInfo(&secretConfig)
+		if err := utils.ReadSecretFromConsole(&secretConfig); err != nil {
+			utils.HandleErrorAndExit("Error reading the secret", err)
+		}
 	}
 	err := utils.EncryptSecrets(keyStoreConfig, secretConfig)
 	if err != nil {
@@ -95,31 +92,6 @@ func initSecretInformation(keyStoreConfig *utils.KeyStoreConfig) {
 	}
 }
 
-func startConsoleForSecretInfo(secretConfig *utils.SecretConfig) {
-	reader := bufio.NewReader(os.Stdin)
-
-	fmt.Printf("Enter plain alias for secret:")
-	alias, _ := reader.ReadString('\n')
-	secretConfig.PlainTextAlias = strings.TrimSpace(alias)
-
-	fmt.Printf("Enter plain text secret:")
-	byteSecret, _ := terminal.ReadPassword(int(syscall.Stdin))
-	secret := string(byteSecret)
-	fmt.Println()
-
-	fmt.Printf("Repeat plain text secret:")
-	byteRepeatSecret, _ := terminal.ReadPassword(int(syscall.Stdin))
-	repeatSecret := string(byteRepeatSecret)
-	fmt.Println()
-
-	if isMatchingSecrets(secret, repeatSecret) {
-		secretConfig.PlainTextSecretText = strings.TrimSpace(secret)
-	} else {
-		fmt.Println("Entered secret values did not match.")
-		startConsoleForSecretInfo(secretConfig)
-	}
-}
-
 func validateFlags() error {
 	if !(utils.IsOAEPEncryption(encryptionAlgorithm) || utils.IsPKCS1Encryption(encryptionAlgorithm)) {
 		return errors.New("Accepts RSA/ECB/OAEPWithSHA1AndMGF1Padding or RSA/ECB/PKCS1Padding as encryption algorithms (-c)")
@@ -130,13 +102,6 @@ func validateFlags() error {
 	return nil
 }
 
-func isMatchingSecrets(secret, repeatSecret string) bool {
-	if secret == repeatSecret {
-		return true
-	}
-	return false
-}
-
 func isNonEmptyString(str string) bool {
 	return len(strings.TrimSpace(str)) > 0
 }
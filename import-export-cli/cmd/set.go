@@ -36,10 +36,12 @@ var flagVCSDeletionEnabled bool
 var flagVCSConfigPath string
 var flagVCSSourceRepoPath string
 var flagVCSDeploymentRepoPath string
+var flagMgwCredentialHelper string
 
 const flagVCSConfigPathName = "vcs-config-path"
 const flagVCSSourceRepoPathName = "vcs-source-repo-path"
 const flagVCSDeploymentRepoPathName = "vcs-deployment-repo-path"
+const flagMgwCredentialHelperName = "mgw-credential-helper"
 
 // Set command related Info
 const SetCmdLiteral = "set"
@@ -52,7 +54,8 @@ const setCmdLongDesc = `Set configuration parameters. You can use one of the fol
 * --vcs-deletion-enabled <enable-or-disable-project-deletion-via-vcs>
 * --vcs-config-path <path-to-custom-vcs-config-file>
 * --vcs-deployment-repo-path <path-to-deployment-repo-for-vcs>
-* --vcs-source-repo-path <path-to-source-repo-for-vcs>`
+* --vcs-source-repo-path <path-to-source-repo-for-vcs>
+* --mgw-credential-helper <command-returning-credentials-of-microgateway-environments>`
 
 const setCmdExamples = utils.ProjectName + ` ` + SetCmdLiteral + ` --http-request-timeout 3600 --export-directory /home/user/exported-apis
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` --http-request-timeout 5000 --export-directory C:\Documents\exported
@@ -62,6 +65,7 @@ const setCmdExamples = utils.ProjectName + ` ` + SetCmdLiteral + ` --http-reques
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` --vcs-config-path /home/user/custom/vcs-config.yaml
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` --vcs-deployment-repo-path /home/user/custom/deployment
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` --vcs-source-repo-path /home/user/custom/source
+` + utils.ProjectName + ` ` + SetCmdLiteral + ` --mgw-credential-helper /home/user/bin/mgw-credentials
 ` + utils.ProjectName + ` ` + SetCmdLiteral + ` ` + SetApiLoggingCmdLiteral + ` --api-id bf36ca3a-0332-49ba-abce-e9992228ae06 --log-level full -e dev --tenant-domain carbon.super`
 
 // SetCmd represents the 'set' command
@@ -78,7 +82,7 @@ var SetCmd = &cobra.Command{
 
 func executeSetCmd(mainConfigFilePath string, cmd *cobra.Command) {
 	// read the existing config vars
-	configVars := utils.GetMainConfigFromFile(mainConfigFilePath)
+	configVars := utils.ReadMainConfigFile(mainConfigFilePath)
 	//Change Http Request timeout
 	if flagHttpRequestTimeout > 0 {
 		//Check whether the provided Http time out value is not equal to default value
@@ -150,6 +154,10 @@ func executeSetCmd(mainConfigFilePath string, cmd *cobra.Command) {
 		configVars.Config.VCSDeploymentRepoPath = flagVCSDeploymentRepoPath
 		fmt.Println("VCS deployment repo path is set to : " + flagVCSDeploymentRepoPath)
 	}
+	if cmd.Flags().Changed(flagMgwCredentialHelperName) {
+		configVars.Config.MgwCredentialHelper = flagMgwCredentialHelper
+		fmt.Println("Microgateway credential helper is set to : " + flagMgwCredentialHelper)
+	}
 
 	utils.WriteConfigFile(configVars, mainConfigFilePath)
 }
@@ -162,7 +170,7 @@ func init() {
 	var defaultExportDirectory string
 
 	// read current values in file to be passed into default values for flags below
-	mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
+	mainConfig := utils.ReadMainConfigFile(utils.MainConfigFilePath)
 
 	if mainConfig.Config.HttpRequestTimeout != 0 {
 		defaultHttpRequestTimeout = mainConfig.Config.HttpRequestTimeout
@@ -190,4 +198,8 @@ func init() {
 		"Path to the source repository to be considered during VCS deploy")
 	SetCmd.Flags().StringVar(&flagVCSDeploymentRepoPath, flagVCSDeploymentRepoPathName, "",
 		"Path to the deoployment repository to be considered during VCS deploy")
+	SetCmd.Flags().StringVar(&flagMgwCredentialHelper, flagMgwCredentialHelperName, "",
+		"Command executed as \"<command> get <environment>\" to get the credentials to log in to a microgateway "+
+			"environment again when its access token expires or is rejected. The credentials are not stored. "+
+			"Set it to \"\" to remove the helper")
 }
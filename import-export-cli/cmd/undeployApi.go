@@ -19,6 +19,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -74,7 +75,8 @@ var UndeployAPICmd = &cobra.Command{
 }
 
 func executeUndeployAPICmd(credential credentials.Credential, deployments []utils.Deployment) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, undeployAPIEnvironment)
+	accessToken, preCommandErr := credentials.GetOAuthAccessToken(context.Background(), credential,
+		undeployAPIEnvironment)
 	if preCommandErr == nil {
 		resp, err := impl.UndeployRevisionFromGateways(accessToken,
 			undeployAPIEnvironment, undeployAPIName, undeployAPIVersion, undeployProvider, undeployRevisionNum,
@@ -19,6 +19,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -75,7 +76,8 @@ var UndeployAPIProductCmd = &cobra.Command{
 }
 
 func executeUndeployAPIProductCmd(credential credentials.Credential, deployments []utils.Deployment) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, undeployAPIProductEnvironment)
+	accessToken, preCommandErr := credentials.GetOAuthAccessToken(context.Background(), credential,
+		undeployAPIProductEnvironment)
 	if preCommandErr == nil {
 		resp, err := impl.UndeployAPIProductRevisionFromGateways(accessToken,
 			undeployAPIProductEnvironment, undeployAPIProductName, undeployAPIProductProvider,
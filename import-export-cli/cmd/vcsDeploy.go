@@ -19,12 +19,13 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/git"
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -52,23 +53,29 @@ var DeployCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		utils.Logln(utils.LogPrefixInfo + deployCmdLiteral + " called")
 		if !utils.EnvExistsInMainConfigFile(flagVCSDeployEnvName, utils.MainConfigFilePath) {
-			fmt.Println(flagVCSDeployEnvName, "does not exists. Add it using add env")
-			os.Exit(1)
+			utils.HandleErrorAndExit(flagVCSDeployEnvName+" does not exists. Add it using add env",
+				utils.NewMissingError("environment "+flagVCSDeployEnvName+" not found"))
 		}
 		mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
 		if mainConfig.Config.VCSSourceRepoPath == "" {
-			fmt.Println("VCS source repo path cannot be empty. Set it using apictl set command.")
-			os.Exit(1)
+			utils.HandleErrorAndExit("VCS source repo path cannot be empty. Set it using apictl set command.", nil)
 		}
 		credential, err := GetCredentials(flagVCSDeployEnvName)
 		if err != nil {
 			utils.HandleErrorAndExit("Error getting credentials", err)
 		}
-		accessOAuthToken, err := credentials.GetOAuthAccessToken(credential, flagVCSDeployEnvName)
+		accessOAuthToken, err := credentials.GetOAuthAccessToken(context.Background(), credential, flagVCSDeployEnvName)
 		if err != nil {
 			utils.HandleErrorAndExit("Error while getting an access token for deploying the project(s)", err)
 		}
-		failedProjects := git.DeployChangedFiles(accessOAuthToken, flagVCSDeployEnvName)
+		failedProjects, err := git.DeployChangedFiles(accessOAuthToken, flagVCSDeployEnvName)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while deploying the project(s)", err)
+		}
+		utils.SetResultData(map[string]interface{}{
+			"environment":    flagVCSDeployEnvName,
+			"failedProjects": failedProjectPaths(failedProjects),
+		})
 		if failedProjects != nil && len(failedProjects) > 0 && flagVCSDeploySkipRollback == false {
 			fmt.Println("\nRolling back to the last successful revision as there are failures..")
 			err = git.Rollback(accessOAuthToken, flagVCSDeployEnvName)
@@ -77,10 +84,23 @@ var DeployCmd = &cobra.Command{
 			} else {
 				utils.HandleErrorAndExit("There are project deployment failures. Rolled back to the last successful revision.", err)
 			}
+		} else if len(failedProjects) > 0 {
+			utils.HandleErrorAndExit("There are project deployment failures.", utils.ErrPartialFailure)
 		}
 	},
 }
 
+// failedProjectPaths returns the relative paths of the failed projects of each project type
+func failedProjectPaths(failedProjects map[string][]*params.ProjectParams) map[string][]string {
+	paths := make(map[string][]string)
+	for projectType, projects := range failedProjects {
+		for _, project := range projects {
+			paths[projectType] = append(paths[projectType], project.RelativePath)
+		}
+	}
+	return paths
+}
+
 func init() {
 	VCSCmd.AddCommand(DeployCmd)
 
@@ -56,7 +56,11 @@ var VCSStatusCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		_, totalProjectsToUpdate, updatedProjectsPerType := git.GetStatus(flagVCSStatusEnvName, git.FromRevTypeLastAttempted)
+		_, totalProjectsToUpdate, updatedProjectsPerType, err := git.GetStatus(flagVCSStatusEnvName,
+			git.FromRevTypeLastAttempted)
+		if err != nil {
+			utils.HandleErrorAndExit("Error while getting the status of the repository", err)
+		}
 		if totalProjectsToUpdate == 0 {
 			fmt.Println("Everything is up-to-date")
 			return
@@ -19,6 +19,7 @@
 package credentials
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -62,6 +63,12 @@ type Environment struct {
 type MgAdapterEnv struct {
 	// AccessToken of microgateway adapter
 	AccessToken string `json:"accessToken"`
+	// Username of microgateway adapter user, used to log in again when the access token expires
+	Username string `json:"username,omitempty"`
+	// Password of microgateway adapter user, used to log in again when the access token expires
+	Password string `json:"password,omitempty"`
+	// ExpiresAt is the expiry time of the access token in unix seconds. Zero when the expiry is not known
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
 }
 
 // GetCredentialStore from file
@@ -82,7 +89,7 @@ func GetDefaultCredentialStore() (Store, error) {
 }
 
 // GetOAuthAccessToken generates an accesstoken for CLI
-func GetOAuthAccessToken(credential Credential, env string) (string, error) {
+func GetOAuthAccessToken(ctx context.Context, credential Credential, env string) (string, error) {
 	tokenEndpoint := utils.GetInternalTokenEndpointOfEnv(env, utils.MainConfigFilePath)
 	data, err := utils.GetOAuthTokens(credential.Username, credential.Password,
 		Base64Encode(credential.ClientId+":"+credential.ClientSecret),
@@ -102,7 +109,7 @@ func GetBasicAuth(credential Credential) string {
 }
 
 //Revoke access Token when user is logging out from environment
-func RevokeAccessToken(credential Credential, env string, token string) error {
+func RevokeAccessToken(ctx context.Context, credential Credential, env string, token string) error {
 
 	//get revoke endpoint
 	tokenRevokeEndpoint := utils.GetTokenRevokeEndpoint(env, utils.MainConfigFilePath)
@@ -117,7 +124,7 @@ func RevokeAccessToken(credential Credential, env string, token string) error {
 	body := utils.HeaderToken + token + utils.TokenTypeForRevocation
 
 	utils.Logln(utils.LogPrefixInfo + "connecting to " + tokenRevokeEndpoint)
-	resp, err := utils.InvokePOSTRequest(tokenRevokeEndpoint, headers, body)
+	resp, err := utils.InvokePOSTRequest(ctx, tokenRevokeEndpoint, headers, body)
 
 	if err != nil {
 		return err
@@ -170,6 +170,16 @@ func (s *JsonStore) SetMICredentials(env, username, password, accessToken string
 // GetMGToken returns token for microgateway adapter from the store or an error
 func (s *JsonStore) GetMGToken(env string) (MgAdapterEnv, error) {
 	if mgAdapterEnv, ok := s.credentials.MgwAdapterEnvs[env]; ok {
+		username, err := Base64Decode(mgAdapterEnv.Username)
+		if err != nil {
+			return MgAdapterEnv{}, err
+		}
+		password, err := Base64Decode(mgAdapterEnv.Password)
+		if err != nil {
+			return MgAdapterEnv{}, err
+		}
+		mgAdapterEnv.Username = username
+		mgAdapterEnv.Password = password
 		return mgAdapterEnv, nil
 	}
 	return MgAdapterEnv{}, fmt.Errorf(
@@ -187,6 +197,24 @@ func (s *JsonStore) SetMGToken(env, accessToken string) error {
 	return nil
 }
 
+// SetMGCredentials set token, its expiry and the credentials used to obtain it for microgateway adapter
+func (s *JsonStore) SetMGCredentials(env, username, password, accessToken string, expiresAt int64) error {
+	s.credentials.MgwAdapterEnvs[env] = MgAdapterEnv{
+		AccessToken: accessToken,
+		Username:    Base64Encode(username),
+		Password:    Base64Encode(password),
+		ExpiresAt:   expiresAt,
+	}
+	err := s.persist()
+	if err != nil {
+		return err
+	}
+	if password != "" {
+		fmt.Printf(PlainTextWarnMessage, s.Path)
+	}
+	return nil
+}
+
 // EraseAPIM remove apim credentials from the store
 func (s *JsonStore) EraseAPIM(env string) error {
 	environment, ok := s.credentials.Environments[env]
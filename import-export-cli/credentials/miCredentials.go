@@ -20,6 +20,7 @@ package credentials
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -42,7 +43,7 @@ type MiCredential struct {
 }
 
 // GetMICredentials returns credentials for mi
-func GetMICredentials(env string) (MiCredential, error) {
+func GetMICredentials(ctx context.Context, env string) (MiCredential, error) {
 
 	store, err := GetDefaultCredentialStore()
 	if err != nil {
@@ -50,14 +51,13 @@ func GetMICredentials(env string) (MiCredential, error) {
 	}
 
 	if !utils.MIExistsInEnv(env, utils.MainConfigFilePath) {
-		fmt.Println("MI does not exists in", env, "Add it using add env")
-		os.Exit(1)
+		utils.HandleMissingEnvAndExit("MI", env)
 	}
 
 	if !store.HasMI(env) {
 
 		fmt.Println("Login to MI in", env)
-		err = RunMILogin(store, env, "", "")
+		err = RunMILogin(ctx, store, env, "", "")
 		if err != nil {
 			return MiCredential{}, err
 		}
@@ -85,7 +85,7 @@ func UpdateMIAccessToken(env, accessToken string) error {
 }
 
 // GetOAuthAccessTokenForMI returns access token for mi
-func GetOAuthAccessTokenForMI(username, password, env string) (string, error) {
+func GetOAuthAccessTokenForMI(ctx context.Context, username, password, env string) (string, error) {
 
 	b64encodedCredentials := Base64Encode(username + ":" + password)
 
@@ -94,7 +94,7 @@ func GetOAuthAccessTokenForMI(username, password, env string) (string, error) {
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBasicPrefix + " " + b64encodedCredentials
 
-	resp, err := utils.InvokeGETRequest(tokenEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(ctx, tokenEndpoint, headers)
 	utils.Logln(utils.LogPrefixInfo + "connecting to " + tokenEndpoint)
 
 	if err != nil {
@@ -118,14 +118,14 @@ func GetOAuthAccessTokenForMI(username, password, env string) (string, error) {
 }
 
 // RevokeAccessTokenForMI revokes the mi management token when the user log out from the environment
-func RevokeAccessTokenForMI(env, token string) error {
+func RevokeAccessTokenForMI(ctx context.Context, env, token string) error {
 
 	tokenRevokeEndpoint := utils.GetMIManagementEndpointOfResource(utils.MiManagementMiLogoutResource, env, utils.MainConfigFilePath)
 
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + token
 
-	resp, err := utils.InvokeGETRequest(tokenRevokeEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(ctx, tokenRevokeEndpoint, headers)
 	utils.Logln(utils.LogPrefixInfo + "connecting to " + tokenRevokeEndpoint)
 
 	if err != nil {
@@ -140,10 +140,9 @@ func RevokeAccessTokenForMI(env, token string) error {
 }
 
 // RunMILogin prompt user to input MI management API username and password
-func RunMILogin(store Store, environment, username, password string) error {
+func RunMILogin(ctx context.Context, store Store, environment, username, password string) error {
 	if !utils.MIExistsInEnv(environment, utils.MainConfigFilePath) {
-		fmt.Println("MI does not exists in", environment, "Add it using add env")
-		os.Exit(1)
+		utils.HandleMissingEnvAndExit("MI", environment)
 	}
 	if username == "" {
 		fmt.Print("Username:")
@@ -163,7 +162,7 @@ func RunMILogin(store Store, environment, username, password string) error {
 		fmt.Println()
 	}
 
-	accessToken, err := GetOAuthAccessTokenForMI(username, password, environment)
+	accessToken, err := GetOAuthAccessTokenForMI(ctx, username, password, environment)
 	if err != nil {
 		return err
 	}
@@ -177,12 +176,12 @@ func RunMILogin(store Store, environment, username, password string) error {
 }
 
 // RunMILogout revoke mi management token and remove credentials from the store
-func RunMILogout(environment string) error {
-	cred, err := GetMICredentials(environment)
+func RunMILogout(ctx context.Context, environment string) error {
+	cred, err := GetMICredentials(ctx, environment)
 	if err != nil {
 		return err
 	}
-	err = RevokeAccessTokenForMI(environment, cred.AccessToken)
+	err = RevokeAccessTokenForMI(ctx, environment, cred.AccessToken)
 	if err != nil {
 		return err
 	}
@@ -196,7 +195,7 @@ func RunMILogout(environment string) error {
 
 // HandleMissingCredentials check for missing credentials and prompt to enter credentials or print error and exit
 func HandleMissingCredentials(env string) {
-	_, err := GetMICredentials(env)
+	_, err := GetMICredentials(context.Background(), env)
 	if err != nil {
 		utils.HandleErrorAndExit("Error getting credentials", err)
 	}
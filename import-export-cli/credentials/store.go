@@ -37,6 +37,8 @@ type Store interface {
 	SetMICredentials(env, username, password, accessToken string) error
 	// SetMGToken sets the Access Token for a Microgateway Adapter env
 	SetMGToken(env, accessToken string) error
+	// SetMGCredentials sets the Access Token, its expiry and the credentials used to obtain it for a Microgateway Adapter env
+	SetMGCredentials(env, username, password, accessToken string, expiresAt int64) error
 	// Erase apim credentials in a given environment
 	EraseAPIM(env string) error
 	// Erase mi credentials in a given environment
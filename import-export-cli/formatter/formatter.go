@@ -56,6 +56,7 @@ type Context struct {
 	Format Format
 
 	// internal usage
+	kind        string
 	finalFormat string
 	buffer      *bytes.Buffer
 }
@@ -101,8 +102,10 @@ func (ctx *Context) postFormat(template *template.Template, headers interface{})
 		// create a tab writer using Output
 		w := tabwriter.NewWriter(ctx.Output, 20, 1, 3, ' ', 0)
 		// print headers
-		_ = template.Funcs(templates.HeaderFuncs).Execute(w, headers)
-		_, _ = w.Write([]byte{'\n'})
+		if !NoHeaders {
+			_ = template.Funcs(templates.HeaderFuncs).Execute(w, headers)
+			_, _ = w.Write([]byte{'\n'})
+		}
 		// write buffer to the w
 		// in this case anything in buffer will be rendered by tabwiter to the Output
 		// buffer contains data to be written
@@ -131,6 +134,10 @@ type Renderer func(io.Writer, *template.Template) error
 func (ctx *Context) Write(r Renderer, headers interface{}) error {
 	// prepare formatting
 	ctx.preFormat()
+	// sorted, filtered and built-in formats need all the items before writing them
+	if ctx.isList() {
+		return ctx.writeList(r, headers)
+	}
 	// parse template
 	tmpl, err := ctx.parseTemplate()
 	if err != nil {
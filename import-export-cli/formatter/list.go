@@ -0,0 +1,417 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package formatter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/spf13/pflag"
+	"github.com/wso2/product-apim-tooling/import-export-cli/templates"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// CSVFormatKey is the identifier used for comma separated values
+const CSVFormatKey = "csv"
+
+// MarkdownFormatKey is the identifier used for a markdown table
+const MarkdownFormatKey = "markdown"
+
+// WideFormatKey is the identifier used for a table with all the fields of the items
+const WideFormatKey = "wide"
+
+// SortBy is the field or the column the lists are sorted by
+var SortBy string
+
+// Filters are the <field>=<value> pairs all the listed items should match
+var Filters []string
+
+// NoHeaders specifies whether the column headers of the lists should be omitted
+var NoHeaders bool
+
+// fieldInTemplate matches the fields used in a column template, like Name in {{.Name}}
+var fieldInTemplate = regexp.MustCompile(`{{\s*(?:\w+\s+)*\.(\w+)`)
+
+// AddListFlags adds the --sort-by, --filter and --no-headers flags to the flag set
+func AddListFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&SortBy, "sort-by", "", "Sort the list by the given field or column")
+	flags.StringSliceVar(&Filters, "filter", []string{}, "Only list the items whose field or column has the "+
+		"given value (<field>=<value>)")
+	flags.BoolVar(&NoHeaders, "no-headers", false, "Do not print the column headers")
+}
+
+// NewListContext creates a context for a list of items. tableFormat is used when the format is empty or one of csv,
+// markdown and wide
+func NewListContext(output io.Writer, format, tableFormat string) *Context {
+	ctx := NewContext(output, format)
+	switch format {
+	case "":
+		ctx.Format = Format(tableFormat)
+	case CSVFormatKey, MarkdownFormatKey, WideFormatKey:
+		ctx.kind = format
+		ctx.Format = Format(tableFormat)
+	}
+	return ctx
+}
+
+// isList returns true if the items need to be collected before writing them
+func (ctx *Context) isList() bool {
+	return ctx.kind != "" || SortBy != "" || len(Filters) > 0
+}
+
+// writeList collects the items rendered by r, then filters, sorts and writes them in the format of the context
+func (ctx *Context) writeList(r Renderer, headers interface{}) error {
+	var items []interface{}
+	collector := template.Must(template.New("").Funcs(template.FuncMap{
+		"collect": func(item interface{}) string {
+			items = append(items, item)
+			return ""
+		},
+	}).Parse("{{collect .}}"))
+	if err := r(ioutil.Discard, collector); err != nil {
+		return err
+	}
+
+	// invalid --filter and --sort-by values are usage errors of the command, returned before printing anything
+	items, err := filterItems(items, headers)
+	if err == nil {
+		err = sortItems(items, headers)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Format.IsTable() {
+		tmpl, err := ctx.parseTemplate()
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := tmpl.Execute(ctx.Output, item); err != nil {
+				return err
+			}
+			_, _ = ctx.Output.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	columns := strings.Split(ctx.finalFormat, "\t")
+	if ctx.kind == WideFormatKey && len(items) > 0 {
+		columns = append(columns, wideColumns(items[0], columns)...)
+	}
+	header, rows, err := renderColumns(columns, items, headers)
+	if err != nil {
+		return err
+	}
+
+	switch ctx.kind {
+	case CSVFormatKey:
+		w := csv.NewWriter(ctx.Output)
+		if !NoHeaders {
+			_ = w.Write(header)
+		}
+		_ = w.WriteAll(rows)
+		return w.Error()
+	case MarkdownFormatKey:
+		if !NoHeaders {
+			writeMarkdownRow(ctx.Output, header)
+			separator := make([]string, len(header))
+			for i := range separator {
+				separator[i] = "---"
+			}
+			writeMarkdownRow(ctx.Output, separator)
+		}
+		for _, row := range rows {
+			writeMarkdownRow(ctx.Output, row)
+		}
+		return nil
+	default:
+		w := tabwriter.NewWriter(ctx.Output, 20, 1, 3, ' ', 0)
+		if !NoHeaders {
+			_, _ = fmt.Fprintln(w, strings.Join(header, "\t"))
+		}
+		for _, row := range rows {
+			_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	}
+}
+
+// renderColumns renders the headers and the cells of the columns for each item
+func renderColumns(columns []string, items []interface{}, headers interface{}) ([]string, [][]string, error) {
+	var header []string
+	var cellTemplates []*template.Template
+	for _, column := range columns {
+		tmpl, err := templates.NewBasicFormatter("").Parse(column)
+		if err != nil {
+			// the column cannot be rendered alone (e.g. a range spanning columns), so render the row as a whole
+			return renderRows(strings.Join(columns, "\t"), items, headers)
+		}
+		cellTemplates = append(cellTemplates, tmpl)
+		header = append(header, renderHeader(column, headers))
+	}
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		row := make([]string, 0, len(cellTemplates))
+		for _, tmpl := range cellTemplates {
+			var b strings.Builder
+			if err := tmpl.Execute(&b, item); err != nil {
+				return nil, nil, err
+			}
+			row = append(row, b.String())
+		}
+		rows = append(rows, row)
+	}
+	return header, rows, nil
+}
+
+// renderRows renders each item with the whole row template and splits the result into cells
+func renderRows(format string, items []interface{}, headers interface{}) ([]string, [][]string, error) {
+	headerTmpl, err := templates.NewBasicFormatter("").Funcs(templates.HeaderFuncs).Parse(format)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Template parsing error: %v\n", err)
+	}
+	rowTmpl, _ := templates.NewBasicFormatter("").Parse(format)
+	var b strings.Builder
+	_ = headerTmpl.Execute(&b, headers)
+	header := strings.Split(b.String(), "\t")
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		b.Reset()
+		if err := rowTmpl.Execute(&b, item); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, strings.Split(b.String(), "\t"))
+	}
+	return header, rows, nil
+}
+
+// renderHeader renders the header of a column using the headers. The name of the field in the column is used when
+// there is no header for it
+func renderHeader(column string, headers interface{}) string {
+	var b strings.Builder
+	tmpl, err := templates.NewBasicFormatter("").Funcs(templates.HeaderFuncs).Parse(column)
+	if err == nil && headers != nil {
+		err = tmpl.Execute(&b, headers)
+	}
+	if err != nil || headers == nil || b.Len() == 0 || strings.Contains(b.String(), "<no value>") {
+		if match := fieldInTemplate.FindStringSubmatch(column); match != nil {
+			return strings.ToUpper(match[1])
+		}
+		return strings.ToUpper(strings.TrimSpace(column))
+	}
+	return b.String()
+}
+
+func writeMarkdownRow(w io.Writer, cells []string) {
+	escaped := make([]string, len(cells))
+	replacer := strings.NewReplacer("|", `\|`, "\n", " ")
+	for i, cell := range cells {
+		escaped[i] = replacer.Replace(cell)
+	}
+	_, _ = fmt.Fprintln(w, "| "+strings.Join(escaped, " | ")+" |")
+}
+
+// wideColumns returns the columns for the fields of the item which are not already in the columns
+func wideColumns(item interface{}, columns []string) []string {
+	used := make(map[string]bool)
+	for _, column := range columns {
+		for _, match := range fieldInTemplate.FindAllStringSubmatch(column, -1) {
+			used[strings.ToLower(match[1])] = true
+		}
+	}
+	var wide []string
+	for _, name := range fieldNames(item) {
+		if !used[strings.ToLower(name)] {
+			used[strings.ToLower(name)] = true
+			wide = append(wide, "{{."+name+"}}")
+		}
+	}
+	return wide
+}
+
+// fieldNames returns the names of the printable methods and fields of the item
+func fieldNames(item interface{}) []string {
+	var names []string
+	val := reflect.ValueOf(item)
+	if !val.IsValid() {
+		return nil
+	}
+	typ := val.Type()
+	for i := 0; i < val.NumMethod(); i++ {
+		method := val.Method(i)
+		if method.Type().NumIn() == 0 && method.Type().NumOut() == 1 && isPrintable(method.Type().Out(0)) {
+			names = append(names, typ.Method(i).Name)
+		}
+	}
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() == reflect.Struct {
+		for i := 0; i < val.NumField(); i++ {
+			field := val.Type().Field(i)
+			if field.PkgPath == "" && !field.Anonymous && isPrintable(field.Type) {
+				names = append(names, field.Name)
+			}
+		}
+	}
+	return names
+}
+
+// isPrintable returns true for the types which can be shown in a single cell
+func isPrintable(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return typ.Elem().Kind() == reflect.String
+	}
+	return false
+}
+
+// fieldValue returns the value of the method or the field of the item with the given name, ignoring the case
+func fieldValue(item interface{}, name string) (string, bool) {
+	val := reflect.ValueOf(item)
+	if !val.IsValid() {
+		return "", false
+	}
+	typ := val.Type()
+	for i := 0; i < val.NumMethod(); i++ {
+		method := val.Method(i)
+		if strings.EqualFold(typ.Method(i).Name, name) && method.Type().NumIn() == 0 && method.Type().NumOut() == 1 {
+			return fmt.Sprint(method.Call(nil)[0].Interface()), true
+		}
+	}
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return "", false
+		}
+		val = val.Elem()
+	}
+	if val.Kind() == reflect.Map {
+		for _, key := range val.MapKeys() {
+			if strings.EqualFold(fmt.Sprint(key.Interface()), name) {
+				return fmt.Sprint(val.MapIndex(key).Interface()), true
+			}
+		}
+	}
+	if val.Kind() != reflect.Struct {
+		return "", false
+	}
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.PkgPath == "" && (strings.EqualFold(field.Name, name) || (jsonName != "" && jsonName == name)) {
+			return fmt.Sprint(val.Field(i).Interface()), true
+		}
+	}
+	return "", false
+}
+
+// resolveField returns the field of a column header (e.g. STATUS for LifeCycleStatus), or the name as it is
+func resolveField(name string, headers interface{}) string {
+	if headerMap, ok := headers.(map[string]string); ok {
+		for field, header := range headerMap {
+			if strings.EqualFold(header, name) {
+				return field
+			}
+		}
+	}
+	return name
+}
+
+// filterItems returns the items matching all the filters
+func filterItems(items []interface{}, headers interface{}) ([]interface{}, error) {
+	if len(Filters) == 0 {
+		return items, nil
+	}
+	type filter struct{ field, value string }
+	var filters []filter
+	for _, f := range Filters {
+		parts := strings.SplitN(f, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("%w: invalid filter %q, the filter should be in <field>=<value> format",
+				utils.ErrInvalidUsage, f)
+		}
+		filters = append(filters, filter{resolveField(strings.TrimSpace(parts[0]), headers), parts[1]})
+	}
+	var filtered []interface{}
+	for _, item := range items {
+		matched := true
+		for _, f := range filters {
+			value, ok := fieldValue(item, f.field)
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown field %q in filter", utils.ErrInvalidUsage, f.field)
+			}
+			if !strings.EqualFold(value, f.value) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// sortItems sorts the items by the value of the SortBy field. Numeric values are compared as numbers
+func sortItems(items []interface{}, headers interface{}) error {
+	if SortBy == "" || len(items) == 0 {
+		return nil
+	}
+	field := resolveField(SortBy, headers)
+	values := make(map[int]string, len(items))
+	for i, item := range items {
+		value, ok := fieldValue(item, field)
+		if !ok {
+			return fmt.Errorf("%w: unknown field %q to sort by", utils.ErrInvalidUsage, SortBy)
+		}
+		values[i] = value
+	}
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := values[indexes[i]], values[indexes[j]]
+		x, errX := strconv.ParseFloat(a, 64)
+		y, errY := strconv.ParseFloat(b, 64)
+		if errX == nil && errY == nil {
+			return x < y
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	sorted := make([]interface{}, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+	copy(items, sorted)
+	return nil
+}
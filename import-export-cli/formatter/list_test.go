@@ -0,0 +1,242 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package formatter
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const testTableFormat = "table {{.Name}}\t{{.Version}}\t{{.Status}}"
+
+type testAPI struct {
+	name    string
+	version string
+	status  string
+	Count   int
+}
+
+func (a testAPI) Name() string {
+	return a.name
+}
+
+func (a testAPI) Version() string {
+	return a.version
+}
+
+func (a testAPI) Status() string {
+	return a.status
+}
+
+var testAPIs = []testAPI{
+	{name: "Pizza|Shack", version: "1.0.0", status: "PUBLISHED", Count: 10},
+	{name: "petstore", version: "2.0.0", status: "CREATED", Count: 9},
+	{name: "Echo", version: "1.0.0", status: "PUBLISHED", Count: 100},
+}
+
+var testHeaders = map[string]string{
+	"Name":    "NAME",
+	"Version": "VERSION",
+	"Status":  "LIFECYCLE STATUS",
+}
+
+// setListFlags sets the list flags and returns a function restoring them
+func setListFlags(sortBy string, filters []string, noHeaders bool) func() {
+	previousSortBy, previousFilters, previousNoHeaders := SortBy, Filters, NoHeaders
+	SortBy, Filters, NoHeaders = sortBy, filters, noHeaders
+	return func() {
+		SortBy, Filters, NoHeaders = previousSortBy, previousFilters, previousNoHeaders
+	}
+}
+
+// renderTestAPIs renders the test APIs using t
+func renderTestAPIs(w io.Writer, t *template.Template) error {
+	for _, api := range testAPIs {
+		if err := t.Execute(w, api); err != nil {
+			return err
+		}
+		_, _ = w.Write([]byte{'\n'})
+	}
+	return nil
+}
+
+func writeTestAPIs(t *testing.T, format string) string {
+	var b bytes.Buffer
+	ctx := NewListContext(&b, format, testTableFormat)
+	if err := ctx.Write(renderTestAPIs, testHeaders); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestWriteList(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		sortBy    string
+		filters   []string
+		noHeaders bool
+		want      string
+	}{
+		{
+			name:   "csv",
+			format: CSVFormatKey,
+			want: "NAME,VERSION,LIFECYCLE STATUS\nPizza|Shack,1.0.0,PUBLISHED\npetstore,2.0.0,CREATED\n" +
+				"Echo,1.0.0,PUBLISHED\n",
+		},
+		{
+			name:   "markdown escapes pipes",
+			format: MarkdownFormatKey,
+			want: "| NAME | VERSION | LIFECYCLE STATUS |\n| --- | --- | --- |\n| Pizza\\|Shack | 1.0.0 | PUBLISHED |\n" +
+				"| petstore | 2.0.0 | CREATED |\n| Echo | 1.0.0 | PUBLISHED |\n",
+		},
+		{
+			name:      "csv without headers",
+			format:    CSVFormatKey,
+			noHeaders: true,
+			want:      "Pizza|Shack,1.0.0,PUBLISHED\npetstore,2.0.0,CREATED\nEcho,1.0.0,PUBLISHED\n",
+		},
+		{
+			name:      "markdown without headers",
+			format:    MarkdownFormatKey,
+			noHeaders: true,
+			want:      "| Pizza\\|Shack | 1.0.0 | PUBLISHED |\n| petstore | 2.0.0 | CREATED |\n| Echo | 1.0.0 | PUBLISHED |\n",
+		},
+		{
+			name:   "sort by field ignoring the case",
+			format: CSVFormatKey,
+			sortBy: "name",
+			want: "NAME,VERSION,LIFECYCLE STATUS\nEcho,1.0.0,PUBLISHED\npetstore,2.0.0,CREATED\n" +
+				"Pizza|Shack,1.0.0,PUBLISHED\n",
+		},
+		{
+			name:      "sort by a numeric field not in the columns",
+			format:    CSVFormatKey,
+			sortBy:    "Count",
+			noHeaders: true,
+			want:      "petstore,2.0.0,CREATED\nPizza|Shack,1.0.0,PUBLISHED\nEcho,1.0.0,PUBLISHED\n",
+		},
+		{
+			name:      "sort by column header",
+			format:    CSVFormatKey,
+			sortBy:    "LIFECYCLE STATUS",
+			noHeaders: true,
+			want:      "petstore,2.0.0,CREATED\nPizza|Shack,1.0.0,PUBLISHED\nEcho,1.0.0,PUBLISHED\n",
+		},
+		{
+			name:      "filter",
+			format:    CSVFormatKey,
+			filters:   []string{"status=published"},
+			noHeaders: true,
+			want:      "Pizza|Shack,1.0.0,PUBLISHED\nEcho,1.0.0,PUBLISHED\n",
+		},
+		{
+			name:      "all the filters should match",
+			format:    CSVFormatKey,
+			filters:   []string{"Status=PUBLISHED", "Count=100"},
+			noHeaders: true,
+			want:      "Echo,1.0.0,PUBLISHED\n",
+		},
+		{
+			name:    "filter and sort in the table format",
+			filters: []string{"version=1.0.0"},
+			sortBy:  "Name",
+			want: "NAME                VERSION             LIFECYCLE STATUS\n" +
+				"Echo                1.0.0               PUBLISHED\n" +
+				"Pizza|Shack         1.0.0               PUBLISHED\n",
+		},
+		{
+			name:      "table without headers",
+			noHeaders: true,
+			want: "Pizza|Shack         1.0.0               PUBLISHED\n" +
+				"petstore            2.0.0               CREATED\n" +
+				"Echo                1.0.0               PUBLISHED\n",
+		},
+		{
+			name:      "custom template",
+			format:    "{{.Name}}:{{.Version}}",
+			sortBy:    "Name",
+			noHeaders: true,
+			want:      "Echo:1.0.0\npetstore:2.0.0\nPizza|Shack:1.0.0\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer setListFlags(test.sortBy, test.filters, test.noHeaders)()
+			assert.Equal(t, test.want, writeTestAPIs(t, test.format))
+		})
+	}
+}
+
+func TestWriteListWideFormat(t *testing.T) {
+	defer setListFlags("", nil, false)()
+	assert.Equal(t, "NAME                VERSION             LIFECYCLE STATUS    COUNT\n"+
+		"Pizza|Shack         1.0.0               PUBLISHED           10\n"+
+		"petstore            2.0.0               CREATED             9\n"+
+		"Echo                1.0.0               PUBLISHED           100\n", writeTestAPIs(t, WideFormatKey))
+}
+
+func TestInvalidListFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		sortBy  string
+		filters []string
+		want    string
+	}{
+		{name: "unknown sort field", sortBy: "Owner", want: `unknown field "Owner" to sort by`},
+		{name: "unknown filter field", filters: []string{"owner=admin"}, want: `unknown field "owner" in filter`},
+		{name: "filter without value", filters: []string{"status"}, want: `invalid filter "status"`},
+		{name: "filter without field", filters: []string{"=PUBLISHED"}, want: `invalid filter "=PUBLISHED"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer setListFlags(test.sortBy, test.filters, false)()
+			var b bytes.Buffer
+			err := NewListContext(&b, "", testTableFormat).Write(renderTestAPIs, testHeaders)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.want)
+				assert.True(t, errors.Is(err, utils.ErrInvalidUsage), "Should be a usage error")
+				assert.Equal(t, utils.ExitCodeUsage, utils.ExitCodeOf(err))
+			}
+			assert.Empty(t, b.String(), "Should not print the list")
+		})
+	}
+}
+
+func TestRenderHeader(t *testing.T) {
+	tests := []struct {
+		column  string
+		headers interface{}
+		want    string
+	}{
+		{"{{.Status}}", testHeaders, "LIFECYCLE STATUS"},
+		{"{{.Count}}", testHeaders, "COUNT"},
+		{"{{truncate 10 .Name}}", testHeaders, "NAME"},
+		{"{{.Name}}", nil, "NAME"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, renderHeader(test.column, test.headers), test.column)
+	}
+}
@@ -20,6 +20,7 @@ package git
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -40,15 +41,16 @@ import (
 // Reads the vcs configuration file and returns. Silently catch the error when config file is not found
 // filePath is the path to look for the VCS configuration file
 // returns *VCSConfig VCS configuration
-func getVCSConfigFromFileSilently(filePath string) *VCSConfig {
+// returns error, if the configuration file cannot be parsed
+func getVCSConfigFromFileSilently(filePath string) (*VCSConfig, error) {
 	var vcsConfig VCSConfig
 	data, err := ioutil.ReadFile(filePath)
 	if err == nil {
 		if err := yaml.Unmarshal(data, &vcsConfig); err != nil {
-			utils.HandleErrorAndExit("VCSConfig: Error parsing "+filePath, err)
+			return nil, fmt.Errorf("VCSConfig: Error parsing %s: %w", filePath, err)
 		}
 	}
-	return &vcsConfig
+	return &vcsConfig, nil
 }
 
 // Reads and returns the environment specific information from the VCS config along with the full VCS config
@@ -57,17 +59,21 @@ func getVCSConfigFromFileSilently(filePath string) *VCSConfig {
 // Returns VCSConfig, the full VCS configuration
 // Returns Environment, the environment specific VCS configuration
 // Returns bool, whether the environment is available in the VCS configuration or not
-func getVCSEnvironmentDetails(repoId, environment string) (VCSConfig, Environment, bool) {
+// Returns error, if the VCS configuration cannot be read
+func getVCSEnvironmentDetails(repoId, environment string) (VCSConfig, Environment, bool, error) {
 	mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
 	if mainConfig.Config.VCSConfigFilePath != "" {
 		VCSConfigFilePath = mainConfig.Config.VCSConfigFilePath
 	}
-	vcsConfig := getVCSConfigFromFileSilently(VCSConfigFilePath)
+	vcsConfig, err := getVCSConfigFromFileSilently(VCSConfigFilePath)
+	if err != nil {
+		return VCSConfig{}, Environment{}, false, err
+	}
 	if vcsConfig.Repos == nil {
 		vcsConfig.Repos = make(map[string]Repo)
 	}
 	envVCSConfig, hasEnv := vcsConfig.Repos[repoId].Environments[environment]
-	return *vcsConfig, envVCSConfig, hasEnv
+	return *vcsConfig, envVCSConfig, hasEnv, nil
 }
 
 // Returns the status of the projects indicating the projects to deploy (need to save, delete or failed previously).
@@ -76,18 +82,22 @@ func getVCSEnvironmentDetails(repoId, environment string) (VCSConfig, Environmen
 // Returns string, id of the git repository (located in vcs.yaml)
 // Returns int, the total number of projects to deploy
 // Returns map[string][]*params.ProjectParams, the details of the projects that needs to deploy
-func GetStatus(environment, fromRevType string) (string, int, map[string][]*params.ProjectParams) {
+// Returns error, if the status of the repository cannot be determined
+func GetStatus(environment, fromRevType string) (string, int, map[string][]*params.ProjectParams, error) {
 	var envRevision string
 	mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
 	repoId, err := getRepoId()
 	if err != nil {
-		utils.HandleErrorAndExit("Error while retrieving repository id", err)
+		return "", 0, nil, fmt.Errorf("error while retrieving repository id: %v", err)
 	}
 	if repoId == "" {
-		utils.HandleErrorAndExit("The repository info: vcs.yaml is not found in the repository root. "+
-			"If this is the first time you are using this repo, please initialize it with 'vcs init'.", nil)
+		return "", 0, nil, errors.New("the repository info: vcs.yaml is not found in the repository root. " +
+			"If this is the first time you are using this repo, please initialize it with 'vcs init'")
+	}
+	_, envVCSConfig, hasEnv, err := getVCSEnvironmentDetails(repoId, environment)
+	if err != nil {
+		return "", 0, nil, err
 	}
-	_, envVCSConfig, hasEnv := getVCSEnvironmentDetails(repoId, environment)
 	if hasEnv {
 		if fromRevType == FromRevTypeLastAttempted {
 			envRevision = envVCSConfig.LastAttemptedRev
@@ -102,7 +112,7 @@ func GetStatus(environment, fromRevType string) (string, int, map[string][]*para
 
 	basePath, err := getRepoBaseDir()
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting repository base folder location", err)
+		return "", 0, nil, fmt.Errorf("error while getting repository base folder location: %v", err)
 	}
 
 	var changedFiles string
@@ -133,7 +143,10 @@ func GetStatus(environment, fromRevType string) (string, int, map[string][]*para
 
 	var totalProjectsToUpdate = 0
 	for _, changedFile := range changedFileList {
-		projectParam := getProjectInfoFromProjectFile(envVCSConfig, basePath, changedFile, changedPathInfoMap)
+		projectParam, err := getProjectInfoFromProjectFile(envVCSConfig, basePath, changedFile, changedPathInfoMap)
+		if err != nil {
+			return "", 0, nil, err
+		}
 		if projectParam.Type != utils.ProjectTypeNone {
 			if updatedProjectsPerType[projectParam.Type] == nil {
 				updatedProjectsPerType[projectParam.Type] = []*params.ProjectParams{}
@@ -159,7 +172,7 @@ func GetStatus(environment, fromRevType string) (string, int, map[string][]*para
 		}
 	}
 
-	return repoId, totalProjectsToUpdate, updatedProjectsPerType
+	return repoId, totalProjectsToUpdate, updatedProjectsPerType, nil
 }
 
 // Returns whether the given project was failed to deploy previously
@@ -181,21 +194,38 @@ func failedDuringEarlierDeploy(vcsEnvConfig Environment, projectParams *params.P
 func Rollback(accessToken, environment string) error {
 	mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
 
-	changeDirectoryToSourceRepo(mainConfig)
+	if err := changeDirectoryToSourceRepo(mainConfig); err != nil {
+		return err
+	}
 
 	// Get the status of the source repo
-	sourceRepoId, _, sourceRepoUpdatedProjectsPerType := GetStatus(environment, FromRevTypeLastSuccessful)
-	_, envVCSConfigSourceRepo, hasEnvSourceRepo := getVCSEnvironmentDetails(sourceRepoId, environment)
+	sourceRepoId, _, sourceRepoUpdatedProjectsPerType, err := GetStatus(environment, FromRevTypeLastSuccessful)
+	if err != nil {
+		return err
+	}
+	_, envVCSConfigSourceRepo, hasEnvSourceRepo, err := getVCSEnvironmentDetails(sourceRepoId, environment)
+	if err != nil {
+		return err
+	}
 
 	var deploymentRepoId, currentBranchDeploymentRepo, tmpBranchNameDeploymentRepo string
 	var envVCSConfigDeploymentRepo Environment
 	var hasEnvDeploymentRepo bool
 	var deploymentRepoUpdatedProjectsPerType map[string][]*params.ProjectParams
 	if mainConfig.Config.VCSDeploymentRepoPath != "" {
-		changeDirectory(mainConfig.Config.VCSDeploymentRepoPath)
+		if err = changeDirectory(mainConfig.Config.VCSDeploymentRepoPath); err != nil {
+			return err
+		}
 		// Get the status of the deployment repo
-		deploymentRepoId, _, deploymentRepoUpdatedProjectsPerType = GetStatus(environment, FromRevTypeLastAttempted)
-		_, envVCSConfigDeploymentRepo, hasEnvDeploymentRepo = getVCSEnvironmentDetails(deploymentRepoId, environment)
+		deploymentRepoId, _, deploymentRepoUpdatedProjectsPerType, err = GetStatus(environment, FromRevTypeLastAttempted)
+		if err != nil {
+			return err
+		}
+		_, envVCSConfigDeploymentRepo, hasEnvDeploymentRepo, err = getVCSEnvironmentDetails(deploymentRepoId,
+			environment)
+		if err != nil {
+			return err
+		}
 	}
 
 	if mainConfig.Config.VCSDeploymentRepoPath != "" {
@@ -227,37 +257,66 @@ func Rollback(accessToken, environment string) error {
 	}
 
 	// Change directory to source repo and checkout to a new branch from the revision
-	changeDirectoryToSourceRepo(mainConfig)
-	currentBranchSourceRepo := getCurrentBranch()
+	if err = changeDirectoryToSourceRepo(mainConfig); err != nil {
+		return err
+	}
+	currentBranchSourceRepo, err := getCurrentBranch()
+	if err != nil {
+		return err
+	}
 	tmpBranchNameSourceRepo := "tmp-" + lastSuccessfulRevisionSourceRepo[0:8]
-	checkoutNewBranchFromRevision(tmpBranchNameSourceRepo, lastSuccessfulRevisionSourceRepo)
+	if err = checkoutNewBranchFromRevision(tmpBranchNameSourceRepo, lastSuccessfulRevisionSourceRepo); err != nil {
+		return err
+	}
 
 	// Change directory to deployment repo and checkout to a new branch from the revision
 	if mainConfig.Config.VCSDeploymentRepoPath != "" {
-		changeDirectory(mainConfig.Config.VCSDeploymentRepoPath)
-		currentBranchDeploymentRepo = getCurrentBranch()
+		if err = changeDirectory(mainConfig.Config.VCSDeploymentRepoPath); err != nil {
+			return err
+		}
+		currentBranchDeploymentRepo, err = getCurrentBranch()
+		if err != nil {
+			return err
+		}
 		tmpBranchNameDeploymentRepo = "tmp-" + lastSuccessfulRevisionDeploymentRepo[0:8]
-		checkoutNewBranchFromRevision(tmpBranchNameDeploymentRepo, lastSuccessfulRevisionDeploymentRepo)
-
+		err = checkoutNewBranchFromRevision(tmpBranchNameDeploymentRepo, lastSuccessfulRevisionDeploymentRepo)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Again change directory to the source repo and deploy the updated projects
-	changeDirectoryToSourceRepo(mainConfig)
-	deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environment, totalProjectsToUpdate, updatedProjectsPerType)
+	if err = changeDirectoryToSourceRepo(mainConfig); err != nil {
+		return err
+	}
+	_, _, _, err = deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environment,
+		totalProjectsToUpdate, updatedProjectsPerType)
+	if err != nil {
+		return err
+	}
 
 	// Again change directory to the source repo (because inside deployUpdatedProjects the directory must have changed to the deployment)
-	changeDirectoryToSourceRepo(mainConfig)
+	if err = changeDirectoryToSourceRepo(mainConfig); err != nil {
+		return err
+	}
 	// Checkout to the current branch and delete the tmp branch in source repo
-	checkoutBranch(currentBranchSourceRepo)
-	deleteTmpBranch(tmpBranchNameSourceRepo)
+	if err = checkoutBranch(currentBranchSourceRepo); err != nil {
+		return err
+	}
+	if err = deleteTmpBranch(tmpBranchNameSourceRepo); err != nil {
+		return err
+	}
 
 	// Chanage directory to the deployment repo
 	if mainConfig.Config.VCSDeploymentRepoPath != "" {
-		changeDirectory(mainConfig.Config.VCSDeploymentRepoPath)
+		if err = changeDirectory(mainConfig.Config.VCSDeploymentRepoPath); err != nil {
+			return err
+		}
 		// Checkout to the current branch and delete the tmp branch in deployment repo
-		checkoutBranch(currentBranchDeploymentRepo)
-		deleteTmpBranch(tmpBranchNameDeploymentRepo)
-
+		if err = checkoutBranch(currentBranchDeploymentRepo); err != nil {
+			return err
+		}
+		return deleteTmpBranch(tmpBranchNameDeploymentRepo)
 	}
 
 	return nil
@@ -266,42 +325,44 @@ func Rollback(accessToken, environment string) error {
 // Creates a new branch from the given revision
 // tmpBranchName is the new branch that is checkout from the revision
 // revision is the git commit id
-func checkoutNewBranchFromRevision(tmpBranchName, revision string) {
+func checkoutNewBranchFromRevision(tmpBranchName, revision string) error {
 	_, err := executeGitCommand("checkout", "-b", tmpBranchName, revision)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while checking out last successful commit ("+
-			revision+") for rolling back", err)
+		return fmt.Errorf("error while checking out last successful commit (%s) for rolling back: %w", revision, err)
 	}
+	return nil
 }
 
 // Switches to the given branch name
 // branchName is the name of the branch that should switch into
-func checkoutBranch(branchName string) {
+func checkoutBranch(branchName string) error {
 	_, err := executeGitCommand("checkout", branchName)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while checking out branch "+branchName, err)
+		return fmt.Errorf("error while checking out branch %s: %w", branchName, err)
 	}
+	return nil
 }
 
 // Returns the name of the current branch of the repository where the user is executing apictl commands
-func getCurrentBranch() string {
+func getCurrentBranch() (string, error) {
 	branch, err := executeGitCommand("rev-parse", "--abbrev-ref", "HEAD")
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting current branch", err)
+		return "", fmt.Errorf("error while getting current branch: %w", err)
 	}
-	return strings.TrimSpace(branch)
+	return strings.TrimSpace(branch), nil
 }
 
 // Deletes the given branch. The branch name must start with "tmp-"
-func deleteTmpBranch(tmpBranch string) {
+func deleteTmpBranch(tmpBranch string) error {
 	//done as a security check
 	if !strings.HasPrefix(tmpBranch, "tmp-") {
-		utils.HandleErrorAndExit("Cannot remove branches not starting with 'tmp-'", nil)
+		return errors.New("cannot remove branches not starting with 'tmp-'")
 	}
 	_, err := executeGitCommand("branch", "-D", tmpBranch)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while deleting the temp branch "+tmpBranch, err)
+		return fmt.Errorf("error while deleting the temp branch %s: %w", tmpBranch, err)
 	}
+	return nil
 }
 
 // Deletes the projects from the environment that are identified as deleted.
@@ -321,8 +382,8 @@ func deployProjectDeletions(accessToken, environment string, deletedProjectsPerT
 			if handleIfError(err, failedProjects, projectParam) {
 				continue
 			}
-			resp, err := impl.DeleteApplication(accessToken, environment, appInfo.Data.Applicationinfo.Name,
-				appInfo.Data.Applicationinfo.Owner)
+			resp, err := impl.DeleteApplication(context.Background(), accessToken, environment,
+				appInfo.Data.Applicationinfo.Name, appInfo.Data.Applicationinfo.Owner)
 			if handleIfError(err, failedProjects, projectParam) {
 				continue
 			}
@@ -340,7 +401,8 @@ func deployProjectDeletions(accessToken, environment string, deletedProjectsPerT
 			if handleIfError(err, failedProjects, projectParam) {
 				continue
 			}
-			resp, err := impl.DeleteAPIProduct(accessToken, environment, apiProductInfo.Data.Name, apiProductInfo.Data.Provider)
+			resp, err := impl.DeleteAPIProduct(context.Background(), accessToken, environment, apiProductInfo.Data.Name,
+				apiProductInfo.Data.Provider)
 			if handleIfError(err, failedProjects, projectParam) {
 				continue
 			}
@@ -358,7 +420,8 @@ func deployProjectDeletions(accessToken, environment string, deletedProjectsPerT
 			if handleIfError(err, failedProjects, projectParam) {
 				continue
 			}
-			resp, err := impl.DeleteAPI(accessToken, environment, apiInfo.Data.Name, apiInfo.Data.Version, apiInfo.Data.Provider)
+			resp, err := impl.DeleteAPI(context.Background(), accessToken, environment, apiInfo.Data.Name,
+				apiInfo.Data.Version, apiInfo.Data.Provider)
 			if handleIfError(err, failedProjects, projectParam) {
 				continue
 			}
@@ -391,12 +454,13 @@ func handleIfError(err error, failedProjects map[string][]*params.ProjectParams,
 //  deleted projects
 // Returns map[string][]*params.ProjectParams, a map of project type (API, App.. ) to each project detail which are
 //  failed during the deployment
+// Returns error, if the VCS configuration cannot be updated
 func deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environment string, totalProjectsToUpdate int,
 	updatedProjectsPerType map[string][]*params.ProjectParams) (bool, map[string][]*params.ProjectParams,
-	map[string][]*params.ProjectParams) {
+	map[string][]*params.ProjectParams, error) {
 	if totalProjectsToUpdate == 0 {
 		fmt.Println("Everything is up-to-date")
-		return false, nil, nil
+		return false, nil, nil, nil
 	}
 
 	fmt.Println("Deploying Projects (" + strconv.Itoa(totalProjectsToUpdate) + ")...")
@@ -431,11 +495,14 @@ func deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environm
 			}
 			importParams := projectParam.MetaData.DeployConfig.Import
 			fmt.Println(strconv.Itoa(i+1) + ": " + projectParam.NickName + ": (" + projectParam.RelativePath + ")")
-			err := impl.ImportAPIToEnv(accessToken, environment, generateSourceProjectPath(mainConfig, projectParam),
+			err := impl.ImportAPIToEnv(context.Background(), accessToken, environment,
+				generateSourceProjectPath(mainConfig, projectParam),
 				projectDeploymentParamsDirLocation, importParams.Update, importParams.PreserveProvider, false, importParams.RotateRevision, false)
 			if err != nil {
 				fmt.Println("Error... ", err)
 				failedProjects[projectParam.Type] = append(failedProjects[projectParam.Type], projectParam)
+			} else {
+				fmt.Println("Successfully imported API.")
 			}
 		}
 	}
@@ -465,12 +532,15 @@ func deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environm
 			}
 			importParams := projectParam.MetaData.DeployConfig.Import
 			fmt.Println(strconv.Itoa(i+1) + ": " + projectParam.NickName + ": (" + projectParam.RelativePath + ")")
-			err := impl.ImportAPIProductToEnv(accessToken, environment, generateSourceProjectPath(mainConfig, projectParam),
+			err := impl.ImportAPIProductToEnv(context.Background(), accessToken, environment,
+				generateSourceProjectPath(mainConfig, projectParam),
 				projectDeploymentParamsDirLocation, importParams.ImportAPIs, importParams.UpdateAPIs, importParams.UpdateAPIProduct,
 				importParams.PreserveProvider, false, importParams.RotateRevision, false)
 			if err != nil {
 				fmt.Println("\terror... ", err)
 				failedProjects[projectParam.Type] = append(failedProjects[projectParam.Type], projectParam)
+			} else {
+				fmt.Println("Successfully imported API Product.")
 			}
 		}
 	}
@@ -488,11 +558,14 @@ func deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environm
 			}
 			importParams := projectParam.MetaData.DeployConfig.Import
 			fmt.Println(strconv.Itoa(i+1) + ": " + projectParam.NickName + ": (" + projectParam.RelativePath + ")")
-			_, err := impl.ImportApplicationToEnv(accessToken, environment, projectParam.AbsolutePath, projectParam.MetaData.Owner,
+			_, err := impl.ImportApplicationToEnv(context.Background(), accessToken, environment,
+				projectParam.AbsolutePath, projectParam.MetaData.Owner,
 				importParams.Update, importParams.PreserveOwner, importParams.SkipSubscriptions, importParams.SkipKeys, false)
 			if err != nil {
 				fmt.Println("\terror... ", err)
 				failedProjects[projectParam.Type] = append(failedProjects[projectParam.Type], projectParam)
+			} else {
+				fmt.Println("Successfully imported Application.")
 			}
 		}
 	}
@@ -500,14 +573,20 @@ func deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environm
 	// If there are no deleted projects, update the VCS config file as there is nothing remaining to do.
 	//  If there are deleted projects, this needs to handle after deleting those.
 	if !hasDeletedProjects {
-		updateVCSConfig(sourceRepoId, environment, failedProjects)
+		if err := UpdateVCSConfig(sourceRepoId, environment, failedProjects); err != nil {
+			return false, nil, nil, err
+		}
 	}
 	if mainConfig.Config.VCSDeploymentRepoPath != "" && deploymentRepoId != "" {
-		changeDirectory(mainConfig.Config.VCSDeploymentRepoPath)
-		updateVCSConfig(deploymentRepoId, environment, failedProjects)
+		if err := changeDirectory(mainConfig.Config.VCSDeploymentRepoPath); err != nil {
+			return false, nil, nil, err
+		}
+		if err := UpdateVCSConfig(deploymentRepoId, environment, failedProjects); err != nil {
+			return false, nil, nil, err
+		}
 	}
 
-	return hasDeletedProjects, deletedProjectsPerType, failedProjects
+	return hasDeletedProjects, deletedProjectsPerType, failedProjects, nil
 }
 
 // This method is responsible for resolving the correct meta data deplof configurations
@@ -526,16 +605,19 @@ func resolveProjectParamsMetaDataDeployConfig(sourceDeploymentMetaData *utils.De
 
 }
 
-// This method is responsible for updating the vcs configuration file at the end of the deployment
+// UpdateVCSConfig is responsible for updating the vcs configuration file at the end of the deployment
 // repoId is the id of the git repository (located in vcs.yaml)
 // environment is the environment name
 // failedProjects are a map of project type to failed projects during the previous deployment
-func updateVCSConfig(repoId, environment string, failedProjects map[string][]*params.ProjectParams) {
-	vcsConfig, envVCSConfig, _ := getVCSEnvironmentDetails(repoId, environment)
-	var err error
+// Returns error, if the VCS configuration or the latest commit cannot be read
+func UpdateVCSConfig(repoId, environment string, failedProjects map[string][]*params.ProjectParams) error {
+	vcsConfig, envVCSConfig, _, err := getVCSEnvironmentDetails(repoId, environment)
+	if err != nil {
+		return err
+	}
 	envVCSConfig.LastAttemptedRev, err = getLatestCommitId()
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting latest commit-id", err)
+		return fmt.Errorf("error while getting latest commit-id: %w", err)
 	}
 	envVCSConfig.FailedProjects = failedProjects
 
@@ -554,6 +636,7 @@ func updateVCSConfig(repoId, environment string, failedProjects map[string][]*pa
 	}
 	vcsConfig.Repos[repoId].Environments[environment] = envVCSConfig
 	utils.WriteConfigFile(vcsConfig, VCSConfigFilePath)
+	return nil
 }
 
 // Logs the deletion project info message and appends the project to delete (projectParam) into deletedProjectsPerType map.
@@ -572,19 +655,29 @@ func handleProjectDeletion(i int, projectParam *params.ProjectParams, deletedPro
 // Deploy all the changes to the specified environment.
 // accesstoken is the access token to access the APIM product REST APIs
 // environment is the environment name
-func DeployChangedFiles(accessToken, environment string) map[string][]*params.ProjectParams {
+func DeployChangedFiles(accessToken, environment string) (map[string][]*params.ProjectParams, error) {
 	mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
 
-	changeDirectoryToSourceRepo(mainConfig)
+	if err := changeDirectoryToSourceRepo(mainConfig); err != nil {
+		return nil, err
+	}
 	// Get the status of the source repo
-	sourceRepoId, _, sourceRepoUpdatedProjectsPerType := GetStatus(environment, FromRevTypeLastAttempted)
+	sourceRepoId, _, sourceRepoUpdatedProjectsPerType, err := GetStatus(environment, FromRevTypeLastAttempted)
+	if err != nil {
+		return nil, err
+	}
 
 	var deploymentRepoId string
 	var deploymentRepoUpdatedProjectsPerType map[string][]*params.ProjectParams
 	if mainConfig.Config.VCSDeploymentRepoPath != "" {
-		changeDirectory(mainConfig.Config.VCSDeploymentRepoPath)
+		if err = changeDirectory(mainConfig.Config.VCSDeploymentRepoPath); err != nil {
+			return nil, err
+		}
 		// Get the status of the deployment repo
-		deploymentRepoId, _, deploymentRepoUpdatedProjectsPerType = GetStatus(environment, FromRevTypeLastAttempted)
+		deploymentRepoId, _, deploymentRepoUpdatedProjectsPerType, err = GetStatus(environment, FromRevTypeLastAttempted)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Get the aggregated status of both the source and the deployment repos
@@ -592,40 +685,59 @@ func DeployChangedFiles(accessToken, environment string) map[string][]*params.Pr
 		deploymentRepoUpdatedProjectsPerType)
 
 	// Again change directory to the source repo and deploy the updated projects
-	changeDirectoryToSourceRepo(mainConfig)
-	hasDeletedProjects, deletedProjectsPerType, failedProjects :=
+	if err = changeDirectoryToSourceRepo(mainConfig); err != nil {
+		return nil, err
+	}
+	hasDeletedProjects, deletedProjectsPerType, failedProjects, err :=
 		deployUpdatedProjects(accessToken, sourceRepoId, deploymentRepoId, environment, totalProjectsToUpdate, updatedProjectsPerType)
+	if err != nil {
+		return nil, err
+	}
 
 	// Deletion will only be considered for source repo
 	if hasDeletedProjects {
-		changeDirectoryToSourceRepo(mainConfig)
+		if err = changeDirectoryToSourceRepo(mainConfig); err != nil {
+			return nil, err
+		}
 		//check whether project deletion is disabled
 		if !mainConfig.Config.VCSDeletionEnabled {
-			utils.HandleErrorAndExit("Error: there are projects to delete while project "+
-				"deletion is disabled via VCS", nil)
+			return nil, errors.New("there are projects to delete while project deletion is disabled via VCS")
 		}
 
 		// work on deleted files
-		_, envVCSConfig, hasEnv := getVCSEnvironmentDetails(sourceRepoId, environment)
+		_, envVCSConfig, hasEnv, err := getVCSEnvironmentDetails(sourceRepoId, environment)
+		if err != nil {
+			return nil, err
+		}
 		if !hasEnv || len(envVCSConfig.LastSuccessfulRev) == 0 {
-			utils.HandleErrorAndExit("Error: there are projects to delete but no last successful "+
-				"revision available in vcs config (vcs_config.yaml)", nil)
-			return nil
+			return nil, errors.New("there are projects to delete but no last successful " +
+				"revision available in vcs config (vcs_config.yaml)")
+		}
+		currentBranch, err := getCurrentBranch()
+		if err != nil {
+			return nil, err
 		}
-		currentBranch := getCurrentBranch()
 		lastSuccessfulRev := envVCSConfig.LastSuccessfulRev[0]
 		tmpBranchName := "tmp-" + lastSuccessfulRev[0:8]
 
 		fmt.Println("\nDeleting projects ..")
-		checkoutNewBranchFromRevision(tmpBranchName, lastSuccessfulRev)
+		if err = checkoutNewBranchFromRevision(tmpBranchName, lastSuccessfulRev); err != nil {
+			return nil, err
+		}
 		failedProjects = deployProjectDeletions(accessToken, environment, deletedProjectsPerType, failedProjects)
-		checkoutBranch(currentBranch)
-		deleteTmpBranch(tmpBranchName)
+		if err = checkoutBranch(currentBranch); err != nil {
+			return nil, err
+		}
+		if err = deleteTmpBranch(tmpBranchName); err != nil {
+			return nil, err
+		}
 
 		// Update the VCS config with failed projects, last attempted and last successful revisions
-		updateVCSConfig(sourceRepoId, environment, failedProjects)
+		if err = UpdateVCSConfig(sourceRepoId, environment, failedProjects); err != nil {
+			return nil, err
+		}
 	}
-	return failedProjects
+	return failedProjects, nil
 }
 
 // Create 'vcs.yaml' in the repository root folder with a unique id (uuid) for the repository.
@@ -666,7 +778,7 @@ func getRepoId() (string, error) {
 	data, err := ioutil.ReadFile(vcsInfoPath)
 	if err == nil {
 		if err := yaml.Unmarshal(data, &repoInfo); err != nil {
-			utils.HandleErrorAndExit("Error parsing "+vcsInfoPath, err)
+			return "", fmt.Errorf("error parsing %s: %w", vcsInfoPath, err)
 		}
 	}
 	return repoInfo.Id, nil
@@ -706,20 +818,23 @@ func logChangedFiles(changedFileList []string) {
 // pathInfoMap is a map of path (string) to project info. This is used for caching and avoid repetitive checking
 // Returns the identified project details. If it is not related to a project, a NONE project info item will be returned
 func getProjectInfoFromProjectFile(envVCSConfig Environment, repoBasePath string, subPath string,
-	pathInfoMap map[string]*params.ProjectParams) *params.ProjectParams {
+	pathInfoMap map[string]*params.ProjectParams) (*params.ProjectParams, error) {
 	subPaths := getSubPaths(repoBasePath, subPath)
 	for _, s := range subPaths {
-		projectParams := checkProjectTypeOfSpecificPath(repoBasePath, s, pathInfoMap)
+		projectParams, err := checkProjectTypeOfSpecificPath(repoBasePath, s, pathInfoMap)
+		if err != nil {
+			return nil, err
+		}
 		if projectParams.Type != utils.ProjectTypeNone {
 			// once we identified the project type, check whether the project is failed previously. If so, mark it as
 			//  failed. This is used to show failed projects by the "status" command.
 			projectParams.FailedDuringPreviousDeploy = failedDuringEarlierDeploy(envVCSConfig, projectParams)
-			return projectParams
+			return projectParams, nil
 		}
 	}
 	return &params.ProjectParams{
 		Type: utils.ProjectTypeNone,
-	}
+	}, nil
 }
 
 // The files in the path "fullPath" is scanned and identifies the project type (API, App,.. ) from this method.
@@ -727,9 +842,9 @@ func getProjectInfoFromProjectFile(envVCSConfig Environment, repoBasePath string
 // pathInfoMap is a map of path (string) to project info. This is used for caching and avoid repetitive checking
 // Returns the identified project details. If it is not related to a project, a NONE project info item will be returned
 func checkProjectTypeOfSpecificPath(repoBasePath, fullPath string,
-	pathInfoMap map[string]*params.ProjectParams) *params.ProjectParams {
+	pathInfoMap map[string]*params.ProjectParams) (*params.ProjectParams, error) {
 	if pathInfoMap[fullPath] != nil {
-		return pathInfoMap[fullPath]
+		return pathInfoMap[fullPath], nil
 	}
 
 	var projectParams = &params.ProjectParams{
@@ -769,7 +884,7 @@ func checkProjectTypeOfSpecificPath(repoBasePath, fullPath string,
 			projectParams.NickName = filepath.Base(projectParams.RelativePath)
 		}
 		// return the projectParams as a deleted project
-		return projectParams
+		return projectParams, nil
 	}
 
 	//If the path exists (checked previously), read through the file names of the specific path and check for
@@ -782,21 +897,21 @@ func checkProjectTypeOfSpecificPath(repoBasePath, fullPath string,
 			projectParams.MetaData = metaData
 			projectParams.Type = utils.ProjectTypeApi
 			if err != nil {
-				utils.HandleErrorAndExit("Error while parsing "+utils.MetaFileAPI+" file:"+fullPathWithFileName, err)
+				return nil, fmt.Errorf("error while parsing %s file %s: %v", utils.MetaFileAPI, fullPathWithFileName, err)
 			}
 		case utils.MetaFileAPIProduct:
 			metaData, err := LoadMetaDataFile(fullPathWithFileName)
 			projectParams.MetaData = metaData
 			projectParams.Type = utils.ProjectTypeApiProduct
 			if err != nil {
-				utils.HandleErrorAndExit("Error while parsing "+utils.MetaFileAPIProduct+" file:"+fullPathWithFileName, err)
+				return nil, fmt.Errorf("error while parsing %s file %s: %v", utils.MetaFileAPIProduct, fullPathWithFileName, err)
 			}
 		case utils.MetaFileApplication:
 			metaData, err := LoadMetaDataFile(fullPathWithFileName)
 			projectParams.MetaData = metaData
 			projectParams.Type = utils.ProjectTypeApplication
 			if err != nil {
-				utils.HandleErrorAndExit("Error while parsing "+utils.MetaFileApplication+" file:"+fullPathWithFileName, err)
+				return nil, fmt.Errorf("error while parsing %s file %s: %v", utils.MetaFileApplication, fullPathWithFileName, err)
 			}
 		}
 		if projectParams.Type != utils.ProjectTypeNone {
@@ -805,7 +920,7 @@ func checkProjectTypeOfSpecificPath(repoBasePath, fullPath string,
 		}
 	}
 	pathInfoMap[fullPath] = projectParams
-	return projectParams
+	return projectParams, nil
 }
 
 // Returns all the subpaths as an array from a given path
@@ -925,23 +1040,25 @@ func contains(stringSlice []string, element string) bool {
 }
 
 // changeDirectory will change the directory to the repoPath specified
-func changeDirectory(repoPath string) {
+func changeDirectory(repoPath string) error {
 	err := os.Chdir(repoPath)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while changing the current directory to "+repoPath, err)
+		return fmt.Errorf("error while changing the current directory to %s: %w", repoPath, err)
 	}
 	utils.Logln("Changed the current directory to  " + repoPath)
+	return nil
 }
 
 // changeDirectoryToSourceRepo will change the directory to the source repo set in mainConfig
-func changeDirectoryToSourceRepo(mainConfig *utils.MainConfig) {
+func changeDirectoryToSourceRepo(mainConfig *utils.MainConfig) error {
 	currentDir, err := os.Getwd()
 	if err != nil {
-		utils.HandleErrorAndExit("Error while retrieving the current directory path.", err)
+		return fmt.Errorf("error while retrieving the current directory path: %w", err)
 	}
 	if !strings.EqualFold(currentDir, mainConfig.Config.VCSSourceRepoPath) {
-		changeDirectory(mainConfig.Config.VCSSourceRepoPath)
+		return changeDirectory(mainConfig.Config.VCSSourceRepoPath)
 	}
+	return nil
 }
 
 // generateSourceProjectPath will derive the source project path by name and the version of an API/API Product
@@ -21,6 +21,7 @@ package impl
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -66,7 +67,7 @@ func AddEnv(envName string, envEndpoints *utils.EnvEndpoints, mainConfigFilePath
 		return errors.New("Environment '" + envName + "' already exists in " + mainConfigFilePath)
 	}
 
-	mainConfig := utils.GetMainConfigFromFile(mainConfigFilePath)
+	mainConfig := utils.ReadMainConfigFile(mainConfigFilePath)
 
 	var validatedEnvEndpoints = utils.EnvEndpoints{
 		TokenEndpoint: envEndpoints.TokenEndpoint,
@@ -96,6 +97,14 @@ func AddEnv(envName string, envEndpoints *utils.EnvEndpoints, mainConfigFilePath
 		validatedEnvEndpoints.MiManagementEndpoint = envEndpoints.MiManagementEndpoint
 	}
 
+	// TLS and proxy settings
+	validatedEnvEndpoints.Connection = envEndpoints.Connection
+	err := utils.PrepareConnectionConfig(&validatedEnvEndpoints.Connection,
+		filepath.Join(utils.APIMClientCertDirPath, envName))
+	if err != nil {
+		return err
+	}
+
 	mainConfig.Environments[envName] = validatedEnvEndpoints
 	utils.WriteConfigFile(mainConfig, mainConfigFilePath)
 
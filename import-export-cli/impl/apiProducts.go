@@ -19,8 +19,8 @@
 package impl
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -36,7 +36,8 @@ import (
 // @param apiProductName : Name of the API Product
 // @param apiProductProvider : Provider of the API Product
 // @return apiId, error
-func GetAPIProductId(accessToken, environment, apiProductName, apiProductProvider string) (string, error) {
+func GetAPIProductId(ctx context.Context, accessToken, environment, apiProductName, apiProductProvider string) (string,
+	error) {
 	// Unified Search endpoint from the config file to search API Products
 	unifiedSearchEndpoint := utils.GetUnifiedSearchEndpointOfEnv(environment, utils.MainConfigFilePath)
 
@@ -49,7 +50,7 @@ func GetAPIProductId(accessToken, environment, apiProductName, apiProductProvide
 	if apiProductProvider != "" {
 		queryVal = queryVal + " provider:\"" + apiProductProvider + "\""
 	}
-	resp, err := utils.InvokeGETRequestWithQueryParam("query", queryVal, unifiedSearchEndpoint, headers)
+	resp, err := utils.InvokeGETRequestWithQueryParam(ctx, "query", queryVal, unifiedSearchEndpoint, headers)
 	if err != nil {
 		return "", err
 	}
@@ -64,18 +65,18 @@ func GetAPIProductId(accessToken, environment, apiProductName, apiProductProvide
 		}
 		// TODO Print the version as well when the versioning support has been implemented for API Products
 		if apiProductProvider != "" {
-			return "", errors.New("Requested API Product is not available in the Publisher. API Product: " + apiProductName +
-				" Provider: " + apiProductProvider)
+			return "", utils.NewNotFoundError("Requested API Product is not available in the Publisher. API Product: " +
+				apiProductName + " Provider: " + apiProductProvider)
 		}
-		return "", errors.New("Requested API Product is not available in the Publisher. API Product: " + apiProductName)
+		return "", utils.NewNotFoundError("Requested API Product is not available in the Publisher. API Product: " +
+			apiProductName)
 	} else {
-		utils.Logf("Error: %s\n", resp.Error())
-		utils.Logf("Body: %s\n", resp.Body())
 		if resp.StatusCode() == http.StatusUnauthorized {
 			// 401 Unauthorized
-			return "", fmt.Errorf("Authorization failed while searching API Product: " + apiProductName)
+			return "", utils.NewResponseError(resp, "Authorization failed while searching API Product: "+apiProductName)
 		}
-		return "", errors.New("Request didn't respond 200 OK for searching API Products. Status: " + resp.Status())
+		return "", utils.NewResponseError(resp, "Request didn't respond 200 OK for searching API Products. Status: "+
+			resp.Status())
 	}
 }
 
@@ -121,7 +122,8 @@ func extractAPIProductDefinition(jsonContent []byte) (*v2.APIProductDefinitionFi
 // @return count (no. of API Products)
 // @return array of API Product objects
 // @return error
-func GetAPIProductList(accessToken, unifiedSearchEndpoint, query, limit string) (count int32, apiProducts []utils.APIProduct, err error) {
+func GetAPIProductList(ctx context.Context, accessToken, unifiedSearchEndpoint, query, limit string) (count int32,
+	apiProducts []utils.APIProduct, err error) {
 	// Unified Search endpoint from the config file to search API Products
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
@@ -137,10 +139,10 @@ func GetAPIProductList(accessToken, unifiedSearchEndpoint, query, limit string)
 		queryParamString += "&limit=" + limit
 	}
 	utils.Logln(utils.LogPrefixInfo+"URL:", unifiedSearchEndpoint+"?"+queryParamString)
-	resp, err := utils.InvokeGETRequestWithQueryParamsString(unifiedSearchEndpoint, queryParamString, headers)
+	resp, err := utils.InvokeGETRequestWithQueryParamsString(ctx, unifiedSearchEndpoint, queryParamString, headers)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+unifiedSearchEndpoint, err)
+		return 0, nil, fmt.Errorf("unable to connect to %s: %w", unifiedSearchEndpoint, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -150,11 +152,11 @@ func GetAPIProductList(accessToken, unifiedSearchEndpoint, query, limit string)
 		unmarshalError := json.Unmarshal([]byte(resp.Body()), &apiProductListResponse)
 
 		if unmarshalError != nil {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+			return 0, nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 		}
 		return apiProductListResponse.Count, apiProductListResponse.List, nil
 	} else {
-		return 0, nil, errors.New(string(resp.Body()))
+		return 0, nil, utils.NewResponseError(resp, "")
 	}
 }
 
@@ -171,10 +173,10 @@ func GetAPIProductRevisionsList(accessToken, revisionListEndpoint string) (count
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 
 	utils.Logln(utils.LogPrefixInfo+"URL:", revisionListEndpoint)
-	resp, err := utils.InvokeGETRequest(revisionListEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(context.Background(), revisionListEndpoint, headers)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+revisionListEndpoint, err)
+		return 0, nil, fmt.Errorf("unable to connect to %s: %w", revisionListEndpoint, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -184,10 +186,10 @@ func GetAPIProductRevisionsList(accessToken, revisionListEndpoint string) (count
 		unmarshalError := json.Unmarshal([]byte(resp.Body()), &revisionListResponse)
 
 		if unmarshalError != nil {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+			return 0, nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 		}
 		return revisionListResponse.Count, revisionListResponse.List, nil
 	} else {
-		return 0, nil, errors.New(string(resp.Body()))
+		return 0, nil, utils.NewResponseError(resp, "")
 	}
 }
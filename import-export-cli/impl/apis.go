@@ -19,8 +19,8 @@
 package impl
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -37,7 +37,7 @@ import (
 // @param apiVersion : Version of the API
 // @param apiProvider : Provider of API
 // @return apiId, error
-func GetAPIId(accessToken, environment, apiName, apiVersion, apiProvider string) (string, error) {
+func GetAPIId(ctx context.Context, accessToken, environment, apiName, apiVersion, apiProvider string) (string, error) {
 	// Unified Search endpoint from the config file to search APIs
 	unifiedSearchEndpoint := utils.GetUnifiedSearchEndpointOfEnv(environment, utils.MainConfigFilePath)
 
@@ -49,7 +49,7 @@ func GetAPIId(accessToken, environment, apiName, apiVersion, apiProvider string)
 	if apiProvider != "" {
 		queryVal = queryVal + " provider:\"" + apiProvider + "\""
 	}
-	resp, err := utils.InvokeGETRequestWithQueryParam("query", queryVal, unifiedSearchEndpoint, headers)
+	resp, err := utils.InvokeGETRequestWithQueryParam(ctx, "query", queryVal, unifiedSearchEndpoint, headers)
 	if err != nil {
 		return "", err
 	}
@@ -63,19 +63,18 @@ func GetAPIId(accessToken, environment, apiName, apiVersion, apiProvider string)
 			return apiId, err
 		}
 		if apiProvider != "" {
-			return "", errors.New("Requested API is not available in the Publisher. API: " + apiName +
+			return "", utils.NewNotFoundError("Requested API is not available in the Publisher. API: " + apiName +
 				" Version: " + apiVersion + " Provider: " + apiProvider)
 		}
-		return "", errors.New("Requested API is not available in the Publisher. API: " + apiName +
+		return "", utils.NewNotFoundError("Requested API is not available in the Publisher. API: " + apiName +
 			" Version: " + apiVersion)
 	} else {
-		utils.Logf("Error: %s\n", resp.Error())
-		utils.Logf("Body: %s\n", resp.Body())
 		if resp.StatusCode() == http.StatusUnauthorized {
 			// 401 Unauthorized
-			return "", fmt.Errorf("Authorization failed while searching API: " + apiName)
+			return "", utils.NewResponseError(resp, "Authorization failed while searching API: "+apiName)
 		}
-		return "", errors.New("Request didn't respond 200 OK for searching APIs. Status: " + resp.Status())
+		return "", utils.NewResponseError(resp, "Request didn't respond 200 OK for searching APIs. Status: "+
+			resp.Status())
 	}
 }
 
@@ -111,7 +110,8 @@ func GetAPIDefinition(filePath string) (*v2.APIDefinitionFile, []byte, error) {
 // @return count (no. of APIs)
 // @return array of API objects
 // @return error
-func GetAPIList(accessToken, apiListEndpoint, query, limit string) (count int32, apis []utils.API, err error) {
+func GetAPIList(ctx context.Context, accessToken, apiListEndpoint, query, limit string) (count int32, apis []utils.API,
+	err error) {
 	queryParamAdded := false
 	getQueryParamConnector := func() (connector string) {
 		if queryParamAdded {
@@ -132,10 +132,10 @@ func GetAPIList(accessToken, apiListEndpoint, query, limit string) (count int32,
 		queryParamSring += getQueryParamConnector() + "limit=" + limit
 	}
 	utils.Logln(utils.LogPrefixInfo+"URL:", apiListEndpoint+"?"+queryParamSring)
-	resp, err := utils.InvokeGETRequestWithQueryParamsString(apiListEndpoint, queryParamSring, headers)
+	resp, err := utils.InvokeGETRequestWithQueryParamsString(ctx, apiListEndpoint, queryParamSring, headers)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+apiListEndpoint, err)
+		return 0, nil, fmt.Errorf("unable to connect to %s: %w", apiListEndpoint, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -145,12 +145,12 @@ func GetAPIList(accessToken, apiListEndpoint, query, limit string) (count int32,
 		unmarshalError := json.Unmarshal([]byte(resp.Body()), &apiListResponse)
 
 		if unmarshalError != nil {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+			return 0, nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 		}
 
 		return apiListResponse.Count, apiListResponse.List, nil
 	} else {
-		return 0, nil, errors.New(string(resp.Body()))
+		return 0, nil, utils.NewResponseError(resp, "")
 	}
 }
 
@@ -160,16 +160,17 @@ func GetAPIList(accessToken, apiListEndpoint, query, limit string) (count int32,
 // @return count (no. of revisions)
 // @return array of revision objects
 // @return error
-func GetRevisionsList(accessToken, revisionListEndpoint string) (count int32, revisions []utils.Revisions, err error) {
+func GetRevisionsList(ctx context.Context, accessToken, revisionListEndpoint string) (count int32,
+	revisions []utils.Revisions, err error) {
 
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 
 	utils.Logln(utils.LogPrefixInfo+"URL:", revisionListEndpoint)
-	resp, err := utils.InvokeGETRequest(revisionListEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(ctx, revisionListEndpoint, headers)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+revisionListEndpoint, err)
+		return 0, nil, fmt.Errorf("unable to connect to %s: %w", revisionListEndpoint, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -179,10 +180,10 @@ func GetRevisionsList(accessToken, revisionListEndpoint string) (count int32, re
 		unmarshalError := json.Unmarshal([]byte(resp.Body()), &revisionListResponse)
 
 		if unmarshalError != nil {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+			return 0, nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 		}
 		return revisionListResponse.Count, revisionListResponse.List, nil
 	} else {
-		return 0, nil, errors.New(string(resp.Body()))
+		return 0, nil, utils.NewResponseError(resp, "")
 	}
 }
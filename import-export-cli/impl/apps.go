@@ -19,8 +19,8 @@
 package impl
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -34,7 +34,7 @@ import (
 // GetAppId Get the ID of an Application if available
 // @param accessToken : Token to call the Developer Portal Rest API
 // @return appId, error
-func GetAppId(accessToken, environment, appName, appOwner string) (string, error) {
+func GetAppId(ctx context.Context, accessToken, environment, appName, appOwner string) (string, error) {
 	// Application REST API endpoint of the environment from the config file
 	applicationEndpoint := utils.GetAdminApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath) +
 		"?user=" + appOwner + "&name=" + appName
@@ -42,7 +42,10 @@ func GetAppId(accessToken, environment, appName, appOwner string) (string, error
 	// Prepping headers
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-	resp, err := utils.InvokeGETRequest(applicationEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(ctx, applicationEndpoint, headers)
+	if err != nil {
+		return "", err
+	}
 
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
@@ -58,17 +61,15 @@ func GetAppId(accessToken, environment, appName, appOwner string) (string, error
 			}
 			return appId, err
 		}
-		return "", errors.New("Cannot find the application: " + appName + " for owner: " + appOwner)
+		return "", utils.NewNotFoundError("Cannot find the application: " + appName + " for owner: " + appOwner)
 
 	} else {
-		utils.Logf("Error: %s\n", resp.Error())
-		utils.Logf("Body: %s\n", resp.Body())
 		if resp.StatusCode() == http.StatusUnauthorized {
 			// 401 Unauthorized
-			return "", fmt.Errorf("Authorization failed while searching CLI application: " + appName)
+			return "", utils.NewResponseError(resp, "Authorization failed while searching CLI application: "+appName)
 		}
-		return "", errors.New("Request didn't respond 200 OK for searching existing applications. " +
-			"Status: " + resp.Status())
+		return "", utils.NewResponseError(resp, "Request didn't respond 200 OK for searching existing applications. "+
+			"Status: "+resp.Status())
 	}
 }
 
@@ -104,8 +105,8 @@ func GetApplicationDefinition(filePath string) (*v2.ApplicationDefinition, []byt
 // @return count (no. of Applications)
 // @return array of Application objects
 // @return error
-func GetApplicationList(accessToken, applicationListEndpoint, appOwner, limit string) (count int32, apps []utils.Application,
-	err error) {
+func GetApplicationList(ctx context.Context, accessToken, applicationListEndpoint, appOwner, limit string) (count int32,
+	apps []utils.Application, err error) {
 
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
@@ -117,12 +118,12 @@ func GetApplicationList(accessToken, applicationListEndpoint, appOwner, limit st
 
 	var resp *resty.Response
 	if appOwner == "" {
-		resp, err = utils.InvokeGETRequest(applicationListEndpoint, headers)
+		resp, err = utils.InvokeGETRequest(ctx, applicationListEndpoint, headers)
 	} else {
-		resp, err = utils.InvokeGETRequestWithQueryParam("user", appOwner, applicationListEndpoint, headers)
+		resp, err = utils.InvokeGETRequestWithQueryParam(ctx, "user", appOwner, applicationListEndpoint, headers)
 	}
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+applicationListEndpoint, err)
+		return 0, nil, fmt.Errorf("unable to connect to %s: %w", applicationListEndpoint, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -132,12 +133,12 @@ func GetApplicationList(accessToken, applicationListEndpoint, appOwner, limit st
 		unmarshalError := json.Unmarshal([]byte(resp.Body()), &appListResponse)
 
 		if unmarshalError != nil {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+			return 0, nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 		}
 
 		return appListResponse.Count, appListResponse.List, nil
 
 	} else {
-		return 0, nil, errors.New(resp.Status())
+		return 0, nil, utils.NewResponseError(resp, resp.Status())
 	}
 }
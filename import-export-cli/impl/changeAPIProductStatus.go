@@ -19,6 +19,8 @@
 package impl
 
 import (
+	"context"
+
 	"github.com/go-resty/resty/v2"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -39,9 +41,9 @@ func ChangeAPIProductStatusInEnv(accessToken, environment, stateChangeAction, na
 // @return response Response in the form of *resty.Response
 func changeAPIProductStatus(changeAPIProductStatusEndpoint, stateChangeAction, name, provider, environment, accessToken string) (*resty.Response, error) {
 	changeAPIProductStatusEndpoint = utils.AppendSlashToString(changeAPIProductStatusEndpoint)
-	apiProductId, err := GetAPIProductId(accessToken, environment, name, provider)
+	apiProductId, err := GetAPIProductId(context.Background(), accessToken, environment, name, provider)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting API Product Id for state change ", err)
+		return nil, err
 	}
 	url := changeAPIProductStatusEndpoint + "change-lifecycle"
 	utils.Logln(utils.LogPrefixInfo+"APIProductStateChange: URL:", url)
@@ -54,7 +56,7 @@ func changeAPIProductStatus(changeAPIProductStatusEndpoint, stateChangeAction, n
 	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 
-	resp, err := utils.InvokePOSTRequestWithQueryParam(queryParams, url, headers, "")
+	resp, err := utils.InvokePOSTRequestWithQueryParam(context.Background(), queryParams, url, headers, "")
 	if err != nil {
 		return nil, err
 	}
@@ -19,14 +19,18 @@
 package impl
 
 import (
+	"context"
+
 	"github.com/go-resty/resty/v2"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 // ChangeAPIStatusInEnv function is used with change-status api command
-func ChangeAPIStatusInEnv(accessToken, environment, stateChangeAction, name, version, provider string) (*resty.Response, error) {
+func ChangeAPIStatusInEnv(ctx context.Context, accessToken, environment, stateChangeAction, name, version,
+	provider string) (*resty.Response, error) {
 	changeAPIStatusEndpoint := utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath)
-	return changeAPIStatus(changeAPIStatusEndpoint, stateChangeAction, name, version, provider, environment, accessToken)
+	return changeAPIStatus(ctx, changeAPIStatusEndpoint, stateChangeAction, name, version, provider, environment,
+		accessToken)
 }
 
 // changeAPIStatus
@@ -38,11 +42,12 @@ func ChangeAPIStatusInEnv(accessToken, environment, stateChangeAction, name, ver
 // @param environment : Environment where the API resides
 // @param accessToken : Access Token for the resource
 // @return response Response in the form of *resty.Response
-func changeAPIStatus(changeAPIStatusEndpoint, stateChangeAction, name, version, provider, environment, accessToken string) (*resty.Response, error) {
+func changeAPIStatus(ctx context.Context, changeAPIStatusEndpoint, stateChangeAction, name, version, provider,
+	environment, accessToken string) (*resty.Response, error) {
 	changeAPIStatusEndpoint = utils.AppendSlashToString(changeAPIStatusEndpoint)
-	apiId, err := GetAPIId(accessToken, environment, name, version, provider)
+	apiId, err := GetAPIId(ctx, accessToken, environment, name, version, provider)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting API Id for state change ", err)
+		return nil, err
 	}
 	url := changeAPIStatusEndpoint + "change-lifecycle"
 	utils.Logln(utils.LogPrefixInfo+"APIStateChange: URL:", url)
@@ -55,7 +60,7 @@ func changeAPIStatus(changeAPIStatusEndpoint, stateChangeAction, name, version,
 	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 
-	resp, err := utils.InvokePOSTRequestWithQueryParam(queryParams, url, headers, "")
+	resp, err := utils.InvokePOSTRequestWithQueryParam(ctx, queryParams, url, headers, "")
 	if err != nil {
 		return nil, err
 	}
@@ -19,6 +19,8 @@
 package impl
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -36,7 +38,7 @@ import (
 // ExecuteNewFileUploadRequest forms an HTTP request
 // Helper function for forming multi-part form data
 // Returns the formed http request and errors
-func ExecuteNewFileUploadRequest(uri string, params map[string]string, paramName, path,
+func ExecuteNewFileUploadRequest(ctx context.Context, uri string, params map[string]string, paramName, path,
 	accessToken string, isOAuthToken bool) (*resty.Response, error) {
 
 	headers := make(map[string]string)
@@ -47,7 +49,7 @@ func ExecuteNewFileUploadRequest(uri string, params map[string]string, paramName
 	}
 	headers[utils.HeaderAccept] = "application/json"
 	headers[utils.HeaderConnection] = utils.HeaderValueKeepAlive
-	return utils.InvokePOSTRequestWithFileAndQueryParams(params, uri, headers, paramName, path)
+	return utils.InvokePOSTRequestWithFileAndQueryParams(ctx, params, uri, headers, paramName, path)
 }
 
 // From the template data (tmpl) writes the target file using the provided mainConfig
@@ -76,6 +78,9 @@ func WriteTargetFileFromTemplate(targetFile string, tmpl []byte, envs *utils.Mai
 func IncludeMetaFileToZip(sourceZipFile, targetZipFile, metaFile string, metaData utils.MetaData) error {
 	//	Create a temp directory (tmpClonedLoc) by extracting the original zip file.
 	tmpClonedLoc, err := utils.GetTempCloneFromDirOrZip(sourceZipFile)
+	if err != nil {
+		return err
+	}
 	// Create the *_meta.yaml file inside the cloned directory.
 	tmpLocationForAPIMetaFile := filepath.Join(tmpClonedLoc, metaFile)
 	marshaledData, err := jsoniter.Marshal(metaData)
@@ -84,6 +89,9 @@ func IncludeMetaFileToZip(sourceZipFile, targetZipFile, metaFile string, metaDat
 	}
 
 	jsonMetaData, err := gabs.ParseJSON(marshaledData)
+	if err != nil {
+		return err
+	}
 	metaContent, err := utils.JsonToYaml(jsonMetaData.Bytes())
 	if err != nil {
 		return err
@@ -92,16 +100,21 @@ func IncludeMetaFileToZip(sourceZipFile, targetZipFile, metaFile string, metaDat
 	//write the meta content into *_meta.yaml files
 	err = ioutil.WriteFile(tmpLocationForAPIMetaFile, metaContent, 0644)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating api_meta.yaml inside the exported zip archive", err)
+		return fmt.Errorf("error creating %s inside the exported zip archive: %w", metaFile, err)
 	}
 
 	err = utils.Zip(tmpClonedLoc, targetZipFile)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating the final zip archive", err)
+		return fmt.Errorf("error creating the final zip archive: %w", err)
 	}
 	return nil
 }
 
+// newImportResponseError creates the error of a failed import with the status and the response body of the server
+func newImportResponseError(resp *resty.Response) error {
+	return utils.NewResponseError(resp, "Status: "+resp.Status()+"\nResponse: "+string(resp.Body()))
+}
+
 //Load the x_meta.yaml file in the provided path and return
 func LoadMetaInfoFromFile(path string) (*utils.MetaData, error) {
 	fileContent, err := GetFileContent(path)
@@ -0,0 +1,98 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	configKeyHeader    = "KEY"
+	configValueHeader  = "VALUE"
+	configSourceHeader = "SOURCE"
+)
+
+// configValue contains a value of the resolved configuration and where it came from
+type configValue struct {
+	key    string
+	value  string
+	source string
+}
+
+func newConfigValueFromResolved(v utils.ConfigValue) *configValue {
+	return &configValue{key: v.Key, value: fmt.Sprint(v.Value), source: v.Source}
+}
+
+// Key of the value
+func (c configValue) Key() string {
+	return c.key
+}
+
+// Value of the key
+func (c configValue) Value() string {
+	return c.value
+}
+
+// Source of the value, which is a config file or an environment variable
+func (c configValue) Source() string {
+	return c.source
+}
+
+// MarshalJSON returns marshaled methods
+func (c *configValue) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(c)
+}
+
+// PrintConfigValues prints the values of the resolved configuration and their sources
+// @param values : Values of the resolved configuration
+// @param format : Format of the output
+// @param defaultConfigValuesTableFormat : Default table format
+func PrintConfigValues(values []utils.ConfigValue, format, defaultConfigValuesTableFormat string) {
+	// create config values context with standard output
+	configValuesContext := formatter.NewListContext(os.Stdout, format, defaultConfigValuesTableFormat)
+
+	// create a new renderer function which iterate collection
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, v := range values {
+			if err := t.Execute(w, newConfigValueFromResolved(v)); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	configValuesTableHeaders := map[string]string{
+		"Key":    configKeyHeader,
+		"Value":  configValueHeader,
+		"Source": configSourceHeader,
+	}
+
+	// execute context
+	if err := configValuesContext.Write(renderer, configValuesTableHeaders); err != nil {
+		utils.HandleErrorAndExit("Error executing template", err)
+	}
+}
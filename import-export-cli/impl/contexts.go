@@ -0,0 +1,196 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	contextCurrentHeader    = "CURRENT"
+	contextNameHeader       = "NAME"
+	contextAPIMHeader       = "APIM"
+	contextMIHeader         = "MI"
+	contextMgwClusterHeader = "MG CLUSTER"
+	contextTenantHeader     = "TENANT"
+	contextOutputHeader     = "OUTPUT"
+)
+
+// namedContext contains information about a context of the main_config.yaml
+type namedContext struct {
+	name    string
+	current bool
+	context utils.Context
+}
+
+// Current returns * for the current context
+func (c namedContext) Current() string {
+	if c.current {
+		return "*"
+	}
+	return ""
+}
+
+// Name of the context
+func (c namedContext) Name() string {
+	return c.name
+}
+
+// APIM environment of the context
+func (c namedContext) APIM() string {
+	return c.context.APIMEnvironment
+}
+
+// MI environment of the context
+func (c namedContext) MI() string {
+	return c.context.MIEnvironment
+}
+
+// MgwCluster of the context
+func (c namedContext) MgwCluster() string {
+	return c.context.MgwCluster
+}
+
+// Tenant of the context
+func (c namedContext) Tenant() string {
+	return c.context.Tenant
+}
+
+// Output format of the context
+func (c namedContext) Output() string {
+	return c.context.Output
+}
+
+// MarshalJSON returns marshaled methods
+func (c *namedContext) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(c)
+}
+
+// SetContext validates the environments of a context and adds or replaces it in the config file
+// @param name : Name of the context
+// @param context : Environments and defaults of the context
+// @param mainConfigFilePath : Path to file where contexts are stored
+// @return error
+func SetContext(name string, context utils.Context, mainConfigFilePath string) error {
+	if name == "" {
+		return errors.New("name of the context cannot be blank")
+	}
+	if context.APIMEnvironment != "" && !utils.APIMExistsInEnv(context.APIMEnvironment, mainConfigFilePath) {
+		return errors.New("APIM does not exists in " + context.APIMEnvironment + ". Add it using add env")
+	}
+	if context.MIEnvironment != "" && !utils.MIExistsInEnv(context.MIEnvironment, mainConfigFilePath) {
+		return errors.New("MI does not exists in " + context.MIEnvironment + ". Add it using add env")
+	}
+	if context.MgwCluster != "" &&
+		!utils.MgwAdapterEnvExistsInMainConfigFile(context.MgwCluster, mainConfigFilePath) {
+		return errors.New("Microgateway cluster " + context.MgwCluster + " does not exists. Add it using mg add env")
+	}
+	if context.Output != "" && context.Output != utils.OutputFormatJSON && context.Output != utils.OutputFormatYAML {
+		return fmt.Errorf("%w: output format should be either %s or %s", utils.ErrInvalidUsage,
+			utils.OutputFormatJSON, utils.OutputFormatYAML)
+	}
+
+	exists := utils.ContextExistsInMainConfigFile(name, mainConfigFilePath)
+	utils.SetContextInMainConfigFile(name, context, mainConfigFilePath)
+	if exists {
+		fmt.Printf("Successfully updated context '%s'\n", name)
+	} else {
+		fmt.Printf("Successfully added context '%s'\n", name)
+	}
+	return nil
+}
+
+// UseContext changes the current context of the config file
+// @param name : Name of the context
+// @param mainConfigFilePath : Path to file where contexts are stored
+// @return error
+func UseContext(name, mainConfigFilePath string) error {
+	if err := utils.SetCurrentContextInMainConfigFile(name, mainConfigFilePath); err != nil {
+		return err
+	}
+	fmt.Printf("Switched to context '%s'\n", name)
+	if override := os.Getenv(utils.ContextEnvVar); override != "" && override != name {
+		fmt.Printf("Context '%s' is still used while %s is set\n", override, utils.ContextEnvVar)
+	}
+	return nil
+}
+
+// DeleteContext removes a context from the config file
+// @param name : Name of the context
+// @param mainConfigFilePath : Path to file where contexts are stored
+// @return error
+func DeleteContext(name, mainConfigFilePath string) error {
+	if err := utils.RemoveContextFromMainConfigFile(name, mainConfigFilePath); err != nil {
+		return err
+	}
+	fmt.Printf("Successfully deleted context '%s'\n", name)
+	return nil
+}
+
+// PrintContexts prints the contexts of the config file and marks the current one
+// @param contexts : Contexts of the config file
+// @param current : Name of the current context
+// @param format : Format of the output
+// @param defaultContextsTableFormat : Default table format
+func PrintContexts(contexts map[string]utils.Context, current, format, defaultContextsTableFormat string) {
+	// create contexts context with standard output
+	contextsContext := formatter.NewListContext(os.Stdout, format, defaultContextsTableFormat)
+
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// create a new renderer function which iterate collection
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, name := range names {
+			c := &namedContext{name: name, current: name == current, context: contexts[name]}
+			if err := t.Execute(w, c); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	contextsTableHeaders := map[string]string{
+		"Current":    contextCurrentHeader,
+		"Name":       contextNameHeader,
+		"APIM":       contextAPIMHeader,
+		"MI":         contextMIHeader,
+		"MgwCluster": contextMgwClusterHeader,
+		"Tenant":     contextTenantHeader,
+		"Output":     contextOutputHeader,
+	}
+
+	// execute context
+	if err := contextsContext.Write(renderer, contextsTableHeaders); err != nil {
+		utils.HandleErrorAndExit("Error executing template", err)
+	}
+}
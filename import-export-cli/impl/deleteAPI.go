@@ -19,7 +19,7 @@
 package impl
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -35,25 +35,26 @@ import (
 // @param deleteAPIVersion : Version of the API to delete
 // @param deleteAPIProvider : Provider of API
 // @return response Response in the form of *resty.Response
-func DeleteAPI(accessToken, environment, deleteAPIName, deleteAPIVersion, deleteAPIProvider string) (*resty.Response, error) {
+func DeleteAPI(ctx context.Context, accessToken, environment, deleteAPIName, deleteAPIVersion,
+	deleteAPIProvider string) (*resty.Response, error) {
 	deleteEndpoint := utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath)
 	deleteEndpoint = utils.AppendSlashToString(deleteEndpoint)
-	apiId, err := GetAPIId(accessToken, environment, deleteAPIName, deleteAPIVersion, deleteAPIProvider)
+	apiId, err := GetAPIId(ctx, accessToken, environment, deleteAPIName, deleteAPIVersion, deleteAPIProvider)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting API Id for deletion ", err)
+		return nil, err
 	}
 	url := deleteEndpoint + apiId
 	utils.Logln(utils.LogPrefixInfo+"DeleteAPI: URL:", url)
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 
-	resp, err := utils.InvokeDELETERequest(url, headers)
+	resp, err := utils.InvokeDELETERequest(ctx, url, headers)
 
 	if err != nil {
 		return nil, err
 	}
 	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
-		return nil, errors.New(strconv.Itoa(resp.StatusCode()) + ":<" + string(resp.Body()) + ">")
+		return nil, utils.NewResponseError(resp, strconv.Itoa(resp.StatusCode())+":<"+string(resp.Body())+">")
 	}
 	return resp, nil
 }
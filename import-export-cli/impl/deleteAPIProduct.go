@@ -19,7 +19,7 @@
 package impl
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"strconv"
 
@@ -35,25 +35,26 @@ import (
 // @param apiProductName : Name of the API Product
 // @param apiProductProvider : Provider of the API Product
 // @return response Response in the form of *resty.Response
-func DeleteAPIProduct(accessToken, environment, apiProductName, apiProductProvider string) (*resty.Response, error) {
+func DeleteAPIProduct(ctx context.Context, accessToken, environment, apiProductName,
+	apiProductProvider string) (*resty.Response, error) {
 	deleteEndpoint := utils.GetApiProductListEndpointOfEnv(environment, utils.MainConfigFilePath)
 	deleteEndpoint = utils.AppendSlashToString(deleteEndpoint)
-	apiProductId, err := GetAPIProductId(accessToken, environment, apiProductName, apiProductProvider)
+	apiProductId, err := GetAPIProductId(ctx, accessToken, environment, apiProductName, apiProductProvider)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting API Product Id for deletion ", err)
+		return nil, err
 	}
 	url := deleteEndpoint + apiProductId
 	utils.Logln(utils.LogPrefixInfo+"DeleteAPIProduct: URL:", url)
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 
-	resp, err := utils.InvokeDELETERequest(url, headers)
+	resp, err := utils.InvokeDELETERequest(ctx, url, headers)
 
 	if err != nil {
 		return nil, err
 	}
 	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
-		return nil, errors.New(strconv.Itoa(resp.StatusCode()) + ":<" + string(resp.Body()) + ">")
+		return nil, utils.NewResponseError(resp, strconv.Itoa(resp.StatusCode())+":<"+string(resp.Body())+">")
 	}
 	return resp, nil
 }
@@ -19,7 +19,7 @@
 package impl
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -32,28 +32,30 @@ import (
 // @param deleteEndpoint : API Manager Developer Portal REST API Endpoint for the environment
 // @param accessToken : Access Token for the resource
 // @return response Response in the form of *resty.Response
-func DeleteApplication(accessToken, environment, deleteAppName, deleteAppOwner string) (*resty.Response, error) {
+func DeleteApplication(ctx context.Context, accessToken, environment, deleteAppName,
+	deleteAppOwner string) (*resty.Response, error) {
 	deleteEndpoint := utils.GetAdminApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath)
 	deleteEndpoint = utils.AppendSlashToString(deleteEndpoint)
-	appId, err := GetAppId(accessToken, environment, deleteAppName, deleteAppOwner)
+	appId, err := GetAppId(ctx, accessToken, environment, deleteAppName, deleteAppOwner)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting App Id for deletion ", err)
+		return nil, err
 	}
 	if appId == "" {
-		utils.HandleErrorAndExit("Cannot find the application: "+deleteAppName+" for owner: "+deleteAppOwner, err)
+		return nil, utils.NewNotFoundError("Cannot find the application: " + deleteAppName + " for owner: " +
+			deleteAppOwner)
 	}
 	url := deleteEndpoint + appId
 	utils.Logln(utils.LogPrefixInfo+"DeleteApplication: URL:", url)
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 
-	resp, err := utils.InvokeDELETERequest(url, headers)
+	resp, err := utils.InvokeDELETERequest(ctx, url, headers)
 
 	if err != nil {
 		return nil, err
 	}
 	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
-		return nil, errors.New(strconv.Itoa(resp.StatusCode()) + ":<" + string(resp.Body()) + ">")
+		return nil, utils.NewResponseError(resp, strconv.Itoa(resp.StatusCode())+":<"+string(resp.Body())+">")
 	}
 	return resp, nil
 }
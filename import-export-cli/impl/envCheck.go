@@ -0,0 +1,310 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	miImpl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Statuses of an endpoint check
+const (
+	EndpointCheckPass = "PASS"
+	EndpointCheckWarn = "WARN"
+	EndpointCheckFail = "FAIL"
+)
+
+const (
+	endpointCheckComponentHeader = "COMPONENT"
+	endpointCheckEndpointHeader  = "ENDPOINT"
+	endpointCheckStatusHeader    = "STATUS"
+	endpointCheckVersionHeader   = "VERSION"
+	endpointCheckTimeHeader      = "TIME"
+	endpointCheckMessageHeader   = "MESSAGE"
+)
+
+// supportedMIVersionPrefix is the Micro Integrator version supported by the mi commands
+const supportedMIVersionPrefix = "4."
+
+// supportedPublisherVersion is the version of the publisher REST API used by the API Manager commands
+const supportedPublisherVersion = "v3"
+
+// apimProductVersions maps the version of the publisher REST API to the API Manager versions exposing it
+var apimProductVersions = map[string]string{
+	"v1": "3.0.x - 3.2.x",
+	"v2": "4.0.x",
+	"v3": "4.1.x",
+	"v4": "4.2.x",
+}
+
+// EndpointCheck is the result of probing an endpoint of an environment
+type EndpointCheck struct {
+	Component string `json:"component" yaml:"component"`
+	Endpoint  string `json:"endpoint" yaml:"endpoint"`
+	Status    string `json:"status" yaml:"status"`
+	Version   string `json:"version,omitempty" yaml:"version,omitempty"`
+	Time      string `json:"time,omitempty" yaml:"time,omitempty"`
+	Message   string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// ProbeEndpoint invokes an endpoint without credentials. An error is returned if the endpoint could not be reached,
+// which describes TLS trust failures
+// @param method : HTTP method
+// @param url : URL of the endpoint
+// @return *resty.Response : response of the endpoint
+// @return time.Duration : time taken by the request
+// @return error
+func ProbeEndpoint(method, url string) (*resty.Response, time.Duration, error) {
+	start := time.Now()
+	resp, err := utils.GetHttpClient(url).R().Execute(method, url)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		return nil, elapsed, describeConnectionError(err)
+	}
+	return resp, elapsed, nil
+}
+
+// NewEndpointCheck creates the result of probing an endpoint from its response. The endpoint is reachable if it
+// responds with any status other than 404 and 5xx, e.g. 401 for the requests without credentials
+func NewEndpointCheck(component, url string, resp *resty.Response, elapsed time.Duration, err error) EndpointCheck {
+	check := EndpointCheck{Component: component, Endpoint: url, Time: elapsed.String()}
+	switch {
+	case err != nil:
+		check.Status = EndpointCheckFail
+		check.Message = err.Error()
+	case resp.StatusCode() == http.StatusNotFound:
+		check.Status = EndpointCheckFail
+		check.Message = "not found, the server does not expose this endpoint"
+	case resp.StatusCode() >= http.StatusInternalServerError:
+		check.Status = EndpointCheckFail
+		check.Message = "server error: " + resp.Status()
+	case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+		check.Status = EndpointCheckPass
+		check.Message = "reachable (" + resp.Status() + " without credentials)"
+	default:
+		check.Status = EndpointCheckPass
+		check.Message = "reachable (" + resp.Status() + ")"
+	}
+	return check
+}
+
+// describeConnectionError explains why the connection to an endpoint failed
+func describeConnectionError(err error) error {
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCertErr x509.CertificateInvalidError
+	switch {
+	case errors.As(err, &unknownAuthorityErr):
+		return errors.New("TLS: the server certificate is not trusted. Add the CA certificate to " +
+			utils.DefaultCertDirPath + " or set the ca_cert of the connection of the environment")
+	case errors.As(err, &hostnameErr):
+		return errors.New("TLS: the server certificate is not valid for " + hostnameErr.Host +
+			". Set the server_name of the connection of the environment")
+	case errors.As(err, &invalidCertErr):
+		return errors.New("TLS: invalid server certificate: " + invalidCertErr.Error())
+	}
+	return errors.New("unreachable: " + err.Error())
+}
+
+// CheckAPIMEndpoints probes the REST APIs, the client registration and the token endpoints of the API Manager of an
+// environment, detects the versions of the REST APIs and warns if they are not the ones used by apictl
+// @param env : Name of the environment
+// @return []EndpointCheck : results of the endpoints
+func CheckAPIMEndpoints(env string) []EndpointCheck {
+	publisherCheck := checkRESTAPI("Publisher", utils.GetPublisherEndpointOfEnv(env, utils.MainConfigFilePath))
+	checks := []EndpointCheck{
+		publisherCheck,
+		checkRESTAPI("DevPortal", devPortalBaseOf(utils.GetDevPortalApplicationListEndpointOfEnv(env,
+			utils.MainConfigFilePath))),
+		checkRESTAPI("Admin", utils.GetAdminEndpointOfEnv(env, utils.MainConfigFilePath)),
+	}
+
+	registrationEndpoint := utils.GetRegistrationEndpointOfEnv(env, utils.MainConfigFilePath)
+	resp, elapsed, err := ProbeEndpoint(http.MethodGet, registrationEndpoint)
+	checks = append(checks, NewEndpointCheck("DCR", registrationEndpoint, resp, elapsed, err))
+
+	tokenEndpoint := utils.GetInternalTokenEndpointOfEnv(env, utils.MainConfigFilePath)
+	resp, elapsed, err = ProbeEndpoint(http.MethodPost, tokenEndpoint)
+	checks = append(checks, NewEndpointCheck("Token", tokenEndpoint, resp, elapsed, err))
+
+	return append(checks, checkAPIMProductVersion(publisherCheck))
+}
+
+// checkRESTAPI probes the swagger of a REST API at the version used by apictl. If the version is not found the
+// other versions are probed to tell the version of the server
+func checkRESTAPI(component, baseUrl string) EndpointCheck {
+	url := baseUrl + "/swagger.yaml"
+	resp, elapsed, err := ProbeEndpoint(http.MethodGet, url)
+	check := NewEndpointCheck(component, baseUrl, resp, elapsed, err)
+	expected := baseUrl[strings.LastIndex(baseUrl, "/")+1:]
+	if err != nil {
+		return check
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		check.Version = expected
+		var swagger struct {
+			Info struct {
+				Version string `yaml:"version"`
+			} `yaml:"info"`
+		}
+		if yaml.Unmarshal(resp.Body(), &swagger) == nil && swagger.Info.Version != "" {
+			check.Version = swagger.Info.Version
+		}
+	case http.StatusNotFound:
+		for _, version := range []string{"v4", "v3", "v2", "v1"} {
+			if version == expected {
+				continue
+			}
+			otherResp, _, otherErr := ProbeEndpoint(http.MethodGet,
+				strings.TrimSuffix(baseUrl, expected)+version+"/swagger.yaml")
+			if otherErr == nil && otherResp.StatusCode() != http.StatusNotFound {
+				check.Version = version
+				check.Message = fmt.Sprintf("incompatible: the server exposes %s REST API %s while %s uses %s",
+					strings.ToLower(component), version, utils.ProjectName, expected)
+				break
+			}
+		}
+	default:
+		if check.Status == EndpointCheckPass {
+			check.Version = expected
+		}
+	}
+	return check
+}
+
+// checkAPIMProductVersion tells the API Manager version from the version of the publisher REST API
+func checkAPIMProductVersion(publisherCheck EndpointCheck) EndpointCheck {
+	check := EndpointCheck{Component: "API Manager", Endpoint: publisherCheck.Endpoint, Status: EndpointCheckPass}
+	version := publisherCheck.Version
+	if version != "" {
+		// the swagger of the REST API may have the minor version as well (e.g. v1.2)
+		version = "v" + strings.SplitN(strings.TrimPrefix(version, "v"), ".", 2)[0]
+	}
+	productVersion, ok := apimProductVersions[version]
+	if !ok {
+		check.Status = EndpointCheckWarn
+		check.Message = "version could not be detected"
+		return check
+	}
+	check.Version = productVersion
+	check.Message = "detected from the publisher REST API " + version
+	if version != supportedPublisherVersion {
+		check.Status = EndpointCheckWarn
+		check.Message = utils.ProjectName + " uses the REST APIs and the v2 artifact format of API Manager " +
+			apimProductVersions[supportedPublisherVersion]
+	}
+	return check
+}
+
+// devPortalBaseOf returns the base URL of the devportal REST API from the URL of a resource
+func devPortalBaseOf(resourceUrl string) string {
+	return resourceUrl[:strings.LastIndex(resourceUrl, "/")]
+}
+
+// CheckMIEndpoint probes the management API of the Micro Integrator of an environment. The version of the Micro
+// Integrator is detected if logged in to it
+// @param env : Name of the environment
+// @return EndpointCheck : result of the endpoint
+func CheckMIEndpoint(env string) EndpointCheck {
+	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementServerResource, env, utils.MainConfigFilePath)
+	resp, elapsed, err := ProbeEndpoint(http.MethodGet, url)
+	check := NewEndpointCheck("MI Management", url, resp, elapsed, err)
+	if check.Status != EndpointCheckPass {
+		return check
+	}
+
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil || !store.HasMI(env) {
+		check.Message += ", login with 'mi login' to detect the version"
+		return check
+	}
+	server, err := miImpl.GetServerSummary(env)
+	if err != nil {
+		check.Status = EndpointCheckWarn
+		check.Message = "version could not be detected: " + err.Error()
+		return check
+	}
+	check.Version = server.ProductName + " " + server.ProductVersion
+	if !strings.HasPrefix(server.ProductVersion, supportedMIVersionPrefix) {
+		check.Status = EndpointCheckWarn
+		check.Message = utils.ProjectName + " supports Micro Integrator " + supportedMIVersionPrefix + "x"
+	}
+	return check
+}
+
+// EndpointChecksFailed returns whether any of the endpoints failed
+func EndpointChecksFailed(checks []EndpointCheck) bool {
+	for _, check := range checks {
+		if check.Status == EndpointCheckFail {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintEndpointChecks prints the results of the endpoints of an environment
+// @param checks : Results of the endpoints
+// @param format : Format of the output
+// @param defaultEndpointChecksTableFormat : Default table format
+func PrintEndpointChecks(checks []EndpointCheck, format, defaultEndpointChecksTableFormat string) {
+	// create endpoint checks context with standard output
+	checksContext := formatter.NewListContext(os.Stdout, format, defaultEndpointChecksTableFormat)
+
+	// create a new renderer function which iterate collection
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, check := range checks {
+			if err := t.Execute(w, check); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	// headers for table
+	checksTableHeaders := map[string]string{
+		"Component": endpointCheckComponentHeader,
+		"Endpoint":  endpointCheckEndpointHeader,
+		"Status":    endpointCheckStatusHeader,
+		"Version":   endpointCheckVersionHeader,
+		"Time":      endpointCheckTimeHeader,
+		"Message":   endpointCheckMessageHeader,
+	}
+
+	// execute context
+	if err := checksContext.Write(renderer, checksTableHeaders); err != nil {
+		utils.HandleErrorAndExit("Error executing template", err)
+	}
+}
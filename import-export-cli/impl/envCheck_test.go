@@ -0,0 +1,103 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRESTAPIDetectsVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/am/publisher/v3/swagger.yaml" {
+			_, _ = w.Write([]byte("info:\n  version: v3\n"))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	check := checkRESTAPI("Publisher", server.URL+"/api/am/publisher/v3")
+	assert.Equal(t, EndpointCheckPass, check.Status)
+	assert.Equal(t, "v3", check.Version)
+
+	product := checkAPIMProductVersion(check)
+	assert.Equal(t, EndpointCheckPass, product.Status)
+	assert.Equal(t, "4.1.x", product.Version)
+}
+
+func TestCheckRESTAPIIncompatibleVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/api/am/publisher/v1/") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	check := checkRESTAPI("Publisher", server.URL+"/api/am/publisher/v3")
+	assert.Equal(t, EndpointCheckFail, check.Status)
+	assert.Equal(t, "v1", check.Version)
+	assert.Contains(t, check.Message, "incompatible")
+
+	product := checkAPIMProductVersion(check)
+	assert.Equal(t, EndpointCheckWarn, product.Status)
+	assert.Equal(t, "3.0.x - 3.2.x", product.Version)
+}
+
+func TestCheckAPIMProductVersion(t *testing.T) {
+	tests := []struct {
+		publisherVersion string
+		wantVersion      string
+		wantStatus       string
+	}{
+		{"v1", "3.0.x - 3.2.x", EndpointCheckWarn},
+		{"v1.2", "3.0.x - 3.2.x", EndpointCheckWarn},
+		{"v2", "4.0.x", EndpointCheckWarn},
+		{"v3", "4.1.x", EndpointCheckPass},
+		{"3.0.0", "4.1.x", EndpointCheckPass},
+		{"v4", "4.2.x", EndpointCheckWarn},
+		{"v5", "", EndpointCheckWarn},
+		{"", "", EndpointCheckWarn},
+	}
+	for _, test := range tests {
+		t.Run(test.publisherVersion, func(t *testing.T) {
+			check := checkAPIMProductVersion(EndpointCheck{Version: test.publisherVersion})
+			assert.Equal(t, test.wantVersion, check.Version)
+			assert.Equal(t, test.wantStatus, check.Status)
+		})
+	}
+}
+
+func TestProbeEndpointUntrustedCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	resp, elapsed, err := ProbeEndpoint(http.MethodGet, server.URL+"/oauth2/token")
+	check := NewEndpointCheck("Token", server.URL, resp, elapsed, err)
+	assert.Equal(t, EndpointCheckFail, check.Status)
+	assert.Contains(t, check.Message, "TLS: the server certificate is not trusted")
+}
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strconv"
@@ -28,10 +29,10 @@ import (
 )
 
 // ExportAPIFromEnv function is used with export api command
-func ExportAPIFromEnv(accessToken, name, version, revisionNum, provider, format, exportEnvironment string, preserveStatus,
-	exportLatestRevision bool) (*resty.Response, error) {
+func ExportAPIFromEnv(ctx context.Context, accessToken, name, version, revisionNum, provider, format,
+	exportEnvironment string, preserveStatus, exportLatestRevision bool) (*resty.Response, error) {
 	publisherEndpoint := utils.GetPublisherEndpointOfEnv(exportEnvironment, utils.MainConfigFilePath)
-	return exportAPI(name, version, revisionNum, provider, format, publisherEndpoint, accessToken, preserveStatus,
+	return exportAPI(ctx, name, version, revisionNum, provider, format, publisherEndpoint, accessToken, preserveStatus,
 		exportLatestRevision)
 }
 
@@ -42,8 +43,8 @@ func ExportAPIFromEnv(accessToken, name, version, revisionNum, provider, format,
 // @param publisherEndpoint : API Manager Publisher Endpoint for the environment
 // @param accessToken : Access Token for the resource
 // @return response Response in the form of *resty.Response
-func exportAPI(name, version, revisionNum, provider, format, publisherEndpoint, accessToken string, preserveStatus,
-	exportLatestRevision bool) (*resty.Response, error) {
+func exportAPI(ctx context.Context, name, version, revisionNum, provider, format, publisherEndpoint, accessToken string,
+	preserveStatus, exportLatestRevision bool) (*resty.Response, error) {
 	publisherEndpoint = utils.AppendSlashToString(publisherEndpoint)
 	query := "apis/export?name=" + name + "&version=" + version + "&providerName=" + provider +
 		"&preserveStatus=" + strconv.FormatBool(preserveStatus)
@@ -63,7 +64,7 @@ func exportAPI(name, version, revisionNum, provider, format, publisherEndpoint,
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 	headers[utils.HeaderAccept] = utils.HeaderValueApplicationZip
 
-	resp, err := utils.InvokeGETRequest(url, headers)
+	resp, err := utils.InvokeGETRequest(ctx, url, headers)
 
 	if err != nil {
 		return nil, err
@@ -77,11 +78,11 @@ func exportAPI(name, version, revisionNum, provider, format, publisherEndpoint,
 // @param exportAPIVersion: Version of the API to be exported
 // @param exportAPIRevisionNumber: Revision number of the api
 // @param zipLocationPath: Path to the export directory
-// @param runningExportApiCommand: Whether the export API command is running
 // @param resp : Response returned from making the HTTP request (only pass a 200 OK)
+// @return path of the exported zip file, error
 // Exported API will be written to a zip file
 func WriteToZip(exportAPIName, exportAPIVersion, exportAPIRevisionNumber, zipLocationPath string,
-	runningExportApiCommand bool, resp *resty.Response) {
+	resp *resty.Response) (string, error) {
 	zipFilename := exportAPIName + "_" + exportAPIVersion
 	if exportAPIRevisionNumber != "" {
 		zipFilename += "_" + utils.GetRevisionNamFromRevisionNum(exportAPIRevisionNumber)
@@ -90,12 +91,12 @@ func WriteToZip(exportAPIName, exportAPIVersion, exportAPIRevisionNumber, zipLoc
 	// Writes the REST API response to a temporary zip file
 	tempZipFile, err := utils.WriteResponseToTempZip(zipFilename, resp)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating the temporary zip file to store the exported API", err)
+		return "", fmt.Errorf("error creating the temporary zip file to store the exported API: %w", err)
 	}
 
 	err = utils.CreateDirIfNotExist(zipLocationPath)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating dir to store zip archive: "+zipLocationPath, err)
+		return "", fmt.Errorf("error creating dir to store zip archive %s: %w", zipLocationPath, err)
 	}
 	exportedFinalZip := filepath.Join(zipLocationPath, zipFilename)
 
@@ -113,12 +114,7 @@ func WriteToZip(exportAPIName, exportAPIVersion, exportAPIRevisionNumber, zipLoc
 	}
 	err = IncludeMetaFileToZip(tempZipFile, exportedFinalZip, utils.MetaFileAPI, metaData)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating the final zip archive with api_meta.yaml file", err)
-	}
-
-	// Output the final zip file location.
-	if runningExportApiCommand {
-		fmt.Println("Successfully exported API!")
-		fmt.Println("Find the exported API at " + exportedFinalZip)
+		return "", fmt.Errorf("error creating the final zip archive with api_meta.yaml file: %w", err)
 	}
+	return exportedFinalZip, nil
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strconv"
@@ -28,10 +29,10 @@ import (
 )
 
 // ExportAPIProductFromEnv function is used with export api command
-func ExportAPIProductFromEnv(accessToken, name, version, revisionNum, provider, format,
+func ExportAPIProductFromEnv(ctx context.Context, accessToken, name, version, revisionNum, provider, format,
 	exportEnvironment string, exportLatestRevision bool, exportAPIProductPreserveStatus bool) (*resty.Response, error) {
 	publisherEndpoint := utils.GetPublisherEndpointOfEnv(exportEnvironment, utils.MainConfigFilePath)
-	return exportAPIProduct(name, version, revisionNum, provider, format, publisherEndpoint, accessToken,
+	return exportAPIProduct(ctx, name, version, revisionNum, provider, format, publisherEndpoint, accessToken,
 		exportLatestRevision, exportAPIProductPreserveStatus)
 }
 
@@ -42,8 +43,8 @@ func ExportAPIProductFromEnv(accessToken, name, version, revisionNum, provider,
 // @param publisherEndpoint : API Manager Publisher Endpoint for the environment
 // @param accessToken : Access Token for the resource
 // @return response Response in the form of *resty.Response
-func exportAPIProduct(name, version, revisionNum, provider, format, publisherEndpoint, accessToken string,
-	exportLatestRevision bool, exportAPIProductPreserveStatus bool) (*resty.Response, error) {
+func exportAPIProduct(ctx context.Context, name, version, revisionNum, provider, format, publisherEndpoint,
+	accessToken string, exportLatestRevision bool, exportAPIProductPreserveStatus bool) (*resty.Response, error) {
 	publisherEndpoint = utils.AppendSlashToString(publisherEndpoint)
 	query := "api-products/export?name=" + name + "&version=" + version + "&providerName=" + provider +
 		"&preserveStatus=" + strconv.FormatBool(exportAPIProductPreserveStatus)
@@ -63,7 +64,7 @@ func exportAPIProduct(name, version, revisionNum, provider, format, publisherEnd
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 	headers[utils.HeaderAccept] = utils.HeaderValueApplicationZip
 
-	resp, err := utils.InvokeGETRequest(url, headers)
+	resp, err := utils.InvokeGETRequest(ctx, url, headers)
 
 	if err != nil {
 		return nil, err
@@ -75,18 +76,20 @@ func exportAPIProduct(name, version, revisionNum, provider, format, publisherEnd
 // WriteAPIProductToZip
 // @param exportAPIProductName : Name of the API Product to be exported
 // @param resp : Response returned from making the HTTP request (only pass a 200 OK)
+// @return path of the exported zip file, error
 // Exported API Product will be written to a zip file
-func WriteAPIProductToZip(exportAPIProductName, exportAPIProductVersion, zipLocationPath string, runningExportAPIProductCommand bool, resp *resty.Response) {
+func WriteAPIProductToZip(exportAPIProductName, exportAPIProductVersion, zipLocationPath string,
+	resp *resty.Response) (string, error) {
 	zipFilename := exportAPIProductName + "_" + exportAPIProductVersion + ".zip" // MyAPIProduct_1.0.0.zip
 	// Writes the REST API response to a temporary zip file
 	tempZipFile, err := utils.WriteResponseToTempZip(zipFilename, resp)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating the temporary zip file to store the exported API Product", err)
+		return "", fmt.Errorf("error creating the temporary zip file to store the exported API Product: %w", err)
 	}
 
 	err = utils.CreateDirIfNotExist(zipLocationPath)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating dir to store zip archive: "+zipLocationPath, err)
+		return "", fmt.Errorf("error creating dir to store zip archive %s: %w", zipLocationPath, err)
 	}
 	exportedFinalZip := filepath.Join(zipLocationPath, zipFilename)
 
@@ -106,11 +109,7 @@ func WriteAPIProductToZip(exportAPIProductName, exportAPIProductVersion, zipLoca
 	}
 	err = IncludeMetaFileToZip(tempZipFile, exportedFinalZip, utils.MetaFileAPIProduct, metaData)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating the final zip archive with api_product_meta.yaml file", err)
-	}
-
-	if runningExportAPIProductCommand {
-		fmt.Println("Successfully exported API Product!")
-		fmt.Println("Find the exported API Product at " + exportedFinalZip)
+		return "", fmt.Errorf("error creating the final zip archive with api_product_meta.yaml file: %w", err)
 	}
+	return exportedFinalZip, nil
 }
@@ -19,10 +19,13 @@
 package impl
 
 import (
+	"context"
+
 	"fmt"
 	"github.com/spf13/cast"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"io"
 	"net/http"
 	"path/filepath"
 	"strconv"
@@ -39,14 +42,15 @@ var startingApiIndexFromList int
 var mainConfigFilePath string
 
 //  Prepare resumption of previous-halted export-apis operation
-func PrepareResumption(credential credentials.Credential, exportRelatedFilesPath, cmdResourceTenantDomain, cmdUsername, cmdExportEnvironment string) {
+func PrepareResumption(ctx context.Context, credential credentials.Credential, exportRelatedFilesPath,
+	cmdResourceTenantDomain, cmdUsername, cmdExportEnvironment string, out io.Writer) error {
 	var lastSuceededAPI utils.API
 	lastSuceededAPI = utils.ReadLastSucceededAPIFileData(exportRelatedFilesPath)
 	var migrationApisExportMetadata utils.MigrationApisExportMetadata
 	err := migrationApisExportMetadata.ReadMigrationApisExportMetadataFile(filepath.Join(exportRelatedFilesPath,
 		utils.MigrationAPIsExportMetadataFileName))
 	if err != nil {
-		utils.HandleErrorAndExit("Error loading metadata for resume from"+filepath.Join(exportRelatedFilesPath,
+		return fmt.Errorf("error loading metadata for resume from %s: %w", filepath.Join(exportRelatedFilesPath,
 			utils.MigrationAPIsExportMetadataFileName), err)
 	}
 	apis = migrationApisExportMetadata.ApiListToExport
@@ -60,41 +64,46 @@ func PrepareResumption(credential credentials.Credential, exportRelatedFilesPath
 		//last iteration had been completed successfully but operation had halted at that point.
 		//So get the next set of APIs for next iteration
 		startingApiIndexFromList = 0
-		count, apis = getAPIList(credential, cmdExportEnvironment, cmdResourceTenantDomain)
+		if count, apis, err = getAPIList(ctx, credential, cmdExportEnvironment, cmdResourceTenantDomain); err != nil {
+			return err
+		}
 		if len(apis)-startingApiIndexFromList > 0 {
 			utils.WriteMigrationApisExportMetadataFile(apis, cmdResourceTenantDomain, cmdUsername,
 				exportRelatedFilesPath, apiListOffset)
 		} else {
-			fmt.Println("Command: export apis execution completed !")
+			fmt.Fprintln(out, "Command: export apis execution completed !")
 		}
 	}
+	return nil
 }
 
 // Delete directories where the APIs are exported, reset the indexes, get first API list and write the
 // migration-apis-export-metadata.yaml file
-func PrepareStartFromBeginning(credential credentials.Credential, exportRelatedFilesPath, cmdResourceTenantDomain, cmdUsername, cmdExportEnvironment string) {
-	fmt.Println("Cleaning all the previously exported APIs of the given target tenant, in the given environment if " +
+func PrepareStartFromBeginning(ctx context.Context, credential credentials.Credential, exportRelatedFilesPath,
+	cmdResourceTenantDomain, cmdUsername, cmdExportEnvironment string, out io.Writer) error {
+	fmt.Fprintln(out, "Cleaning all the previously exported APIs of the given target tenant, in the given environment if "+
 		"any, and prepare to export APIs from beginning")
 	//cleaning existing old files (if exists) related to exportation
 	if err := utils.RemoveDirectoryIfExists(filepath.Join(exportRelatedFilesPath, utils.ExportedApisDirName)); err != nil {
-		utils.HandleErrorAndExit("Error occurred while cleaning existing old files (if exists) related to "+
-			"exportation", err)
+		return fmt.Errorf("error occurred while cleaning existing old files related to exportation: %w", err)
 	}
 	if err := utils.RemoveFileIfExists(filepath.Join(exportRelatedFilesPath, utils.MigrationAPIsExportMetadataFileName)); err != nil {
-		utils.HandleErrorAndExit("Error occurred while cleaning existing old files (if exists) related to "+
-			"exportation", err)
+		return fmt.Errorf("error occurred while cleaning existing old files related to exportation: %w", err)
 	}
 	if err := utils.RemoveFileIfExists(filepath.Join(exportRelatedFilesPath, utils.LastSucceededApiFileName)); err != nil {
-		utils.HandleErrorAndExit("Error occurred while cleaning existing old files (if exists) related to "+
-			"exportation", err)
+		return fmt.Errorf("error occurred while cleaning existing old files related to exportation: %w", err)
 	}
 
 	apiListOffset = 0
 	startingApiIndexFromList = 0
-	count, apis = getAPIList(credential, cmdExportEnvironment, cmdResourceTenantDomain)
+	var err error
+	if count, apis, err = getAPIList(ctx, credential, cmdExportEnvironment, cmdResourceTenantDomain); err != nil {
+		return err
+	}
 	//write  migration-apis-export-metadata.yaml file
 	utils.WriteMigrationApisExportMetadataFile(apis, cmdResourceTenantDomain, cmdUsername, exportRelatedFilesPath,
 		apiListOffset)
+	return nil
 }
 
 // get the index of the finally (successfully) exported API from the list of APIs listed in migration-apis-export-metadata.yaml
@@ -110,95 +119,106 @@ func getLastSuceededApiIndex(lastSuceededApi utils.API) int {
 }
 
 // Get the list of APIs from the defined offset index, upto the limit of constant value utils.MaxAPIsToExportOnce
-func getAPIList(credential credentials.Credential, cmdExportEnvironment, cmdResourceTenantDomain string) (count int32, apis []utils.API) {
-	accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, cmdExportEnvironment)
-	if preCommandErr == nil {
-		apiListEndpoint := utils.GetApiListEndpointOfEnv(cmdExportEnvironment, utils.MainConfigFilePath)
-		apiListEndpoint += "?limit=" + strconv.Itoa(utils.MaxAPIsToExportOnce) + "&offset=" + strconv.Itoa(apiListOffset)
-		if cmdResourceTenantDomain != "" {
-			apiListEndpoint += "&tenantDomain=" + cmdResourceTenantDomain
-		}
-		count, apis, err := GetAPIList(accessToken, apiListEndpoint, "", "")
-		if err == nil {
-			return count, apis
-		} else {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"Getting List of APIs.", utils.GetHttpErrorResponse(err))
-		}
-	} else {
-		utils.HandleErrorAndExit(utils.LogPrefixError+"Error in getting access token for user while getting "+
-			"the list of APIs: ", preCommandErr)
+func getAPIList(ctx context.Context, credential credentials.Credential, cmdExportEnvironment,
+	cmdResourceTenantDomain string) (count int32, apis []utils.API, err error) {
+	accessToken, preCommandErr := credentials.GetOAuthAccessToken(ctx, credential, cmdExportEnvironment)
+	if preCommandErr != nil {
+		return 0, nil, fmt.Errorf("error in getting access token for user while getting the list of APIs: %w",
+			preCommandErr)
 	}
-	return 0, nil
+	apiListEndpoint := utils.GetApiListEndpointOfEnv(cmdExportEnvironment, utils.MainConfigFilePath)
+	apiListEndpoint += "?limit=" + strconv.Itoa(utils.MaxAPIsToExportOnce) + "&offset=" + strconv.Itoa(apiListOffset)
+	if cmdResourceTenantDomain != "" {
+		apiListEndpoint += "&tenantDomain=" + cmdResourceTenantDomain
+	}
+	count, apis, err = GetAPIList(ctx, accessToken, apiListEndpoint, "", "")
+	if err != nil {
+		return 0, nil, fmt.Errorf("error getting list of APIs: %w", err)
+	}
+	return count, apis, nil
 }
 
 // Get the revisions associated with the api
-func getRevisionsListForAPI(accessToken, cmdExportEnvironment string, api utils.API,
+func getRevisionsListForAPI(ctx context.Context, accessToken, cmdExportEnvironment string, api utils.API,
 	exportAllRevisions bool) (count int32, revisions []utils.Revisions, err error) {
 	var query string
 	if !exportAllRevisions {
 		query = "deployed:true"
 	}
-	return GetRevisionListFromEnv(accessToken, cmdExportEnvironment, api.Name, api.Version, api.Provider, query)
+	return GetRevisionListFromEnv(ctx, accessToken, cmdExportEnvironment, api.Name, api.Version, api.Provider, query)
 }
 
 // Do the API exportation
-func ExportAPIs(credential credentials.Credential, exportRelatedFilesPath, cmdExportEnvironment, cmdResourceTenantDomain,
-	exportAPIsFormat, cmdUsername, apiExportDir string, exportAPIPreserveStatus, runningExportApiCommand, exportAllRevisions bool) {
+func ExportAPIs(ctx context.Context, credential credentials.Credential, exportRelatedFilesPath, cmdExportEnvironment,
+	cmdResourceTenantDomain,
+	exportAPIsFormat, cmdUsername, apiExportDir string, exportAPIPreserveStatus, exportAllRevisions bool,
+	out io.Writer) error {
 	if count == 0 {
-		fmt.Println("No APIs available to be exported..!")
+		fmt.Fprintln(out, "No APIs available to be exported..!")
 	} else {
 		var counterSuceededAPIs = 0
 		for count > 0 {
 			utils.Logln(utils.LogPrefixInfo+"Found ", count, "of APIs to be exported in the iteration beginning with the offset #"+
 				strconv.Itoa(apiListOffset)+". Maximum limit of APIs exported in single iteration is "+
 				strconv.Itoa(utils.MaxAPIsToExportOnce))
-			accessToken, preCommandErr := credentials.GetOAuthAccessToken(credential, cmdExportEnvironment)
+			accessToken, preCommandErr := credentials.GetOAuthAccessToken(ctx, credential, cmdExportEnvironment)
 			if preCommandErr == nil {
 				for i := startingApiIndexFromList; i < len(apis); i++ {
 					if exportAllRevisions {
 						//Export the working copy of the api
-						exportAPIandWriteToZip(apis[i], "", accessToken, cmdExportEnvironment, apiExportDir,
-							exportRelatedFilesPath, exportAPIsFormat, exportAPIPreserveStatus, runningExportApiCommand)
+						err := exportAPIandWriteToZip(ctx, apis[i], "", accessToken, cmdExportEnvironment, apiExportDir,
+							exportRelatedFilesPath, exportAPIsFormat, exportAPIPreserveStatus)
+						if err != nil {
+							return err
+						}
 						counterSuceededAPIs++
 					}
-					revisionCount, revisions, err := getRevisionsListForAPI(accessToken, cmdExportEnvironment, apis[i],
-						exportAllRevisions)
+					revisionCount, revisions, err := getRevisionsListForAPI(ctx, accessToken, cmdExportEnvironment,
+						apis[i], exportAllRevisions)
 					if err != nil {
-						fmt.Println("An error occurred while getting the revisions list for API " + apis[i].Version +
-							"_" + apis[i].Version, err)
+						fmt.Fprintln(out, "An error occurred while getting the revisions list for API "+apis[i].Version+
+							"_"+apis[i].Version, err)
 					} else if revisionCount > 0 {
 						for j := 0; j < len(revisions); j++ {
 							exportApiRevision := utils.GetRevisionNumFromRevisionName(revisions[j].RevisionNumber)
-							exportAPIandWriteToZip(apis[i], exportApiRevision, accessToken, cmdExportEnvironment,
-								apiExportDir, exportRelatedFilesPath, exportAPIsFormat, exportAPIPreserveStatus,
-								runningExportApiCommand)
+							err := exportAPIandWriteToZip(ctx, apis[i], exportApiRevision, accessToken,
+								cmdExportEnvironment, apiExportDir, exportRelatedFilesPath, exportAPIsFormat,
+								exportAPIPreserveStatus)
+							if err != nil {
+								return err
+							}
 							counterSuceededAPIs++
 						}
 					}
 				}
 			} else {
 				// error getting OAuth tokens
-				fmt.Println("Error getting OAuth Tokens : " + preCommandErr.Error())
+				fmt.Fprintln(out, "Error getting OAuth Tokens : "+preCommandErr.Error())
 			}
-			fmt.Println("Batch of " + cast.ToString(count) + " APIs exported successfully..!")
+			fmt.Fprintln(out, "Batch of "+cast.ToString(count)+" APIs exported successfully..!")
 
 			apiListOffset += utils.MaxAPIsToExportOnce
-			count, apis = getAPIList(credential, cmdExportEnvironment, cmdResourceTenantDomain)
+			var err error
+			if count, apis, err = getAPIList(ctx, credential, cmdExportEnvironment,
+				cmdResourceTenantDomain); err != nil {
+				return err
+			}
 			startingApiIndexFromList = 0
 			if len(apis) > 0 {
 				utils.WriteMigrationApisExportMetadataFile(apis, cmdResourceTenantDomain, cmdUsername,
 					exportRelatedFilesPath, apiListOffset)
 			}
 		}
-		fmt.Println("\nTotal number of APIs exported: " + cast.ToString(counterSuceededAPIs))
-		fmt.Println("API export path: " + apiExportDir)
-		fmt.Println("\nCommand: export-apis execution completed !")
+		fmt.Fprintln(out, "\nTotal number of APIs exported: "+cast.ToString(counterSuceededAPIs))
+		fmt.Fprintln(out, "API export path: "+apiExportDir)
+		fmt.Fprintln(out, "\nCommand: export-apis execution completed !")
 	}
+	return nil
 }
 
 //Export the API and archive to zip format
-func exportAPIandWriteToZip(api utils.API, revisionNumber, accessToken, cmdExportEnvironment, apiExportDir,
-	exportRelatedFilesPath, exportAPIsFormat string, exportAPIPreserveStatus, runningExportApiCommand bool) {
+func exportAPIandWriteToZip(ctx context.Context, api utils.API, revisionNumber, accessToken, cmdExportEnvironment,
+	apiExportDir, exportRelatedFilesPath, exportAPIsFormat string, exportAPIPreserveStatus bool) error {
 
 	exportAPIName := api.Name
 	exportAPIVersion := api.Version
@@ -207,25 +227,28 @@ func exportAPIandWriteToZip(api utils.API, revisionNumber, accessToken, cmdExpor
 	if revisionNumber != "" {
 		exportApiRevision = utils.GetRevisionNumFromRevisionName(revisionNumber)
 	}
-	resp, err := ExportAPIFromEnv(accessToken, exportAPIName, exportAPIVersion, exportApiRevision,
+	resp, err := ExportAPIFromEnv(ctx, accessToken, exportAPIName, exportAPIVersion, exportApiRevision,
 		exportApiProvider, exportAPIsFormat, cmdExportEnvironment, exportAPIPreserveStatus, false)
 	if err != nil {
-		utils.HandleErrorAndExit("Error exporting", err)
+		return fmt.Errorf("error exporting: %w", err)
 	}
 
-	if resp.StatusCode() == http.StatusOK {
-		utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
-		WriteToZip(exportAPIName, exportAPIVersion, exportApiRevision, apiExportDir, runningExportApiCommand, resp)
-		//write on last-succeeded-api.log
-		utils.WriteLastSuceededAPIFileData(exportRelatedFilesPath, api)
-	} else {
-		fmt.Println("Error exporting API:", exportAPIName, "-", exportAPIVersion, " of Provider:", exportApiProvider)
-		utils.PrintErrorResponseAndExit(resp)
+	if resp.StatusCode() != http.StatusOK {
+		return utils.NewResponseError(resp, "Error exporting API: "+exportAPIName+" - "+exportAPIVersion+
+			" of Provider: "+exportApiProvider+". Status: "+resp.Status())
+	}
+	utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
+	if _, err = WriteToZip(exportAPIName, exportAPIVersion, exportApiRevision, apiExportDir, resp); err != nil {
+		return err
 	}
+	//write on last-succeeded-api.log
+	utils.WriteLastSuceededAPIFileData(exportRelatedFilesPath, api)
+	return nil
 }
 
 // Create the required directory structure to save the exported APIs
-func CreateExportAPIsDirStructure(artifactExportDirectory, cmdResourceTenantDomain, cmdExportEnvironment string, cmdForceStartFromBegin bool) string {
+func CreateExportAPIsDirStructure(artifactExportDirectory, cmdResourceTenantDomain, cmdExportEnvironment string,
+	cmdForceStartFromBegin bool) (string, error) {
 	var resourceTenantDirName = utils.GetMigrationExportTenantDirName(cmdResourceTenantDomain)
 
 	var createDirError error
@@ -248,8 +271,8 @@ func CreateExportAPIsDirStructure(artifactExportDirectory, cmdResourceTenantDoma
 	}
 
 	if createDirError != nil {
-		utils.HandleErrorAndExit("Error in creating directory structure for the API export for migration .",
+		return "", fmt.Errorf("error in creating directory structure for the API export for migration: %w",
 			createDirError)
 	}
-	return migrationsArtifactsEnvTenantApisPath
+	return migrationsArtifactsEnvTenantApisPath, nil
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -28,9 +29,10 @@ import (
 )
 
 // ExportAppFromEnv function is used with export app command
-func ExportAppFromEnv(accessToken, name, owner, format, exportEnvironment string, exportAppWithKeys bool) (*resty.Response, error) {
+func ExportAppFromEnv(ctx context.Context, accessToken, name, owner, format, exportEnvironment string,
+	exportAppWithKeys bool) (*resty.Response, error) {
 	devportalApplicationsEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(exportEnvironment, utils.MainConfigFilePath)
-	return ExportApp(name, owner, format, devportalApplicationsEndpoint, accessToken, exportAppWithKeys)
+	return ExportApp(ctx, name, owner, format, devportalApplicationsEndpoint, accessToken, exportAppWithKeys)
 }
 
 // ExportApp
@@ -40,7 +42,8 @@ func ExportAppFromEnv(accessToken, name, owner, format, exportEnvironment string
 // @param devportalApplicationsEndpoint : Dev Portal Applications Endpoint for the environment
 // @param accessToken : Access Token for the resource
 // @return response Response in the form of *resty.Response
-func ExportApp(name, owner, format, devportalApplicationsEndpoint, accessToken string, exportAppWithKeys bool) (*resty.Response, error) {
+func ExportApp(ctx context.Context, name, owner, format, devportalApplicationsEndpoint, accessToken string,
+	exportAppWithKeys bool) (*resty.Response, error) {
 	devportalApplicationsEndpoint = utils.AppendSlashToString(devportalApplicationsEndpoint)
 
 	query := "export"
@@ -62,7 +65,7 @@ func ExportApp(name, owner, format, devportalApplicationsEndpoint, accessToken s
 		queryParams["format"] = format
 	}
 
-	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(queryParams, url, headers)
+	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(ctx, queryParams, url, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -73,19 +76,20 @@ func ExportApp(name, owner, format, devportalApplicationsEndpoint, accessToken s
 // @param exportAppName : Name of the Application to be exported
 // @param exportAppOwner : Owner of the Application to be exported
 // @param resp : Response returned from making the HTTP request (only pass a 200 OK)
+// @return path of the exported zip file, error
 // Exported Application will be written to a zip file
 func WriteApplicationToZip(exportAppName, exportAppOwner, zipLocationPath string,
-	resp *resty.Response) {
+	resp *resty.Response) (string, error) {
 	zipFilename := replaceUserStoreDomainDelimiter(exportAppOwner) + "_" + exportAppName + ".zip" // admin_testApp.zip
 	// Writes the REST API response to a temporary zip file
 	tempZipFile, err := utils.WriteResponseToTempZip(zipFilename, resp)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating the temporary zip file to store the exported application", err)
+		return "", fmt.Errorf("error creating the temporary zip file to store the exported application: %w", err)
 	}
 
 	err = utils.CreateDirIfNotExist(zipLocationPath)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating dir to store zip archive: "+zipLocationPath, err)
+		return "", fmt.Errorf("error creating dir to store zip archive %s: %w", zipLocationPath, err)
 	}
 
 	exportedFinalZip := filepath.Join(zipLocationPath, zipFilename)
@@ -105,11 +109,9 @@ func WriteApplicationToZip(exportAppName, exportAppOwner, zipLocationPath string
 	}
 	err = IncludeMetaFileToZip(tempZipFile, exportedFinalZip, utils.MetaFileApplication, metaData)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating the final zip archive with application_meta.yaml file", err)
+		return "", fmt.Errorf("error creating the final zip archive with application_meta.yaml file: %w", err)
 	}
-
-	fmt.Println("Successfully exported Application!")
-	fmt.Println("Find the exported Application at " + exportedFinalZip)
+	return exportedFinalZip, nil
 }
 
 // The Application owner name is used to construct a unique name for the app export zip.
@@ -19,6 +19,8 @@
 package impl
 
 import (
+	"context"
+
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -33,9 +35,9 @@ import (
 // @return error
 func GetAPIProductRevisionListFromEnv(accessToken, environment, apiProductName, provider,
 	query string) (count int32, revisions []utils.Revisions, err error) {
-	apiProductId, err := GetAPIProductId(accessToken, environment, apiProductName, provider)
+	apiProductId, err := GetAPIProductId(context.Background(), accessToken, environment, apiProductName, provider)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting API Product Id to list revisions ", err)
+		return 0, nil, err
 	}
 	revisionListEndpoint := utils.GetApiProductListEndpointOfEnv(environment, utils.MainConfigFilePath)
 	revisionListEndpoint = utils.AppendSlashToString(revisionListEndpoint)
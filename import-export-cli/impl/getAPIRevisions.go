@@ -19,7 +19,7 @@
 package impl
 
 import (
-	"fmt"
+	"context"
 	"io"
 	"os"
 	"text/template"
@@ -85,10 +85,11 @@ func (r *revision) MarshalJSON() ([]byte, error) {
 // @return count (no. of APIs)
 // @return array of revision objects
 // @return error
-func GetRevisionListFromEnv(accessToken, environment, apiName, apiVersion, provider, query string) (count int32, revisions []utils.Revisions, err error) {
-	apiId, err := GetAPIId(accessToken, environment, apiName, apiVersion, provider)
+func GetRevisionListFromEnv(ctx context.Context, accessToken, environment, apiName, apiVersion, provider,
+	query string) (count int32, revisions []utils.Revisions, err error) {
+	apiId, err := GetAPIId(ctx, accessToken, environment, apiName, apiVersion, provider)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting API Id to list revisions ", err)
+		return 0, nil, err
 	}
 	revisionListEndpoint := utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath)
 	revisionListEndpoint = utils.AppendSlashToString(revisionListEndpoint)
@@ -96,21 +97,19 @@ func GetRevisionListFromEnv(accessToken, environment, apiName, apiVersion, provi
 	if query != "" {
 		url += "?query=" + query
 	}
-	return GetRevisionsList(accessToken, url)
+	return GetRevisionsList(ctx, accessToken, url)
 }
 
 // Print Revisions in the given template
 // @param revisions	Available revisions list for the API
 // @param format	Format type of the output
 func PrintRevisions(revisions []utils.Revisions, format string) {
-	if format == "" {
-		format = defaultRevisionTableFormat
-	} else if format == utils.JsonArrayFormatType {
+	if format == utils.JsonArrayFormatType {
 		utils.ListArtifactsInJsonArrayFormat(revisions, utils.ProjectTypeRevision)
 		return
 	}
 	// create revision Context with standard output
-	revisionContext := formatter.NewContext(os.Stdout, format)
+	revisionContext := formatter.NewListContext(os.Stdout, format, defaultRevisionTableFormat)
 
 	// create a new renderer function which iterate collection
 	renderer := func(w io.Writer, t *template.Template) error {
@@ -138,6 +137,6 @@ func PrintRevisions(revisions []utils.Revisions, format string) {
 
 	// execute context
 	if err := revisionContext.Write(renderer, revisionTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
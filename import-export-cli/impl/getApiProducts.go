@@ -19,7 +19,7 @@
 package impl
 
 import (
-	"fmt"
+	"context"
 	"io"
 	"os"
 	"text/template"
@@ -85,22 +85,21 @@ func (a *apiProduct) MarshalJSON() ([]byte, error) {
 // @return count (no. of API Products)
 // @return array of API Product objects
 // @return error
-func GetAPIProductListFromEnv(accessToken, environment, query, limit string) (count int32, apiProducts []utils.APIProduct, err error) {
+func GetAPIProductListFromEnv(ctx context.Context, accessToken, environment, query, limit string) (count int32,
+	apiProducts []utils.APIProduct, err error) {
 	unifiedSearchEndpoint := utils.GetUnifiedSearchEndpointOfEnv(environment, utils.MainConfigFilePath)
-	return GetAPIProductList(accessToken, unifiedSearchEndpoint, query, limit)
+	return GetAPIProductList(ctx, accessToken, unifiedSearchEndpoint, query, limit)
 }
 
 // PrintAPIProducts
 func PrintAPIProducts(apiProducts []utils.APIProduct, format string) {
-	if format == "" {
-		format = defaultApiProductTableFormat
-	} else if format == utils.JsonArrayFormatType {
+	if format == utils.JsonArrayFormatType {
 		utils.ListArtifactsInJsonArrayFormat(apiProducts, utils.ProjectTypeApiProduct)
 		return
 	}
 
 	// create API Product context with standard output
-	apiProductContext := formatter.NewContext(os.Stdout, format)
+	apiProductContext := formatter.NewListContext(os.Stdout, format, defaultApiProductTableFormat)
 
 	// create a new renderer function which iterate collection
 	renderer := func(w io.Writer, t *template.Template) error {
@@ -124,7 +123,7 @@ func PrintAPIProducts(apiProducts []utils.APIProduct, format string) {
 
 	// execute context
 	if err := apiProductContext.Write(renderer, apiProductTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
 
@@ -19,7 +19,7 @@
 package impl
 
 import (
-	"fmt"
+	"context"
 	"io"
 	"os"
 	"text/template"
@@ -100,22 +100,21 @@ func (a *api) MarshalJSON() ([]byte, error) {
 // @return count (no. of APIs)
 // @return array of API objects
 // @return error
-func GetAPIListFromEnv(accessToken, environment, query, limit string) (count int32, apis []utils.API, err error) {
+func GetAPIListFromEnv(ctx context.Context, accessToken, environment, query, limit string) (count int32,
+	apis []utils.API, err error) {
 	apiListEndpoint := utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath)
-	return GetAPIList(accessToken, apiListEndpoint, query, limit)
+	return GetAPIList(ctx, accessToken, apiListEndpoint, query, limit)
 }
 
 // PrintAPIs
 func PrintAPIs(apis []utils.API, format string) {
-	if format == "" {
-		format = defaultApiTableFormat
-	} else if format == utils.JsonArrayFormatType {
+	if format == utils.JsonArrayFormatType {
 		utils.ListArtifactsInJsonArrayFormat(apis, utils.ProjectTypeApi)
 		return
 	}
 
 	// create api context with standard output
-	apiContext := formatter.NewContext(os.Stdout, format)
+	apiContext := formatter.NewListContext(os.Stdout, format, defaultApiTableFormat)
 
 	// create a new renderer function which iterate collection
 	renderer := func(w io.Writer, t *template.Template) error {
@@ -140,6 +139,6 @@ func PrintAPIs(apis []utils.API, format string) {
 
 	// execute context
 	if err := apiContext.Write(renderer, apiTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,8 +19,8 @@
 package impl
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"os"
 	"text/template"
@@ -92,9 +92,10 @@ func (a *app) MarshalJSON() ([]byte, error) {
 // @return count (no. of Applications)
 // @return array of Application objects
 // @return error
-func GetApplicationListFromEnv(accessToken, environment, appOwner, limit string) (count int32, apps []utils.Application, err error) {
+func GetApplicationListFromEnv(ctx context.Context, accessToken, environment, appOwner, limit string) (count int32,
+	apps []utils.Application, err error) {
 	applicationListEndpoint := utils.GetAdminApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath)
-	return GetApplicationList(accessToken, applicationListEndpoint, appOwner, limit)
+	return GetApplicationList(ctx, accessToken, applicationListEndpoint, appOwner, limit)
 }
 
 // extractAppDefinition extracts ApplicationDefinition from jsonContent
@@ -110,15 +111,13 @@ func extractAppDefinition(jsonContent []byte) (*v2.ApplicationDefinition, error)
 
 // PrintApps
 func PrintApps(apps []utils.Application, format string) {
-	if format == "" {
-		format = defaultAppTableFormat
-	} else if format == utils.JsonArrayFormatType {
+	if format == utils.JsonArrayFormatType {
 		utils.ListArtifactsInJsonArrayFormat(apps, utils.ProjectTypeApplication)
 		return
 	}
 
 	// create new app context with standard output
-	appContext := formatter.NewContext(os.Stdout, format)
+	appContext := formatter.NewListContext(os.Stdout, format, defaultAppTableFormat)
 
 	// create a new renderer function which iterate collection of apps
 	renderer := func(w io.Writer, t *template.Template) error {
@@ -143,6 +142,6 @@ func PrintApps(apps []utils.Application, format string) {
 
 	// execute context
 	if err := appContext.Write(renderer, appTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
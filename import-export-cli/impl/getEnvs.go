@@ -19,7 +19,6 @@
 package impl
 
 import (
-	"fmt"
 	"io"
 	"os"
 	"text/template"
@@ -111,12 +110,8 @@ func (e *endpoints) MarshalJSON() ([]byte, error) {
 
 // PrintEnvs
 func PrintEnvs(envData map[string]utils.EnvEndpoints, format, defaulEnvsTableFormat string) {
-	if format == "" {
-		format = defaulEnvsTableFormat
-	}
-
 	// create api context with standard output
-	envsContext := formatter.NewContext(os.Stdout, format)
+	envsContext := formatter.NewListContext(os.Stdout, format, defaulEnvsTableFormat)
 
 	// create a new renderer function which iterate collection
 	renderer := func(w io.Writer, t *template.Template) error {
@@ -143,6 +138,6 @@ func PrintEnvs(envData map[string]utils.EnvEndpoints, format, defaulEnvsTableFor
 
 	// execute context
 	if err := envsContext.Write(renderer, envsTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
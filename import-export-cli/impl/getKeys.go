@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -41,7 +42,9 @@ var keyGenEnv string
 var keyGenTokenEndpoint string
 
 //Subscribe the given API or API Product to the default application and generate an access token
-func GetKeys(cred credentials.Credential, envName, name, version, provider, tokenEndpoint string) {
+// @return access token to invoke the API or API Product, error
+func GetKeys(ctx context.Context, cred credentials.Credential, envName, name, version, provider,
+	tokenEndpoint string) (string, error) {
 	keyGenEnv = envName
 	apiName = name
 	apiVersion = version
@@ -49,134 +52,126 @@ func GetKeys(cred credentials.Credential, envName, name, version, provider, toke
 	keyGenTokenEndpoint = tokenEndpoint
 
 	//generating access token for the env based on the credentials
-	accessToken, err := credentials.GetOAuthAccessToken(cred, keyGenEnv)
+	accessToken, err := credentials.GetOAuthAccessToken(ctx, cred, keyGenEnv)
 	if err != nil {
-		utils.HandleErrorAndExit("Internal error occurred", err)
+		return "", err
 	}
 	utils.Logln(utils.LogPrefixInfo + "Generated a token to access the Publisher and DevPortal REST APIs.")
 	//retrieving subscription tiers
-	tiers, err := getAvailableAPITiers(accessToken)
-
-	if tiers != nil && err == nil {
-		utils.Logln(utils.LogPrefixInfo+"Retrieved available subscription tiers of the API or API Product: ", tiers)
-		// Needs an available subscription tier when subscribing to the particular API or API Product using the application
-		subscriptionThrottlingTier = tiers[0]
-	} else {
-		utils.HandleErrorAndExit("Internal error occurred", err)
+	tiers, err := getAvailableAPITiers(ctx, accessToken)
+	if err != nil {
+		return "", err
 	}
+	if len(tiers) == 0 {
+		return "", errors.New("no subscription tiers are available for the API or API Product: " + apiName)
+	}
+	utils.Logln(utils.LogPrefixInfo+"Retrieved available subscription tiers of the API or API Product: ", tiers)
+	// Needs an available subscription tier when subscribing to the particular API or API Product using the application
+	subscriptionThrottlingTier = tiers[0]
+
 	// Retrieving application throttling policy
-	applicationThrottlingPolicy, err := getApplicationThrottlingPolicy(accessToken)
-	// If the application throttling policy call fails, exit with the error
+	applicationThrottlingPolicy, err := getApplicationThrottlingPolicy(ctx, accessToken)
 	if err != nil {
-		utils.HandleErrorAndExit("Internal error occurred", err)
+		return "", err
 	}
 	utils.Logln(utils.LogPrefixInfo+"Retrieved application throttling policy successfully: ", applicationThrottlingPolicy)
 	//search if the default cli application already exists
-	appId, err := searchApplication(utils.DefaultCliApp, accessToken)
+	appId, err := searchApplication(ctx, utils.DefaultCliApp, accessToken)
 	if err != nil {
-		utils.HandleErrorAndExit("Internal error occurred", err)
+		return "", err
 	}
 	utils.Logln(utils.LogPrefixInfo + "Searched if application exists.")
 	//if the application exists
 	if appId != "" {
 		utils.Logln(utils.LogPrefixInfo + "CLI application already exists")
 		// Subscribe API or API Product to a given application
-		subId, err := subscribe(appId, accessToken)
+		subId, err := subscribe(ctx, appId, accessToken)
 		// If subscription fails
 		if subId == "" && err != nil {
-			utils.HandleErrorAndExit("Error occurred while subscribing.", err)
+			return "", fmt.Errorf("error occurred while subscribing: %w", err)
 		}
 
-		scopes, err := getScopes(appId, accessToken)
+		scopes, err := getScopes(ctx, appId, accessToken)
 		//retrieve application specific details
-		appDetails, err := getApplicationDetails(appId, accessToken)
-		if appDetails != nil {
-			//Reading configuration to check if the application needs to be updated
-			configVars := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
-			tokenType = configVars.Config.TokenType
-
-			//retrieve keys of application to see if there are already generated keys
-			appKeys, keysErr := getApplicationKeys(appId, accessToken)
-			if keysErr != nil {
-				utils.HandleErrorAndExit("Error occurred while getting CLI application keys.", keysErr)
+		appDetails, err := getApplicationDetails(ctx, appId, accessToken)
+		if appDetails == nil {
+			return "", fmt.Errorf("error while retrieving the CLI application: %w", err)
+		}
+		//Reading configuration to check if the application needs to be updated
+		configVars := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
+		tokenType = configVars.Config.TokenType
+
+		//retrieve keys of application to see if there are already generated keys
+		appKeys, keysErr := getApplicationKeys(ctx, appId, accessToken)
+		if keysErr != nil {
+			return "", fmt.Errorf("error occurred while getting CLI application keys: %w", keysErr)
+		}
+
+		//if keys have been already generated before, then update the consumer key and secret
+		if appKeys.Count != 0 {
+			//If the keys have not been generated and the application is updated
+			token, err := getNewToken(ctx, &appKeys.List[0], scopes)
+			//Assert token endpoint related fails and errors
+			if err != nil {
+				return "", fmt.Errorf("error while generating token: %w", err)
 			}
-
-			//if keys have been already generated before, then update the consumer key and secret
-			if appKeys.Count != 0 {
-				//If the keys have not been generated and the application is updated
-				token, err := getNewToken(&appKeys.List[0], scopes)
-				//Assert token endpoint related fails and errors
-				if err != nil {
-					utils.HandleErrorAndExit("Error while generating token. ", err)
-				}
-
-				if accessToken != "" {
-					// Access Token generated successfully.
-					fmt.Println(token)
-				} else {
-					utils.HandleErrorAndExit("Error while generating token: ", err)
-				}
-			} else {
-				//If the application is already created but the keys have not generated in the first time
-				keygenResponse, err := generateApplicationKeys(appId, accessToken)
-				if keygenResponse == nil && err != nil {
-					utils.HandleErrorAndExit("Error occurred while generating CLI application keys.", err)
-				}
-				// Access Token generated successfully.
-				fmt.Println(keygenResponse.Token.AccessToken)
-			}
-		} else {
-			utils.HandleErrorAndExit("Error while retrieving the CLI application:", err)
-		}
-	} else {
-		//If the default cli appId does not exist in the environment
-		//Create the application
-		createdAppId, appName, err := createApplication(accessToken, applicationThrottlingPolicy)
-		appId = createdAppId
-		if createdAppId != "" || appName != "" {
-			utils.Logln(utils.LogPrefixInfo+"Created CLI application: ", appName)
-		} else {
-			//if error occurred while creating the application, then
-			utils.HandleErrorAndExit("Error while creating the CLI application:", err)
-		}
-		//Search the if the given API or API Product is present to subscribe
-		subId, err := subscribe(appId, accessToken)
-		//If subscription failed
-		if subId == "" && err != nil {
-			utils.HandleErrorAndExit("Error occurred while subscribing.", err)
-		}
-		scopes, err := getScopes(appId, accessToken)
-		//If errors occurred while retrieving scopes
-		if scopes == nil && err != nil {
-			utils.HandleErrorAndExit("Error while retrieving scopes ", err)
-		}
-		//Generate the tokens
-		keygenResponse, err := generateApplicationKeys(appId, accessToken)
-		if err != nil {
-			utils.HandleErrorAndExit("Error while generating CLI application keys", err)
-		}
-		appKey := &utils.ApplicationKey{}
-		appKey.ConsumerKey = keygenResponse.ConsumerKey
-		appKey.ConsumerSecret = keygenResponse.ConsumerSecret
-		token, err := getNewToken(appKey, scopes)
-		if token != "" {
 			// Access Token generated successfully.
-			fmt.Println(token)
-		} else {
-			utils.HandleErrorAndExit("Error while generating token: ", err)
+			return token, nil
 		}
+		//If the application is already created but the keys have not generated in the first time
+		keygenResponse, err := generateApplicationKeys(ctx, appId, accessToken)
+		if keygenResponse == nil {
+			return "", fmt.Errorf("error occurred while generating CLI application keys: %w", err)
+		}
+		// Access Token generated successfully.
+		return keygenResponse.Token.AccessToken, nil
+	}
+
+	//If the default cli appId does not exist in the environment
+	//Create the application
+	createdAppId, appName, err := createApplication(ctx, accessToken, applicationThrottlingPolicy)
+	appId = createdAppId
+	if createdAppId == "" && appName == "" {
+		//if error occurred while creating the application, then
+		return "", fmt.Errorf("error while creating the CLI application: %w", err)
+	}
+	utils.Logln(utils.LogPrefixInfo+"Created CLI application: ", appName)
+	//Search the if the given API or API Product is present to subscribe
+	subId, err := subscribe(ctx, appId, accessToken)
+	//If subscription failed
+	if subId == "" && err != nil {
+		return "", fmt.Errorf("error occurred while subscribing: %w", err)
+	}
+	scopes, err := getScopes(ctx, appId, accessToken)
+	//If errors occurred while retrieving scopes
+	if scopes == nil && err != nil {
+		return "", fmt.Errorf("error while retrieving scopes: %w", err)
+	}
+	//Generate the tokens
+	keygenResponse, err := generateApplicationKeys(ctx, appId, accessToken)
+	if err != nil {
+		return "", fmt.Errorf("error while generating CLI application keys: %w", err)
+	}
+	appKey := &utils.ApplicationKey{}
+	appKey.ConsumerKey = keygenResponse.ConsumerKey
+	appKey.ConsumerSecret = keygenResponse.ConsumerSecret
+	token, err := getNewToken(ctx, appKey, scopes)
+	if token == "" {
+		return "", fmt.Errorf("error while generating token: %w", err)
 	}
+	// Access Token generated successfully.
+	return token, nil
 }
 
 // Retrieve an available throttling tiers of the API or API Product
 // @param accessToken : Access token to authenticate the devportal REST API
 // @return tiers, error
-func getAvailableAPITiers(accessToken string) ([]string, error) {
-	apiId, err := searchApiOrProduct(accessToken)
+func getAvailableAPITiers(ctx context.Context, accessToken string) ([]string, error) {
+	apiId, err := searchApiOrProduct(ctx, accessToken)
 	if apiId == "" && err != nil {
 		return nil, err
 	}
-	api, err := getApiOrProduct(apiId, accessToken)
+	api, err := getApiOrProduct(ctx, apiId, accessToken)
 	if err == nil && api != nil {
 		return api.Policies, err
 	} else {
@@ -187,11 +182,14 @@ func getAvailableAPITiers(accessToken string) ([]string, error) {
 // Retrieve an available application throttling policy
 // @param accessToken : Access token to authenticate the devportal REST API
 // @return throttlingPolicy, error
-func getApplicationThrottlingPolicy(accessToken string) (string, error) {
+func getApplicationThrottlingPolicy(ctx context.Context, accessToken string) (string, error) {
 	applicationThrottlingPoliciesEndpoint := utils.GetDevPortalThrottlingPoliciesEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) + "/application"
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-	resp, err := utils.InvokeGETRequest(applicationThrottlingPoliciesEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(ctx, applicationThrottlingPoliciesEndpoint, headers)
+	if err != nil {
+		return "", err
+	}
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
 		applicationThrottlingData := &utils.ThrottlingPoliciesList{}
@@ -217,7 +215,7 @@ func getApplicationThrottlingPolicy(accessToken string) (string, error) {
 // Calling DCR endpoint
 // @param credential : Username and Password
 // @return client_id, client_secret, error
-func CallDCREndpoint(credential credentials.Credential, keyGenEnv string) (string, string, error) {
+func CallDCREndpoint(ctx context.Context, credential credentials.Credential, keyGenEnv string) (string, string, error) {
 	//Base64 encoding the credentials
 	b64encodedCredentials := credentials.GetBasicAuth(credential)
 	//Prepping the headers
@@ -234,9 +232,9 @@ func CallDCREndpoint(credential credentials.Credential, keyGenEnv string) (strin
 							}`)
 	registrationEndpoint := utils.GetRegistrationEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 	//Calling the DCR endpoint
-	resp, err := utils.InvokePOSTRequest(registrationEndpoint, headers, body)
+	resp, err := utils.InvokePOSTRequest(ctx, registrationEndpoint, headers, body)
 	if err != nil {
-		utils.HandleErrorAndExit("DCR request failed. Reason: ", err)
+		return "", "", fmt.Errorf("DCR request failed: %w", err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo + "Getting ClientID, ClientSecret: Status - " + resp.Status())
@@ -266,13 +264,16 @@ func CallDCREndpoint(credential credentials.Credential, keyGenEnv string) (strin
 // @param appName : Name of the application
 // @param accessToken : Access token to authenticate the devportal REST API
 // @return appId, error
-func searchApplication(appName string, accessToken string) (string, error) {
+func searchApplication(ctx context.Context, appName string, accessToken string) (string, error) {
 	//Application REST API endpoint of the environment from the config file
 	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 	//Prepping headers
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-	resp, err := utils.InvokeGETRequestWithQueryParam("query", appName, applicationEndpoint, headers)
+	resp, err := utils.InvokeGETRequestWithQueryParam(ctx, "query", appName, applicationEndpoint, headers)
+	if err != nil {
+		return "", err
+	}
 
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
@@ -300,8 +301,11 @@ func searchApplication(appName string, accessToken string) (string, error) {
 // Searching if the API or API Product is available
 // @param accessToken : Access token to call the devportal REST API
 // @return apiId, error
-func searchApiOrProduct(accessToken string) (string, error) {
-	resp, _ := getApiOrApiProductByType(accessToken, "")
+func searchApiOrProduct(ctx context.Context, accessToken string) (string, error) {
+	resp, err := getApiOrApiProductByType(ctx, accessToken, "")
+	if err != nil {
+		return "", err
+	}
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
 		apiData := &utils.ApiSearch{}
@@ -312,7 +316,10 @@ func searchApiOrProduct(accessToken string) (string, error) {
 			return apiId, err
 		}
 		// Search by defining the type as an API Product
-		resp, err = getApiOrApiProductByType(accessToken, utils.DefaultApiProductType)
+		resp, err = getApiOrApiProductByType(ctx, accessToken, utils.DefaultApiProductType)
+		if err != nil {
+			return "", err
+		}
 		if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 			// 200 OK or 201 Created
 			apiData := &utils.ApiSearch{}
@@ -352,7 +359,7 @@ func searchApiOrProduct(accessToken string) (string, error) {
 // @param accessToken : Access token to call the devportal REST API
 // @param searchType : Type of the searching artifact
 // @return response, error
-func getApiOrApiProductByType(accessToken, searchType string) (*resty.Response, error) {
+func getApiOrApiProductByType(ctx context.Context, accessToken, searchType string) (*resty.Response, error) {
 	// Unified Search endpoint from the config file to search APIs or API Products
 	unifiedSearchEndpoint := utils.GetUnifiedSearchEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 
@@ -375,24 +382,23 @@ func getApiOrApiProductByType(accessToken, searchType string) (*resty.Response,
 			queryVal = queryVal + " type:\"" + searchType + "\""
 		}
 	}
-	return utils.InvokeGETRequestWithQueryParam("query", queryVal, unifiedSearchEndpoint, headers)
+	return utils.InvokeGETRequestWithQueryParam(ctx, "query", queryVal, unifiedSearchEndpoint, headers)
 }
 
 // Subscribe API or API Product to a given application
 // @param appId : Application ID to subscribe the API or API Product
 // @param accessToken : Token to call REST API
 // @return subscriptionId, error
-func subscribe(appId string, accessToken string) (string, error) {
-	apiId, err := searchApiOrProduct(accessToken)
+func subscribe(ctx context.Context, appId string, accessToken string) (string, error) {
+	apiId, err := searchApiOrProduct(ctx, accessToken)
 	if apiId != "" && err == nil {
 		//If the API or API Product is present, subscribe that API or API Product to the application
 		utils.Logln(utils.LogPrefixInfo+"API or API Product name: ", apiName, "& version: ", apiVersion, "exists")
-		subId, err := subscribeApiOrProduct(apiId, appId, accessToken)
-		if subId != "" {
-			utils.Logln(utils.LogPrefixInfo+"API or API Product", apiName, ":", apiVersion, "subscribed successfully.")
-		} else {
-			utils.HandleErrorAndExit("Error while subscribing the CLI application to the API: "+appId, err)
+		subId, err := subscribeApiOrProduct(ctx, apiId, appId, accessToken)
+		if subId == "" {
+			return "", fmt.Errorf("error while subscribing the CLI application to the API %s: %w", apiId, err)
 		}
+		utils.Logln(utils.LogPrefixInfo+"API or API Product", apiName, ":", apiVersion, "subscribed successfully.")
 		return subId, err
 	} else {
 		return "", errors.New("API or API Product is not found. Name: " + apiName + " version: " + apiVersion)
@@ -403,12 +409,15 @@ func subscribe(appId string, accessToken string) (string, error) {
 // @param apiId : API ID to retrieve the information
 // @param accessToken : Access token to call the REST API
 // @return API, error
-func getApiOrProduct(apiId string, accessToken string) (*utils.APIData, error) {
+func getApiOrProduct(ctx context.Context, apiId string, accessToken string) (*utils.APIData, error) {
 	// First check whether this is an API
 	apiEndpoint := utils.GetApiListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) + "/" + apiId
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-	resp, err := utils.InvokeGETRequest(apiEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(ctx, apiEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
 		apiData := &utils.APIData{}
@@ -420,7 +429,10 @@ func getApiOrProduct(apiId string, accessToken string) (*utils.APIData, error) {
 		apiProductEndpoint := utils.GetApiProductListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) + "/" + apiId
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-		resp, err := utils.InvokeGETRequest(apiProductEndpoint, headers)
+		resp, err := utils.InvokeGETRequest(ctx, apiProductEndpoint, headers)
+		if err != nil {
+			return nil, err
+		}
 		if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 			// 200 OK or 201 Created
 			apiData := &utils.APIData{}
@@ -444,7 +456,7 @@ func getApiOrProduct(apiId string, accessToken string) (*utils.APIData, error) {
 // @param appId : Application ID to be subscribed
 // @param accessToken : Access token to call the REST API
 // @return subscriptionId, error
-func subscribeApiOrProduct(apiId string, appId string, accessToken string) (string, error) {
+func subscribeApiOrProduct(ctx context.Context, apiId string, appId string, accessToken string) (string, error) {
 	//todo: subscription endpoint to be included in conf
 	subEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 	subEndpoint = strings.Replace(subEndpoint, "applications", "subscriptions", -1)
@@ -456,7 +468,7 @@ func subscribeApiOrProduct(apiId string, appId string, accessToken string) (stri
 	queryParams := map[string]string{
 		utils.ApiId: apiId}
 	//Checking if there is a subscription of given API to the give application
-	subResp, subErr := utils.InvokeGETRequestWithMultipleQueryParams(queryParams, subEndpoint, headers)
+	subResp, subErr := utils.InvokeGETRequestWithMultipleQueryParams(ctx, queryParams, subEndpoint, headers)
 
 	if subResp.StatusCode() == http.StatusOK || subResp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
@@ -480,9 +492,12 @@ func subscribeApiOrProduct(apiId string, appId string, accessToken string) (stri
 		//If there is no subscription, make a subscription
 		body, err := json.Marshal(subscriptionReq)
 		if body == nil && err != nil {
-			utils.HandleErrorAndExit("Error occurred while creating CLI application subscription request.", err)
+			return "", fmt.Errorf("error occurred while creating CLI application subscription request: %w", err)
+		}
+		resp, err := utils.InvokePOSTRequest(ctx, subEndpoint, headers, string(body))
+		if err != nil {
+			return "", err
 		}
-		resp, err := utils.InvokePOSTRequest(subEndpoint, headers, string(body))
 		if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 			// 200 OK or 201 Created
 			subscription := &utils.Subscription{}
@@ -513,14 +528,17 @@ func subscribeApiOrProduct(apiId string, appId string, accessToken string) (stri
 // @param appId : Application ID
 // @param accessToken : Access token to call the devportl REST API
 // @return AppDetails, error
-func getApplicationDetails(appId string, accessToken string) (*utils.AppDetails, error) {
+func getApplicationDetails(ctx context.Context, appId string, accessToken string) (*utils.AppDetails, error) {
 
 	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) + "/" + appId
 	//Prepping headers
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 	//Retrieving the details of the particular application
-	resp, err := utils.InvokeGETRequest(applicationEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(ctx, applicationEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
 		appData := &utils.AppDetails{}
@@ -544,7 +562,7 @@ func getApplicationDetails(appId string, accessToken string) (*utils.AppDetails,
 // @param appId : Application ID
 // @param accessToken : Access token to call the devportal REST API
 // @return AppDetails, error
-func getApplicationKeys(appId string, accessToken string) (*utils.AppKeyList, error) {
+func getApplicationKeys(ctx context.Context, appId string, accessToken string) (*utils.AppKeyList, error) {
 
 	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) +
 		"/" + appId + "/keys"
@@ -552,7 +570,10 @@ func getApplicationKeys(appId string, accessToken string) (*utils.AppKeyList, er
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 	//Retrieving the details of the particular application
-	resp, err := utils.InvokeGETRequest(applicationEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(ctx, applicationEndpoint, headers)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
 		keyData := &utils.AppKeyList{}
@@ -585,7 +606,10 @@ func updateApplicationDetails(appId string, body string, accessToken string) (*u
 	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
 
 	//Retrieving the details of the particular application
-	resp, err := utils.InvokePutRequest(nil, applicationEndpoint, headers, body)
+	resp, err := utils.InvokePutRequest(context.Background(), nil, applicationEndpoint, headers, body)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
 		appData := &utils.AppDetails{}
@@ -607,7 +631,7 @@ func updateApplicationDetails(appId string, body string, accessToken string) (*u
 // @param accessToken : Access token to call the devportal REST API
 // @param throttlingPolicy : Throttling policy to create the application
 // @return client_id, client_secret, error
-func createApplication(accessToken string, throttlingPolicy string) (string, string, error) {
+func createApplication(ctx context.Context, accessToken string, throttlingPolicy string) (string, string, error) {
 
 	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
 	headers := make(map[string]string)
@@ -622,9 +646,12 @@ func createApplication(accessToken string, throttlingPolicy string) (string, str
 	}
 	body, err := json.Marshal(appUpdateReq)
 	if body == nil && err != nil {
-		utils.HandleErrorAndExit("Error occurred while creating CLI application update request.", err)
+		return "", "", fmt.Errorf("error occurred while creating CLI application update request: %w", err)
+	}
+	resp, err := utils.InvokePOSTRequest(ctx, applicationEndpoint, headers, string(body))
+	if err != nil {
+		return "", "", err
 	}
-	resp, err := utils.InvokePOSTRequest(applicationEndpoint, headers, string(body))
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
 		applicationResponse := &utils.Application{}
@@ -648,7 +675,7 @@ func createApplication(accessToken string, throttlingPolicy string) (string, str
 // @param key : Details of the particular key
 // @param scopes[] : Scopes to generate the token
 // @return accessToken, error
-func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
+func getNewToken(ctx context.Context, key *utils.ApplicationKey, scopes []string) (string, error) {
 	var tokenEndpoint string
 	if keyGenTokenEndpoint == "" {
 		tokenEndpoint = utils.GetTokenEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath)
@@ -664,7 +691,7 @@ func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
 	headers[utils.HeaderContentType] = utils.HeaderValueXWWWFormUrlEncoded
 	headers[utils.HeaderAccept] = utils.HeaderValueApplicationJSON
 
-	resp, err := utils.InvokePOSTRequest(tokenEndpoint, headers, body)
+	resp, err := utils.InvokePOSTRequest(ctx, tokenEndpoint, headers, body)
 
 	if err != nil {
 		return "", errors.New("Token Endpoint is not valid. " + err.Error())
@@ -695,8 +722,8 @@ func getNewToken(key *utils.ApplicationKey, scopes []string) (string, error) {
 // @param appId : Application ID to get the scopes of subscribed APIs and API Products
 // @param accessToken : Access token to call the devportal REST API
 // @return scope[], error
-func getScopes(appId string, accessToken string) ([]string, error) {
-	appDetails, err := getApplicationDetails(appId, accessToken)
+func getScopes(ctx context.Context, appId string, accessToken string) ([]string, error) {
+	appDetails, err := getApplicationDetails(ctx, appId, accessToken)
 	if err != nil || appDetails == nil {
 		utils.HandleErrorAndContinue("Error occurred while retrieving subscribed scopes. "+
 			"Scopes may not be included in the access token", err)
@@ -717,7 +744,7 @@ func getScopes(appId string, accessToken string) ([]string, error) {
 // @param appId : Application ID of the app to be generated keys
 // @param token : Token to invoke the devportal REST API
 // @return client_id, client_secret, error
-func generateApplicationKeys(appId string, token string) (*utils.KeygenResponse, error) {
+func generateApplicationKeys(ctx context.Context, appId string, token string) (*utils.KeygenResponse, error) {
 
 	applicationEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(keyGenEnv, utils.MainConfigFilePath) +
 		"/" + appId + "/generate-keys"
@@ -731,10 +758,13 @@ func generateApplicationKeys(appId string, token string) (*utils.KeygenResponse,
 	}
 	body, err := json.Marshal(generateKeyReq)
 	if body == nil && err != nil {
-		utils.HandleErrorAndExit("Error occurred while creating CLI application key generation request.", err)
+		return nil, fmt.Errorf("error occurred while creating CLI application key generation request: %w", err)
 	}
 
-	resp, err := utils.InvokePOSTRequest(applicationEndpoint, headers, string(body))
+	resp, err := utils.InvokePOSTRequest(ctx, applicationEndpoint, headers, string(body))
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusCreated {
 		// 200 OK or 201 Created
 		keygenResponse := &utils.KeygenResponse{}
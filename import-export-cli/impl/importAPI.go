@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -144,9 +145,26 @@ func replaceEnvVariables(apiFilePath string) error {
 	return nil
 }
 
+// PrepareAPIProjectForEnv substitutes environment variables in the API project located in apiFilePath and applies the
+// configurations of the given environment from the params file or directory, if apiParamsPath is provided
+func PrepareAPIProjectForEnv(apiFilePath, apiParamsPath, environment string) error {
+	utils.Logln(utils.LogPrefixInfo + "Substituting environment variables in API files...")
+	err := replaceEnvVariables(apiFilePath)
+	if err != nil {
+		return err
+	}
+
+	if apiParamsPath != "" {
+		//Reading params file of the API and add configurations into temp artifact
+		return handleCustomizedParameters(apiFilePath, apiParamsPath, environment)
+	}
+	return nil
+}
+
 // importAPI imports an API to the API manager
-func importAPI(endpoint, filePath, accessToken string, extraParams map[string]string, isOauth bool) error {
-	resp, err := ExecuteNewFileUploadRequest(endpoint, extraParams, "file",
+func importAPI(ctx context.Context, endpoint, filePath, accessToken string, extraParams map[string]string,
+	isOauth bool) error {
+	resp, err := ExecuteNewFileUploadRequest(ctx, endpoint, extraParams, "file",
 		filePath, accessToken, isOauth)
 	utils.Logf("Response : %v", resp)
 	if err != nil {
@@ -155,27 +173,24 @@ func importAPI(endpoint, filePath, accessToken string, extraParams map[string]st
 	}
 	if resp.StatusCode() == http.StatusCreated || resp.StatusCode() == http.StatusOK {
 		// 201 Created or 200 OK
-		fmt.Println("Successfully imported API.")
 		return nil
-	} else {
-		// We have an HTTP error
-		fmt.Println("Error importing API.")
-		fmt.Println("Status: " + resp.Status())
-		fmt.Println("Response:", resp)
-		return errors.New(resp.Status())
 	}
+	// We have an HTTP error
+	return newImportResponseError(resp)
 }
 
 // ImportAPIToEnv function is used with import-api command
-func ImportAPIToEnv(accessOAuthToken, importEnvironment, importPath, apiParamsPath string, importAPIUpdate,
+func ImportAPIToEnv(ctx context.Context, accessOAuthToken, importEnvironment, importPath, apiParamsPath string,
+	importAPIUpdate,
 	preserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments bool) error {
 	publisherEndpoint := utils.GetPublisherEndpointOfEnv(importEnvironment, utils.MainConfigFilePath)
-	return ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiParamsPath, importAPIUpdate,
-		preserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments)
+	return ImportAPI(ctx, accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiParamsPath,
+		importAPIUpdate, preserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments)
 }
 
 // ImportAPI function is used with import-api command
-func ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiParamsPath string, importAPIUpdate,
+func ImportAPI(ctx context.Context, accessOAuthToken, publisherEndpoint, importEnvironment, importPath,
+	apiParamsPath string, importAPIUpdate,
 	preserveProvider, importAPISkipCleanup, importAPIRotateRevision, importAPISkipDeployments bool) error {
 	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedApisDirName)
 	resolvedAPIFilePath, err := resolveImportFilePath(importPath, exportDirectory)
@@ -248,7 +263,7 @@ func ImportAPI(accessOAuthToken, publisherEndpoint, importEnvironment, importPat
 	}
 	utils.Logln(utils.LogPrefixInfo + "Import URL: " + publisherEndpoint)
 
-	err = importAPI(publisherEndpoint, apiFilePath, accessOAuthToken, extraParams, true)
+	err = importAPI(ctx, publisherEndpoint, apiFilePath, accessOAuthToken, extraParams, true)
 	return err
 }
 
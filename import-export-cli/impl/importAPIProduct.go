@@ -19,8 +19,7 @@
 package impl
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -57,8 +56,9 @@ func resolveImportAPIProductFilePath(file, defaultExportDirectory string) (strin
 }
 
 // importAPIProduct imports an API Product to the API manager
-func importAPIProduct(endpoint, filePath, accessToken string, extraParams map[string]string) error {
-	resp, err := ExecuteNewFileUploadRequest(endpoint, extraParams, "file",
+func importAPIProduct(ctx context.Context, endpoint, filePath, accessToken string,
+	extraParams map[string]string) error {
+	resp, err := ExecuteNewFileUploadRequest(ctx, endpoint, extraParams, "file",
 		filePath, accessToken, true)
 	if err != nil {
 		return err
@@ -66,29 +66,27 @@ func importAPIProduct(endpoint, filePath, accessToken string, extraParams map[st
 
 	if resp.StatusCode() == http.StatusCreated || resp.StatusCode() == http.StatusOK {
 		// 201 Created or 200 OK
-		fmt.Println("Successfully imported API Product.")
 		return nil
-	} else {
-		// We have an HTTP error
-		fmt.Println("Error importing API Product.")
-		fmt.Println("Status: " + resp.Status())
-		fmt.Println("Response:", resp)
-		return errors.New(resp.Status())
 	}
+	// We have an HTTP error
+	return newImportResponseError(resp)
 }
 
 // ImportAPIProductToEnv function is used with import-api-product command
-func ImportAPIProductToEnv(accessOAuthToken, importEnvironment, importPath, apiProductParamsPath string, importAPIs, importAPIsUpdate,
+func ImportAPIProductToEnv(ctx context.Context, accessOAuthToken, importEnvironment, importPath,
+	apiProductParamsPath string, importAPIs, importAPIsUpdate,
 	importAPIProductUpdate, importAPIProductPreserveProvider, importAPIProductSkipCleanup, rotateRevision,
 	skipDeployments bool) error {
 	publisherEndpoint := utils.GetPublisherEndpointOfEnv(importEnvironment, utils.MainConfigFilePath)
-	return ImportAPIProduct(accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiProductParamsPath, importAPIs,
+	return ImportAPIProduct(ctx, accessOAuthToken, publisherEndpoint, importEnvironment, importPath,
+		apiProductParamsPath, importAPIs,
 		importAPIsUpdate, importAPIProductUpdate, importAPIProductPreserveProvider, importAPIProductSkipCleanup, rotateRevision,
 		skipDeployments)
 }
 
 // ImportAPIProduct function is used with import-api-product command
-func ImportAPIProduct(accessOAuthToken, publisherEndpoint, importEnvironment, importPath, apiProductParamsPath string, importAPIs, importAPIsUpdate,
+func ImportAPIProduct(ctx context.Context, accessOAuthToken, publisherEndpoint, importEnvironment, importPath,
+	apiProductParamsPath string, importAPIs, importAPIsUpdate,
 	importAPIProductUpdate, importAPIProductPreserveProvider, importAPIProductSkipCleanup,
 	rotateRevision, skipDeployments bool) error {
 	var exportDirectory = filepath.Join(utils.ExportDirectory, utils.ExportedApiProductsDirName)
@@ -158,9 +156,6 @@ func ImportAPIProduct(accessOAuthToken, publisherEndpoint, importEnvironment, im
 		defer cleanupFunc()
 	}
 
-	if err != nil {
-		utils.HandleErrorAndExit("Error getting OAuth Tokens", err)
-	}
 	extraParams := map[string]string{}
 	publisherEndpoint += "/api-products/import" + "?preserveProvider=" +
 		strconv.FormatBool(importAPIProductPreserveProvider) + "&rotateRevision=" + strconv.FormatBool(rotateRevision)
@@ -182,7 +177,7 @@ func ImportAPIProduct(accessOAuthToken, publisherEndpoint, importEnvironment, im
 	}
 
 	utils.Logln(utils.LogPrefixInfo + "Import URL: " + publisherEndpoint)
-	err = importAPIProduct(publisherEndpoint, apiProductFilePath, accessOAuthToken, extraParams)
+	err = importAPIProduct(ctx, publisherEndpoint, apiProductFilePath, accessOAuthToken, extraParams)
 	return err
 }
 
@@ -20,7 +20,7 @@ package impl
 
 import (
 	"bytes"
-	"errors"
+	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -44,11 +44,11 @@ import (
 // @param skipSubscriptions: Skip importing subscriptions
 // @param skipKeys: skip importing keys of application
 // @param skipCleanup: skip cleaning up temporary files created during the operation
-func ImportApplicationToEnv(accessToken, environment, filename, appOwner string, updateApplication, preserveOwner,
-	skipSubscriptions, skipKeys, skipCleanup bool) (*http.Response, error) {
+func ImportApplicationToEnv(ctx context.Context, accessToken, environment, filename, appOwner string, updateApplication,
+	preserveOwner, skipSubscriptions, skipKeys, skipCleanup bool) (*http.Response, error) {
 	devportalApplicationsEndpoint := utils.GetDevPortalApplicationListEndpointOfEnv(environment, utils.MainConfigFilePath)
-	return ImportApplication(accessToken, devportalApplicationsEndpoint, filename, appOwner, updateApplication, preserveOwner,
-		skipSubscriptions, skipKeys, skipCleanup)
+	return ImportApplication(ctx, accessToken, devportalApplicationsEndpoint, filename, appOwner, updateApplication,
+		preserveOwner, skipSubscriptions, skipKeys, skipCleanup)
 }
 
 // ImportApplication function is used with import-app command
@@ -61,8 +61,8 @@ func ImportApplicationToEnv(accessToken, environment, filename, appOwner string,
 // @param skipSubscriptions: Skip importing subscriptions
 // @param skipKeys: skip importing keys of application
 // @param skipCleanup: skip cleaning up temporary files created during the operation
-func ImportApplication(accessToken, devportalApplicationsEndpoint, filename, appOwner string, updateApplication, preserveOwner,
-	skipSubscriptions, skipKeys, skipCleanup bool) (*http.Response, error) {
+func ImportApplication(ctx context.Context, accessToken, devportalApplicationsEndpoint, filename, appOwner string,
+	updateApplication, preserveOwner, skipSubscriptions, skipKeys, skipCleanup bool) (*http.Response, error) {
 
 	exportDirectory := filepath.Join(utils.ExportDirectory, utils.ExportedAppsDirName)
 	devportalApplicationsEndpoint = utils.AppendSlashToString(devportalApplicationsEndpoint)
@@ -76,7 +76,7 @@ func ImportApplication(accessToken, devportalApplicationsEndpoint, filename, app
 
 	applicationFilePath, err := resolveApplicationImportFilePath(filename, exportDirectory)
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating request.", err)
+		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
 	// If applicationFilePath contains a directory, zip it. Otherwise, leave it as it is.
@@ -92,22 +92,18 @@ func ImportApplication(accessToken, devportalApplicationsEndpoint, filename, app
 
 	extraParams := map[string]string{}
 
-	resp, err := NewAppFileUploadRequest(applicationImportUrl, extraParams, "file", applicationFilePath, accessToken)
+	resp, err := NewAppFileUploadRequest(ctx, applicationImportUrl, extraParams, "file", applicationFilePath,
+		accessToken)
 	if err != nil {
-		utils.HandleErrorAndExit("Error executing request.", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
 	if resp.StatusCode() == http.StatusCreated || resp.StatusCode() == http.StatusOK {
 		// 201 Created or 200 OK
-		fmt.Println("Successfully imported Application.")
 		return nil, nil
-	} else {
-		// We have an HTTP error
-		fmt.Println("Error importing Application.")
-		fmt.Println("Status: " + resp.Status())
-		fmt.Println("Response:", resp)
-		return nil, errors.New(resp.Status())
 	}
+	// We have an HTTP error
+	return nil, newImportResponseError(resp)
 }
 
 // resolveApplicationImportFilePath resolves the archive/directory for import
@@ -134,7 +130,7 @@ func resolveApplicationImportFilePath(file, defaultExportDirectory string) (stri
 // NewFileUploadRequest form an HTTP Put request
 // Helper function for forming multi-part form data
 // Returns the formed http request and errors
-func NewAppFileUploadRequest(uri string, params map[string]string, paramName, path,
+func NewAppFileUploadRequest(ctx context.Context, uri string, params map[string]string, paramName, path,
 	accessToken string) (*resty.Response, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -165,7 +161,7 @@ func NewAppFileUploadRequest(uri string, params map[string]string, paramName, pa
 	headers[utils.HeaderAccept] = "*/*"
 	headers[utils.HeaderConnection] = utils.HeaderValueKeepAlive
 
-	resp, err := utils.InvokePOSTRequest(uri, headers, body.Bytes())
+	resp, err := utils.InvokePOSTRequest(ctx, uri, headers, body.Bytes())
 
 	return resp, err
 }
@@ -19,6 +19,8 @@
 package impl
 
 import (
+	"context"
+
 	"github.com/renstrom/dedent"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 	"net/http"
@@ -50,12 +52,14 @@ func TestImportApplication1(t *testing.T) {
 	owner := "admin"
 	accessToken := "access-token"
 
-	_, err := ImportApplication(accessToken, server.URL, name, owner, false,true, true, true, false)
+	_, err := ImportApplication(context.Background(), accessToken, server.URL, name, owner, false,true, true, true,
+		false)
 	if err != nil {
 		t.Errorf("Error: %s\n", err.Error())
 	}
 	utils.Insecure = true
-	_, err = ImportApplication(accessToken, server.URL, name, owner, false,true, true, true, false)
+	_, err = ImportApplication(context.Background(), accessToken, server.URL, name, owner, false,true, true, true,
+		false)
 	if err != nil {
 		t.Errorf("Error: %s\n", err.Error())
 	}
@@ -87,7 +91,7 @@ func TestNewAppFileUploadRequest(t *testing.T) {
 	extraParams := map[string]string{}
 	filePath := filepath.FromSlash(utils.GetRelativeTestDataPathFromImpl() + "sampleApp.zip")
 	accessToken := "access-token"
-	_, err := NewAppFileUploadRequest(server.URL, extraParams, "file", filePath, accessToken)
+	_, err := NewAppFileUploadRequest(context.Background(), server.URL, extraParams, "file", filePath, accessToken)
 	if err != nil {
 		t.Errorf("Error: %s\n", err.Error())
 	}
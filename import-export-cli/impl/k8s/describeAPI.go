@@ -0,0 +1,181 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultAPIDetailedFormat = "detail Name - {{.Name}}\n" +
+	"Namespace - {{.Namespace}}\n" +
+	"Mode - {{.Mode}}\n" +
+	"Status - {{.Status}}\n" +
+	"Ready - {{.Ready}}\n" +
+	"Replicas - {{.Replicas}}\n" +
+	"Image - {{.Image}}\n" +
+	"URL - {{.URL}}\n" +
+	"Age - {{.Age}}\n" +
+	"Swagger ConfigMap - {{.SwaggerConfigMapName}}\n" +
+	"Params ConfigMap - {{.ParamsConfigMapName}}\n" +
+	"Certs Secret - {{.CertsSecretName}}\n" +
+	"Warning Events :\n" +
+	"{{ if eq (len .RecentEvents) 0 }}" +
+	"No Warning Events found\n" +
+	"{{else}}" +
+	"{{ range .RecentEvents }}" +
+	" {{ . }}\n" +
+	"{{ end }}" +
+	"{{ end }}" +
+	"Swagger :\n" +
+	"{{.Swagger}}\n" +
+	"Params :\n" +
+	"{{.Params}}"
+
+// APIDetails is an API deployed with the API Operator along with its swagger and params
+type APIDetails struct {
+	API
+	swaggerConfigMap *corev1.ConfigMap
+	paramsConfigMap  *corev1.ConfigMap
+}
+
+// SwaggerConfigMapName returns the name of the ConfigMap with the swagger or the project of the API
+func (a APIDetails) SwaggerConfigMapName() string {
+	return valueOrNotAvailable(a.cr.Spec.SwaggerConfigMapName)
+}
+
+// ParamsConfigMapName returns the name of the ConfigMap with the params of the API
+func (a APIDetails) ParamsConfigMapName() string {
+	return valueOrNotAvailable(a.cr.Spec.ParamsValues)
+}
+
+// CertsSecretName returns the name of the Secret with the certificates of the API
+func (a APIDetails) CertsSecretName() string {
+	return valueOrNotAvailable(a.cr.Spec.CertsValues)
+}
+
+// Swagger returns the contents of the swagger ConfigMap, API projects are shown by their file name and size
+func (a APIDetails) Swagger() string {
+	return configMapContents(a.swaggerConfigMap)
+}
+
+// Params returns the params of the API
+func (a APIDetails) Params() string {
+	return configMapContents(a.paramsConfigMap)
+}
+
+// MarshalJSON marshals the API using custom marshaller which uses methods instead of fields
+func (a *APIDetails) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(a)
+}
+
+// GetAPIDetails returns the API with the given name along with the resources generated for it by the API Operator,
+// its swagger and params
+func GetAPIDetails(client *k8sUtils.K8sClient, namespace, name string) (*APIDetails, error) {
+	ctx := context.Background()
+	obj, err := client.Get(ctx, k8sUtils.ApiOpCrdApi, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	api, err := getAPIResources(ctx, client, *obj)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &APIDetails{API: *api}
+	if details.swaggerConfigMap, err = getConfigMap(ctx, client, api.cr.Namespace,
+		api.cr.Spec.SwaggerConfigMapName); err != nil {
+		return nil, err
+	}
+	if details.paramsConfigMap, err = getConfigMap(ctx, client, api.cr.Namespace,
+		api.cr.Spec.ParamsValues); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// PrintAPIDetails prints details about an API according to the given format
+func PrintAPIDetails(api *APIDetails, format string) {
+	if format == "" || strings.HasPrefix(format, formatter.TableFormatKey) {
+		format = defaultAPIDetailedFormat
+	}
+
+	apiContext := formatter.NewContext(os.Stdout, format)
+	renderer := func(w io.Writer, t *template.Template) error {
+		return t.Execute(w, api)
+	}
+
+	if err := apiContext.Write(renderer, nil); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// getConfigMap returns the ConfigMap with the given name, or nil if the name is empty or the ConfigMap is not found
+func getConfigMap(ctx context.Context, client *k8sUtils.K8sClient, namespace,
+	name string) (*corev1.ConfigMap, error) {
+	if name == "" {
+		return nil, nil
+	}
+	configMap, err := client.Clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8sUtils.IsK8sNotFound(err) {
+		return nil, nil
+	}
+	return configMap, err
+}
+
+// configMapContents returns the data of the ConfigMap, binary data is shown by the key and the size
+func configMapContents(configMap *corev1.ConfigMap) string {
+	if configMap == nil {
+		return notAvailable
+	}
+	var keys []string
+	for key := range configMap.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var contents []string
+	for _, key := range keys {
+		if len(configMap.Data)+len(configMap.BinaryData) > 1 {
+			contents = append(contents, "# "+key)
+		}
+		contents = append(contents, strings.TrimRight(configMap.Data[key], "\n"))
+	}
+	for key, value := range configMap.BinaryData {
+		contents = append(contents, fmt.Sprintf("%s (%d bytes)", key, len(value)))
+	}
+	return strings.Join(contents, "\n")
+}
+
+func valueOrNotAvailable(value string) string {
+	if value == "" {
+		return notAvailable
+	}
+	return value
+}
@@ -0,0 +1,405 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	wso2v1alpha2 "github.com/wso2/k8s-api-operator/api-operator/pkg/apis/wso2/v1alpha2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	apiNameHeader      = "NAME"
+	apiNamespaceHeader = "NAMESPACE"
+	apiModeHeader      = "MODE"
+	apiStatusHeader    = "STATUS"
+	apiReadyHeader     = "READY"
+	apiReplicasHeader  = "REPLICAS"
+	apiImageHeader     = "IMAGE"
+	apiURLHeader       = "URL"
+	apiWarningsHeader  = "WARNINGS"
+	apiAgeHeader       = "AGE"
+
+	// DefaultAPIsTableFormat is the default format used to list the APIs in the kubernetes cluster
+	DefaultAPIsTableFormat = "table {{.Name}}\t{{.Namespace}}\t{{.Status}}\t{{.Ready}}\t{{.Replicas}}\t{{.Image}}\t{{.URL}}\t" +
+		"{{.Warnings}}\t{{.Age}}"
+)
+
+// API status values
+const (
+	APIStatusReady    = "Ready"
+	APIStatusNotReady = "NotReady"
+	APIStatusPending  = "Pending"
+)
+
+const (
+	ingressesResource = "ingresses"
+	routesResource    = "routes.route.openshift.io"
+	// maxRecentEvents is the maximum number of warning events shown for an API
+	maxRecentEvents = 5
+	// notAvailable is shown when a value of an API is not available
+	notAvailable = "-"
+)
+
+// API is an API deployed with the API Operator along with the resources generated for it
+type API struct {
+	cr         wso2v1alpha2.API
+	deployment *appsv1.Deployment
+	service    *corev1.Service
+	hpa        *autoscalingv1.HorizontalPodAutoscaler
+	// ingresses are the Ingresses or OpenShift Routes of the API
+	ingresses []unstructured.Unstructured
+	// events are the recent warning events of the API and its resources, latest first
+	events []corev1.Event
+}
+
+// Name of the API
+func (a API) Name() string {
+	return a.cr.Name
+}
+
+// Namespace of the API
+func (a API) Namespace() string {
+	return a.cr.Namespace
+}
+
+// Mode of the API
+func (a API) Mode() string {
+	if a.cr.Spec.Mode == "" {
+		return notAvailable
+	}
+	return a.cr.Spec.Mode.String()
+}
+
+// Status of the API: Ready if all replicas of the API are ready, Pending if the API is not deployed yet
+func (a API) Status() string {
+	if a.deployment == nil {
+		return APIStatusPending
+	}
+	if a.deployment.Status.ReadyReplicas > 0 && a.deployment.Status.ReadyReplicas >= a.desiredReplicas() {
+		return APIStatusReady
+	}
+	return APIStatusNotReady
+}
+
+// Ready returns the ready replicas and the desired replicas of the API
+func (a API) Ready() string {
+	if a.deployment == nil {
+		return notAvailable
+	}
+	return fmt.Sprintf("%d/%d", a.deployment.Status.ReadyReplicas, a.desiredReplicas())
+}
+
+// Replicas returns the current replicas of the API along with the min and max replicas if autoscaled
+func (a API) Replicas() string {
+	if a.deployment == nil {
+		return notAvailable
+	}
+	if a.hpa == nil {
+		return fmt.Sprint(a.deployment.Status.Replicas)
+	}
+	minReplicas := int32(1)
+	if a.hpa.Spec.MinReplicas != nil {
+		minReplicas = *a.hpa.Spec.MinReplicas
+	}
+	return fmt.Sprintf("%d (%d-%d)", a.deployment.Status.Replicas, minReplicas, a.hpa.Spec.MaxReplicas)
+}
+
+// Image of the API
+func (a API) Image() string {
+	if a.deployment == nil || len(a.deployment.Spec.Template.Spec.Containers) == 0 {
+		return notAvailable
+	}
+	return a.deployment.Spec.Template.Spec.Containers[0].Image
+}
+
+// URL returns the external URL of the API exposed with an Ingress, an OpenShift Route or a LoadBalancer Service
+func (a API) URL() string {
+	for _, ingress := range a.ingresses {
+		if url := ingressURL(ingress); url != "" {
+			return url
+		}
+	}
+	if a.service != nil && a.service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		for _, lbIngress := range a.service.Status.LoadBalancer.Ingress {
+			host := lbIngress.Hostname
+			if host == "" {
+				host = lbIngress.IP
+			}
+			if host != "" && len(a.service.Spec.Ports) > 0 {
+				return fmt.Sprintf("https://%s:%d", host, a.service.Spec.Ports[0].Port)
+			}
+		}
+	}
+	return notAvailable
+}
+
+// Warnings returns the count of the recent warning events and the reason of the latest one
+func (a API) Warnings() string {
+	if len(a.events) == 0 {
+		return notAvailable
+	}
+	return fmt.Sprintf("%d (%s)", len(a.events), a.events[0].Reason)
+}
+
+// RecentEvents returns the recent warning events of the API and its resources, latest first
+func (a API) RecentEvents() []string {
+	events := make([]string, 0, len(a.events))
+	for _, event := range a.events {
+		events = append(events, fmt.Sprintf("%s ago\t%s\t%s/%s\t%s", age(eventTime(event)), event.Reason,
+			event.InvolvedObject.Kind, event.InvolvedObject.Name, strings.TrimSpace(event.Message)))
+	}
+	return events
+}
+
+// Age of the API
+func (a API) Age() string {
+	return age(a.cr.CreationTimestamp.Time)
+}
+
+// MarshalJSON marshals the API using custom marshaller which uses methods instead of fields
+func (a *API) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(a)
+}
+
+func (a API) desiredReplicas() int32 {
+	if a.deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *a.deployment.Spec.Replicas
+}
+
+// GetAPIs returns the APIs in the namespace, or in all namespaces if allNamespaces is true, along with the resources
+// generated for them by the API Operator
+func GetAPIs(client *k8sUtils.K8sClient, namespace string, allNamespaces bool) ([]API, error) {
+	ctx := context.Background()
+	list, err := client.List(ctx, k8sUtils.ApiOpCrdApi, namespace, allNamespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	apis := make([]API, 0, len(list.Items))
+	for _, item := range list.Items {
+		api, err := getAPIResources(ctx, client, item)
+		if err != nil {
+			return nil, err
+		}
+		apis = append(apis, *api)
+	}
+	sort.Slice(apis, func(i, j int) bool {
+		if apis[i].Namespace() != apis[j].Namespace() {
+			return apis[i].Namespace() < apis[j].Namespace()
+		}
+		return apis[i].Name() < apis[j].Name()
+	})
+	return apis, nil
+}
+
+// PrintAPIs prints the APIs according to the given format
+func PrintAPIs(apis []API, format string) {
+	if format == "" {
+		format = DefaultAPIsTableFormat
+	}
+	apisContext := formatter.NewContext(os.Stdout, format)
+
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, api := range apis {
+			if err := t.Execute(w, api); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	apisTableHeaders := map[string]string{
+		"Name":      apiNameHeader,
+		"Namespace": apiNamespaceHeader,
+		"Mode":      apiModeHeader,
+		"Status":    apiStatusHeader,
+		"Ready":     apiReadyHeader,
+		"Replicas":  apiReplicasHeader,
+		"Image":     apiImageHeader,
+		"URL":       apiURLHeader,
+		"Warnings":  apiWarningsHeader,
+		"Age":       apiAgeHeader,
+	}
+	if err := apisContext.Write(renderer, apisTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// getAPIResources returns the API of the CR along with the resources generated for it by the API Operator.
+// The Deployment, Service and HPA are named after the API and the Ingresses and Routes are owned by the API
+func getAPIResources(ctx context.Context, client *k8sUtils.K8sClient, obj unstructured.Unstructured) (*API, error) {
+	api := &API{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &api.cr); err != nil {
+		return nil, err
+	}
+	namespace, name := api.cr.Namespace, api.cr.Name
+
+	deployment, err := client.Clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err = ignoreNotFound(err); err != nil {
+		return nil, err
+	}
+	if deployment != nil && deployment.Name != "" {
+		api.deployment = deployment
+	}
+	service, err := client.Clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err = ignoreNotFound(err); err != nil {
+		return nil, err
+	}
+	if service != nil && service.Name != "" {
+		api.service = service
+	}
+	hpa, err := client.Clientset.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(ctx, name,
+		metav1.GetOptions{})
+	if err = ignoreNotFound(err); err != nil {
+		return nil, err
+	}
+	if hpa != nil && hpa.Name != "" {
+		api.hpa = hpa
+	}
+
+	for _, resource := range []string{ingressesResource, routesResource} {
+		list, err := client.List(ctx, resource, namespace, false)
+		if meta.IsNoMatchError(err) {
+			continue // e.g. Routes are only available in OpenShift
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			if isOwnedBy(item, api.cr.UID) {
+				api.ingresses = append(api.ingresses, item)
+			}
+		}
+	}
+
+	api.events, err = getWarningEvents(ctx, client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return api, nil
+}
+
+// getWarningEvents returns the recent warning events of the API and its resources, i.e. resources named after the API
+// and the pods of the API
+func getWarningEvents(ctx context.Context, client *k8sUtils.K8sClient, namespace, name string) ([]corev1.Event,
+	error) {
+	list, err := client.Clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", corev1.EventTypeWarning).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var events []corev1.Event
+	for _, event := range list.Items {
+		objectName := event.InvolvedObject.Name
+		if event.Type == corev1.EventTypeWarning && (objectName == name || strings.HasPrefix(objectName, name+"-")) {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return eventTime(events[i]).After(eventTime(events[j]))
+	})
+	if len(events) > maxRecentEvents {
+		events = events[:maxRecentEvents]
+	}
+	return events, nil
+}
+
+// ingressURL returns the URL of the first host of an Ingress or an OpenShift Route
+func ingressURL(ingress unstructured.Unstructured) string {
+	if host, _, _ := unstructured.NestedString(ingress.Object, "spec", "host"); host != "" {
+		// OpenShift Route
+		if _, found, _ := unstructured.NestedMap(ingress.Object, "spec", "tls"); found {
+			return "https://" + host
+		}
+		return "http://" + host
+	}
+	rules, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "rules")
+	for _, rule := range rules {
+		host, _, _ := unstructured.NestedString(rule.(map[string]interface{}), "host")
+		if host == "" {
+			continue
+		}
+		if tls, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "tls"); len(tls) > 0 {
+			return "https://" + host
+		}
+		return "http://" + host
+	}
+	return ""
+}
+
+// isOwnedBy returns whether the resource is owned by the resource with the given UID
+func isOwnedBy(obj unstructured.Unstructured, uid types.UID) bool {
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// eventTime returns the time the event last occurred
+func eventTime(event corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// age returns the time elapsed since the given time in a human readable format
+func age(t time.Time) string {
+	if t.IsZero() {
+		return notAvailable
+	}
+	return duration.HumanDuration(time.Since(t))
+}
+
+// ignoreNotFound returns nil if the error is a not found error
+func ignoreNotFound(err error) error {
+	if k8sUtils.IsK8sNotFound(err) {
+		return nil
+	}
+	return err
+}
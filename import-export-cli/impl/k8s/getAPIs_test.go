@@ -0,0 +1,154 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package k8s
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var (
+	apiGVK     = schema.GroupVersionKind{Group: "wso2.com", Version: "v1alpha2", Kind: "API"}
+	ingressGVK = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}
+)
+
+func newFakeK8sClient() *k8sUtils.K8sClient {
+	apiCr := &unstructured.Unstructured{}
+	apiCr.SetGroupVersionKind(apiGVK)
+	apiCr.SetNamespace("wso2")
+	apiCr.SetName("petstore")
+	apiCr.SetUID("petstore-uid")
+	_ = unstructured.SetNestedField(apiCr.Object, "petstore-swagger", "spec", "swaggerConfigMapName")
+	_ = unstructured.SetNestedField(apiCr.Object, "petstore-params", "spec", "paramsValues")
+
+	ingress := &unstructured.Unstructured{}
+	ingress.SetGroupVersionKind(ingressGVK)
+	ingress.SetNamespace("wso2")
+	ingress.SetName("api-operator-ingress-petstore")
+	ingress.SetOwnerReferences([]metav1.OwnerReference{{Kind: "API", Name: "petstore", UID: "petstore-uid"}})
+	_ = unstructured.SetNestedSlice(ingress.Object, []interface{}{
+		map[string]interface{}{"host": "petstore.example.com"},
+	}, "spec", "rules")
+
+	replicas := int32(2)
+	clientset := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "wso2", Name: "petstore"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Image: "wso2/petstore:v1"}},
+				}},
+			},
+			Status: appsv1.DeploymentStatus{Replicas: 2, ReadyReplicas: 1},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "wso2", Name: "petstore-abc.1"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "petstore-abc"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+			LastTimestamp:  metav1.NewTime(time.Now()),
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "wso2", Name: "inventory.1"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Name: "inventory"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "FailedCreate",
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "wso2", Name: "petstore-swagger"},
+			Data:       map[string]string{"swagger.yaml": "openapi: 3.0.0\n"},
+		},
+	)
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(apiGVK, meta.RESTScopeNamespace)
+	mapper.Add(ingressGVK, meta.RESTScopeNamespace)
+
+	return &k8sUtils.K8sClient{
+		Clientset: clientset,
+		Dynamic:   dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), apiCr, ingress),
+		Mapper:    mapper,
+	}
+}
+
+func TestGetAPIs(t *testing.T) {
+	apis, err := GetAPIs(newFakeK8sClient(), "wso2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apis) != 1 {
+		t.Fatalf("got %d APIs, want 1", len(apis))
+	}
+
+	api := apis[0]
+	expected := map[string]string{
+		"Name":     "petstore",
+		"Status":   APIStatusNotReady,
+		"Ready":    "1/2",
+		"Replicas": "2",
+		"Image":    "wso2/petstore:v1",
+		"URL":      "http://petstore.example.com",
+		"Warnings": "1 (BackOff)",
+	}
+	actual := map[string]string{
+		"Name":     api.Name(),
+		"Status":   api.Status(),
+		"Ready":    api.Ready(),
+		"Replicas": api.Replicas(),
+		"Image":    api.Image(),
+		"URL":      api.URL(),
+		"Warnings": api.Warnings(),
+	}
+	for key, value := range expected {
+		if actual[key] != value {
+			t.Errorf("got %s %q, want %q", key, actual[key], value)
+		}
+	}
+}
+
+func TestGetAPIDetails(t *testing.T) {
+	api, err := GetAPIDetails(newFakeK8sClient(), "wso2", "petstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.Swagger() != "openapi: 3.0.0" {
+		t.Errorf("got swagger %q, want the contents of the swagger configmap", api.Swagger())
+	}
+	// params configmap is not found
+	if api.Params() != notAvailable {
+		t.Errorf("got params %q, want %q", api.Params(), notAvailable)
+	}
+	if events := api.RecentEvents(); len(events) != 1 || !strings.Contains(events[0], "Pod/petstore-abc") {
+		t.Errorf("got events %v, want the warning event of the pod of the API", events)
+	}
+}
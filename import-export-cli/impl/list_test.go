@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -75,7 +76,7 @@ func TestGetAPIListOK(t *testing.T) {
 	}))
 	defer server.Close()
 
-	count, apiList, err := GetAPIList("access_token", server.URL, "", "")
+	count, apiList, err := GetAPIList(context.Background(), "access_token", server.URL, "", "")
 	fmt.Println("Count:", count)
 	fmt.Println("List:", apiList)
 
@@ -100,7 +101,7 @@ func TestGetAPIListUnreachable(t *testing.T) {
 	}))
 	defer server.Close()
 
-	count, list, err := GetAPIList("access_token", server.URL, "", "")
+	count, list, err := GetAPIList(context.Background(), "access_token", server.URL, "", "")
 	if count != 0 {
 		t.Errorf("Incorrect Count. Expected %d, got %d\n", 0, count)
 	}
@@ -151,7 +152,7 @@ func TestGetApplicationListOK(t *testing.T) {
 	}))
 	defer server.Close()
 
-	count, appList, err := GetApplicationList("access_token", server.URL, "admin","")
+	count, appList, err := GetApplicationList(context.Background(), "access_token", server.URL, "admin","")
 	fmt.Println("Count:", count)
 	fmt.Println("List:", appList)
 
@@ -173,7 +174,7 @@ func TestGetApplicationListUnreachable(t *testing.T) {
 	}))
 	defer server.Close()
 
-	count, list, err := GetApplicationList("access_token", server.URL, "admin","")
+	count, list, err := GetApplicationList(context.Background(), "access_token", server.URL, "admin","")
 	if count != 0 {
 		t.Errorf("Incorrect Count. Expected %d, got %d\n", 0, count)
 	}
@@ -232,7 +233,7 @@ func TestGetAPIProductListOK(t *testing.T) {
 	}))
 	defer server.Close()
 
-	count, apiList, err := GetAPIProductList("access_token",server.URL,"", " ")
+	count, apiList, err := GetAPIProductList(context.Background(), "access_token",server.URL,"", " ")
 	fmt.Println("Count:", count)
 	fmt.Println("List:", apiList)
 
@@ -257,7 +258,7 @@ func TestGetAPIProductListUnreachable(t *testing.T) {
 	}))
 	defer server.Close()
 
-	count, list, err := GetAPIProductList("access_token",server.URL,"", " ")
+	count, list, err := GetAPIProductList(context.Background(), "access_token",server.URL,"", " ")
 	if count != 0 {
 		t.Errorf("Incorrect Count. Expected %d, got %d\n", 0, count)
 	}
@@ -19,8 +19,8 @@
 package impl
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -86,10 +86,10 @@ func GetPerAPILoggingListFromEnv(credential credentials.Credential, environment,
 	}
 	apiListEndpoint := utils.GetAPILoggingListEndpointOfEnv(environment, tenantDomain, utils.MainConfigFilePath)
 	utils.Logln(utils.LogPrefixInfo+"URL:", apiListEndpoint)
-	resp, err := utils.InvokeGETRequest(apiListEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(context.Background(), apiListEndpoint, headers)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+apiListEndpoint, err)
+		return nil, fmt.Errorf("unable to connect to %s: %w", apiListEndpoint, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -99,22 +99,19 @@ func GetPerAPILoggingListFromEnv(credential credentials.Credential, environment,
 		unmarshalError := json.Unmarshal([]byte(resp.Body()), &apiLoggerListResponse)
 
 		if unmarshalError != nil {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+			return nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 		}
 
 		return apiLoggerListResponse.Apis, nil
 	} else {
-		return nil, errors.New(string(resp.Body()))
+		return nil, utils.NewResponseError(resp, "")
 	}
 }
 
 // PrintAPILoggers
 func PrintAPILoggers(apis []utils.APILogger, format string) {
-	if format == "" {
-		format = defaultLoggingApiTableFormat
-	}
 	// Create API context with standard output
-	apiContext := formatter.NewContext(os.Stdout, format)
+	apiContext := formatter.NewListContext(os.Stdout, format, defaultLoggingApiTableFormat)
 
 	// Create a new renderer function which iterate collection
 	renderer := func(w io.Writer, t *template.Template) error {
@@ -136,7 +133,7 @@ func PrintAPILoggers(apis []utils.APILogger, format string) {
 
 	// Execute context
 	if err := apiContext.Write(renderer, apiLoggerTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
 
@@ -152,10 +149,10 @@ func GetPerAPILoggingDetailsFromEnv(credential credentials.Credential, environme
 	}
 	apiDetailsEndpoint := utils.GetAPILoggingDetailsEndpointOfEnv(environment, apiId, tenantDomain, utils.MainConfigFilePath)
 	utils.Logln(utils.LogPrefixInfo+"URL:", apiDetailsEndpoint)
-	resp, err := utils.InvokeGETRequest(apiDetailsEndpoint, headers)
+	resp, err := utils.InvokeGETRequest(context.Background(), apiDetailsEndpoint, headers)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+apiDetailsEndpoint, err)
+		return nil, fmt.Errorf("unable to connect to %s: %w", apiDetailsEndpoint, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -165,12 +162,12 @@ func GetPerAPILoggingDetailsFromEnv(credential credentials.Credential, environme
 		unmarshalError := json.Unmarshal([]byte(resp.Body()), &apiLoggerListResponse)
 
 		if unmarshalError != nil {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+			return nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 		}
 
 		return apiLoggerListResponse.Apis, nil
 	} else {
-		return nil, errors.New(string(resp.Body()))
+		return nil, utils.NewResponseError(resp, "")
 	}
 }
 
@@ -189,10 +186,10 @@ func SetAPILoggingLevel(credential credentials.Credential, environment, apiId, t
 	apiSetEndpoint := utils.GetAPILoggingSetEndpointOfEnv(environment, apiId, tenantDomain, utils.MainConfigFilePath)
 	utils.Logln(utils.LogPrefixInfo+"URL:", apiSetEndpoint)
 	body := `{"logLevel":"` + logLevel + `"}`
-	resp, err := utils.InvokePutRequest(nil, apiSetEndpoint, headers, body)
+	resp, err := utils.InvokePutRequest(context.Background(), nil, apiSetEndpoint, headers, body)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+apiSetEndpoint, err)
+		return nil, fmt.Errorf("unable to connect to %s: %w", apiSetEndpoint, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -201,6 +198,6 @@ func SetAPILoggingLevel(credential credentials.Credential, environment, apiId, t
 		// 200 OK or 201 Created
 		return resp, nil
 	} else {
-		return nil, errors.New(string(resp.Body()))
+		return nil, utils.NewResponseError(resp, "")
 	}
 }
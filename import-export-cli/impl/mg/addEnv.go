@@ -21,6 +21,7 @@ package mg
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -38,7 +39,7 @@ func AddEnv(envName string, mgwEndpoints *utils.MgwEndpoints) error {
 		return errors.New("MgwAdapter Environment '" + envName + "' already exists in " + mainConfigFilePath)
 	}
 
-	mainConfig := utils.GetMainConfigFromFile(mainConfigFilePath)
+	mainConfig := utils.ReadMainConfigFile(mainConfigFilePath)
 
 	var validatedMgwEndpoints = utils.MgwEndpoints{}
 	if mgwEndpoints.AdapterEndpoint == "" {
@@ -47,6 +48,14 @@ func AddEnv(envName string, mgwEndpoints *utils.MgwEndpoints) error {
 		validatedMgwEndpoints.AdapterEndpoint = mgwEndpoints.AdapterEndpoint
 	}
 
+	// TLS and proxy settings
+	validatedMgwEndpoints.Connection = mgwEndpoints.Connection
+	err := utils.PrepareConnectionConfig(&validatedMgwEndpoints.Connection,
+		filepath.Join(utils.MgwClientCertDirPath, envName))
+	if err != nil {
+		return err
+	}
+
 	mainConfig.MgwAdapterEnvs[envName] = validatedMgwEndpoints
 	utils.WriteConfigFile(mainConfig, mainConfigFilePath)
 
@@ -19,75 +19,120 @@
 package mg
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"strconv"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
-//DeployAPI creats or updates an API in the microgateway depending on the override param
-func DeployAPI(env, filePath string, extraParams map[string]string,
-	importAPISkipCleanup bool, override bool) {
-	//TODO: (VirajSalaka) support substituting parameters with params file. At the moment it is in hold on state, as the decision to use environments is
-	//not finalized yet.
+//DeployAPI creats or updates an API in the microgateway depending on the override param. If apiParamsPath is
+//provided, the configurations defined for the microgateway environment in the params file are applied to the API
+func DeployAPI(ctx context.Context, env, filePath, apiParamsPath string, extraParams map[string]string,
+	importAPISkipCleanup bool, override bool) error {
+	utils.Logln(utils.LogPrefixInfo + "Creating workspace")
+	tmpPath, err := utils.GetTempCloneFromDirOrZip(filePath)
+	if err != nil {
+		return fmt.Errorf("error adding API to microgateway: %w", err)
+	}
+	defer func() {
+		if importAPISkipCleanup {
+			utils.Logln(utils.LogPrefixInfo+"Leaving", tmpPath)
+			return
+		}
+		utils.Logln(utils.LogPrefixInfo+"Deleting", tmpPath)
+		err := os.RemoveAll(tmpPath)
+		if err != nil {
+			utils.Logln(utils.LogPrefixError + err.Error())
+		}
+	}()
+
+	// only substitute the environment variables here, since the params are applied to the project itself
+	err = impl.PrepareAPIProjectForEnv(tmpPath, "", env)
+	if err != nil {
+		return fmt.Errorf("error adding API to microgateway: %w", err)
+	}
+	if apiParamsPath != "" {
+		err = applyEnvParams(tmpPath, apiParamsPath, env)
+		if err != nil {
+			return fmt.Errorf("error applying params of environment %s to the API: %w", env, err)
+		}
+	}
+
 	// if apiFilePath contains a directory, zip it. Otherwise, leave it as it is.
-	filePath, err, cleanupFunc := utils.CreateZipFileFromProject(filePath, importAPISkipCleanup)
+	filePath, err, cleanupFunc := utils.CreateZipFileFromProject(tmpPath, importAPISkipCleanup)
 	if err != nil {
-		utils.HandleErrorAndExit("Error adding API to microgateway", err)
+		return fmt.Errorf("error adding API to microgateway: %w", err)
 	}
 	//cleanup the temporary artifacts once consuming the zip file
 	if cleanupFunc != nil {
 		defer cleanupFunc()
 	}
-	mgwAdapterInfo, err := GetMgwAdapterInfo(env)
-	if err != nil {
-		utils.HandleErrorAndExit("Error retriving stored url and access token to microgateway", err)
+	resp, err := invokeWithReLogin(ctx, env, func(mgwAdapterInfo MgwAdapterInfo) (*resty.Response, error) {
+		endpoint := mgwAdapterInfo.Endpoint + apisResourcePath
+		if override {
+			endpoint += "?override=" + strconv.FormatBool(true)
+		}
+		return utils.InvokePOSTRequestWithFileAndQueryParams(ctx, extraParams, endpoint,
+			getDeployHeaders(mgwAdapterInfo.AccessToken), "file", filePath)
+	})
+	if override {
+		return getUpdateAPIError(resp, err)
 	}
-	endpoint := mgwAdapterInfo.Endpoint + apisResourcePath
+	return getAddAPIError(resp, err)
+}
 
+// getDeployHeaders returns the headers of a request deploying an API with the access token
+func getDeployHeaders(accessToken string) map[string]string {
 	headers := make(map[string]string)
-	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + mgwAdapterInfo.AccessToken
+	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 	headers[utils.HeaderAccept] = "application/json"
 	headers[utils.HeaderConnection] = utils.HeaderValueKeepAlive
-
-	if override {
-		UpdateAPI(endpoint, extraParams, headers, "file", filePath)
-	} else {
-		AddAPI(endpoint, extraParams, headers, "file", filePath)
-	}
+	return headers
 }
 
 //AddAPI creats an API in the microgateway
 func AddAPI(endpoint string, extraParams, headers map[string]string,
-	fileParamName string, filePath string) {
-	resp, err := utils.InvokePOSTRequestWithFileAndQueryParams(extraParams, endpoint, headers,
+	fileParamName string, filePath string) error {
+	resp, err := utils.InvokePOSTRequestWithFileAndQueryParams(context.Background(), extraParams, endpoint, headers,
 		"file", filePath)
+	return getAddAPIError(resp, err)
+}
+
+// getAddAPIError returns the error of a request creating an API, or nil if the API was created
+func getAddAPIError(resp *resty.Response, err error) error {
 	if err != nil {
-		utils.HandleErrorAndExit("Error deploying API.", err)
+		return fmt.Errorf("error deploying API: %w", err)
 	}
 	if resp.StatusCode() == http.StatusOK {
-		fmt.Println("Successfully deployed API to microgateway.")
+		return nil
 	} else if resp.StatusCode() == http.StatusConflict {
-		fmt.Println("Unable to deploy API. API already exists. Status: " + resp.Status())
-	} else {
-		fmt.Println("Unable to deploy API. Error Status: " + resp.Status())
+		return utils.NewResponseError(resp, "Unable to deploy API. API already exists. Status: "+resp.Status())
 	}
+	return utils.NewResponseError(resp, "Unable to deploy API. Error Status: "+resp.Status())
 }
 
 //UpdateAPI updates an API in the microgateway
 func UpdateAPI(endpoint string, extraParams, headers map[string]string,
-	fileParamName string, filePath string) {
+	fileParamName string, filePath string) error {
 
 	endpoint += "?override=" + strconv.FormatBool(true)
-	resp, err := utils.InvokePOSTRequestWithFileAndQueryParams(extraParams, endpoint, headers,
+	resp, err := utils.InvokePOSTRequestWithFileAndQueryParams(context.Background(), extraParams, endpoint, headers,
 		"file", filePath)
+	return getUpdateAPIError(resp, err)
+}
+
+// getUpdateAPIError returns the error of a request updating an API, or nil if the API was updated
+func getUpdateAPIError(resp *resty.Response, err error) error {
 	if err != nil {
-		utils.HandleErrorAndExit("Error updating API.", err)
+		return fmt.Errorf("error updating API: %w", err)
 	}
 	if resp.StatusCode() == http.StatusOK {
-		fmt.Println("Successfully deployed/updated the API in microgateway.")
-	} else {
-		fmt.Println("Unable to update API. Error Status: " + resp.Status())
+		return nil
 	}
+	return utils.NewResponseError(resp, "Unable to update API. Error Status: "+resp.Status())
 }
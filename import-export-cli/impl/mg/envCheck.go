@@ -0,0 +1,53 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// CheckAdapterEndpoint probes the REST API of the adapter of a Microgateway cluster
+// @param env : Name of the Microgateway cluster
+// @return impl.EndpointCheck : result of the endpoint
+func CheckAdapterEndpoint(env string) impl.EndpointCheck {
+	mgwAdapterEndpoints, err := utils.GetEndpointsOfMgwAdapterEnv(env, utils.MainConfigFilePath)
+	if err != nil {
+		return impl.EndpointCheck{Component: "MG Adapter", Status: impl.EndpointCheckFail, Message: err.Error()}
+	}
+	url := strings.TrimSuffix(mgwAdapterEndpoints.AdapterEndpoint, "/") + apisResourcePath
+	resp, elapsed, err := impl.ProbeEndpoint(http.MethodGet, url)
+	check := impl.NewEndpointCheck("MG Adapter", mgwAdapterEndpoints.AdapterEndpoint, resp, elapsed, err)
+	if !strings.HasSuffix(strings.TrimSuffix(mgwAdapterEndpoints.AdapterEndpoint, "/"),
+		DefaultMgwAdapterEndpointSuffix) {
+		if check.Status == impl.EndpointCheckPass {
+			check.Status = impl.EndpointCheckWarn
+		}
+		check.Message = "the adapter endpoint does not end with " + DefaultMgwAdapterEndpointSuffix +
+			" used by " + utils.ProjectName
+		return check
+	}
+	if check.Status == impl.EndpointCheckPass {
+		check.Version = DefaultMgwAdapterEndpointSuffix[strings.LastIndex(DefaultMgwAdapterEndpointSuffix, "/")+1:]
+	}
+	return check
+}
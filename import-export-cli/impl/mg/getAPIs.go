@@ -19,14 +19,14 @@
 package mg
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
-	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"text/template"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -95,18 +95,16 @@ func (a *APIMetaListItem) MarshalJSON() ([]byte, error) {
 }
 
 // GetAPIsList sends GET request and returns the metadata of APIs
-func GetAPIsList(env string, queryParam map[string]string) (
+func GetAPIsList(ctx context.Context, env string, queryParam map[string]string) (
 	total int, count int, apis []APIMetaListItem, err error) {
 
-	mgwAdapterInfo, err := GetMgwAdapterInfo(env)
-	if err != nil {
-		return 0, 0, nil, err
-	}
-	apiListEndpoint := mgwAdapterInfo.Endpoint + apisResourcePath
+	resp, err := invokeWithReLogin(ctx, env, func(mgwAdapterInfo MgwAdapterInfo) (*resty.Response, error) {
+		apiListEndpoint := mgwAdapterInfo.Endpoint + apisResourcePath
 
-	headers := make(map[string]string)
-	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + mgwAdapterInfo.AccessToken
-	resp, err := utils.InvokeGETRequestWithMultipleQueryParams(queryParam, apiListEndpoint, headers)
+		headers := make(map[string]string)
+		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + mgwAdapterInfo.AccessToken
+		return utils.InvokeGETRequestWithMultipleQueryParams(ctx, queryParam, apiListEndpoint, headers)
+	})
 
 	if err != nil {
 		return 0, 0, nil, err
@@ -119,13 +117,13 @@ func GetAPIsList(env string, queryParam map[string]string) (
 		}
 		return apiMetaResponse.Total, apiMetaResponse.Count, apiMetaResponse.List, nil
 	}
-	return 0, 0, nil, errors.New(string(resp.Body()))
+	return 0, 0, nil, utils.NewResponseError(resp, "")
 }
 
-// PrintAPIs will print an array of APIs as a table
-func PrintAPIs(apis []APIMetaListItem) {
+// PrintAPIs will print an array of APIs in the format, as a table by default
+func PrintAPIs(apis []APIMetaListItem, format string) {
 	// create api context with standard output
-	apiContext := formatter.NewContext(os.Stdout, defaultAPITableFormat)
+	apiContext := formatter.NewListContext(os.Stdout, format, defaultAPITableFormat)
 
 	// create a new renderer function which iterate collection
 	renderer := func(w io.Writer, t *template.Template) error {
@@ -150,6 +148,6 @@ func PrintAPIs(apis []APIMetaListItem) {
 
 	// execute context
 	if err := apiContext.Write(renderer, apiTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -20,6 +20,8 @@ package mg
 
 import (
 	"bufio"
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -41,7 +43,7 @@ type MgwAdapterInfo struct {
 func RunLogin(environment, loginUsername, loginPassword string, loginPasswordStdin bool) error {
 	store, err := credentials.GetDefaultCredentialStore()
 	if err != nil {
-		utils.HandleErrorAndExit("Error occurred while loading credential store : ", err)
+		return fmt.Errorf("error occurred while loading credential store: %w", err)
 	}
 	mgwAdapterEndpoints, err := utils.GetEndpointsOfMgwAdapterEnv(environment, utils.MainConfigFilePath)
 	if err != nil {
@@ -82,21 +84,50 @@ func RunLogin(environment, loginUsername, loginPassword string, loginPasswordStd
 		return errors.New("username or password not entered")
 	}
 
-	tokenEndpoint := deriveTokenEndpointForMGAdapter(mgwAdapterEndpoints.AdapterEndpoint)
-	accessToken, err := getAccessTokenFromMGAdapter(loginUsername, loginPassword, tokenEndpoint)
-	if err != nil {
-		utils.HandleErrorAndExit("Error getting access token from adapter endpoint: "+
-			tokenEndpoint, err)
-	}
-
-	if err = store.SetMGToken(environment, accessToken); err != nil {
+	if err = loginToMgwAdapter(context.Background(), store, environment, mgwAdapterEndpoints.AdapterEndpoint,
+		loginUsername, loginPassword, true); err != nil {
 		return err
 	}
 	fmt.Println("Successfully logged into Microgateway Adapter in environment: ", environment)
 	return nil
 }
 
-func getAccessTokenFromMGAdapter(username, password, tokenEndpoint string) (string, error) {
+// loginToMgwAdapter obtains an access token from the adapter and stores it along with its expiry. If storeCredentials
+// is set, the credentials used are stored as well, so that the user can be logged in again once the token expires
+func loginToMgwAdapter(ctx context.Context, store credentials.Store, environment, adapterEndpoint, username,
+	password string, storeCredentials bool) error {
+	tokenEndpoint := deriveTokenEndpointForMGAdapter(adapterEndpoint)
+	accessToken, err := getAccessTokenFromMGAdapter(ctx, username, password, tokenEndpoint)
+	if err != nil {
+		return errors.New("Error getting access token from adapter endpoint: " + tokenEndpoint + ". " + err.Error())
+	}
+	if !storeCredentials {
+		username, password = "", ""
+	}
+	return store.SetMGCredentials(environment, username, password, accessToken, getTokenExpiry(accessToken))
+}
+
+// getTokenExpiry returns the expiry time (exp claim) of a JWT access token in unix seconds. Zero is returned if the
+// token is not a JWT or does not have an expiry
+func getTokenExpiry(accessToken string) int64 {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return 0
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return 0
+	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+	}{}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return 0
+	}
+	return claims.Exp
+}
+
+func getAccessTokenFromMGAdapter(ctx context.Context, username, password, tokenEndpoint string) (string, error) {
 	body := make(map[string]string)
 	body["username"] = username
 	body["password"] = password
@@ -104,7 +135,7 @@ func getAccessTokenFromMGAdapter(username, password, tokenEndpoint string) (stri
 	headers := make(map[string]string)
 	headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
 
-	resp, err := utils.InvokePOSTRequest(tokenEndpoint, headers, body)
+	resp, err := utils.InvokePOSTRequest(ctx, tokenEndpoint, headers, body)
 	if err != nil {
 		return "", errors.New("Unable to connect to Microgateway Token endpoint. " + err.Error())
 	}
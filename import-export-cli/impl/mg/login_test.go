@@ -0,0 +1,53 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTokenExpiry(t *testing.T) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	jwt := func(payload string) string {
+		return header + "." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+	}
+	tests := []struct {
+		name  string
+		token string
+		want  int64
+	}{
+		{"JWT with exp", jwt(`{"sub":"admin","exp":1893456000}`), 1893456000},
+		{"JWT with padded payload", header + "." + base64.URLEncoding.EncodeToString([]byte(`{"exp":1}`)) + ".sig", 1},
+		{"JWT without exp", jwt(`{"sub":"admin"}`), 0},
+		{"JWT with a non numeric exp", jwt(`{"exp":"tomorrow"}`), 0},
+		{"payload which is not JSON", jwt(`not json`), 0},
+		{"payload which is not base64", header + ".%%%.signature", 0},
+		{"opaque token", "2c6b8e6a-1d5c-3b7f-9d0e-4f1a2b3c4d5e", 0},
+		{"token with two parts", header + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"exp":1}`)), 0},
+		{"empty token", "", 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, getTokenExpiry(test.token))
+		})
+	}
+}
@@ -19,9 +19,12 @@
 package mg
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"strings"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -39,23 +42,63 @@ func deriveTokenEndpointForMGAdapter(mgAdapterEndpoint string) string {
 	}
 }
 
-func GetMgwAdapterInfo(env string) (mgwAdapterInfo MgwAdapterInfo, err error) {
+// GetMgwAdapterInfo returns the adapter endpoint and the access token of the environment, logging in again if the
+// token has expired
+func GetMgwAdapterInfo(ctx context.Context, env string) (mgwAdapterInfo MgwAdapterInfo, err error) {
+	return getMgwAdapterInfo(ctx, env, false)
+}
+
+// getMgwAdapterInfo returns the adapter endpoint and the access token of the environment. The user is logged in again
+// if the token has expired or if forceReLogin is set
+func getMgwAdapterInfo(ctx context.Context, env string, forceReLogin bool) (mgwAdapterInfo MgwAdapterInfo, err error) {
 	store, err := credentials.GetDefaultCredentialStore()
 	if err != nil {
 		return mgwAdapterInfo, err
 	}
 	mgToken, err := store.GetMGToken(env)
-	if err != nil || mgToken.AccessToken == "" {
-		err = errors.New("Error loading access token. " + err.Error())
-		return mgwAdapterInfo, err
+	if err != nil {
+		return mgwAdapterInfo, errors.New("Error loading access token. " + err.Error())
+	}
+	if mgToken.AccessToken == "" {
+		return mgwAdapterInfo, errors.New("Error loading access token. Login to the environment " + env)
 	}
 	mgwAdapterEndpoints, err := utils.GetEndpointsOfMgwAdapterEnv(env, utils.MainConfigFilePath)
-	if err != nil || mgwAdapterEndpoints.AdapterEndpoint == "" {
-		err = errors.New("Error loading Adapter endpoint. " + err.Error())
-		return mgwAdapterInfo, err
+	if err != nil {
+		return mgwAdapterInfo, errors.New("Error loading Adapter endpoint. " + err.Error())
+	}
+	if mgwAdapterEndpoints.AdapterEndpoint == "" {
+		return mgwAdapterInfo, errors.New("Error loading Adapter endpoint. Adapter endpoint of " + env +
+			" is not set")
+	}
+	if forceReLogin || isTokenExpired(mgToken) {
+		mgToken, err = reLogin(ctx, store, env, mgwAdapterEndpoints.AdapterEndpoint, mgToken)
+		if err != nil {
+			return mgwAdapterInfo, err
+		}
 	}
 
 	mgwAdapterInfo.Endpoint = mgwAdapterEndpoints.AdapterEndpoint
 	mgwAdapterInfo.AccessToken = mgToken.AccessToken
 	return mgwAdapterInfo, nil
 }
+
+// invokeWithReLogin invokes the request to the adapter of the environment with its access token. If the adapter
+// rejects the token, which happens when it has been revoked or its expiry is not known, the user is logged in again
+// and the request is retried once
+func invokeWithReLogin(ctx context.Context, env string,
+	invoke func(mgwAdapterInfo MgwAdapterInfo) (*resty.Response, error)) (*resty.Response, error) {
+	mgwAdapterInfo, err := GetMgwAdapterInfo(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := invoke(mgwAdapterInfo)
+	if err != nil || resp.StatusCode() != http.StatusUnauthorized {
+		return resp, err
+	}
+	utils.Logln(utils.LogPrefixInfo + "Access token of " + env + " was rejected by the adapter")
+	mgwAdapterInfo, err = getMgwAdapterInfo(ctx, env, true)
+	if err != nil {
+		return nil, err
+	}
+	return invoke(mgwAdapterInfo)
+}
@@ -0,0 +1,267 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const endpointCertificatesFileName = "endpoint_certificates.yaml"
+
+// mgParamsFile represents the parts of an api_params.yaml that the microgateway adapter understands
+type mgParamsFile struct {
+	Environments []struct {
+		Name    string          `yaml:"name"`
+		Configs mgEnvParameters `yaml:"configs"`
+	} `yaml:"environments"`
+}
+
+type mgEnvParameters struct {
+	Endpoints              *params.EndpointData        `yaml:"endpoints"`
+	Security               map[string]endpointSecurity `yaml:"security"`
+	Certs                  []endpointCertificateParams `yaml:"certs"`
+	DeploymentEnvironments []deploymentEnvironment     `yaml:"deploymentEnvironments"`
+}
+
+type endpointSecurity struct {
+	Enabled          bool              `yaml:"enabled" json:"enabled"`
+	Type             string            `yaml:"type" json:"type,omitempty"`
+	Username         string            `yaml:"username" json:"username,omitempty"`
+	Password         string            `yaml:"password" json:"password,omitempty"`
+	TokenURL         string            `yaml:"tokenUrl" json:"tokenUrl,omitempty"`
+	ClientID         string            `yaml:"clientId" json:"clientId,omitempty"`
+	ClientSecret     string            `yaml:"clientSecret" json:"clientSecret,omitempty"`
+	GrantType        string            `yaml:"grantType" json:"grantType,omitempty"`
+	CustomParameters map[string]string `yaml:"customParameters" json:"customParameters,omitempty"`
+}
+
+type endpointCertificateParams struct {
+	HostName string `yaml:"hostName"`
+	Alias    string `yaml:"alias"`
+	Path     string `yaml:"path"`
+}
+
+// endpointCertificatesFile represents the Endpoint-certificates/endpoint_certificates.yaml of an API project
+type endpointCertificatesFile struct {
+	Type    string                `yaml:"type"`
+	Version string                `yaml:"version"`
+	Data    []endpointCertificate `yaml:"data"`
+}
+
+type endpointCertificate struct {
+	Alias       string `yaml:"alias"`
+	Endpoint    string `yaml:"endpoint"`
+	Certificate string `yaml:"certificate"`
+}
+
+// applyEnvParams applies the endpoints, endpoint security, endpoint certificates and deployment environments defined
+// for the environment in the params file (or the params file of a deployment directory) directly to the API project,
+// since the microgateway adapter only accepts the project layout and does not process params files itself
+func applyEnvParams(projectPath, paramsPath, env string) error {
+	paramsFilePath, certsDir := paramsPath, filepath.Dir(paramsPath)
+	if info, err := os.Stat(paramsPath); err == nil && info.IsDir() {
+		paramsFilePath = filepath.Join(paramsPath, utils.ParamFile)
+		certsDir = filepath.Join(paramsPath, utils.DeploymentCertificatesDirectory)
+	}
+	utils.Logln(utils.LogPrefixInfo + "Loading params from " + paramsFilePath)
+	content, err := params.GetEnvSubstitutedFileContent(paramsFilePath)
+	if err != nil {
+		return err
+	}
+	paramsFile := &mgParamsFile{}
+	if err = yaml.Unmarshal([]byte(content), paramsFile); err != nil {
+		return fmt.Errorf("error reading %s: %w", paramsFilePath, err)
+	}
+	var envParams *mgEnvParameters
+	for i := range paramsFile.Environments {
+		if paramsFile.Environments[i].Name == env {
+			envParams = &paramsFile.Environments[i].Configs
+			break
+		}
+	}
+	if envParams == nil {
+		return errors.New("Environment '" + env + "' does not exist in " + paramsPath)
+	}
+
+	if err = applyEndpointParams(projectPath, envParams); err != nil {
+		return err
+	}
+	if err = addEndpointCertificates(projectPath, certsDir, envParams.Certs); err != nil {
+		return err
+	}
+	if len(envParams.DeploymentEnvironments) > 0 {
+		return writeDeploymentEnvironments(projectPath, envParams.DeploymentEnvironments)
+	}
+	return nil
+}
+
+// applyEndpointParams sets the endpoint URLs and endpoint security of the environment in the api.yaml (or api.json)
+// of the API project
+func applyEndpointParams(projectPath string, envParams *mgEnvParameters) error {
+	if envParams.Endpoints == nil && len(envParams.Security) == 0 {
+		return nil
+	}
+	apiFilePath := filepath.Join(projectPath, utils.APIDefinitionFileYaml)
+	isYaml := true
+	if !utils.IsFileExist(apiFilePath) {
+		apiFilePath = filepath.Join(projectPath, utils.APIDefinitionFileJson)
+		isYaml = false
+	}
+	content, err := ioutil.ReadFile(apiFilePath)
+	if err != nil {
+		return err
+	}
+	if isYaml {
+		if content, err = utils.YamlToJson(content); err != nil {
+			return err
+		}
+	}
+	apiDefinition := map[string]interface{}{}
+	if err = json.Unmarshal(content, &apiDefinition); err != nil {
+		return fmt.Errorf("error reading %s: %w", apiFilePath, err)
+	}
+	data, ok := apiDefinition["data"].(map[string]interface{})
+	if !ok {
+		return errors.New("invalid API definition in " + apiFilePath)
+	}
+	endpointConfig, _ := data["endpointConfig"].(map[string]interface{})
+	if endpointConfig == nil {
+		endpointConfig = map[string]interface{}{"endpoint_type": "http"}
+	}
+
+	if envParams.Endpoints != nil {
+		setEndpoint(endpointConfig, "production_endpoints", envParams.Endpoints.Production)
+		setEndpoint(endpointConfig, "sandbox_endpoints", envParams.Endpoints.Sandbox)
+	}
+	if len(envParams.Security) > 0 {
+		securityConfig, _ := endpointConfig["endpoint_security"].(map[string]interface{})
+		if securityConfig == nil {
+			securityConfig = map[string]interface{}{}
+		}
+		for keyType, security := range envParams.Security {
+			security.Type = strings.ToUpper(security.Type)
+			securityConfig[keyType] = security
+		}
+		endpointConfig["endpoint_security"] = securityConfig
+	}
+	data["endpointConfig"] = endpointConfig
+
+	if content, err = json.MarshalIndent(apiDefinition, "", "  "); err != nil {
+		return err
+	}
+	if isYaml {
+		if content, err = utils.JsonToYaml(content); err != nil {
+			return err
+		}
+	}
+	utils.Logln(utils.LogPrefixInfo + "Applying the endpoint params to " + apiFilePath)
+	return ioutil.WriteFile(apiFilePath, content, 0644)
+}
+
+// setEndpoint sets the URL and the config of the given endpoint, retaining any other details already defined for it
+func setEndpoint(endpointConfig map[string]interface{}, key string, endpointParams *params.Endpoint) {
+	if endpointParams == nil || endpointParams.Url == nil {
+		return
+	}
+	endpoint, _ := endpointConfig[key].(map[string]interface{})
+	if endpoint == nil {
+		endpoint = map[string]interface{}{}
+	}
+	endpoint["url"] = *endpointParams.Url
+	if endpointParams.Config != nil {
+		endpoint["config"] = endpointParams.Config
+	}
+	endpointConfig[key] = endpoint
+}
+
+// addEndpointCertificates copies the endpoint certificates of the environment into the Endpoint-certificates
+// directory of the API project and registers them in its endpoint_certificates.yaml. Relative certificate paths are
+// resolved against certsDir
+func addEndpointCertificates(projectPath, certsDir string, certs []endpointCertificateParams) error {
+	if len(certs) == 0 {
+		return nil
+	}
+	certsPath := filepath.Join(projectPath, utils.InitProjectEndpointCertificates)
+	if err := utils.CreateDirIfNotExist(certsPath); err != nil {
+		return err
+	}
+	certsFilePath := filepath.Join(certsPath, endpointCertificatesFileName)
+	certsFile := &endpointCertificatesFile{Type: "endpoint_certificates"}
+	if utils.IsFileExist(certsFilePath) {
+		content, err := ioutil.ReadFile(certsFilePath)
+		if err != nil {
+			return err
+		}
+		if err = yaml.Unmarshal(content, certsFile); err != nil {
+			return err
+		}
+	}
+
+	for _, cert := range certs {
+		if cert.HostName == "" || cert.Alias == "" || cert.Path == "" {
+			return errors.New("hostName, alias and path are required for the endpoint certificates in params")
+		}
+		certPath := cert.Path
+		if !filepath.IsAbs(certPath) {
+			certPath = filepath.Join(certsDir, certPath)
+		}
+		if !utils.IsFileExist(certPath) {
+			return errors.New("endpoint certificate " + certPath + " does not exist")
+		}
+		if err := utils.CopyFile(certPath, filepath.Join(certsPath, filepath.Base(certPath))); err != nil {
+			return err
+		}
+		certsFile.Data = append(certsFile.Data, endpointCertificate{
+			Alias:       cert.Alias,
+			Endpoint:    cert.HostName,
+			Certificate: filepath.Base(certPath),
+		})
+	}
+
+	content, err := yaml.Marshal(certsFile)
+	if err != nil {
+		return err
+	}
+	utils.Logln(utils.LogPrefixInfo + "Adding the endpoint certificates to " + certsFilePath)
+	return ioutil.WriteFile(certsFilePath, content, 0644)
+}
+
+// writeDeploymentEnvironments replaces the deployment environments of the API project with the ones of the environment
+func writeDeploymentEnvironments(projectPath string, deploymentEnvs []deploymentEnvironment) error {
+	content, err := yaml.Marshal(&deploymentEnvironmentsFile{
+		Type: "deployment_environments",
+		Data: deploymentEnvs,
+	})
+	if err != nil {
+		return err
+	}
+	deploymentEnvsFilePath := filepath.Join(projectPath, utils.DeploymentEnvFile)
+	utils.Logln(utils.LogPrefixInfo + "Applying the deployment environments in params to " + deploymentEnvsFilePath)
+	return ioutil.WriteFile(deploymentEnvsFilePath, content, 0644)
+}
@@ -0,0 +1,163 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const testParams = `environments:
+  - name: prod
+    configs:
+      endpoints:
+        production:
+          url: https://prod.example.com
+  - name: dev
+    configs:
+      endpoints:
+        production:
+          url: https://dev.example.com
+          config:
+            retryTimeOut: 3
+        sandbox:
+          url: https://dev-sandbox.example.com
+      security:
+        production:
+          enabled: true
+          type: basic
+          username: admin
+          password: secret
+      certs:
+        - hostName: https://dev.example.com
+          alias: dev
+          path: dev.crt
+      deploymentEnvironments:
+        - displayOnDevportal: true
+          deploymentEnvironment: Default
+          deploymentVhost: dev.example.com
+`
+
+func TestDeployAPIWithParams(t *testing.T) {
+	adapter := newStubAdapter()
+	server := httptest.NewServer(adapter)
+	defer server.Close()
+	dir := setUpMgEnv(t, server.URL+DefaultMgwAdapterEndpointSuffix, credentials.MgAdapterEnv{AccessToken: "token"})
+	projectPath := filepath.Join(dir, "A")
+	writeAPIProject(t, projectPath, "A", "1.0.0", "sample")
+
+	// a deployment directory holds the params file and the certificates
+	paramsPath := filepath.Join(dir, "DeploymentArtifacts_A")
+	if err := os.MkdirAll(filepath.Join(paramsPath, utils.DeploymentCertificatesDirectory), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(paramsPath, utils.ParamFile), []byte(testParams), 0644); err != nil {
+		t.Fatal(err)
+	}
+	certPath := filepath.Join(paramsPath, utils.DeploymentCertificatesDirectory, "dev.crt")
+	if err := ioutil.WriteFile(certPath, []byte("certificate"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := DeployAPI(context.Background(), testMgEnv, projectPath, paramsPath, map[string]string{}, false, false)
+	assert.Nil(t, err)
+	files := adapter.apis[apiKey("A", "1.0.0")]
+	if !assert.NotNil(t, files, "The API should be deployed") {
+		return
+	}
+	assert.NotContains(t, files, "intermediate_params.yaml", "The params should not be sent to the adapter")
+
+	var definition struct {
+		Data struct {
+			Description    string `yaml:"description"`
+			EndpointConfig struct {
+				ProductionEndpoints map[string]interface{}            `yaml:"production_endpoints"`
+				SandboxEndpoints    map[string]interface{}            `yaml:"sandbox_endpoints"`
+				EndpointSecurity    map[string]map[string]interface{} `yaml:"endpoint_security"`
+			} `yaml:"endpointConfig"`
+		} `yaml:"data"`
+	}
+	if err := yaml.Unmarshal(files[utils.APIDefinitionFileYaml], &definition); err != nil {
+		t.Fatal(err)
+	}
+	endpointConfig := definition.Data.EndpointConfig
+	assert.Equal(t, "sample", definition.Data.Description, "The rest of the API should be retained")
+	assert.Equal(t, "https://dev.example.com", endpointConfig.ProductionEndpoints["url"])
+	assert.Equal(t, map[interface{}]interface{}{"retryTimeOut": 3}, endpointConfig.ProductionEndpoints["config"])
+	assert.Equal(t, "https://dev-sandbox.example.com", endpointConfig.SandboxEndpoints["url"])
+	assert.Equal(t, map[string]interface{}{"enabled": true, "type": "BASIC", "username": "admin",
+		"password": "secret"}, endpointConfig.EndpointSecurity["production"])
+
+	assert.Equal(t, []byte("certificate"), files["dev.crt"])
+	certs := &endpointCertificatesFile{}
+	if err := yaml.Unmarshal(files[endpointCertificatesFileName], certs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []endpointCertificate{{Alias: "dev", Endpoint: "https://dev.example.com",
+		Certificate: "dev.crt"}}, certs.Data)
+
+	deploymentEnvs := &deploymentEnvironmentsFile{}
+	if err := yaml.Unmarshal(files[utils.DeploymentEnvFile], deploymentEnvs); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []deploymentEnvironment{{DisplayOnDevportal: true, DeploymentEnvironment: "Default",
+		DeploymentVhost: "dev.example.com"}}, deploymentEnvs.Data)
+
+	content, err := ioutil.ReadFile(filepath.Join(projectPath, utils.APIDefinitionFileYaml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, string(content), "dev.example.com", "The source project should not be modified")
+}
+
+func TestDeployAPIWithParamsFile(t *testing.T) {
+	adapter := newStubAdapter()
+	server := httptest.NewServer(adapter)
+	defer server.Close()
+	dir := setUpMgEnv(t, server.URL+DefaultMgwAdapterEndpointSuffix, credentials.MgAdapterEnv{AccessToken: "token"})
+	projectPath := filepath.Join(dir, "A")
+	writeAPIProject(t, projectPath, "A", "1.0.0", "sample")
+	paramsPath := filepath.Join(dir, "params.yaml")
+	if err := ioutil.WriteFile(paramsPath, []byte(testParams), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the certificate is resolved against the directory of the params file, where it does not exist
+	err := DeployAPI(context.Background(), testMgEnv, projectPath, paramsPath, map[string]string{}, false, false)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "endpoint certificate "+filepath.Join(dir, "dev.crt")+" does not exist")
+	}
+	assert.Empty(t, adapter.deployments)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "dev.crt"), []byte("certificate"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = DeployAPI(context.Background(), testMgEnv, projectPath, paramsPath, map[string]string{}, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("certificate"), adapter.apis[apiKey("A", "1.0.0")]["dev.crt"])
+}
@@ -0,0 +1,191 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultGatewayEnvironment = "Default"
+
+// deploymentEnvironmentsFile represents the deployment_environments.yaml of an API project
+type deploymentEnvironmentsFile struct {
+	Type    string                  `yaml:"type"`
+	Version string                  `yaml:"version"`
+	Data    []deploymentEnvironment `yaml:"data"`
+}
+
+type deploymentEnvironment struct {
+	DisplayOnDevportal    bool   `yaml:"displayOnDevportal"`
+	DeploymentEnvironment string `yaml:"deploymentEnvironment"`
+	DeploymentVhost       string `yaml:"deploymentVhost,omitempty"`
+}
+
+// ExportAPIProjectFromEnv exports an API from the API Manager environment and extracts it to a temporary directory.
+// If gatewayEnvs or vhost is provided, the deployment environments of the exported API are overridden with them.
+// Returns the path of the extracted API project and a function to clean it up once it is consumed
+func ExportAPIProjectFromEnv(accessToken, apimEnv, name, version, provider, revisionNum string, gatewayEnvs []string,
+	vhost string) (string, func(), error) {
+	resp, err := impl.ExportAPIFromEnv(context.Background(), accessToken, name, version, revisionNum, provider,
+		utils.DefaultExportFormat, apimEnv, true, false)
+	if err != nil {
+		return "", nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return "", nil, errors.New("Error exporting API " + name + ":" + version + " from " + apimEnv + ". Status: " +
+			resp.Status() + " " + string(resp.Body()))
+	}
+
+	tempZipFile, err := utils.WriteResponseToTempZip(name+"_"+version+".zip", resp)
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() {
+		_ = os.RemoveAll(filepath.Dir(tempZipFile))
+	}()
+
+	projectPath, err := utils.GetTempCloneFromDirOrZip(tempZipFile)
+	if err != nil {
+		return "", nil, err
+	}
+	cleanupFunc := func() {
+		utils.Logln(utils.LogPrefixInfo+"Deleting", projectPath)
+		_ = os.RemoveAll(projectPath)
+	}
+
+	if len(gatewayEnvs) > 0 || vhost != "" {
+		err = overrideDeploymentEnvironments(projectPath, gatewayEnvs, vhost)
+		if err != nil {
+			cleanupFunc()
+			return "", nil, err
+		}
+	}
+	return projectPath, cleanupFunc, nil
+}
+
+// PromoteAPIs exports the APIs matching the query from the API Manager environment and deploys them in the
+// microgateway environment using the params file in paramsPath, if provided. The exported API projects are kept
+// when skipCleanup is set. Returns the number of APIs matching the query and the number of APIs failed to be promoted
+func PromoteAPIs(accessToken, apimEnv, mgEnv, query string, gatewayEnvs []string, vhost, paramsPath string,
+	skipCleanup, override bool) (int, int, error) {
+	count, apis, err := impl.GetAPIListFromEnv(context.Background(), accessToken, apimEnv, query,
+		strconv.Itoa(utils.DefaultApisDisplayLimit))
+	if err != nil {
+		return 0, 0, err
+	}
+	if int(count) > len(apis) {
+		if _, apis, err = impl.GetAPIListFromEnv(context.Background(), accessToken, apimEnv, query,
+			strconv.Itoa(int(count))); err != nil {
+			return 0, 0, err
+		}
+	}
+	if len(apis) == 0 {
+		fmt.Println("No APIs found in " + apimEnv + " matching the query " + query)
+		return 0, 0, nil
+	}
+
+	// fail early if the user is not logged in to the microgateway adapter environment
+	if _, err := GetMgwAdapterInfo(context.Background(), mgEnv); err != nil {
+		return 0, 0, err
+	}
+
+	failedCount := 0
+	for _, api := range apis {
+		key := apiKey(api.Name, api.Version)
+		err := promoteAPI(accessToken, apimEnv, mgEnv, api, gatewayEnvs, vhost, paramsPath, skipCleanup,
+			override)
+		if err != nil {
+			failedCount++
+			fmt.Println(utils.LogPrefixError+"Deploying API "+key+" to microgateway failed:", err)
+			continue
+		}
+		fmt.Println("Successfully deployed API " + key + " to microgateway.")
+	}
+	fmt.Printf("\nAPIs deployed: %d, failed: %d\n", len(apis)-failedCount, failedCount)
+	return len(apis), failedCount, nil
+}
+
+func promoteAPI(accessToken, apimEnv, mgEnv string, api utils.API, gatewayEnvs []string, vhost, paramsPath string,
+	skipCleanup, override bool) error {
+	projectPath, cleanupFunc, err := ExportAPIProjectFromEnv(accessToken, apimEnv, api.Name, api.Version,
+		api.Provider, "", gatewayEnvs, vhost)
+	if err != nil {
+		return err
+	}
+	if !skipCleanup {
+		defer cleanupFunc()
+	}
+	return DeployAPI(context.Background(), mgEnv, projectPath, paramsPath, map[string]string{}, skipCleanup, override)
+}
+
+// overrideDeploymentEnvironments replaces the deployment environments of the API project with the given gateway
+// environments. If no gateway environment is given, the existing ones are retained and only the vhost is overridden
+func overrideDeploymentEnvironments(projectPath string, gatewayEnvs []string, vhost string) error {
+	deploymentEnvsFilePath := filepath.Join(projectPath, utils.DeploymentEnvFile)
+	deploymentEnvs := &deploymentEnvironmentsFile{
+		Type: "deployment_environments",
+	}
+	if utils.IsFileExist(deploymentEnvsFilePath) {
+		content, err := ioutil.ReadFile(deploymentEnvsFilePath)
+		if err != nil {
+			return err
+		}
+		if err = yaml.Unmarshal(content, deploymentEnvs); err != nil {
+			return err
+		}
+	}
+
+	if len(gatewayEnvs) > 0 {
+		deploymentEnvs.Data = nil
+		for _, gatewayEnv := range gatewayEnvs {
+			deploymentEnvs.Data = append(deploymentEnvs.Data, deploymentEnvironment{
+				DisplayOnDevportal:    true,
+				DeploymentEnvironment: gatewayEnv,
+			})
+		}
+	} else if len(deploymentEnvs.Data) == 0 {
+		deploymentEnvs.Data = []deploymentEnvironment{{
+			DisplayOnDevportal:    true,
+			DeploymentEnvironment: defaultGatewayEnvironment,
+		}}
+	}
+	if vhost != "" {
+		for i := range deploymentEnvs.Data {
+			deploymentEnvs.Data[i].DeploymentVhost = vhost
+		}
+	}
+
+	content, err := yaml.Marshal(deploymentEnvs)
+	if err != nil {
+		return err
+	}
+	utils.Logln(utils.LogPrefixInfo + "Overriding the deployment environments in " + deploymentEnvsFilePath)
+	return ioutil.WriteFile(deploymentEnvsFilePath, content, 0644)
+}
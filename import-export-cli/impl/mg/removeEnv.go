@@ -20,6 +20,10 @@ package mg
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
@@ -52,6 +56,13 @@ func RemoveEnv(envName, mainConfigFilePath string) error {
 		if err != nil {
 			return err
 		}
+		// remove client certificates converted from PKCS#12
+		_ = os.RemoveAll(filepath.Join(utils.MgwClientCertDirPath, envName))
+
+		if contexts := utils.RemoveMgwClusterFromContextsInMainConfigFile(envName, mainConfigFilePath); len(contexts) > 0 {
+			fmt.Println(utils.LogPrefixWarning + "Microgateway Adapter '" + envName +
+				"' is no longer used by the contexts: " + strings.Join(contexts, ", "))
+		}
 	} else {
 		// environment does not exist in mainConfig file (endpoints file). Nothing to remove
 		return errors.New("Microgateway Adapter '" + envName + "' not found in " + mainConfigFilePath)
@@ -0,0 +1,399 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/git"
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/specs/params"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Sync status of an API
+const (
+	SyncStatusAdded     = "ADDED"
+	SyncStatusUpdated   = "UPDATED"
+	SyncStatusUnchanged = "UNCHANGED"
+	SyncStatusRemoved   = "REMOVED"
+	SyncStatusOrphaned  = "NOT_IN_SOURCE"
+	SyncStatusFailed    = "FAILED"
+)
+
+const (
+	syncStateFileName = "mg_sync_state.yaml"
+
+	apiSyncStatusHeader = "STATUS"
+	apiSyncPathHeader   = "PATH"
+
+	defaultSyncResultTableFormat = "table {{.Name}}\t{{.Version}}\t{{.Status}}\t{{.Path}}"
+)
+
+var syncStateFilePath = filepath.Join(utils.ConfigDirPath, syncStateFileName)
+
+// SyncResultItem holds the sync status of a single API
+type SyncResultItem struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Status  string `json:"status"`
+	Path    string `json:"path"`
+	Error   error  `json:"-"`
+}
+
+// syncState holds the checksums of the API projects last synced to each microgateway environment
+type syncState struct {
+	Environments map[string]map[string]string `yaml:"environments"`
+}
+
+// apiProject is an API project found in the source directory
+type apiProject struct {
+	path     string
+	name     string
+	version  string
+	checksum string
+}
+
+func apiKey(name, version string) string {
+	return name + ":" + version
+}
+
+// SyncAPIs deploys every API project in sourceDir to the microgateway environment, applying the params file or
+// deployment directory in paramsPath to each of them if it is given. APIs deployed in the adapter which are absent in
+// the source are undeployed if undeployRemoved is true. If useVCS is true, the change detection of the git
+// repository containing sourceDir is used to decide which projects need to be updated
+func SyncAPIs(env, sourceDir, paramsPath string, undeployRemoved, useVCS bool) ([]SyncResultItem, error) {
+	sourceDir, err := filepath.Abs(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+	if sourceDir, err = filepath.EvalSymlinks(sourceDir); err != nil {
+		return nil, err
+	}
+	projects, err := findAPIProjects(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+	if paramsPath != "" {
+		// the APIs need to be deployed again when the params change even if the projects do not
+		paramsChecksum, err := getDirectoryChecksum(paramsPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, project := range projects {
+			project.checksum = combineChecksums(project.checksum, paramsChecksum)
+		}
+	}
+
+	deployedAPIs, err := getAllDeployedAPIs(env)
+	if err != nil {
+		return nil, err
+	}
+
+	state := loadSyncState()
+	envState := state.Environments[env]
+	if envState == nil {
+		envState = make(map[string]string)
+		state.Environments[env] = envState
+	}
+
+	var repoId string
+	var changedProjects map[string]*params.ProjectParams
+	if useVCS {
+		repoId, changedProjects, err = getChangedAPIProjectsFromVCS(env, sourceDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var results []SyncResultItem
+	failedProjects := make(map[string][]*params.ProjectParams)
+	sourceAPIs := make(map[string]bool)
+	for _, project := range projects {
+		key := apiKey(project.name, project.version)
+		sourceAPIs[key] = true
+		result := SyncResultItem{Name: project.name, Version: project.version, Path: project.path}
+
+		_, deployed := deployedAPIs[key]
+		if deployed {
+			changed := envState[key] != project.checksum
+			if useVCS {
+				_, changed = changedProjects[project.path]
+			}
+			if !changed {
+				result.Status = SyncStatusUnchanged
+				results = append(results, result)
+				continue
+			}
+			result.Status = SyncStatusUpdated
+		} else {
+			result.Status = SyncStatusAdded
+		}
+
+		utils.Logln(utils.LogPrefixInfo + "Deploying API " + key + " from " + project.path)
+		if err := DeployAPI(context.Background(), env, project.path, paramsPath, map[string]string{}, false,
+			deployed); err != nil {
+			result.Status = SyncStatusFailed
+			result.Error = err
+			delete(envState, key)
+			failedProject, ok := changedProjects[project.path]
+			if !ok {
+				failedProject = &params.ProjectParams{
+					Type:         utils.ProjectTypeApi,
+					AbsolutePath: project.path,
+					RelativePath: project.path,
+					NickName:     filepath.Base(project.path),
+				}
+			}
+			failedProjects[utils.ProjectTypeApi] = append(failedProjects[utils.ProjectTypeApi], failedProject)
+		} else {
+			envState[key] = project.checksum
+		}
+		results = append(results, result)
+	}
+
+	for key, api := range deployedAPIs {
+		if sourceAPIs[key] {
+			continue
+		}
+		result := SyncResultItem{Name: api.APIName, Version: api.APIVersion, Status: SyncStatusOrphaned}
+		if undeployRemoved {
+			utils.Logln(utils.LogPrefixInfo + "Undeploying API " + key)
+			queryParams := map[string]string{
+				"apiName": api.APIName,
+				"version": api.APIVersion,
+			}
+			if err := UndeployAPI(context.Background(), env, queryParams); err != nil {
+				result.Status = SyncStatusFailed
+				result.Error = err
+			} else {
+				result.Status = SyncStatusRemoved
+				delete(envState, key)
+			}
+		}
+		results = append(results, result)
+	}
+
+	utils.WriteConfigFile(state, syncStateFilePath)
+	if useVCS {
+		err = runInDirectory(sourceDir, func() error {
+			return git.UpdateVCSConfig(repoId, env, failedProjects)
+		})
+	}
+	return results, err
+}
+
+// PrintSyncResult prints the sync status of each API as a table
+func PrintSyncResult(results []SyncResultItem) {
+	sort.SliceStable(results, func(i, j int) bool {
+		return apiKey(results[i].Name, results[i].Version) < apiKey(results[j].Name, results[j].Version)
+	})
+	syncContext := formatter.NewContext(os.Stdout, defaultSyncResultTableFormat)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, result := range results {
+			if err := t.Execute(w, result); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	syncTableHeaders := map[string]string{
+		"Name":    apiNameHeader,
+		"Version": apiVersionHeader,
+		"Status":  apiSyncStatusHeader,
+		"Path":    apiSyncPathHeader,
+	}
+	if err := syncContext.Write(renderer, syncTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+
+	counts := make(map[string]int)
+	for _, result := range results {
+		counts[result.Status]++
+		if result.Error != nil {
+			fmt.Println(utils.LogPrefixError+"Syncing API "+apiKey(result.Name, result.Version)+" failed:", result.Error)
+		}
+	}
+	fmt.Printf("\nAdded: %d, Updated: %d, Unchanged: %d, Removed: %d, Not in source: %d, Failed: %d\n",
+		counts[SyncStatusAdded], counts[SyncStatusUpdated], counts[SyncStatusUnchanged], counts[SyncStatusRemoved],
+		counts[SyncStatusOrphaned], counts[SyncStatusFailed])
+}
+
+// findAPIProjects walks through sourceDir and returns the API projects in it. A directory containing an api.yaml
+// (or api.json) is considered as an API project and is not scanned further
+func findAPIProjects(sourceDir string) ([]*apiProject, error) {
+	var projects []*apiProject
+	seen := make(map[string]string)
+	err := filepath.Walk(sourceDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if !utils.IsFileExist(filepath.Join(path, utils.APIDefinitionFileYaml)) &&
+			!utils.IsFileExist(filepath.Join(path, "api.json")) {
+			return nil
+		}
+		api, _, err := impl.GetAPIDefinition(path)
+		if err != nil {
+			return errors.New("Error reading API definition of " + path + ": " + err.Error())
+		}
+		key := apiKey(api.Data.Name, api.Data.Version)
+		if existingPath, ok := seen[key]; ok {
+			return errors.New("API " + key + " is defined in both " + existingPath + " and " + path)
+		}
+		seen[key] = path
+		checksum, err := getDirectoryChecksum(path)
+		if err != nil {
+			return err
+		}
+		projects = append(projects, &apiProject{
+			path:     path,
+			name:     api.Data.Name,
+			version:  api.Data.Version,
+			checksum: checksum,
+		})
+		return filepath.SkipDir
+	})
+	return projects, err
+}
+
+// getDirectoryChecksum returns a checksum computed from the relative paths and the content of the files in dir. If
+// dir is a file, the checksum is computed from its content
+func getDirectoryChecksum(dir string) (string, error) {
+	hash := sha256.New()
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		_, _ = hash.Write([]byte(filepath.ToSlash(relPath)))
+		_, _ = hash.Write(content)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// combineChecksums returns a checksum computed from the given checksums
+func combineChecksums(checksums ...string) string {
+	hash := sha256.New()
+	for _, checksum := range checksums {
+		_, _ = hash.Write([]byte(checksum))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// getAllDeployedAPIs returns the APIs deployed in the adapter mapped by name and version
+func getAllDeployedAPIs(env string) (map[string]APIMetaListItem, error) {
+	queryParams := map[string]string{"limit": strconv.Itoa(utils.DefaultApisDisplayLimit)}
+	total, count, apis, err := GetAPIsList(context.Background(), env, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	if total > count {
+		queryParams["limit"] = strconv.Itoa(total)
+		if _, _, apis, err = GetAPIsList(context.Background(), env, queryParams); err != nil {
+			return nil, err
+		}
+	}
+	deployedAPIs := make(map[string]APIMetaListItem)
+	for _, api := range apis {
+		deployedAPIs[apiKey(api.APIName, api.APIVersion)] = api
+	}
+	return deployedAPIs, nil
+}
+
+// getChangedAPIProjectsFromVCS returns the id of the git repository containing sourceDir and the API projects
+// changed since the last attempted sync to the environment, mapped by their absolute paths
+func getChangedAPIProjectsFromVCS(env, sourceDir string) (string, map[string]*params.ProjectParams, error) {
+	var repoId string
+	var updatedProjectsPerType map[string][]*params.ProjectParams
+	err := runInDirectory(sourceDir, func() (err error) {
+		repoId, _, updatedProjectsPerType, err = git.GetStatus(env, git.FromRevTypeLastAttempted)
+		return err
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	changedProjects := make(map[string]*params.ProjectParams)
+	for _, project := range updatedProjectsPerType[utils.ProjectTypeApi] {
+		if !project.Deleted {
+			changedProjects[project.AbsolutePath] = project
+		}
+	}
+	return repoId, changedProjects, nil
+}
+
+// runInDirectory runs fn with dir as the working directory, as the git commands operate on the working directory
+func runInDirectory(dir string, fn func() error) error {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err = os.Chdir(dir); err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Chdir(currentDir)
+	}()
+	return fn()
+}
+
+// loadSyncState reads the sync state file. An empty state is returned if the file does not exist
+func loadSyncState() *syncState {
+	state := &syncState{}
+	data, err := ioutil.ReadFile(syncStateFilePath)
+	if err == nil {
+		if err := yaml.Unmarshal(data, state); err != nil {
+			utils.Logln(utils.LogPrefixWarning+"Ignoring the invalid sync state file "+syncStateFilePath, err)
+		}
+	}
+	if state.Environments == nil {
+		state.Environments = make(map[string]map[string]string)
+	}
+	return state
+}
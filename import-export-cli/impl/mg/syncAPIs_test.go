@@ -0,0 +1,433 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+const testMgEnv = "dev"
+
+// stubAdapter is a microgateway adapter keeping the deployed APIs in memory
+type stubAdapter struct {
+	mutex sync.Mutex
+	apis  map[string]map[string][]byte
+	// deployments holds the name, version and override param of each deployment request
+	deployments []string
+	undeployed  []string
+}
+
+func newStubAdapter() *stubAdapter {
+	return &stubAdapter{apis: make(map[string]map[string][]byte)}
+}
+
+func (a *stubAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if r.URL.Path != DefaultMgwAdapterEndpointSuffix+apisResourcePath {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		// APIMetaListItem marshals its methods, hence the adapter response is built with the field names
+		var list []map[string]string
+		for key := range a.apis {
+			parts := strings.SplitN(key, ":", 2)
+			list = append(list, map[string]string{"apiName": parts[0], "version": parts[1]})
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i]["apiName"] < list[j]["apiName"] })
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"total": len(list), "count": len(list), "list": list})
+	case http.MethodPost:
+		files, err := readUploadedProject(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var definition struct {
+			Data struct {
+				Name    string `yaml:"name"`
+				Version string `yaml:"version"`
+			} `yaml:"data"`
+		}
+		if err := yaml.Unmarshal(files[utils.APIDefinitionFileYaml], &definition); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		key := apiKey(definition.Data.Name, definition.Data.Version)
+		override := r.URL.Query().Get("override")
+		if _, exists := a.apis[key]; exists && override != "true" {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		a.apis[key] = files
+		a.deployments = append(a.deployments, key+" override="+override)
+	case http.MethodDelete:
+		key := apiKey(r.URL.Query().Get("apiName"), r.URL.Query().Get("version"))
+		if _, exists := a.apis[key]; !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(a.apis, key)
+		a.undeployed = append(a.undeployed, key)
+	}
+}
+
+// readUploadedProject reads the files of the zipped project uploaded as the multipart 'file' parameter, keyed by
+// their base names
+func readUploadedProject(r *http.Request) (map[string][]byte, error) {
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+	for _, entry := range reader.File {
+		content, err := entry.Open()
+		if err != nil {
+			return nil, err
+		}
+		files[path.Base(entry.Name)], err = ioutil.ReadAll(content)
+		content.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// setUpMgEnv points the main config, the credential store and the sync state of the package to a temporary
+// directory having the microgateway environment 'dev' with the adapter endpoint, logged in with the token
+func setUpMgEnv(t *testing.T, adapterEndpoint string, token credentials.MgAdapterEnv) string {
+	dir, err := ioutil.TempDir("", "mg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainConfigFilePath, credentialsDirPath, stateFilePath :=
+		utils.MainConfigFilePath, utils.LocalCredentialsDirectoryPath, syncStateFilePath
+	utils.MainConfigFilePath = filepath.Join(dir, utils.MainConfigFileName)
+	utils.LocalCredentialsDirectoryPath = dir
+	syncStateFilePath = filepath.Join(dir, syncStateFileName)
+	utils.ResetHttpClients()
+	t.Cleanup(func() {
+		utils.MainConfigFilePath, utils.LocalCredentialsDirectoryPath, syncStateFilePath =
+			mainConfigFilePath, credentialsDirPath, stateFilePath
+		utils.ResetHttpClients()
+		os.RemoveAll(dir)
+	})
+
+	utils.WriteConfigFile(&utils.MainConfig{
+		Config:         utils.Config{HttpRequestTimeout: utils.DefaultHttpRequestTimeout},
+		MgwAdapterEnvs: map[string]utils.MgwEndpoints{testMgEnv: {AdapterEndpoint: adapterEndpoint}},
+	}, utils.MainConfigFilePath)
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.SetMGCredentials(testMgEnv, token.Username, token.Password, token.AccessToken, token.ExpiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// writeAPIProject writes a project with an api.yaml of the API to dir
+func writeAPIProject(t *testing.T, dir, name, version, description string) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	definition := "type: api\nversion: v4.1.0\ndata:\n  name: " + name + "\n  version: " + version +
+		"\n  context: /" + strings.ToLower(name) + "\n  description: " + description + "\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, utils.APIDefinitionFileYaml), []byte(definition), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func syncStatuses(results []SyncResultItem) map[string]string {
+	statuses := make(map[string]string)
+	for _, result := range results {
+		statuses[apiKey(result.Name, result.Version)] = result.Status
+	}
+	return statuses
+}
+
+func TestFindAPIProjects(t *testing.T) {
+	tests := []struct {
+		name     string
+		projects map[string][2]string
+		want     []string
+		wantErr  string
+	}{
+		{
+			name:     "nested projects",
+			projects: map[string][2]string{"a": {"A", "1.0.0"}, "group/b": {"B", "1.0.0"}, "group/c": {"C", "2.0.0"}},
+			want:     []string{"A:1.0.0", "B:1.0.0", "C:2.0.0"},
+		},
+		{
+			name:     "projects inside a project are not scanned",
+			projects: map[string][2]string{"a": {"A", "1.0.0"}, "a/Sequences/b": {"B", "1.0.0"}},
+			want:     []string{"A:1.0.0"},
+		},
+		{
+			name:     "git directory is skipped",
+			projects: map[string][2]string{".git/a": {"A", "1.0.0"}, "b": {"B", "1.0.0"}},
+			want:     []string{"B:1.0.0"},
+		},
+		{
+			name:     "duplicate API",
+			projects: map[string][2]string{"a": {"A", "1.0.0"}, "b": {"A", "1.0.0"}},
+			wantErr:  "API A:1.0.0 is defined in both",
+		},
+		{
+			name:     "empty directory",
+			projects: map[string][2]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "mg-sync")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			for projectPath, api := range test.projects {
+				writeAPIProject(t, filepath.Join(dir, projectPath), api[0], api[1], "sample")
+			}
+
+			projects, err := findAPIProjects(dir)
+			if test.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+			var found []string
+			for _, project := range projects {
+				found = append(found, apiKey(project.name, project.version))
+				assert.NotEmpty(t, project.checksum)
+			}
+			sort.Strings(found)
+			assert.Equal(t, test.want, found)
+		})
+	}
+}
+
+func TestGetDirectoryChecksum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mg-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeAPIProject(t, filepath.Join(dir, "a"), "A", "1.0.0", "sample")
+	writeAPIProject(t, filepath.Join(dir, "b"), "A", "1.0.0", "sample")
+
+	checksumA, err := getDirectoryChecksum(filepath.Join(dir, "a"))
+	assert.Nil(t, err)
+	checksumB, _ := getDirectoryChecksum(filepath.Join(dir, "b"))
+	assert.Equal(t, checksumA, checksumB, "Projects with the same content should have the same checksum")
+
+	writeAPIProject(t, filepath.Join(dir, "b"), "A", "1.0.0", "changed")
+	checksumB, _ = getDirectoryChecksum(filepath.Join(dir, "b"))
+	assert.NotEqual(t, checksumA, checksumB, "A changed file should change the checksum")
+
+	assert.Nil(t, os.Rename(filepath.Join(dir, "b", utils.APIDefinitionFileYaml), filepath.Join(dir, "b", "api.yml")))
+	renamed, _ := getDirectoryChecksum(filepath.Join(dir, "b"))
+	assert.NotEqual(t, checksumB, renamed, "A renamed file should change the checksum")
+}
+
+func TestSyncAPIs(t *testing.T) {
+	adapter := newStubAdapter()
+	server := httptest.NewServer(adapter)
+	defer server.Close()
+	dir := setUpMgEnv(t, server.URL+DefaultMgwAdapterEndpointSuffix, credentials.MgAdapterEnv{AccessToken: "token"})
+	source := filepath.Join(dir, "apis")
+	writeAPIProject(t, filepath.Join(source, "a"), "A", "1.0.0", "sample")
+	writeAPIProject(t, filepath.Join(source, "b"), "B", "1.0.0", "sample")
+
+	steps := []struct {
+		name            string
+		change          func()
+		undeployRemoved bool
+		want            map[string]string
+		wantDeployments []string
+	}{
+		{
+			name:            "first sync deploys every API",
+			want:            map[string]string{"A:1.0.0": SyncStatusAdded, "B:1.0.0": SyncStatusAdded},
+			wantDeployments: []string{"A:1.0.0 override=", "B:1.0.0 override="},
+		},
+		{
+			name: "sync without changes is idempotent",
+			want: map[string]string{"A:1.0.0": SyncStatusUnchanged, "B:1.0.0": SyncStatusUnchanged},
+		},
+		{
+			name:            "changed project is overridden",
+			change:          func() { writeAPIProject(t, filepath.Join(source, "b"), "B", "1.0.0", "changed") },
+			want:            map[string]string{"A:1.0.0": SyncStatusUnchanged, "B:1.0.0": SyncStatusUpdated},
+			wantDeployments: []string{"B:1.0.0 override=true"},
+		},
+		{
+			name:   "removed project is reported",
+			change: func() { assert.Nil(t, os.RemoveAll(filepath.Join(source, "a"))) },
+			want:   map[string]string{"A:1.0.0": SyncStatusOrphaned, "B:1.0.0": SyncStatusUnchanged},
+		},
+		{
+			name:            "removed project is undeployed",
+			undeployRemoved: true,
+			want:            map[string]string{"A:1.0.0": SyncStatusRemoved, "B:1.0.0": SyncStatusUnchanged},
+		},
+		{
+			name: "API undeployed outside sync is deployed again",
+			change: func() {
+				adapter.mutex.Lock()
+				delete(adapter.apis, "B:1.0.0")
+				adapter.mutex.Unlock()
+			},
+			want:            map[string]string{"B:1.0.0": SyncStatusAdded},
+			wantDeployments: []string{"B:1.0.0 override="},
+		},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			if step.change != nil {
+				step.change()
+			}
+			adapter.deployments = nil
+			results, err := SyncAPIs(testMgEnv, source, "", step.undeployRemoved, false)
+			assert.Nil(t, err)
+			assert.Equal(t, step.want, syncStatuses(results))
+			sort.Strings(adapter.deployments)
+			assert.Equal(t, step.wantDeployments, adapter.deployments)
+		})
+	}
+	assert.Equal(t, []string{"A:1.0.0"}, adapter.undeployed)
+
+	state := loadSyncState()
+	assert.Equal(t, []string{"B:1.0.0"}, keysOf(state.Environments[testMgEnv]),
+		"The state file should keep the checksums of the synced APIs only")
+}
+
+func TestSyncAPIsWithParams(t *testing.T) {
+	adapter := newStubAdapter()
+	server := httptest.NewServer(adapter)
+	defer server.Close()
+	dir := setUpMgEnv(t, server.URL+DefaultMgwAdapterEndpointSuffix, credentials.MgAdapterEnv{AccessToken: "token"})
+	source := filepath.Join(dir, "apis")
+	writeAPIProject(t, filepath.Join(source, "a"), "A", "1.0.0", "sample")
+	results, err := SyncAPIs(testMgEnv, source, "", false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"A:1.0.0": SyncStatusAdded}, syncStatuses(results))
+
+	// the params file does not define the environment, so applying it fails before the API is uploaded
+	paramsPath := filepath.Join(dir, "mg_params.yaml")
+	content := "environments:\n  - name: prod\n    configs:\n      endpoints:\n" +
+		"        production:\n          url: https://prod.example.com\n"
+	if err := ioutil.WriteFile(paramsPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	results, err = SyncAPIs(testMgEnv, source, paramsPath, false, false)
+	assert.Nil(t, err)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, SyncStatusFailed, results[0].Status,
+			"An unchanged API should be deployed again when params are given")
+		assert.Contains(t, results[0].Error.Error(), "Environment '"+testMgEnv+"' does not exist in "+paramsPath)
+	}
+	assert.Equal(t, 1, len(adapter.deployments), "The API should not be uploaded without applying the params")
+
+	content += "  - name: " + testMgEnv + "\n    configs:\n      endpoints:\n" +
+		"        production:\n          url: https://dev.example.com\n"
+	if err := ioutil.WriteFile(paramsPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	results, err = SyncAPIs(testMgEnv, source, paramsPath, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"A:1.0.0": SyncStatusUpdated}, syncStatuses(results))
+	var definition struct {
+		Data struct {
+			Description    string `yaml:"description"`
+			EndpointConfig struct {
+				ProductionEndpoints struct {
+					URL string `yaml:"url"`
+				} `yaml:"production_endpoints"`
+			} `yaml:"endpointConfig"`
+		} `yaml:"data"`
+	}
+	if err := yaml.Unmarshal(adapter.apis["A:1.0.0"][utils.APIDefinitionFileYaml], &definition); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "https://dev.example.com", definition.Data.EndpointConfig.ProductionEndpoints.URL,
+		"The API should be deployed with the endpoint of the environment")
+	assert.Equal(t, "sample", definition.Data.Description)
+}
+
+func TestSyncAPIsReportsFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(APIMeta{})
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	dir := setUpMgEnv(t, server.URL+DefaultMgwAdapterEndpointSuffix, credentials.MgAdapterEnv{AccessToken: "token"})
+	source := filepath.Join(dir, "apis")
+	writeAPIProject(t, filepath.Join(source, "a"), "A", "1.0.0", "sample")
+
+	results, err := SyncAPIs(testMgEnv, source, "", false, false)
+	assert.Nil(t, err)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, SyncStatusFailed, results[0].Status)
+		assert.Error(t, results[0].Error)
+	}
+	assert.Empty(t, loadSyncState().Environments[testMgEnv], "A failed API should not be recorded as synced")
+}
+
+func keysOf(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,272 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Login states of a microgateway adapter environment
+const (
+	LoginStateLoggedIn    = "LOGGED_IN"
+	LoginStateExpired     = "EXPIRED"
+	LoginStateNotLoggedIn = "NOT_LOGGED_IN"
+)
+
+const (
+	envNameHeader            = "NAME"
+	envAdapterEndpointHeader = "ADAPTER ENDPOINT"
+	envLoginStateHeader      = "LOGIN STATE"
+	envTokenExpiryHeader     = "TOKEN EXPIRY"
+
+	// DefaultEnvsTableFormat is the default format used to list the microgateway adapter environments
+	DefaultEnvsTableFormat = "table {{.Name}}\t{{.AdapterEndpoint}}\t{{.LoginState}}\t{{.TokenExpiry}}"
+
+	// tokenExpirySkew is the time before the actual expiry, a token is considered as expired
+	tokenExpirySkew = 30 * time.Second
+)
+
+// MgwAdapterEnv holds the details of a microgateway adapter environment along with its login state
+type MgwAdapterEnv struct {
+	EnvName            string
+	EnvAdapterEndpoint string
+	EnvLoginState      string
+	EnvTokenExpiry     int64
+}
+
+// Name of the environment
+func (e MgwAdapterEnv) Name() string {
+	return e.EnvName
+}
+
+// AdapterEndpoint of the environment
+func (e MgwAdapterEnv) AdapterEndpoint() string {
+	return e.EnvAdapterEndpoint
+}
+
+// LoginState of the environment
+func (e MgwAdapterEnv) LoginState() string {
+	return e.EnvLoginState
+}
+
+// TokenExpiry of the access token of the environment
+func (e MgwAdapterEnv) TokenExpiry() string {
+	if e.EnvTokenExpiry == 0 {
+		return "-"
+	}
+	return time.Unix(e.EnvTokenExpiry, 0).Format(time.RFC3339)
+}
+
+// MarshalJSON marshals the environment using custom marshaller which uses methods instead of fields
+func (e *MgwAdapterEnv) MarshalJSON() ([]byte, error) {
+	return formatter.MarshalJSON(e)
+}
+
+// mgwClusterCredentials represents a file with the credentials of microgateway adapter environments
+type mgwClusterCredentials struct {
+	Clusters map[string]mgwClusterCredential `yaml:"mgw-clusters"`
+}
+
+type mgwClusterCredential struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// isTokenExpired returns whether the access token of the environment has expired or is about to expire
+func isTokenExpired(mgToken credentials.MgAdapterEnv) bool {
+	return mgToken.ExpiresAt != 0 && time.Now().Add(tokenExpirySkew).Unix() >= mgToken.ExpiresAt
+}
+
+// reLogin logs in to the microgateway adapter environment again using the stored credentials or the credentials
+// returned by the credential helper configured in main config, and returns the new token. The credentials returned by
+// the credential helper are not stored, since the helper is asked for them whenever the token has to be renewed
+func reLogin(ctx context.Context, store credentials.Store, env, adapterEndpoint string,
+	mgToken credentials.MgAdapterEnv) (credentials.MgAdapterEnv, error) {
+	username, password := mgToken.Username, mgToken.Password
+	storeCredentials := true
+	if username == "" || password == "" {
+		credentialHelper := utils.GetMainConfigFromFile(utils.MainConfigFilePath).Config.MgwCredentialHelper
+		if credentialHelper == "" {
+			return mgToken, errors.New("Access token of microgateway adapter environment " + env + " has expired " +
+				"or is no longer valid. Log in again with `" + utils.ProjectName + " mg login " + env + "`")
+		}
+		var err error
+		username, password, err = getCredentialsFromHelper(ctx, credentialHelper, env)
+		if err != nil {
+			return mgToken, err
+		}
+		storeCredentials = false
+	}
+
+	utils.Logln(utils.LogPrefixInfo + "Access token of " + env + " has expired or is no longer valid. Logging in again")
+	if err := loginToMgwAdapter(ctx, store, env, adapterEndpoint, username, password, storeCredentials); err != nil {
+		return mgToken, err
+	}
+	return store.GetMGToken(env)
+}
+
+// getCredentialsFromHelper runs the credential helper command as "<helper> get <env>" and reads the credentials
+// from its output formatted as {"username": "..", "password": ".."}
+func getCredentialsFromHelper(ctx context.Context, credentialHelper, env string) (string, string, error) {
+	args := strings.Fields(credentialHelper)
+	args = append(args, "get", env)
+	utils.Logln(utils.LogPrefixInfo + "Executing credential helper: " + strings.Join(args, " "))
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = os.Stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", "", errors.New("Error executing credential helper " + args[0] + ". " + err.Error())
+	}
+	helperCredentials := struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}{}
+	if err = json.Unmarshal(output, &helperCredentials); err != nil {
+		return "", "", errors.New("Invalid response from credential helper " + args[0] + ". " + err.Error())
+	}
+	if helperCredentials.Username == "" || helperCredentials.Password == "" {
+		return "", "", errors.New("Credential helper " + args[0] + " did not return credentials for " + env)
+	}
+	return helperCredentials.Username, helperCredentials.Password, nil
+}
+
+// LoginToAllMgwAdapters logs in to all the microgateway adapter environments with credentials in the given file.
+// Environment variables in the file are substituted. Returns the number of environments failed to log in
+func LoginToAllMgwAdapters(credentialsFilePath string) (int, error) {
+	content, err := ioutil.ReadFile(credentialsFilePath)
+	if err != nil {
+		return 0, err
+	}
+	substitutedContent, err := utils.EnvSubstituteForCurlyBraces(string(content))
+	if err != nil {
+		return 0, err
+	}
+	clusterCredentials := &mgwClusterCredentials{}
+	if err = yaml.Unmarshal([]byte(substitutedContent), clusterCredentials); err != nil {
+		return 0, err
+	}
+	if len(clusterCredentials.Clusters) == 0 {
+		return 0, errors.New("no microgateway adapter environments found in " + credentialsFilePath)
+	}
+
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil {
+		return 0, err
+	}
+	mgwAdapterEnvs := utils.GetMainConfigFromFile(utils.MainConfigFilePath).MgwAdapterEnvs
+
+	var envNames []string
+	for env := range clusterCredentials.Clusters {
+		envNames = append(envNames, env)
+	}
+	sort.Strings(envNames)
+
+	failedCount := 0
+	for _, env := range envNames {
+		credential := clusterCredentials.Clusters[env]
+		mgwEndpoints, ok := mgwAdapterEnvs[env]
+		if !ok {
+			failedCount++
+			fmt.Println(utils.LogPrefixError + "Env " + env + " does not exists. Add it using `apictl mg add env`")
+			continue
+		}
+		err := loginToMgwAdapter(context.Background(), store, env, mgwEndpoints.AdapterEndpoint, credential.Username,
+			credential.Password, true)
+		if err != nil {
+			failedCount++
+			fmt.Println(utils.LogPrefixError+"Login to Microgateway Adapter in environment "+env+" failed:", err)
+			continue
+		}
+		fmt.Println("Successfully logged into Microgateway Adapter in environment: ", env)
+	}
+	return failedCount, nil
+}
+
+// GetMgwAdapterEnvs returns the microgateway adapter environments in main config along with their login states
+func GetMgwAdapterEnvs() ([]MgwAdapterEnv, error) {
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil {
+		return nil, err
+	}
+	var envs []MgwAdapterEnv
+	for name, mgwEndpoints := range utils.GetMainConfigFromFile(utils.MainConfigFilePath).MgwAdapterEnvs {
+		env := MgwAdapterEnv{
+			EnvName:            name,
+			EnvAdapterEndpoint: mgwEndpoints.AdapterEndpoint,
+			EnvLoginState:      LoginStateNotLoggedIn,
+		}
+		if store.HasMG(name) {
+			mgToken, err := store.GetMGToken(name)
+			if err != nil {
+				return nil, err
+			}
+			env.EnvTokenExpiry = mgToken.ExpiresAt
+			env.EnvLoginState = LoginStateLoggedIn
+			if isTokenExpired(mgToken) {
+				env.EnvLoginState = LoginStateExpired
+			}
+		}
+		envs = append(envs, env)
+	}
+	sort.Slice(envs, func(i, j int) bool {
+		return envs[i].EnvName < envs[j].EnvName
+	})
+	return envs, nil
+}
+
+// PrintMgwAdapterEnvs prints the microgateway adapter environments according to the given format
+func PrintMgwAdapterEnvs(envs []MgwAdapterEnv, format string) {
+	envsContext := formatter.NewListContext(os.Stdout, format, DefaultEnvsTableFormat)
+
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, env := range envs {
+			if err := t.Execute(w, env); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+
+	envsTableHeaders := map[string]string{
+		"Name":            envNameHeader,
+		"AdapterEndpoint": envAdapterEndpointHeader,
+		"LoginState":      envLoginStateHeader,
+		"TokenExpiry":     envTokenExpiryHeader,
+	}
+	if err := envsContext.Write(renderer, envsTableHeaders); err != nil {
+		utils.HandleErrorAndExit("Error executing template", err)
+	}
+}
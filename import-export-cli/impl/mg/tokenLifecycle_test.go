@@ -0,0 +1,228 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package mg
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestIsTokenExpired(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		expiresAt int64
+		want      bool
+	}{
+		{"unknown expiry", 0, false},
+		{"expired", now.Add(-time.Hour).Unix(), true},
+		{"expires within the skew", now.Add(tokenExpirySkew / 2).Unix(), true},
+		{"expires after the skew", now.Add(2 * tokenExpirySkew).Unix(), false},
+		{"expires in an hour", now.Add(time.Hour).Unix(), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			token := credentials.MgAdapterEnv{AccessToken: "token", ExpiresAt: test.expiresAt}
+			assert.Equal(t, test.want, isTokenExpired(token))
+		})
+	}
+}
+
+func TestGetCredentialsFromHelper(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helpers are written as shell scripts")
+	}
+	dir, err := ioutil.TempDir("", "mg-helper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// writeHelper writes an executable script printing the output, the arguments are available as $@
+	writeHelper := func(name, script string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name         string
+		helper       string
+		wantUsername string
+		wantPassword string
+		wantErr      string
+	}{
+		{
+			name:         "credentials of the environment",
+			helper:       writeHelper("valid", `echo "{\"username\": \"$1-$2\", \"password\": \"secret\"}"`),
+			wantUsername: "get-dev",
+			wantPassword: "secret",
+		},
+		{
+			name: "helper with arguments",
+			helper: writeHelper("args", `echo "{\"username\": \"$*\", \"password\": \"secret\"}"`) +
+				" --vault prod",
+			wantUsername: "--vault prod get dev",
+			wantPassword: "secret",
+		},
+		{
+			name:    "helper failing",
+			helper:  writeHelper("failing", `echo "no credentials" >&2; exit 1`),
+			wantErr: "Error executing credential helper",
+		},
+		{
+			name:    "helper not found",
+			helper:  filepath.Join(dir, "missing"),
+			wantErr: "Error executing credential helper",
+		},
+		{
+			name:    "output which is not JSON",
+			helper:  writeHelper("text", `echo "admin:secret"`),
+			wantErr: "Invalid response from credential helper",
+		},
+		{
+			name:    "output without password",
+			helper:  writeHelper("nopassword", `echo '{"username": "admin"}'`),
+			wantErr: "did not return credentials for dev",
+		},
+		{
+			name:    "empty output",
+			helper:  writeHelper("empty", `exit 0`),
+			wantErr: "Invalid response from credential helper",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			username, password, err := getCredentialsFromHelper(context.Background(), test.helper, "dev")
+			if test.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), test.wantErr)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantUsername, username)
+			assert.Equal(t, test.wantPassword, password)
+		})
+	}
+}
+
+func TestInvokeWithReLogin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helpers are written as shell scripts")
+	}
+	tests := []struct {
+		name         string
+		token        credentials.MgAdapterEnv
+		helperOutput string
+		wantLogins   int
+		wantStored   credentials.MgAdapterEnv
+		wantErr      string
+	}{
+		{
+			name:       "valid token",
+			token:      credentials.MgAdapterEnv{AccessToken: "fresh"},
+			wantStored: credentials.MgAdapterEnv{AccessToken: "fresh"},
+		},
+		{
+			name:       "revoked token with stored credentials",
+			token:      credentials.MgAdapterEnv{AccessToken: "revoked", Username: "admin", Password: "admin"},
+			wantLogins: 1,
+			wantStored: credentials.MgAdapterEnv{AccessToken: "fresh", Username: "admin", Password: "admin"},
+		},
+		{
+			name:         "revoked token with credential helper",
+			token:        credentials.MgAdapterEnv{AccessToken: "revoked"},
+			helperOutput: `{"username": "admin", "password": "admin"}`,
+			wantLogins:   1,
+			wantStored:   credentials.MgAdapterEnv{AccessToken: "fresh"},
+		},
+		{
+			name:       "revoked token without credentials",
+			token:      credentials.MgAdapterEnv{AccessToken: "revoked"},
+			wantStored: credentials.MgAdapterEnv{AccessToken: "revoked"},
+			wantErr:    "Log in again with",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logins, listings := 0, 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case DefaultMgwAdapterEndpointSuffix + "/" + defaultTokenEndpointPath:
+					logins++
+					_ = json.NewEncoder(w).Encode(map[string]string{"accessToken": "fresh"})
+				case DefaultMgwAdapterEndpointSuffix + apisResourcePath:
+					listings++
+					if r.Header.Get(utils.HeaderAuthorization) != utils.HeaderValueAuthBearerPrefix+" fresh" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					_ = json.NewEncoder(w).Encode(APIMeta{})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			dir := setUpMgEnv(t, server.URL+DefaultMgwAdapterEndpointSuffix, test.token)
+			if test.helperOutput != "" {
+				helper := filepath.Join(dir, "helper")
+				script := "#!/bin/sh\necho '" + test.helperOutput + "'\n"
+				if err := ioutil.WriteFile(helper, []byte(script), 0755); err != nil {
+					t.Fatal(err)
+				}
+				mainConfig := utils.GetMainConfigFromFile(utils.MainConfigFilePath)
+				mainConfig.Config.MgwCredentialHelper = helper
+				utils.WriteConfigFile(mainConfig, utils.MainConfigFilePath)
+			}
+
+			_, _, _, err := GetAPIsList(context.Background(), testMgEnv, map[string]string{})
+			if test.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), test.wantErr)
+				}
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.wantLogins+1, listings, "The request should be retried once after logging in")
+			}
+			assert.Equal(t, test.wantLogins, logins)
+
+			store, err := credentials.GetDefaultCredentialStore()
+			if err != nil {
+				t.Fatal(err)
+			}
+			stored, err := store.GetMGToken(testMgEnv)
+			assert.Nil(t, err)
+			assert.Equal(t, test.wantStored, stored, "The credentials of a credential helper should not be stored")
+		})
+	}
+}
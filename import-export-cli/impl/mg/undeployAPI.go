@@ -19,25 +19,24 @@
 package mg
 
 import (
-	"errors"
+	"context"
 	"net/http"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var mgUndeployAPIResourcePath = "/apis"
 
 // UndeployAPI sends a DELETE request to delete an API
-func UndeployAPI(env string, queryParam map[string]string) (err error) {
-	mgwAdapterInfo, err := GetMgwAdapterInfo(env)
-	if err != nil {
-		return err
-	}
-	apiDeleteEndpoint := mgwAdapterInfo.Endpoint + apisResourcePath
+func UndeployAPI(ctx context.Context, env string, queryParam map[string]string) (err error) {
+	resp, err := invokeWithReLogin(ctx, env, func(mgwAdapterInfo MgwAdapterInfo) (*resty.Response, error) {
+		apiDeleteEndpoint := mgwAdapterInfo.Endpoint + apisResourcePath
 
-	headers := make(map[string]string)
-	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + mgwAdapterInfo.AccessToken
-	resp, err := utils.InvokeDELETERequestWithParams(apiDeleteEndpoint, queryParam, headers)
+		headers := make(map[string]string)
+		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + mgwAdapterInfo.AccessToken
+		return utils.InvokeDELETERequestWithParams(ctx, apiDeleteEndpoint, queryParam, headers)
+	})
 
 	if err != nil {
 		return err
@@ -45,7 +44,7 @@ func UndeployAPI(env string, queryParam map[string]string) (err error) {
 	if resp.StatusCode() == http.StatusOK {
 		return nil
 	} else if resp.StatusCode() == http.StatusNotFound {
-		return errors.New("the API does not exist")
+		return utils.NewResponseError(resp, "the API does not exist")
 	}
-	return errors.New(string(resp.Body()))
+	return utils.NewResponseError(resp, "")
 }
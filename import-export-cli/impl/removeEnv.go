@@ -0,0 +1,99 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// RemoveEnv removes an environment and its endpoints from the config file. Stored credentials of the environment are
+// revoked and erased as well, and contexts referring to the environment no longer use it.
+// @param envName : Name of the environment to be removed
+// @param mainConfigFilePath : Path to file where env endpoints are stored
+// @param envKeysFilePath : Path to file where env keys are stored
+// @return error
+func RemoveEnv(ctx context.Context, envName, mainConfigFilePath, envKeysFilePath string) error {
+	if envName == "" {
+		return errors.New("name of the environment cannot be blank")
+	}
+	if !utils.EnvExistsInMainConfigFile(envName, mainConfigFilePath) {
+		// environment does not exist in mainConfig file (endpoints file). Nothing to remove
+		return errors.New("environment '" + envName + "' not found in " + mainConfigFilePath)
+	}
+
+	if utils.EnvExistsInKeysFile(envName, envKeysFilePath) {
+		// environment exists in keys file, it has to be cleared first
+		err := utils.RemoveEnvFromKeysFile(envName, envKeysFilePath, mainConfigFilePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	// remove keys also if user has already logged into this environment
+	store, err := credentials.GetDefaultCredentialStore()
+	if err == nil {
+		if store.HasAPIM(envName) {
+			if err = logoutAPIM(ctx, store, envName); err != nil {
+				utils.Logln("Log out is unsuccessful for APIM.", err)
+			}
+		}
+		if store.HasMI(envName) {
+			if err = credentials.RunMILogout(ctx, envName); err != nil {
+				utils.Logln("Log out is unsuccessful for MI.", err)
+			}
+		}
+	}
+
+	// remove env from mainConfig file (endpoints file)
+	err = utils.RemoveEnvFromMainConfigFile(envName, mainConfigFilePath)
+	if err != nil {
+		return err
+	}
+	// remove client certificates converted from PKCS#12
+	_ = os.RemoveAll(filepath.Join(utils.APIMClientCertDirPath, envName))
+
+	if contexts := utils.RemoveEnvFromContextsInMainConfigFile(envName, mainConfigFilePath); len(contexts) > 0 {
+		fmt.Println(utils.LogPrefixWarning + "Environment '" + envName + "' is no longer used by the contexts: " +
+			strings.Join(contexts, ", "))
+	}
+	return nil
+}
+
+// logoutAPIM revokes the access token of the stored APIM credentials of the environment and erases them
+func logoutAPIM(ctx context.Context, store credentials.Store, envName string) error {
+	cred, err := store.GetAPIMCredentials(envName)
+	if err != nil {
+		return err
+	}
+	accessToken, err := credentials.GetOAuthAccessToken(ctx, cred, envName)
+	if err == nil {
+		if err = credentials.RevokeAccessToken(ctx, cred, envName, accessToken); err != nil {
+			utils.Logln(utils.LogPrefixWarning+"Unable to revoke the access token of "+envName, err)
+		}
+	}
+	return store.EraseAPIM(envName)
+}
@@ -19,7 +19,10 @@
 package impl
 
 import (
+	"context"
+
 	"encoding/json"
+	"fmt"
 	"github.com/go-resty/resty/v2"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -27,9 +30,9 @@ import (
 func UndeployRevisionFromGateways(accessToken, environment, name, version, provider, revisionNum string,
 	gateways []utils.Deployment, allGatewayEnvironments bool) (*resty.Response, error) {
 
-	apiId, err := GetAPIId(accessToken, environment, name, version, provider)
+	apiId, err := GetAPIId(context.Background(), accessToken, environment, name, version, provider)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting API Id for undeploy", err)
+		return nil, err
 	}
 	apiRevisionEndpoint := utils.GetApiListEndpointOfEnv(environment, utils.MainConfigFilePath)
 	return undeployRevision(accessToken, apiRevisionEndpoint, apiId, revisionNum, gateways,
@@ -61,8 +64,8 @@ func undeployRevision(accessToken, undeployRevisionEndpoint, apiId, revisionNum
 
 	body, err := json.Marshal(gateways)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while converting gateways array", err)
+		return nil, fmt.Errorf("error while converting gateways array: %w", err)
 	}
 
-	return utils.InvokePOSTRequest(undeployRevisionEndpoint, headers, string(body))
+	return utils.InvokePOSTRequest(context.Background(), undeployRevisionEndpoint, headers, string(body))
 }
@@ -19,6 +19,8 @@
 package impl
 
 import (
+	"context"
+
 	"github.com/go-resty/resty/v2"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
@@ -26,9 +28,9 @@ import (
 func UndeployAPIProductRevisionFromGateways(accessToken, environment, name, provider, revisionNum string,
 	gateways []utils.Deployment, allGatewayEnvironments bool) (*resty.Response, error) {
 
-	apiId, err := GetAPIProductId(accessToken, environment, name, provider)
+	apiId, err := GetAPIProductId(context.Background(), accessToken, environment, name, provider)
 	if err != nil {
-		utils.HandleErrorAndExit("Error while getting the API Product Id for undeploy", err)
+		return nil, err
 	}
 	apiRevisionEndpoint := utils.GetApiProductListEndpointOfEnv(environment, utils.MainConfigFilePath)
 	return undeployRevision(accessToken, apiRevisionEndpoint, apiId, revisionNum, gateways,
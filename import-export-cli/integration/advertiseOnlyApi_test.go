@@ -30,6 +30,8 @@ import (
 // check whether the advertise only properties (advertised, original devportal URL and API owner) have been set correctly.
 // Export the API and check whether certificates have not been exported.
 func TestInitDeploymentDirImportExportAdvertiseOnlyAPIAdminSuperTenant(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 
@@ -76,6 +78,8 @@ func TestInitDeploymentDirImportExportAdvertiseOnlyAPIAdminSuperTenant(t *testin
 // has the provider as the super tenant admin) with certificates and check whether the advertise only properties (advertised,
 // original devportal URL and API owner) have been set correctly. Export the API and check whether certificates have not been exported.
 func TestInitDeploymentDirImportExportAdvertiseOnlyAPIAdminTenant(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 
@@ -125,6 +129,8 @@ func TestInitDeploymentDirImportExportAdvertiseOnlyAPIAdminTenant(t *testing.T)
 // with certificates and check whether the advertise only properties (advertised, original devportal URL and API owner)
 // have been set correctly. Export the API and check whether certificates have not been exported.
 func TestInitDeploymentDirImportExportAdvertiseOnlyAPIDevopsSuperTenant(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 
@@ -175,6 +181,8 @@ func TestInitDeploymentDirImportExportAdvertiseOnlyAPIDevopsSuperTenant(t *testi
 // advertise only properties (advertised, original devportal URL and API owner) have been set correctly. Export the API
 // and check whether certificates have not been exported.
 func TestInitDeploymentDirImportExportAdvertiseOnlyAPIDevopsTenant(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 
@@ -222,6 +230,8 @@ func TestInitDeploymentDirImportExportAdvertiseOnlyAPIDevopsTenant(t *testing.T)
 
 // Export a third party Async API from one environment, import to another environment, and reimport it with update
 func TestExportImportAdvertiseOnlyAsyncApiWithUpdate(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -27,6 +27,8 @@ import (
 
 // Get log levels of APIs of the carbon.super tenant in an environment as a super admin user
 func TestGetAPILogLevelsSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -51,6 +53,8 @@ func TestGetAPILogLevelsSuperAdminUser(t *testing.T) {
 
 // Get log levels of APIs of the carbon.super tenant in an environment as a non super admin user
 func TestGetAPILogLevelsNonSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantAdminUsername := superAdminUser + "@" + TENANT1
 	tenantAdminPassword := superAdminPassword
 
@@ -73,6 +77,8 @@ func TestGetAPILogLevelsNonSuperAdminUser(t *testing.T) {
 
 // Get log levels of APIs of another tenant in an environment as a super admin user
 func TestGetAPILogLevelsAnotherTenantSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -97,6 +103,8 @@ func TestGetAPILogLevelsAnotherTenantSuperAdminUser(t *testing.T) {
 
 // Get log levels of APIs of the another tenant in an environment as a non super admin user
 func TestGetAPILogLevelsAnotherTenantNonSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantAdminUsername := superAdminUser + "@" + TENANT1
 	tenantAdminPassword := superAdminPassword
 
@@ -119,6 +127,8 @@ func TestGetAPILogLevelsAnotherTenantNonSuperAdminUser(t *testing.T) {
 
 // Get log level of an API of the carbon.super tenant in an environment as a super admin user
 func TestGetAPILogLevelSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -142,6 +152,8 @@ func TestGetAPILogLevelSuperAdminUser(t *testing.T) {
 
 // Get log level of an API of the carbon.super tenant in an environment as a non super admin user
 func TestGetAPILogLevelNonSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantAdminUsername := superAdminUser + "@" + TENANT1
 	tenantAdminPassword := superAdminPassword
 
@@ -165,6 +177,8 @@ func TestGetAPILogLevelNonSuperAdminUser(t *testing.T) {
 
 // Get log level of an API of another tenant in an environment as a super admin user
 func TestGetAPILogLevelAnotherTenantSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -188,6 +202,8 @@ func TestGetAPILogLevelAnotherTenantSuperAdminUser(t *testing.T) {
 
 // Get log level of an API of the another tenant in an environment as a non super admin user
 func TestGetAPILogLevelAnotherTenantNonSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantAdminUsername := superAdminUser + "@" + TENANT1
 	tenantAdminPassword := superAdminPassword
 
@@ -211,6 +227,8 @@ func TestGetAPILogLevelAnotherTenantNonSuperAdminUser(t *testing.T) {
 
 // Set log level of an API of the carbon.super tenant in an environment as a super admin user
 func TestSetAPILogLevelSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -235,6 +253,8 @@ func TestSetAPILogLevelSuperAdminUser(t *testing.T) {
 
 // Set log level of an API of the carbon.super tenant in an environment as a non super admin user
 func TestSetAPILogLevelNonSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantAdminUsername := superAdminUser + "@" + TENANT1
 	tenantAdminPassword := superAdminPassword
 
@@ -259,6 +279,8 @@ func TestSetAPILogLevelNonSuperAdminUser(t *testing.T) {
 
 // Set log level of an API of another tenant in an environment as a super admin user
 func TestSetAPILogLevelAnotherTenantSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -283,6 +305,8 @@ func TestSetAPILogLevelAnotherTenantSuperAdminUser(t *testing.T) {
 
 // Set log level of an API of the another tenant in an environment as a non super admin user
 func TestSetAPILogLevelAnotherTenantNonSuperAdminUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantAdminUsername := superAdminUser + "@" + TENANT1
 	tenantAdminPassword := superAdminPassword
 
@@ -26,6 +26,8 @@ import (
 )
 
 func TestExportInvalidApiProductRevision(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
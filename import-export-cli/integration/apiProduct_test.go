@@ -33,6 +33,8 @@ const numberOfAPIProducts = 5 // Number of API Products to be added in a loop
 
 // Export an API Product with its dependent APIs from one environment as a super tenant non admin user
 func TestExportApiProductNonAdminSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apiPublisher := publisher.UserName
 	apiPublisherPassword := publisher.Password
 
@@ -71,6 +73,8 @@ func TestExportApiProductNonAdminSuperTenantUser(t *testing.T) {
 // Export an API Product with its dependent APIs from one environment as a super tenant non admin user
 // who does not have permission
 func TestExportApiProductNonAdminSuperTenantUserWithoutPermission(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apiPublisher := publisher.UserName
 	apiPublisherPassword := publisher.Password
 
@@ -111,6 +115,8 @@ func TestExportApiProductNonAdminSuperTenantUserWithoutPermission(t *testing.T)
 
 // Export an API Product with its dependent APIs from one environment and import to another environment freshly as super tenant admin
 func TestExportImportApiProductAdminSuperTenantUserWithImportApis(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -163,6 +169,8 @@ func TestExportImportApiProductAdminSuperTenantUserWithImportApis(t *testing.T)
 // Export an API Product with its dependent APIs from one environment and import to another environment freshly as super tenant user
 // with Internal/devops role
 func TestExportImportApiProductDevopsSuperTenantUserWithImportApis(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUsername := devops.UserName
 	devopsPassword := devops.Password
 
@@ -215,6 +223,8 @@ func TestExportImportApiProductDevopsSuperTenantUserWithImportApis(t *testing.T)
 // Export an API Product with its dependent APIs from one environment and import it as super tenant admin
 // when dependent APIs are already in that environment and you do not want to update those APIs.
 func TestExportImportApiProductAdminSuperTenantUserWithoutImportApis(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -264,6 +274,8 @@ func TestExportImportApiProductAdminSuperTenantUserWithoutImportApis(t *testing.
 // Export an API Product with its dependent APIs from one environment and import it as super tenant user with Internal/devops
 // role when dependent APIs are already in that environment and you do not want to update those APIs.
 func TestExportImportApiProductDevopsSuperTenantUserWithoutImportApis(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUsername := devops.UserName
 	devopsPassword := devops.Password
 
@@ -313,6 +325,8 @@ func TestExportImportApiProductDevopsSuperTenantUserWithoutImportApis(t *testing
 // Export an API Product with its dependent APIs from one environment and import it as super tenant admin
 // when dependent APIs are already in that environment and you want to update those APIs.
 func TestExportImportApiProductAdminSuperTenantUserWithUpdateApis(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -362,6 +376,8 @@ func TestExportImportApiProductAdminSuperTenantUserWithUpdateApis(t *testing.T)
 // Export an API Product with its dependent APIs from one environment and import it as super tenant user with Internal/devops
 // role when dependent APIs are already in that environment and you want to update those APIs.
 func TestExportImportApiProductDevopsSuperTenantUserWithUpdateApis(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUsername := devops.UserName
 	devopsPassword := devops.Password
 
@@ -411,6 +427,8 @@ func TestExportImportApiProductDevopsSuperTenantUserWithUpdateApis(t *testing.T)
 // Export an API Product with its dependent APIs from one environment and import to another environment freshly as super tenant admin
 // and try to update that API Product (without updating dependent APIs)
 func TestExportImportApiProductAdminSuperTenantUserWithUpdateApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := testutils.Credentials{Username: superAdminUser, Password: superAdminPassword}
 
 	apiCreator := testutils.Credentials{Username: creator.UserName, Password: creator.Password}
@@ -443,6 +461,8 @@ func TestExportImportApiProductAdminSuperTenantUserWithUpdateApiProduct(t *testi
 // Export an API Product with its dependent APIs from one environment and import to another environment freshly as super tenant user with
 // Internal/devops role and try to update that API Product (without updating dependent APIs)
 func TestExportImportApiProductDevopsSuperTenantUserWithUpdateApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUser := testutils.Credentials{Username: devops.UserName, Password: devops.Password}
 
 	apiCreator := testutils.Credentials{Username: creator.UserName, Password: creator.Password}
@@ -476,6 +496,8 @@ func TestExportImportApiProductDevopsSuperTenantUserWithUpdateApiProduct(t *test
 // and try to update that API Product and dependent APIs.
 // This same command can be used to update only the dependent APIs as well.
 func TestExportImportApiProductAdminSuperTenantUserWithUpdateApisAndApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := testutils.Credentials{Username: superAdminUser, Password: superAdminPassword}
 
 	apiCreator := testutils.Credentials{Username: creator.UserName, Password: creator.Password}
@@ -510,6 +532,8 @@ func TestExportImportApiProductAdminSuperTenantUserWithUpdateApisAndApiProduct(t
 // role and try to update that API Product and dependent APIs.
 // This same command can be used to update only the dependent APIs as well.
 func TestExportImportApiProductDevopsSuperTenantUserWithUpdateApisAndApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUser := testutils.Credentials{Username: devops.UserName, Password: devops.Password}
 
 	apiCreator := testutils.Credentials{Username: creator.UserName, Password: creator.Password}
@@ -542,6 +566,8 @@ func TestExportImportApiProductDevopsSuperTenantUserWithUpdateApisAndApiProduct(
 
 // Export an API Product with its dependent APIs from one environment and import to another environment freshly as cross tenant admin
 func TestExportImportApiProductCrossTenantUserWithImportApis(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := testutils.Credentials{Username: superAdminUser, Password: superAdminPassword}
 
 	tenantAdminUser := testutils.Credentials{Username: superAdminUser + "@" + TENANT1, Password: superAdminPassword}
@@ -572,6 +598,8 @@ func TestExportImportApiProductCrossTenantUserWithImportApis(t *testing.T) {
 // Export an API Product with its dependent APIs from one environment and import to another environment freshly as cross tenant user
 // with Internal/devops role
 func TestExportImportApiProductCrossTenantDevopsWithImportApis(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUser := testutils.Credentials{Username: devops.UserName, Password: devops.Password}
 
 	tenantDevopsUser := testutils.Credentials{Username: devops.UserName + "@" + TENANT1, Password: devops.Password}
@@ -602,6 +630,8 @@ func TestExportImportApiProductCrossTenantDevopsWithImportApis(t *testing.T) {
 // Export an API Product with its dependent APIs from one environment as super tenant admin and import to another environment freshly
 // as cross tenant admin and try to update that API Product (without updating dependent APIs)
 func TestExportImportApiProductCrossTenantUserWithUpdateApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := testutils.Credentials{Username: superAdminUser, Password: superAdminPassword}
 
 	tenantAdminUser := testutils.Credentials{Username: superAdminUser + "@" + TENANT1, Password: superAdminPassword}
@@ -640,6 +670,8 @@ func TestExportImportApiProductCrossTenantUserWithUpdateApiProduct(t *testing.T)
 // Export an API Product with its dependent APIs from one environment as super tenant user with Internal/devops role
 // and import to another environment freshly as cross tenant admin and try to update that API Product (without updating dependent APIs)
 func TestExportImportApiProductCrossTenantDevopsWithUpdateApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUser := testutils.Credentials{Username: devops.UserName, Password: devops.Password}
 
 	tenantDevopsUser := testutils.Credentials{Username: devops.UserName + "@" + TENANT1, Password: devops.Password}
@@ -679,6 +711,8 @@ func TestExportImportApiProductCrossTenantDevopsWithUpdateApiProduct(t *testing.
 // and try to update that API Product and dependent APIs.
 // This same command can be used to update only the dependent APIs as well.
 func TestExportImportApiProductCrossTenantUserWithUpdateApisAndApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := testutils.Credentials{Username: superAdminUser, Password: superAdminPassword}
 
 	tenantAdminUser := testutils.Credentials{Username: superAdminUser + "@" + TENANT1, Password: superAdminPassword}
@@ -718,6 +752,8 @@ func TestExportImportApiProductCrossTenantUserWithUpdateApisAndApiProduct(t *tes
 //  and import to another environment freshly as tenant admin and try to update that API Product and dependent APIs.
 // This same command can be used to update only the dependent APIs as well.
 func TestExportImportApiProductCrossTenantDevopsWithUpdateApisAndApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUser := testutils.Credentials{Username: devops.UserName, Password: devops.Password}
 
 	tenantDevopsUser := testutils.Credentials{Username: devops.UserName + "@" + TENANT1, Password: devops.Password}
@@ -754,6 +790,8 @@ func TestExportImportApiProductCrossTenantDevopsWithUpdateApisAndApiProduct(t *t
 }
 
 func TestListApiProductsAdminSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -793,6 +831,8 @@ func TestListApiProductsAdminSuperTenantUser(t *testing.T) {
 }
 
 func TestListApiProductsDevopsSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUsername := devops.UserName
 	devopsPassword := devops.Password
 
@@ -832,6 +872,8 @@ func TestListApiProductsDevopsSuperTenantUser(t *testing.T) {
 }
 
 func TestListApiProductsAdminTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantAdminUsername := superAdminUser + "@" + TENANT1
 	tenantAdminPassword := superAdminPassword
 
@@ -871,6 +913,8 @@ func TestListApiProductsAdminTenantUser(t *testing.T) {
 }
 
 func TestListApiProductsDevopsTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantDevopsUsername := devops.UserName + "@" + TENANT1
 	tenantDevopsPassword := devops.Password
 
@@ -911,6 +955,8 @@ func TestListApiProductsDevopsTenantUser(t *testing.T) {
 
 // API products listing with JsonArray format
 func TestListApiProductsWithJsonArrayFormat(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -946,6 +992,8 @@ func TestListApiProductsWithJsonArrayFormat(t *testing.T) {
 }
 
 func TestDeleteApiProductAdminSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -989,6 +1037,8 @@ func TestDeleteApiProductAdminSuperTenantUser(t *testing.T) {
 }
 
 func TestDeleteApiProductDevopsSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	devopsUsername := devops.UserName
 	devopsPassword := devops.Password
 
@@ -1032,6 +1082,8 @@ func TestDeleteApiProductDevopsSuperTenantUser(t *testing.T) {
 }
 
 func TestDeleteApiProductAdminTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantAdminUsername := superAdminUser + "@" + TENANT1
 	tenantAdminPassword := superAdminPassword
 
@@ -1075,6 +1127,8 @@ func TestDeleteApiProductAdminTenantUser(t *testing.T) {
 }
 
 func TestDeleteApiProductDevopsTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantDevopsUsername := devops.UserName + "@" + TENANT1
 	tenantDevopsPassword := devops.Password
 
@@ -1118,6 +1172,8 @@ func TestDeleteApiProductDevopsTenantUser(t *testing.T) {
 }
 
 func TestDeleteApiProductSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apiCreator := creator.UserName
 	apiCreatorPassword := creator.Password
 
@@ -1155,6 +1211,8 @@ func TestDeleteApiProductSuperTenantUser(t *testing.T) {
 }
 
 func TestDeleteApiProductWithActiveSubscriptionsSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUsername := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -1210,6 +1268,8 @@ func TestDeleteApiProductWithActiveSubscriptionsSuperTenantUser(t *testing.T) {
 
 // API products search using query parameters
 func TestApiProductSearchWithQueryParams(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -1265,6 +1325,8 @@ func TestApiProductSearchWithQueryParams(t *testing.T) {
 }
 
 func TestChangeLifeCycleStatusOfApiProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
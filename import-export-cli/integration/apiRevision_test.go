@@ -26,6 +26,8 @@ import (
 )
 
 func TestExportApiNonDeloyedRevision(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -54,6 +56,8 @@ func TestExportApiNonDeloyedRevision(t *testing.T) {
 }
 
 func TestExportApiDeloyedRevision(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -81,6 +85,8 @@ func TestExportApiDeloyedRevision(t *testing.T) {
 }
 
 func TestExportApiWorkingCopy(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -105,6 +111,8 @@ func TestExportApiWorkingCopy(t *testing.T) {
 }
 
 func TestExportApiLatestRevision(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -133,6 +141,8 @@ func TestExportApiLatestRevision(t *testing.T) {
 }
 
 func TestExportImportApiSameGWEnv(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -164,6 +174,8 @@ func TestExportImportApiSameGWEnv(t *testing.T) {
 }
 
 func TestExportInvalidApiRevision(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -36,6 +36,8 @@ const numberOfAPIs = 5 // Number of APIs to be added in a loop
 // Export an API from one environment and check the structure of the DTO whether it is similat to what is being
 // maintained by APICTL
 func TestExportApiCompareStruct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apiPublisher := publisher.UserName
 	apiPublisherPassword := publisher.Password
 
@@ -1175,6 +1177,8 @@ func TestChangeLifeCycleStatusOfApiWithActiveSubscriptionDevopsSuperTenantUser(t
 
 // Export a SOAP API from one environment and import to another environment by specifying the provider name
 func TestExportImportSoapApi(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -1198,6 +1202,8 @@ func TestExportImportSoapApi(t *testing.T) {
 
 // Export a SOAPTOREST API from one environment and import to another environment by specifying the provider name
 func TestExportImportSoapToRestApi(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -1221,6 +1227,8 @@ func TestExportImportSoapToRestApi(t *testing.T) {
 
 // Export a GraphQL API from one environment and import to another environment as by specifying the provider name
 func TestExportImportGraphQLApi(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -1244,6 +1252,8 @@ func TestExportImportGraphQLApi(t *testing.T) {
 
 // Export a WebSocket API from one environment and import to another environment by specifying the provider name
 func TestExportImportWebSocketApi(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -1266,6 +1276,8 @@ func TestExportImportWebSocketApi(t *testing.T) {
 
 // Export a WebSub/WebHook API from one environment and import to another environment by specifying the provider name
 func TestExportImportWebSubApi(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -1289,6 +1301,8 @@ func TestExportImportWebSubApi(t *testing.T) {
 
 // Export a Server Sent Events API from one environment and import to another environment by specifying the provider name
 func TestExportImportSSEApi(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -1312,6 +1326,8 @@ func TestExportImportSSEApi(t *testing.T) {
 // Export a Web Socket API (that was created using an Async API definition) from one environment and
 //import to another environment by specifying the provider name
 func TestExportImportWebSocketApiFromAsyncApiDef(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -1335,6 +1351,8 @@ func TestExportImportWebSocketApiFromAsyncApiDef(t *testing.T) {
 
 // Import an API and then create a new version of that API by updating the context and version only and import again
 func TestCreateNewVersionOfApiByUpdatingVersion(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -26,8 +26,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 var (
@@ -40,10 +44,60 @@ var (
 	archiveFileName string
 )
 
+// versionVariable : Variable holding the apictl version, set at build time
+const versionVariable = "github.com/wso2/product-apim-tooling/import-export-cli/cmd.Version"
+
 func init() {
 	flag.StringVar(&archiveFileName, "archive", "", "Archive file name of apictl distribution")
 }
 
+// IsArchiveProvided : Check whether an apictl distribution archive was given with '-archive'
+func IsArchiveProvided() bool {
+	return archiveFileName != ""
+}
+
+// BuildBinary : Build apictl from the module at modulePath into destPath, to run the tests without a distribution
+// archive. The version is stamped into the binary unless it is empty
+func BuildBinary(modulePath, destPath, version string) {
+	if runtime.GOOS == "windows" {
+		BinaryName = "apictl.exe"
+	}
+
+	args := []string{"build", "-o", filepath.Join(destPath, BinaryName)}
+	if version != "" {
+		args = append(args, "-ldflags", "-X "+versionVariable+"="+version)
+	}
+	cmd := exec.Command("go", append(args, ".")...)
+	cmd.Dir = modulePath
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		Fatal("Error building apictl:", err, string(output))
+	}
+
+	RelativeBinaryPath = filepath.Clean(destPath) + string(os.PathSeparator)
+}
+
+// UseConfigHome : Keep the configuration of apictl in homeDir instead of the home directory of the user, both for the
+// binary run by the tests and for the configuration paths read by the tests, so that running the tests does not change
+// the environments of the user
+func UseConfigHome(homeDir string) {
+	if err := os.Setenv(utils.ConfigDirEnvVar, homeDir); err != nil {
+		Fatal(err)
+	}
+	utils.HomeDirectory = homeDir
+	utils.ConfigDirPath = filepath.Join(homeDir, utils.ConfigDirName)
+	utils.LocalCredentialsDirectoryPath = filepath.Join(homeDir, utils.LocalCredentialsDirectoryName)
+	utils.EnvKeysAllFilePath = filepath.Join(utils.LocalCredentialsDirectoryPath, utils.EnvKeysAllFileName)
+	utils.MainConfigFilePath = filepath.Join(utils.ConfigDirPath, utils.MainConfigFileName)
+	utils.SampleMainConfigFilePath = filepath.Join(utils.ConfigDirPath, utils.SampleMainConfigFileName)
+	utils.DefaultAPISpecFilePath = filepath.Join(utils.ConfigDirPath, utils.DefaultAPISpecFileName)
+	utils.DefaultExportDirPath = filepath.Join(utils.ConfigDirPath, utils.DefaultExportDirName)
+	utils.DefaultCertDirPath = filepath.Join(utils.ConfigDirPath, utils.CertificatesDirName)
+	utils.DefaultClientCertDirPath = filepath.Join(utils.ConfigDirPath, utils.ClientCertificatesDirName)
+	utils.APIMClientCertDirPath = filepath.Join(utils.DefaultClientCertDirPath, "apim")
+	utils.MgwClientCertDirPath = filepath.Join(utils.DefaultClientCertDirPath, "mg")
+}
+
 // ExtractArchiveFile : Extract apictl distribution archive file
 func ExtractArchiveFile(path string) {
 	if archiveFileName == "" {
@@ -61,6 +61,10 @@ func Execute(t *testing.T, args ...string) (string, error) {
 	t.Log("base.Execute() - apictl command:", cmd.String())
 	// run command
 	output, err := cmd.Output()
+	// Errors are written to stderr, which belongs to the output of a failed command as seen on a terminal
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		output = append(output, exitErr.Stderr...)
+	}
 
 	t.Log("base.Execute() - apictl command output:", string(output))
 	return string(output), err
@@ -133,6 +137,26 @@ func MILogin(t *testing.T, env string, username string, password string) {
 	})
 }
 
+// SetupMGEnv : Adds a new microgateway environment and automatically removes it when the calling test function
+// execution ends
+func SetupMGEnv(t *testing.T, env, adapter string) {
+	Execute(t, "mg", "add", "env", env, "--adapter", adapter)
+
+	t.Cleanup(func() {
+		Execute(t, "mg", "remove", "env", env)
+	})
+}
+
+// MGLogin : Logs into a microgateway environment and automatically logs out when the calling test function
+// execution ends
+func MGLogin(t *testing.T, env string, username string, password string) {
+	Execute(t, "mg", "login", env, "-u", username, "-p", password, "-k", "--verbose")
+
+	t.Cleanup(func() {
+		Execute(t, "mg", "logout", env)
+	})
+}
+
 // IsAPIArchiveExists : Returns true if exported application archive exists on file system, else returns false
 //
 func IsAPIArchiveExists(t *testing.T, path string, name string, version string) bool {
@@ -134,6 +134,8 @@ func TestExportApisWithExportApisCommandDeprecated(t *testing.T) {
 }
 
 func TestListApiProductsDevopsTenantUserDeprecated(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	tenantDevopsUsername := devops.UserName + "@" + TENANT1
 	tenantDevopsPassword := devops.Password
 
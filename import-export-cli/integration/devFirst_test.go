@@ -83,6 +83,8 @@ import (
 
 //Initialize a project Initialize an API without any flag
 func TestInitializeProject(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			apim := GetDevClient()
@@ -122,6 +124,8 @@ func TestInitializeProject(t *testing.T) {
 
 // Initialize an API with --definition flag and import it
 func TestInitializeAPIWithDefinitionFlag(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			apim := GetDevClient()
@@ -145,6 +149,8 @@ func TestInitializeAPIWithDefinitionFlag(t *testing.T) {
 
 //Initialize an API from Swagger 2 Specification
 func TestInitializeAPIFromSwagger2Definition(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 	username := superAdminUser
@@ -163,6 +169,8 @@ func TestInitializeAPIFromSwagger2Definition(t *testing.T) {
 
 //Initialize an API from OpenAPI 3 Specification
 func TestInitializeAPIFromOpenAPI3Definition(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 	username := superAdminUser
@@ -181,6 +189,8 @@ func TestInitializeAPIFromOpenAPI3Definition(t *testing.T) {
 
 //Initialize an API from API Specification URL
 func TestInitializeAPIFromAPIDefinitionURL(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	username := superAdminUser
 	password := superAdminPassword
 	apim := GetDevClient()
@@ -199,6 +209,8 @@ func TestInitializeAPIFromAPIDefinitionURL(t *testing.T) {
 
 //Import API from initialized project with swagger 2 definition
 func TestImportProjectCreatedFromSwagger2Definition(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 	username := superAdminUser
@@ -222,6 +234,8 @@ func TestImportProjectCreatedFromSwagger2Definition(t *testing.T) {
 
 //Import API from initialized project with openAPI 3 definition
 func TestImportProjectCreatedFromOpenAPI3Definition(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 	username := superAdminUser
@@ -245,6 +259,8 @@ func TestImportProjectCreatedFromOpenAPI3Definition(t *testing.T) {
 
 // Import API from initialized project from API definition which is already in publisher with --update flag
 func TestImportProjectCreatedPassWhenAPIIsExisted(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			apim := GetDevClient()
@@ -285,6 +301,8 @@ func TestImportProjectCreatedPassWhenAPIIsExisted(t *testing.T) {
 
 //Import API from initialized project from API definition which is already in publisher without --update flag
 func TestImportProjectCreatedFailWhenAPIIsExisted(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 	username := superAdminUser
@@ -311,6 +329,8 @@ func TestImportProjectCreatedFailWhenAPIIsExisted(t *testing.T) {
 
 //Import Api with a Document and Export that Api with a Document
 func TestImportAndExportAPIWithDocument(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	username := superAdminUser
 	password := superAdminPassword
 	apim := GetDevClient()
@@ -349,6 +369,8 @@ func TestImportAndExportAPIWithDocument(t *testing.T) {
 
 //Import Api with an Image and Export that Api with an image (.png Type)
 func TestImportAndExportAPIWithPngIcon(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	username := superAdminUser
 	password := superAdminPassword
 	apim := GetDevClient()
@@ -379,6 +401,8 @@ func TestImportAndExportAPIWithPngIcon(t *testing.T) {
 
 //Import Api with an Image and Export that Api with an image (.jpeg Type)
 func TestImportAndExportAPIWithJpegImage(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetDevClient()
 	projectName := base.GenerateRandomName(16)
 	username := superAdminUser
@@ -409,6 +433,8 @@ func TestImportAndExportAPIWithJpegImage(t *testing.T) {
 
 //Import and export API with updated thumbnail and document and assert that
 func TestUpdateDocAndImageOfAPIOfExistingAPI(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apim := GetProdClient()
 	projectName := base.GenerateRandomName(16)
 	username := superAdminUser
@@ -475,6 +501,8 @@ func TestUpdateDocAndImageOfAPIOfExistingAPI(t *testing.T) {
 
 // Test a verified (syntactically correct) custom operation policy (sequence) update
 func TestAPIOperationPolicyUpdate(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -28,6 +28,8 @@ import (
 // (where the env variables have been already set) and check whether the env
 // variable values have been set correctly in the imported API
 func TestImportAPIProjectWithDynamicData(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -68,6 +70,8 @@ func TestImportAPIProjectWithDynamicData(t *testing.T) {
 // Initialize a project and import it with a params file with dynamic data
 // without setting the env variables
 func TestImportAPIProjectWithDynamicDataFailure(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -103,6 +107,8 @@ func TestImportAPIProjectWithDynamicDataFailure(t *testing.T) {
 // (where the env variables have been already set) and export it to check whether
 // the env variable values have been set correctly in the exported sequence
 func TestImportAPIProjectWithDynamicDataSequence(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -146,6 +152,8 @@ func TestImportAPIProjectWithDynamicDataSequence(t *testing.T) {
 // Initialize a project and import it with a sequence file with dynamic data
 // without setting the env variables
 func TestImportAPIProjectWithDynamicDataSequenceFailure(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -31,6 +31,8 @@ import (
 )
 
 func TestEnvironmentSpecificParamsEndpoint(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -66,6 +68,8 @@ func TestEnvironmentSpecificParamsEndpoint(t *testing.T) {
 // Add an API to one environment, export it and re-import it to another environment by setting
 // the configs for endpoints using the params file
 func TestEnvironmentSpecificParamsEndpointConfigs(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -118,6 +122,8 @@ func TestEnvironmentSpecificParamsEndpointConfigs(t *testing.T) {
 // Add an API to one environment, export it and re-import it to another environment
 // by disabling the endpoint security using the params file
 func TestEnvironmentSpecificParamsEndpointSecurityFalse(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -152,6 +158,8 @@ func TestEnvironmentSpecificParamsEndpointSecurityFalse(t *testing.T) {
 // Add an API to one environment, export it and re-import it to another environment by overriding the endpoint security
 // (with the security type digest), using the params file
 func TestEnvironmentSpecificParamsEndpointSecurityDigest(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -182,6 +190,8 @@ func TestEnvironmentSpecificParamsEndpointSecurityDigest(t *testing.T) {
 // Add an API to one environment, export it and re-import it to another environment by overriding the endpoint security
 // (with the security type basic), using the params file
 func TestEnvironmentSpecificParamsEndpointSecurityBasic(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -212,6 +222,8 @@ func TestEnvironmentSpecificParamsEndpointSecurityBasic(t *testing.T) {
 // Add an API to one environment, export it and re-import it to another environment by overriding the endpoint security
 // (with the security type oauth), using the params file
 func TestEnvironmentSpecificParamsEndpointSecurityOauth(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -241,6 +253,8 @@ func TestEnvironmentSpecificParamsEndpointSecurityOauth(t *testing.T) {
 
 // Import an API with the external params file that has HTTP/REST endpoints without load balancing or failover configs
 func TestHttpRestEndpointParamsWithoutLoadBalancingOrFailover(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -271,6 +285,8 @@ func TestHttpRestEndpointParamsWithoutLoadBalancingOrFailover(t *testing.T) {
 
 // Import an API with the external params file that has HTTP/SOAP endpoints without load balancing or failover configs.
 func TestHttpSoapEndpointParamsWithoutLoadBalancingOrFailover(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -300,6 +316,8 @@ func TestHttpSoapEndpointParamsWithoutLoadBalancingOrFailover(t *testing.T) {
 
 // Import an API with the external params file that has HTTP/REST endpoints with load balancing configs
 func TestHttpRestEndpointParamsWithLoadBalancing(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -330,6 +348,8 @@ func TestHttpRestEndpointParamsWithLoadBalancing(t *testing.T) {
 
 // Import an API with the external params file that has HTTP/SOAP endpoints with load balancing config
 func TestHttpSoapEndpointParamsWithLoadBalancing(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -359,6 +379,8 @@ func TestHttpSoapEndpointParamsWithLoadBalancing(t *testing.T) {
 
 // Import an API with the external params file that has HTTP/REST endpoints with failover config
 func TestHttpRestEndpointParamsWithFailover(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -389,6 +411,8 @@ func TestHttpRestEndpointParamsWithFailover(t *testing.T) {
 
 // Import an API with the external params file that has HTTP/SOAP endpoints with failover config
 func TestHttpSoapEndpointParamsWithFailover(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -419,6 +443,8 @@ func TestHttpSoapEndpointParamsWithFailover(t *testing.T) {
 
 // Import an API with the external params file that has AWS Lambda Endpoint with role supplied credentials configs
 func TestAwsLambdaEndpointParamsWithRoleSupplied(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -449,6 +475,8 @@ func TestAwsLambdaEndpointParamsWithRoleSupplied(t *testing.T) {
 
 // Import an API with the external params file that has AWS Lambda Endpoint with stored credentials config
 func TestAwsLambdaEndpointParamsWithStoredCred(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -478,6 +506,8 @@ func TestAwsLambdaEndpointParamsWithStoredCred(t *testing.T) {
 
 // Import an API with the external params file that has Dynamic endpoint config
 func TestDynamicEndpointParams(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -508,6 +538,8 @@ func TestDynamicEndpointParams(t *testing.T) {
 // Export an API from one environment and generate the deployment directory for that. Import it to another environment with the params
 // and certificates. Validate the imported API with the used params. Again, re-export it to validate the certs.
 func TestExportApiGenDeploymentDirImportSuperTenant(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -543,6 +575,8 @@ func TestExportApiGenDeploymentDirImportSuperTenant(t *testing.T) {
 // Export an API Product from one environment and generate the deployment directory for that. Import it to another environment with the params
 // and certificates. Validate the imported API Product with the used params. Again, re-export it to validate the certs.
 func TestExportApiProductGenDeploymentDirImport(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
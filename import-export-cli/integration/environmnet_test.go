@@ -136,6 +136,8 @@ func TestRemoveEnvironmentWithoutLoggedInUsers(t *testing.T) {
 
 //Change Export directory using apictl and assert the change
 func TestChangeExportDirectory(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	dev := GetDevClient()
 	changedExportDirectory, _ := filepath.Abs(testutils.CustomTestExportDirectory)
 
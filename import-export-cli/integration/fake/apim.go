@@ -0,0 +1,489 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package fake
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// APIMDefaultPort : Port of an API Manager without a port offset, used to express the fake as a port offset
+	APIMDefaultPort = 9443
+
+	// AdminUsername : Username of the super tenant admin the fake API Manager starts with
+	AdminUsername = "admin"
+	// AdminPassword : Password of the super tenant admin the fake API Manager starts with
+	AdminPassword = "admin"
+
+	adminRole = "admin"
+
+	scopeAdmin                  = "apim:admin"
+	scopeAPIView                = "apim:api_view"
+	scopeAPICreate              = "apim:api_create"
+	scopeAPIPublish             = "apim:api_publish"
+	scopeAPIDelete              = "apim:api_delete"
+	scopeAPIImportExport        = "apim:api_import_export"
+	scopeAPIProductImportExport = "apim:api_product_import_export"
+	scopeAppImportExport        = "apim:app_import_export"
+	scopeAppManage              = "apim:app_manage"
+	scopeAppOwnerChange         = "apim:app_owner_change"
+	scopeSubManage              = "apim:sub_manage"
+	scopeSubscribe              = "apim:subscribe"
+	scopeDefault                = "default"
+
+	accessTokenValiditySeconds  = 3600
+	refreshTokenValiditySeconds = 86400
+)
+
+// roleScopes : Scopes each role is allowed to obtain, following the default role to scope mapping of API Manager
+var roleScopes = map[string][]string{
+	adminRole: {scopeAdmin, scopeAPIView, scopeAPICreate, scopeAPIPublish, scopeAPIDelete, scopeAPIImportExport,
+		scopeAPIProductImportExport, scopeAppImportExport, scopeAppManage, scopeAppOwnerChange, scopeSubManage,
+		scopeSubscribe},
+	"Internal/devops": {scopeAPIView, scopeAPICreate, scopeAPIPublish, scopeAPIDelete, scopeAPIImportExport,
+		scopeAPIProductImportExport, scopeAppImportExport, scopeAppManage, scopeSubManage, scopeSubscribe},
+	"Internal/creator":    {scopeAPIView, scopeAPICreate, scopeAPIDelete},
+	"Internal/publisher":  {scopeAPIView, scopeAPIPublish},
+	"Internal/subscriber": {scopeAppManage, scopeSubManage, scopeSubscribe},
+}
+
+// APIM : In-memory fake of an API Manager instance, serving the SOAP user and tenant admin services, DCR, the token
+// endpoint and the subset of the Publisher, DevPortal and Admin REST APIs used by apictl
+type APIM struct {
+	server *httptest.Server
+	mutex  sync.Mutex
+
+	tenants       map[string]*tenant
+	clients       map[string]*oauthClient
+	accessTokens  map[string]*session
+	refreshTokens map[string]*session
+	apis          map[string]*apiRecord
+	applications  map[string]*applicationRecord
+	subscriptions map[string]*subscriptionRecord
+	nextTenantID  int
+	nextSequence  int
+}
+
+type tenant struct {
+	id     int
+	domain string
+	active bool
+	users  map[string]*user
+}
+
+type user struct {
+	password string
+	roles    []string
+}
+
+type oauthClient struct {
+	secret string
+	owner  string
+}
+
+// session : Identity and granted scopes behind an access token
+type session struct {
+	name         string
+	tenantDomain string
+	scopes       map[string]bool
+}
+
+func (s *session) username() string {
+	return qualifiedUsername(s.name, s.tenantDomain)
+}
+
+// hasAnyScope : Check whether the token was granted at least one of the scopes
+func (s *session) hasAnyScope(scopes ...string) bool {
+	for _, scope := range scopes {
+		if s.scopes[scope] {
+			return true
+		}
+	}
+	return false
+}
+
+// NewAPIM : Start a fake API Manager with the super tenant and its admin user
+func NewAPIM() *APIM {
+	apim := &APIM{
+		tenants:       make(map[string]*tenant),
+		clients:       make(map[string]*oauthClient),
+		accessTokens:  make(map[string]*session),
+		refreshTokens: make(map[string]*session),
+		apis:          make(map[string]*apiRecord),
+		applications:  make(map[string]*applicationRecord),
+		subscriptions: make(map[string]*subscriptionRecord),
+		nextTenantID:  1,
+	}
+	superTenant := apim.addTenant(DefaultTenantDomain, AdminUsername, AdminPassword)
+	superTenant.id = -1234
+	superTenant.active = true
+	apim.server = newTLSServer(apim)
+	return apim
+}
+
+// URL : Base URL of the fake API Manager
+func (apim *APIM) URL() string {
+	return apim.server.URL
+}
+
+// Host : Host of the fake API Manager
+func (apim *APIM) Host() string {
+	host, _ := hostAndPort(apim.server)
+	return host
+}
+
+// PortOffset : Port offset of the fake API Manager, relative to the default API Manager port
+func (apim *APIM) PortOffset() int {
+	_, port := hostAndPort(apim.server)
+	return port - APIMDefaultPort
+}
+
+// Close : Shut down the fake API Manager
+func (apim *APIM) Close() {
+	apim.server.Close()
+}
+
+// ServeHTTP : Route a request to the SOAP admin services, the OAuth endpoints or the REST APIs
+func (apim *APIM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	apim.mutex.Lock()
+	defer apim.mutex.Unlock()
+
+	segments := splitPath(r.URL.Path)
+	switch {
+	case len(segments) == 2 && segments[0] == "services":
+		apim.serveSOAP(w, r, segments[1])
+	case len(segments) == 3 && segments[0] == "client-registration" && segments[2] == "register":
+		apim.registerClient(w, r)
+	case len(segments) == 2 && segments[0] == "oauth2" && segments[1] == "token":
+		apim.issueToken(w, r)
+	case len(segments) == 2 && segments[0] == "oauth2" && segments[1] == "revoke":
+		apim.revokeToken(w, r)
+	case len(segments) >= 4 && segments[0] == "api" && segments[1] == "am":
+		s, ok := apim.authenticate(r)
+		if !ok {
+			writeError(w, http.StatusUnauthorized, "Invalid Credentials. Make sure you have provided the correct "+
+				"security credentials")
+			return
+		}
+		switch segments[2] {
+		case "publisher":
+			apim.servePublisher(w, r, s, segments[4:])
+		case "devportal":
+			apim.serveDevPortal(w, r, s, segments[4:])
+		case "admin":
+			apim.serveAdmin(w, r, s, segments[4:])
+		default:
+			writeError(w, http.StatusNotFound, "No matching resource found for "+r.URL.Path)
+		}
+	default:
+		writeError(w, http.StatusNotFound, "No matching resource found for "+r.URL.Path)
+	}
+}
+
+func (apim *APIM) addTenant(domain, adminName, adminPassword string) *tenant {
+	t := &tenant{id: apim.nextTenantID, domain: domain, users: make(map[string]*user)}
+	apim.nextTenantID++
+	t.users[adminName] = &user{password: adminPassword, roles: []string{adminRole}}
+	apim.tenants[domain] = t
+	return t
+}
+
+// findUser : Resolve a username with its password to a user of an active tenant
+func (apim *APIM) findUser(username, password string) (*user, string, string, bool) {
+	name, domain := splitUsername(username)
+	t, ok := apim.tenants[domain]
+	if !ok || !t.active {
+		return nil, "", "", false
+	}
+	u, ok := t.users[name]
+	if !ok || u.password != password {
+		return nil, "", "", false
+	}
+	return u, name, domain, true
+}
+
+func (apim *APIM) authenticate(r *http.Request) (*session, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, authorizationBearer) {
+		return nil, false
+	}
+	s, ok := apim.accessTokens[strings.TrimPrefix(header, authorizationBearer)]
+	return s, ok
+}
+
+// registerClient : Handle the DCR request of a user, authenticated with basic auth
+func (apim *APIM) registerClient(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "Basic authentication is required for client registration")
+		return
+	}
+	if _, _, _, ok := apim.findUser(username, password); !ok {
+		writeError(w, http.StatusUnauthorized, "Authentication failed for "+username)
+		return
+	}
+	var request struct {
+		ClientName  string `json:"clientName"`
+		CallbackURL string `json:"callbackUrl"`
+		GrantType   string `json:"grantType"`
+	}
+	if err := jsonDecode(r.Body, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The client properties are returned as a serialized JSON object, as done by the key manager
+	properties, _ := json.Marshal(map[string]string{
+		"username":      username,
+		"client_name":   request.ClientName,
+		"redirect_uris": request.CallbackURL,
+		"grant_types":   request.GrantType,
+	})
+	clientID, clientSecret := newID(), newID()
+	apim.clients[clientID] = &oauthClient{secret: clientSecret, owner: username}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"clientId":          clientID,
+		"clientSecret":      clientSecret,
+		"clientName":        request.ClientName,
+		"callBackURL":       request.CallbackURL,
+		"isSaasApplication": true,
+		"appOwner":          username,
+		"jsonString":        string(properties),
+	})
+}
+
+// issueToken : Handle the password, client credentials and refresh token grants of a registered client. The grant parameters are
+// accepted both from the form body and from the query string
+func (apim *APIM) issueToken(w http.ResponseWriter, r *http.Request) {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if client, found := apim.clients[clientID]; !ok || !found || client.secret != clientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client",
+			"error_description": "Client Authentication failed."})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+	var s *session
+	switch r.Form.Get("grant_type") {
+	case "client_credentials":
+		name, domain := splitUsername(apim.clients[clientID].owner)
+		s = &session{name: name, tenantDomain: domain, scopes: map[string]bool{scopeDefault: true}}
+	case "password":
+		u, name, domain, found := apim.findUser(r.Form.Get("username"), r.Form.Get("password"))
+		if !found {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant",
+				"error_description": "Authentication failed for " + r.Form.Get("username")})
+			return
+		}
+		s = &session{name: name, tenantDomain: domain, scopes: grantScopes(u.roles, r.Form.Get("scope"))}
+	case "refresh_token":
+		previous, found := apim.refreshTokens[r.Form.Get("refresh_token")]
+		if !found {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant",
+				"error_description": "Persisted access token data not found"})
+			return
+		}
+		delete(apim.refreshTokens, r.Form.Get("refresh_token"))
+		s = previous
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type",
+			"error_description": "Unsupported grant_type value"})
+		return
+	}
+	accessToken, refreshToken := newID(), newID()
+	apim.accessTokens[accessToken] = s
+	apim.refreshTokens[refreshToken] = s
+	var scopes []string
+	for scope := range s.scopes {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"scope":         strings.Join(scopes, " "),
+		"token_type":    "Bearer",
+		"expires_in":    accessTokenValiditySeconds,
+	})
+}
+
+// grantScopes : Scopes granted for a token request, which are the requested scopes the roles are allowed to obtain
+func grantScopes(roles []string, requested string) map[string]bool {
+	allowed := make(map[string]bool)
+	for _, role := range roles {
+		for _, scope := range roleScopes[role] {
+			allowed[scope] = true
+		}
+	}
+	granted := map[string]bool{scopeDefault: true}
+	for _, scope := range strings.Fields(requested) {
+		if allowed[scope] {
+			granted[scope] = true
+		}
+	}
+	return granted
+}
+
+func (apim *APIM) revokeToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	delete(apim.accessTokens, r.Form.Get("token"))
+	delete(apim.refreshTokens, r.Form.Get("token"))
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveSOAP : Handle the RemoteUserStoreManagerService and TenantMgtAdminService operations used to prepare users
+// and tenants. Operations are selected by the SOAPAction header
+func (apim *APIM) serveSOAP(w http.ResponseWriter, r *http.Request, service string) {
+	username, password, _ := r.BasicAuth()
+	caller, _, domain, ok := apim.findUser(username, password)
+	if !ok || !hasRole(caller, adminRole) {
+		writeSOAPFault(w, http.StatusUnauthorized, "Access denied for "+username)
+		return
+	}
+	fields, err := parseSOAPFields(r.Body)
+	if err != nil {
+		writeSOAPFault(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	field := func(name string) string {
+		if values := fields[name]; len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	action := strings.TrimPrefix(strings.Trim(r.Header.Get("SOAPAction"), "\""), "urn:")
+
+	switch service {
+	case "RemoteUserStoreManagerService":
+		t := apim.tenants[domain]
+		switch action {
+		case "addUser":
+			t.users[field("userName")] = &user{password: field("credential"), roles: fields["roleList"]}
+			w.WriteHeader(http.StatusAccepted)
+		case "deleteUser":
+			delete(t.users, field("userName"))
+			w.WriteHeader(http.StatusAccepted)
+		case "isExistingUser":
+			_, exists := t.users[field("userName")]
+			writeSOAPResponse(w, action, "<ns:return>"+strconv.FormatBool(exists)+"</ns:return>")
+		case "addRole", "deleteRole":
+			writeSOAPResponse(w, action, "")
+		default:
+			writeSOAPFault(w, http.StatusBadRequest, "Unsupported operation "+action)
+		}
+	case "TenantMgtAdminService":
+		if domain != DefaultTenantDomain {
+			writeSOAPFault(w, http.StatusUnauthorized, "Tenants can only be managed by the super tenant admin")
+			return
+		}
+		t := apim.tenants[field("tenantDomain")]
+		switch action {
+		case "addTenant":
+			if t == nil {
+				t = apim.addTenant(field("tenantDomain"), field("admin"), field("adminPassword"))
+			}
+			writeSOAPResponse(w, action, "<ns:return>"+strconv.Itoa(t.id)+"</ns:return>")
+		case "getTenant":
+			id, active := 0, false
+			if t != nil {
+				id, active = t.id, t.active
+			}
+			writeSOAPResponse(w, action, fmt.Sprintf("<ns:return>\n<ax:active>%t</ax:active>\n"+
+				"<ax:tenantDomain>%s</ax:tenantDomain>\n<ax:tenantId>%d</ax:tenantId>\n</ns:return>",
+				active, field("tenantDomain"), id))
+		case "activateTenant", "deactivateTenant":
+			if t == nil {
+				writeSOAPFault(w, http.StatusInternalServerError, "Tenant "+field("tenantDomain")+" does not exist")
+				return
+			}
+			t.active = action == "activateTenant"
+			writeSOAPResponse(w, action, "")
+		default:
+			writeSOAPFault(w, http.StatusBadRequest, "Unsupported operation "+action)
+		}
+	default:
+		writeSOAPFault(w, http.StatusNotFound, "Unsupported service "+service)
+	}
+}
+
+func hasRole(u *user, role string) bool {
+	for _, r := range u.roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSOAPFields : Collect the text of the elements of a SOAP envelope by their local name, so that requests are
+// read regardless of the namespace prefixes used
+func parseSOAPFields(body io.Reader) (map[string][]string, error) {
+	fields := make(map[string][]string)
+	decoder := xml.NewDecoder(body)
+	current := ""
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return fields, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch element := token.(type) {
+		case xml.StartElement:
+			current = element.Name.Local
+		case xml.CharData:
+			if text := strings.TrimSpace(string(element)); text != "" && current != "" {
+				fields[current] = append(fields[current], text)
+			}
+		case xml.EndElement:
+			current = ""
+		}
+	}
+}
+
+func writeSOAPResponse(w http.ResponseWriter, action, content string) {
+	w.Header().Set(headerContentType, contentTypeXML)
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "<soapenv:Envelope xmlns:soapenv=\"http://schemas.xmlsoap.org/soap/envelope/\">\n<soapenv:Body>\n"+
+		"<ns:%sResponse xmlns:ns=\"http://service.ws.um.carbon.wso2.org\" "+
+		"xmlns:ax=\"http://beans.common.stratos.carbon.wso2.org/xsd\">\n%s\n</ns:%sResponse>\n"+
+		"</soapenv:Body>\n</soapenv:Envelope>\n", action, content, action)
+}
+
+func writeSOAPFault(w http.ResponseWriter, status int, message string) {
+	w.Header().Set(headerContentType, contentTypeXML)
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<soapenv:Envelope xmlns:soapenv=\"http://schemas.xmlsoap.org/soap/envelope/\"><soapenv:Body>"+
+		"<soapenv:Fault><faultcode>soapenv:Server</faultcode><faultstring>%s</faultstring></soapenv:Fault>"+
+		"</soapenv:Body></soapenv:Envelope>", message)
+}
@@ -0,0 +1,824 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package fake
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	miServiceURL         = "http://localhost:8290"
+	cAppDescriptor       = "artifacts.xml"
+	artifactDescriptor   = "artifact.xml"
+	connectorDescriptor  = "connector.xml"
+	artifactTypeCApp     = "carbon/application"
+	statisticsEnabled    = "enabled"
+	statisticsDisabled   = "disabled"
+	stateActive          = "active"
+	stateInactive        = "inactive"
+	settingEnable        = "enable"
+	settingDisable       = "disable"
+	templateTypeSequence = "sequence"
+	templateTypeEndpoint = "endpoint"
+)
+
+// artifactResources : Management API resources of the artifact types a CApp can deploy
+var artifactResources = map[string]string{
+	"synapse/api":                "apis",
+	"synapse/proxy-service":      "proxy-services",
+	"synapse/endpoint":           "endpoints",
+	"synapse/inbound-endpoint":   "inbound-endpoints",
+	"synapse/sequence":           "sequences",
+	"synapse/task":               "tasks",
+	"synapse/template":           "templates",
+	"synapse/local-entry":        "local-entries",
+	"synapse/message-store":      "message-stores",
+	"synapse/message-processors": "message-processors",
+	"synapse/lib":                "connectors",
+	"service/dataservice":        "data-services",
+}
+
+// artifactQueryKeys : Query parameters naming a single artifact of a resource, of which the first is the one
+// documented for the management API
+var artifactQueryKeys = map[string][]string{
+	"apis":               {"apiName"},
+	"proxy-services":     {"proxyServiceName"},
+	"endpoints":          {"endpointName"},
+	"inbound-endpoints":  {"inboundEndpointName"},
+	"sequences":          {"sequenceName"},
+	"tasks":              {"taskName"},
+	"local-entries":      {"name", "localEntryName"},
+	"message-stores":     {"name", "messageStoreName"},
+	"message-processors": {"name", "messageProcessorName"},
+	"data-services":      {"dataServiceName"},
+	"applications":       {"carbonAppName"},
+}
+
+// cApp : Carbon application deployed in the fake Micro Integrator
+type cApp struct {
+	name      string
+	version   string
+	artifacts []*synapseArtifact
+}
+
+// synapseArtifact : Artifact deployed from a CApp with its configuration and runtime state
+type synapseArtifact struct {
+	name         string
+	artifactType string
+	fileName     string
+	container    string
+	config       []byte
+	root         xmlNode
+	active       bool
+	statistics   bool
+	tracing      bool
+}
+
+// xmlNode : Generic element of a synapse configuration
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Content  string     `xml:",chardata"`
+	Children []xmlNode  `xml:",any"`
+}
+
+func (n *xmlNode) attr(name string) string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (n *xmlNode) child(name string) *xmlNode {
+	for i := range n.Children {
+		if n.Children[i].XMLName.Local == name {
+			return &n.Children[i]
+		}
+	}
+	return nil
+}
+
+func (n *xmlNode) children(name string) []xmlNode {
+	var children []xmlNode
+	for _, child := range n.Children {
+		if child.XMLName.Local == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// parameters : Name and value of the parameter children of an element
+func (n *xmlNode) parameters() map[string]string {
+	parameters := make(map[string]string)
+	for _, parameter := range n.children("parameter") {
+		parameters[parameter.attr("name")] = strings.TrimSpace(parameter.Content)
+	}
+	return parameters
+}
+
+// DeployCApp : Deploy the artifacts of a CApp file the way the Micro Integrator hot deploys a CApp copied to its
+// carbonapps directory. A CApp with the name of a deployed one replaces it
+func (mi *MI) DeployCApp(filePath string) error {
+	mi.mutex.Lock()
+	defer mi.mutex.Unlock()
+
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+	files := make(map[string]*zip.File)
+	for _, file := range reader.File {
+		files[path.Clean(strings.ReplaceAll(file.Name, "\\", "/"))] = file
+	}
+
+	var descriptor struct {
+		Artifacts []struct {
+			Name         string `xml:"name,attr"`
+			Version      string `xml:"version,attr"`
+			Type         string `xml:"type,attr"`
+			Dependencies []struct {
+				Artifact string `xml:"artifact,attr"`
+				Version  string `xml:"version,attr"`
+			} `xml:"dependency"`
+		} `xml:"artifact"`
+	}
+	if err := readZipXML(files, cAppDescriptor, &descriptor); err != nil {
+		return err
+	}
+	if len(descriptor.Artifacts) != 1 || descriptor.Artifacts[0].Type != artifactTypeCApp {
+		return errors.New(filePath + " is not a carbon application")
+	}
+
+	app := &cApp{name: descriptor.Artifacts[0].Name, version: descriptor.Artifacts[0].Version}
+	for _, dependency := range descriptor.Artifacts[0].Dependencies {
+		artifact, err := readArtifact(files, dependency.Artifact+"_"+dependency.Version, app.name)
+		if err != nil {
+			return err
+		}
+		app.artifacts = append(app.artifacts, artifact)
+	}
+
+	mi.undeployCApp(app.name)
+	for _, artifact := range app.artifacts {
+		resource := artifactResources[artifact.artifactType]
+		if mi.artifacts[resource] == nil {
+			mi.artifacts[resource] = make(map[string]*synapseArtifact)
+		}
+		mi.artifacts[resource][artifact.name] = artifact
+	}
+	mi.cApps = append(mi.cApps, app)
+	mi.log("INFO", "ApplicationManager", "Successfully Deployed Carbon Application : "+app.name+
+		"_"+app.version)
+	return nil
+}
+
+func (mi *MI) undeployCApp(name string) {
+	for i, app := range mi.cApps {
+		if app.name != name {
+			continue
+		}
+		for _, artifact := range app.artifacts {
+			delete(mi.artifacts[artifactResources[artifact.artifactType]], artifact.name)
+		}
+		mi.cApps = append(mi.cApps[:i], mi.cApps[i+1:]...)
+		return
+	}
+}
+
+// readArtifact : Read an artifact of a CApp from the artifact.xml in its directory and the configuration it names
+func readArtifact(files map[string]*zip.File, directory, container string) (*synapseArtifact, error) {
+	var descriptor struct {
+		Name string `xml:"name,attr"`
+		Type string `xml:"type,attr"`
+		File string `xml:"file"`
+	}
+	if err := readZipXML(files, path.Join(directory, artifactDescriptor), &descriptor); err != nil {
+		return nil, err
+	}
+	if _, ok := artifactResources[descriptor.Type]; !ok {
+		return nil, errors.New("unsupported artifact type " + descriptor.Type + " of " + descriptor.Name)
+	}
+	config, err := readZipFile(files, path.Join(directory, descriptor.File))
+	if err != nil {
+		return nil, err
+	}
+
+	artifact := &synapseArtifact{
+		name:         descriptor.Name,
+		artifactType: descriptor.Type,
+		fileName:     descriptor.File,
+		container:    container,
+		config:       config,
+		active:       true,
+	}
+	if descriptor.Type == "synapse/lib" {
+		// A connector is a zip archive of which the descriptor holds the component name the connector is used by
+		connector, err := zip.NewReader(bytes.NewReader(config), int64(len(config)))
+		if err != nil {
+			return nil, err
+		}
+		connectorFiles := make(map[string]*zip.File)
+		for _, file := range connector.File {
+			connectorFiles[path.Clean(file.Name)] = file
+		}
+		if err := readZipXML(connectorFiles, connectorDescriptor, &artifact.root); err != nil {
+			return nil, err
+		}
+		return artifact, nil
+	}
+	if err := xml.Unmarshal(config, &artifact.root); err != nil {
+		return nil, err
+	}
+	artifact.statistics = isEnabled(artifact.root.attr("statistics"))
+	artifact.tracing = isEnabled(artifact.root.attr("trace"))
+	if artifact.root.attr("startOnLoad") == "false" {
+		artifact.active = false
+	}
+	if active, ok := artifact.root.parameters()["is.active"]; ok && active == "false" {
+		artifact.active = false
+	}
+	return artifact, nil
+}
+
+func readZipFile(files map[string]*zip.File, name string) ([]byte, error) {
+	file, ok := files[name]
+	if !ok {
+		return nil, errors.New(name + " not found in the archive")
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+func readZipXML(files map[string]*zip.File, name string, v interface{}) error {
+	data, err := readZipFile(files, name)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(data, v)
+}
+
+func isEnabled(value string) bool {
+	return value == settingEnable || value == "true"
+}
+
+func enabledText(enabled bool) string {
+	if enabled {
+		return statisticsEnabled
+	}
+	return statisticsDisabled
+}
+
+// serveArtifacts : Handle the resources of the deployed artifacts and CApps
+func (mi *MI) serveArtifacts(w http.ResponseWriter, r *http.Request, segments []string) {
+	resource := segments[0]
+	switch {
+	case len(segments) == 1 && resource == "applications" && r.Method == http.MethodGet:
+		mi.serveCApps(w, r)
+	case len(segments) == 1 && resource == "templates" && r.Method == http.MethodGet:
+		mi.serveTemplates(w, r)
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		if _, ok := artifactQueryKeys[resource]; !ok && resource != "connectors" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		name, named := artifactNameParam(r, resource)
+		if !named {
+			var list []interface{}
+			for _, artifact := range mi.sortedArtifacts(resource) {
+				list = append(list, artifactSummary(resource, artifact))
+			}
+			writeMIList(w, list)
+			return
+		}
+		artifact, exists := mi.artifacts[resource][name]
+		if !exists {
+			writeArtifactNotFound(w, resource, name)
+			return
+		}
+		writeJSON(w, http.StatusOK, artifactDetail(resource, artifact))
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		mi.updateArtifact(w, r, resource)
+	case matchPath(segments, "message-stores", "messages"):
+		mi.serveMessageStoreMessages(w, r)
+	case matchPath(segments, "message-processors", "head-message") && r.Method == http.MethodPost:
+		mi.serveHeadMessage(w, r)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func artifactNameParam(r *http.Request, resource string) (string, bool) {
+	for _, key := range artifactQueryKeys[resource] {
+		if values, ok := r.URL.Query()[key]; ok {
+			return values[0], true
+		}
+	}
+	return "", false
+}
+
+func (mi *MI) sortedArtifacts(resource string) []*synapseArtifact {
+	var artifacts []*synapseArtifact
+	for _, artifact := range mi.artifacts[resource] {
+		artifacts = append(artifacts, artifact)
+	}
+	sort.Slice(artifacts, func(i, j int) bool {
+		return artifacts[i].name < artifacts[j].name
+	})
+	return artifacts
+}
+
+// writeArtifactNotFound : Report a missing artifact, which the management API does with an error message for some
+// resources and with an empty 404 for the others
+func writeArtifactNotFound(w http.ResponseWriter, resource, name string) {
+	switch resource {
+	case "tasks":
+		writeMIError(w, http.StatusNotFound, "Specified task "+name+" not found")
+	case "local-entries":
+		writeMIError(w, http.StatusNotFound, "Reference for "+name+" could not be resolved")
+	case "message-stores":
+		writeMIError(w, http.StatusNotFound, "Specified message store ('"+name+"') not found")
+	case "message-processors":
+		writeMIError(w, http.StatusNotFound, "Specified message processor ('"+name+"') not found")
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// artifactSummary : List item of an artifact
+func artifactSummary(resource string, artifact *synapseArtifact) map[string]interface{} {
+	detail := artifactDetail(resource, artifact)
+	summary := map[string]interface{}{"name": artifact.name}
+	var fields []string
+	switch resource {
+	case "apis":
+		fields = []string{"url"}
+	case "proxy-services", "data-services":
+		fields = []string{"wsdl1_1", "wsdl2_0"}
+	case "endpoints":
+		fields = []string{"type", "isActive"}
+	case "inbound-endpoints":
+		fields = []string{"protocol"}
+	case "sequences":
+		fields = []string{"container", "stats", "tracing"}
+	case "local-entries", "message-processors":
+		fields = []string{"type", "status"}
+	case "message-stores":
+		fields = []string{"type", "size"}
+	case "connectors":
+		fields = []string{"status", "package", "description"}
+		summary["name"] = detail["name"]
+	}
+	for _, field := range fields {
+		if value, ok := detail[field]; ok {
+			summary[field] = value
+		}
+	}
+	return summary
+}
+
+// artifactDetail : Artifact as returned by the management API when it is requested by name
+func artifactDetail(resource string, artifact *synapseArtifact) map[string]interface{} {
+	root := &artifact.root
+	detail := map[string]interface{}{
+		"name":          artifact.name,
+		"configuration": string(artifact.config),
+	}
+	switch resource {
+	case "apis":
+		var resources []interface{}
+		for _, apiResource := range root.children("resource") {
+			url := apiResource.attr("uri-template")
+			if url == "" {
+				url = apiResource.attr("url-mapping")
+			}
+			resources = append(resources, map[string]interface{}{
+				"methods": strings.Fields(apiResource.attr("methods")),
+				"url":     url,
+			})
+		}
+		detail["url"] = miServiceURL + root.attr("context")
+		detail["version"] = root.attr("version")
+		detail["resources"] = resources
+		detail["stats"] = enabledText(artifact.statistics)
+		detail["tracing"] = enabledText(artifact.tracing)
+	case "proxy-services":
+		detail["wsdl1_1"] = miServiceURL + "/services/" + artifact.name + "?wsdl"
+		detail["wsdl2_0"] = miServiceURL + "/services/" + artifact.name + "?wsdl2"
+		detail["isRunning"] = artifact.active
+		detail["stats"] = enabledText(artifact.statistics)
+		detail["tracing"] = enabledText(artifact.tracing)
+	case "endpoints":
+		detail["isActive"] = artifact.active
+		detail["stats"] = enabledText(artifact.statistics)
+		detail["tracing"] = enabledText(artifact.tracing)
+		if len(root.Children) > 0 {
+			definition := &root.Children[0]
+			detail["type"] = definition.XMLName.Local
+			detail["method"] = strings.ToUpper(definition.attr("method"))
+			detail["uriTemplate"] = definition.attr("uri-template")
+			detail["address"] = definition.attr("uri")
+			detail["serviceName"] = definition.attr("service")
+			detail["portName"] = definition.attr("port")
+			detail["wsdlUri"] = definition.attr("uri")
+			if definition.XMLName.Local != "wsdl" {
+				detail["wsdlUri"] = ""
+			}
+		}
+	case "inbound-endpoints":
+		parameters := []interface{}{}
+		if definition := root.child("parameters"); definition != nil {
+			for _, parameter := range definition.children("parameter") {
+				parameters = append(parameters, map[string]string{
+					"name":  parameter.attr("name"),
+					"value": strings.TrimSpace(parameter.Content),
+				})
+			}
+		}
+		detail["protocol"] = root.attr("protocol")
+		detail["sequence"] = root.attr("sequence")
+		detail["error"] = root.attr("onError")
+		detail["parameters"] = parameters
+		detail["stats"] = enabledText(artifact.statistics)
+		detail["tracing"] = enabledText(artifact.tracing)
+	case "sequences":
+		mediators := []string{}
+		for _, mediator := range root.Children {
+			mediators = append(mediators, mediator.XMLName.Local)
+		}
+		detail["container"] = "[ Deployed From Artifact Container: " + artifact.container + " ] "
+		detail["mediators"] = mediators
+		detail["stats"] = enabledText(artifact.statistics)
+		detail["tracing"] = enabledText(artifact.tracing)
+	case "tasks":
+		trigger := root.child("trigger")
+		if trigger == nil {
+			trigger = &xmlNode{}
+		}
+		detail["triggerType"] = "simple"
+		if trigger.attr("cron") != "" {
+			detail["triggerType"] = "cron"
+		}
+		detail["cronExpression"] = trigger.attr("cron")
+		detail["triggerCount"] = trigger.attr("count")
+		detail["triggerInterval"] = trigger.attr("interval")
+		detail["group"] = root.attr("group")
+		detail["implementation"] = root.attr("class")
+	case "local-entries":
+		entryType := "Inline Text"
+		switch {
+		case root.attr("src") != "":
+			entryType = "Remote URL"
+		case len(root.Children) > 0:
+			entryType = "Inline XML"
+		}
+		detail["type"] = entryType
+		detail["value"] = strings.TrimSpace(root.Content)
+		if root.attr("src") != "" {
+			detail["value"] = root.attr("src")
+		}
+	case "message-stores":
+		class := root.attr("class")
+		storeClass := class[strings.LastIndex(class, ".")+1:]
+		detail["type"] = messageStoreType(storeClass)
+		detail["file"] = artifact.fileName
+		detail["container"] = "[ Deployed From Artifact Container: " + artifact.container + " ] "
+		detail["producer"] = strings.TrimSuffix(class, "Store") + "Producer"
+		detail["consumer"] = strings.TrimSuffix(class, "Store") + "Consumer"
+		detail["properties"] = root.parameters()
+		detail["size"] = 0
+	case "message-processors":
+		status := stateInactive
+		if artifact.active {
+			status = stateActive
+		}
+		class := root.attr("class")
+		detail["type"] = messageProcessorType(class[strings.LastIndex(class, ".")+1:])
+		detail["fileName"] = artifact.fileName
+		detail["messageStore"] = root.attr("messageStore")
+		detail["artifactContainer"] = "[ Deployed From Artifact Container: " + artifact.container + " ] "
+		detail["parameters"] = root.parameters()
+		detail["status"] = status
+	case "connectors":
+		component := root.child("component")
+		if component == nil {
+			component = &xmlNode{}
+		}
+		description := ""
+		if node := component.child("description"); node != nil {
+			description = strings.TrimSpace(node.Content)
+		}
+		detail["name"] = component.attr("name")
+		detail["package"] = component.attr("package")
+		detail["description"] = description
+		detail["status"] = statisticsEnabled
+	case "data-services":
+		queries := []interface{}{}
+		for _, query := range root.children("query") {
+			queries = append(queries, map[string]string{
+				"id":        query.attr("id"),
+				"namespace": "http://ws.wso2.org/dataservice/" + query.attr("id"),
+			})
+		}
+		description := ""
+		if node := root.child("description"); node != nil {
+			description = strings.TrimSpace(node.Content)
+		}
+		detail["serviceName"] = artifact.name
+		detail["serviceDescription"] = description
+		detail["serviceGroupName"] = artifact.name
+		detail["wsdl1_1"] = miServiceURL + "/services/" + artifact.name + "?wsdl"
+		detail["wsdl2_0"] = miServiceURL + "/services/" + artifact.name + "?wsdl2"
+		detail["queries"] = queries
+	}
+	return detail
+}
+
+// messageStoreType : Type name the management API reports for a message store implementation
+func messageStoreType(storeClass string) string {
+	switch storeClass {
+	case "InMemoryStore":
+		return "in-memory-message-store"
+	case "JmsStore":
+		return "jms-message-store"
+	case "RabbitMQStore":
+		return "rabbitmq-message-store"
+	case "JDBCMessageStore":
+		return "jdbc-message-store"
+	case "ResequenceMessageStore":
+		return "resequence-message-store"
+	}
+	return "custom-message-store"
+}
+
+// messageProcessorType : Type name the management API reports for a message processor implementation
+func messageProcessorType(processorClass string) string {
+	switch processorClass {
+	case "ScheduledMessageForwardingProcessor":
+		return "Scheduled-message-forwarding-processor"
+	case "FailoverScheduledMessageForwardingProcessor":
+		return "Failover-scheduled-message-forwarding-processor"
+	case "SamplingProcessor":
+		return "Sampling-processor"
+	}
+	return "Custom-message-processor"
+}
+
+// serveCApps : List the deployed CApps or get one of them with its artifacts
+func (mi *MI) serveCApps(w http.ResponseWriter, r *http.Request) {
+	name, named := artifactNameParam(r, "applications")
+	if !named {
+		list := []interface{}{}
+		for _, app := range mi.cApps {
+			list = append(list, map[string]string{"name": app.name, "version": app.version})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"activeCount": len(list),
+			"activeList":  list,
+			"faultyCount": 0,
+			"faultyList":  []interface{}{},
+		})
+		return
+	}
+	for _, app := range mi.cApps {
+		if app.name != name {
+			continue
+		}
+		artifacts := []interface{}{}
+		for _, artifact := range app.artifacts {
+			artifactType := artifact.artifactType[strings.Index(artifact.artifactType, "/")+1:]
+			artifacts = append(artifacts, map[string]string{"name": artifact.name, "type": artifactType})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"name":      app.name,
+			"version":   app.version,
+			"artifacts": artifacts,
+		})
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+// serveTemplates : List the templates, the templates of a type or get a template of a type. A type the management
+// API does not know is ignored, as it is by the Micro Integrator
+func (mi *MI) serveTemplates(w http.ResponseWriter, r *http.Request) {
+	templateType := r.URL.Query().Get("type")
+	byType := make(map[string][]*synapseArtifact)
+	for _, artifact := range mi.sortedArtifacts("templates") {
+		byType[artifactTemplateType(artifact)] = append(byType[artifactTemplateType(artifact)], artifact)
+	}
+	if templateType != templateTypeSequence && templateType != templateTypeEndpoint {
+		names := func(artifacts []*synapseArtifact) []interface{} {
+			list := []interface{}{}
+			for _, artifact := range artifacts {
+				list = append(list, map[string]string{"name": artifact.name})
+			}
+			return list
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"sequenceTemplateList": names(byType[templateTypeSequence]),
+			"endpointTemplateList": names(byType[templateTypeEndpoint]),
+		})
+		return
+	}
+
+	name, named := r.URL.Query()["name"]
+	if !named {
+		name, named = r.URL.Query()["templateName"]
+	}
+	if !named {
+		var list []interface{}
+		for _, artifact := range byType[templateType] {
+			list = append(list, map[string]string{"name": artifact.name})
+		}
+		writeMIList(w, list)
+		return
+	}
+	for _, artifact := range byType[templateType] {
+		if artifact.name != name[0] {
+			continue
+		}
+		var parameters []interface{}
+		for _, parameter := range artifact.root.children("parameter") {
+			if templateType == templateTypeEndpoint {
+				parameters = append(parameters, parameter.attr("name"))
+				continue
+			}
+			parameters = append(parameters, map[string]interface{}{
+				"name":         parameter.attr("name"),
+				"mandatory":    parameter.attr("isMandatory") == "true",
+				"defaultValue": parameter.attr("defaultValue"),
+			})
+		}
+		if parameters == nil {
+			parameters = []interface{}{}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"name":          artifact.name,
+			"Parameters":    parameters,
+			"configuration": string(artifact.config),
+		})
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+// artifactTemplateType : Whether a template wraps a sequence or an endpoint
+func artifactTemplateType(artifact *synapseArtifact) string {
+	if artifact.root.child(templateTypeEndpoint) != nil {
+		return templateTypeEndpoint
+	}
+	return templateTypeSequence
+}
+
+// updateArtifact : Change the state, the statistics or the tracing of an artifact
+func (mi *MI) updateArtifact(w http.ResponseWriter, r *http.Request, resource string) {
+	var body struct {
+		Name       string `json:"name"`
+		Status     string `json:"status"`
+		Statistics string `json:"statistics"`
+		Trace      string `json:"trace"`
+	}
+	if err := jsonDecode(r.Body, &body); err != nil {
+		writeMIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	artifact, exists := mi.artifacts[resource][body.Name]
+
+	switch {
+	case body.Statistics != "" || body.Trace != "":
+		if resource != "apis" && resource != "proxy-services" && resource != "sequences" &&
+			resource != "endpoints" && resource != "inbound-endpoints" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if !exists {
+			writeMIError(w, http.StatusNotFound, "Specified artifact ('"+body.Name+"') not found")
+			return
+		}
+		setting, value, target := "statistics", body.Statistics, &artifact.statistics
+		if body.Trace != "" {
+			setting, value, target = "tracing", body.Trace, &artifact.tracing
+		}
+		if value != settingEnable && value != settingDisable {
+			writeMIError(w, http.StatusBadRequest, "Invalid value "+value+" for "+setting)
+			return
+		}
+		*target = value == settingEnable
+		result := "Disabled"
+		if *target {
+			result = "Enabled"
+		}
+		writeMIMessage(w, result+" "+setting+" for ('"+body.Name+"')")
+	case resource == "endpoints":
+		if !exists {
+			writeMIError(w, http.StatusNotFound, "Endpoint does not exist")
+			return
+		}
+		artifact.active = body.Status == stateActive
+		switched := "Off"
+		if artifact.active {
+			switched = "On"
+		}
+		writeMIMessage(w, body.Name+" is switched "+switched)
+	case resource == "proxy-services":
+		if !exists {
+			writeMIError(w, http.StatusNotFound, "Proxy service could not be found")
+			return
+		}
+		artifact.active = body.Status == stateActive
+		result := "stopped"
+		if artifact.active {
+			result = "started"
+		}
+		writeMIMessage(w, body.Name+" "+result+" successfully")
+	case resource == "message-processors":
+		if !exists {
+			writeMIError(w, http.StatusNotFound, "Message processor does not exist")
+			return
+		}
+		artifact.active = body.Status == stateActive
+		result := "deactivated"
+		if artifact.active {
+			result = "activated"
+		}
+		writeMIMessage(w, body.Name+" : is "+result)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveMessageStoreMessages : Get or purge the messages of a message store, which are always empty as the fake
+// does not serve integration traffic
+func (mi *MI) serveMessageStoreMessages(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if _, exists := mi.artifacts["message-stores"][name]; !exists {
+		writeArtifactNotFound(w, "message-stores", name)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeMIList(w, nil)
+	case http.MethodDelete:
+		writeMIMessage(w, "Successfully purged the messages of the message store ('"+name+"')")
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveHeadMessage : Retry or drop the head message of a message processor, of which the store is always empty
+func (mi *MI) serveHeadMessage(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name   string `json:"name"`
+		Action string `json:"action"`
+	}
+	if err := jsonDecode(r.Body, &body); err != nil {
+		writeMIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	artifact, exists := mi.artifacts["message-processors"][body.Name]
+	if !exists {
+		writeMIError(w, http.StatusNotFound, "Message processor does not exist")
+		return
+	}
+	if body.Action != "retry" && body.Action != "drop" {
+		writeMIError(w, http.StatusBadRequest, "Invalid action "+body.Action)
+		return
+	}
+	writeMIError(w, http.StatusNotFound, "No messages found in the message store "+
+		artifact.root.attr("messageStore")+" of the message processor ('"+body.Name+"')")
+}
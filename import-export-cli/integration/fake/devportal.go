@@ -0,0 +1,612 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package fake
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	applicationStatusApproved = "APPROVED"
+	subscriptionStatusActive  = "UNBLOCKED"
+	residentKeyManager        = "Resident Key Manager"
+	defaultThrottlingPolicy   = "Unlimited"
+)
+
+// applicationRecord : Application stored as the JSON object it was created with along with its OAuth keys
+type applicationRecord struct {
+	sequence     int
+	tenantDomain string
+	data         map[string]interface{}
+	keys         []map[string]interface{}
+}
+
+func (app *applicationRecord) field(name string) string {
+	value, _ := app.data[name].(string)
+	return value
+}
+
+type subscriptionRecord struct {
+	id               string
+	applicationID    string
+	apiID            string
+	throttlingPolicy string
+}
+
+func (subscription *subscriptionRecord) info() map[string]interface{} {
+	return map[string]interface{}{
+		"subscriptionId":   subscription.id,
+		"applicationId":    subscription.applicationID,
+		"apiId":            subscription.apiID,
+		"throttlingPolicy": subscription.throttlingPolicy,
+		"status":           subscriptionStatusActive,
+	}
+}
+
+func (apim *APIM) serveDevPortal(w http.ResponseWriter, r *http.Request, s *session, segments []string) {
+	appScopes := []string{scopeAppManage, scopeSubscribe, scopeAppImportExport}
+	switch {
+	case matchPath(segments, "apis") && r.Method == http.MethodGet:
+		var infos []interface{}
+		for _, api := range apim.tenantAPIs(s) {
+			if status := api.field("lifeCycleStatus"); status == "PUBLISHED" || status == "PROTOTYPED" {
+				infos = append(infos, api.info())
+			}
+		}
+		writeJSON(w, http.StatusOK, paginate(r, infos))
+	case matchPath(segments, "applications") && r.Method == http.MethodGet:
+		if requireScope(w, s, appScopes...) {
+			var infos []interface{}
+			for _, app := range apim.ownApplications(s) {
+				if matchesQuery(app.data, r.URL.Query().Get("query")) {
+					infos = append(infos, app.data)
+				}
+			}
+			writeJSON(w, http.StatusOK, paginate(r, infos))
+		}
+	case matchPath(segments, "applications") && r.Method == http.MethodPost:
+		if requireScope(w, s, appScopes...) {
+			apim.createApplication(w, r, s)
+		}
+	case matchPath(segments, "applications", "export") && r.Method == http.MethodGet:
+		if requireScope(w, s, scopeAppManage, scopeAppImportExport) {
+			apim.exportApplication(w, r, s)
+		}
+	case matchPath(segments, "applications", "import") && r.Method == http.MethodPost:
+		if requireScope(w, s, scopeAppManage, scopeAppImportExport) {
+			apim.importApplication(w, r, s)
+		}
+	case len(segments) >= 2 && segments[0] == "applications":
+		app, ok := apim.applications[segments[1]]
+		if !ok || app.field("owner") != s.username() {
+			writeError(w, http.StatusNotFound, "Requested application with Id '"+segments[1]+"' not found")
+			return
+		}
+		if requireScope(w, s, appScopes...) {
+			apim.serveApplication(w, r, app, segments[2:])
+		}
+	case matchPath(segments, "throttling-policies", "application") && r.Method == http.MethodGet:
+		var policies []interface{}
+		for _, policy := range []string{defaultThrottlingPolicy, "10PerMin", "20PerMin", "50PerMin"} {
+			policies = append(policies, map[string]interface{}{"name": policy, "policyLevel": "application"})
+		}
+		writeJSON(w, http.StatusOK, paginate(r, policies))
+	case matchPath(segments, "subscriptions") && r.Method == http.MethodGet:
+		if requireScope(w, s, scopeSubscribe, scopeSubManage) {
+			apim.listSubscriptions(w, r, s)
+		}
+	case matchPath(segments, "subscriptions") && r.Method == http.MethodPost:
+		if requireScope(w, s, scopeSubscribe, scopeSubManage) {
+			apim.createSubscription(w, r, s)
+		}
+	case matchPath(segments, "subscriptions", "*") && r.Method == http.MethodDelete:
+		subscription, ok := apim.subscriptions[segments[1]]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Requested subscription with Id '"+segments[1]+"' not found")
+			return
+		}
+		if requireScope(w, s, scopeSubscribe, scopeSubManage) {
+			apim.removeSubscription(subscription)
+			w.WriteHeader(http.StatusOK)
+		}
+	default:
+		writeError(w, http.StatusNotFound, "No matching resource found for "+r.URL.Path)
+	}
+}
+
+func (apim *APIM) serveApplication(w http.ResponseWriter, r *http.Request, app *applicationRecord,
+	segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, app.data)
+	case len(segments) == 0 && r.Method == http.MethodPut:
+		data := make(map[string]interface{})
+		if err := jsonDecode(r.Body, &data); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, field := range []string{"name", "description", "throttlingPolicy", "tokenType", "attributes"} {
+			if value, ok := data[field]; ok {
+				app.data[field] = value
+			}
+		}
+		writeJSON(w, http.StatusOK, app.data)
+	case len(segments) == 0 && r.Method == http.MethodDelete:
+		apim.deleteApplication(app)
+		w.WriteHeader(http.StatusOK)
+	case matchPath(segments, "generate-keys") && r.Method == http.MethodPost:
+		var request struct {
+			KeyType                 string   `json:"keyType"`
+			GrantTypesToBeSupported []string `json:"grantTypesToBeSupported"`
+			ValidityTime            int      `json:"validityTime"`
+		}
+		if err := jsonDecode(r.Body, &request); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if findKey(app, request.KeyType) != nil {
+			writeError(w, http.StatusConflict, "Keys are already generated for the key type "+request.KeyType)
+			return
+		}
+		key := map[string]interface{}{
+			"keyMappingId":        newID(),
+			"keyManager":          residentKeyManager,
+			"consumerKey":         strings.ReplaceAll(newID(), "-", ""),
+			"consumerSecret":      strings.ReplaceAll(newID(), "-", ""),
+			"mode":                "CREATED",
+			"supportedGrantTypes": request.GrantTypesToBeSupported,
+			"callbackUrl":         "",
+			"keyState":            "COMPLETED",
+			"keyType":             request.KeyType,
+			"groupId":             "",
+			"additionalProperties": map[string]interface{}{
+				"id_token_expiry_time":                 accessTokenValiditySeconds,
+				"application_access_token_expiry_time": accessTokenValiditySeconds,
+				"user_access_token_expiry_time":        accessTokenValiditySeconds,
+				"refresh_token_expiry_time":            refreshTokenValiditySeconds,
+			},
+			"token": map[string]interface{}{
+				"accessToken":  newID(),
+				"tokenScopes":  []string{scopeDefault},
+				"validityTime": request.ValidityTime,
+			},
+		}
+		app.keys = append(app.keys, key)
+		// Keys are OAuth clients of their own, which get tokens with the client credentials grant
+		apim.clients[key["consumerKey"].(string)] = &oauthClient{secret: key["consumerSecret"].(string),
+			owner: app.field("owner")}
+		writeJSON(w, http.StatusOK, key)
+	case (matchPath(segments, "oauth-keys") || matchPath(segments, "keys")) && r.Method == http.MethodGet:
+		keys := make([]interface{}, len(app.keys))
+		for i, key := range app.keys {
+			keys[i] = key
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(keys), "list": keys})
+	default:
+		writeError(w, http.StatusNotFound, "No matching resource found for "+r.URL.Path)
+	}
+}
+
+// tenantApplications : Applications of the tenant of the session in the order they were created
+func (apim *APIM) tenantApplications(s *session) []*applicationRecord {
+	var apps []*applicationRecord
+	for _, app := range apim.applications {
+		if app.tenantDomain == s.tenantDomain {
+			apps = append(apps, app)
+		}
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i].sequence < apps[j].sequence })
+	return apps
+}
+
+// ownApplications : Applications owned by the user of the session
+func (apim *APIM) ownApplications(s *session) []*applicationRecord {
+	var apps []*applicationRecord
+	for _, app := range apim.tenantApplications(s) {
+		if app.field("owner") == s.username() {
+			apps = append(apps, app)
+		}
+	}
+	return apps
+}
+
+func (apim *APIM) findApplication(tenantDomain, name, owner string) (*applicationRecord, bool) {
+	for _, app := range apim.applications {
+		if app.tenantDomain == tenantDomain && app.field("name") == name && app.field("owner") == owner {
+			return app, true
+		}
+	}
+	return nil, false
+}
+
+func (apim *APIM) addApplication(tenantDomain, owner string, data map[string]interface{}) *applicationRecord {
+	data["applicationId"] = newID()
+	data["owner"] = owner
+	data["status"] = applicationStatusApproved
+	data["subscriptionCount"] = 0
+	data["keys"] = []interface{}{}
+	for _, field := range []string{"groups", "subscriptionScopes"} {
+		if _, ok := data[field].([]interface{}); !ok {
+			data[field] = []interface{}{}
+		}
+	}
+	if policy, _ := data["throttlingPolicy"].(string); policy == "" {
+		data["throttlingPolicy"] = defaultThrottlingPolicy
+	}
+	apim.nextSequence++
+	app := &applicationRecord{sequence: apim.nextSequence, tenantDomain: tenantDomain, data: data}
+	apim.applications[data["applicationId"].(string)] = app
+	return app
+}
+
+func (apim *APIM) deleteApplication(app *applicationRecord) {
+	for _, subscription := range apim.subscriptions {
+		if subscription.applicationID == app.field("applicationId") {
+			delete(apim.subscriptions, subscription.id)
+		}
+	}
+	delete(apim.applications, app.field("applicationId"))
+}
+
+func (apim *APIM) createApplication(w http.ResponseWriter, r *http.Request, s *session) {
+	data := make(map[string]interface{})
+	if err := jsonDecode(r.Body, &data); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	name, _ := data["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "The name of the application is required")
+		return
+	}
+	if _, exists := apim.findApplication(s.tenantDomain, name, s.username()); exists {
+		writeError(w, http.StatusConflict, "An application with the name "+name+" already exists")
+		return
+	}
+	app := apim.addApplication(s.tenantDomain, s.username(), data)
+	writeJSON(w, http.StatusCreated, app.data)
+}
+
+func (apim *APIM) addSubscription(app *applicationRecord, api *apiRecord, throttlingPolicy string) *subscriptionRecord {
+	subscription := &subscriptionRecord{id: newID(), applicationID: app.field("applicationId"),
+		apiID: api.field("id"), throttlingPolicy: throttlingPolicy}
+	apim.subscriptions[subscription.id] = subscription
+	count, _ := app.data["subscriptionCount"].(int)
+	app.data["subscriptionCount"] = count + 1
+	return subscription
+}
+
+func (apim *APIM) removeSubscription(subscription *subscriptionRecord) {
+	delete(apim.subscriptions, subscription.id)
+	if app, ok := apim.applications[subscription.applicationID]; ok {
+		count, _ := app.data["subscriptionCount"].(int)
+		app.data["subscriptionCount"] = count - 1
+	}
+}
+
+// applicationSubscriptions : Subscriptions of an application in the order of the APIs subscribed to
+func (apim *APIM) applicationSubscriptions(app *applicationRecord) []*subscriptionRecord {
+	var subscriptions []*subscriptionRecord
+	for _, subscription := range apim.subscriptions {
+		if subscription.applicationID == app.field("applicationId") {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return apim.apis[subscriptions[i].apiID].sequence < apim.apis[subscriptions[j].apiID].sequence
+	})
+	return subscriptions
+}
+
+func (apim *APIM) listSubscriptions(w http.ResponseWriter, r *http.Request, s *session) {
+	query := r.URL.Query()
+	var infos []interface{}
+	if applicationID := query.Get("applicationId"); applicationID != "" {
+		app, ok := apim.applications[applicationID]
+		if !ok || app.field("owner") != s.username() {
+			writeError(w, http.StatusNotFound, "Requested application with Id '"+applicationID+"' not found")
+			return
+		}
+		for _, subscription := range apim.applicationSubscriptions(app) {
+			infos = append(infos, subscription.info())
+		}
+	} else {
+		apiID := query.Get("apiId")
+		for _, app := range apim.ownApplications(s) {
+			for _, subscription := range apim.applicationSubscriptions(app) {
+				if apiID == "" || subscription.apiID == apiID {
+					infos = append(infos, subscription.info())
+				}
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, paginate(r, infos))
+}
+
+func (apim *APIM) createSubscription(w http.ResponseWriter, r *http.Request, s *session) {
+	var request struct {
+		ApplicationID    string `json:"applicationId"`
+		APIID            string `json:"apiId"`
+		ThrottlingPolicy string `json:"throttlingPolicy"`
+	}
+	if err := jsonDecode(r.Body, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	app, ok := apim.applications[request.ApplicationID]
+	if !ok || app.field("owner") != s.username() {
+		writeError(w, http.StatusNotFound, "Requested application with Id '"+request.ApplicationID+"' not found")
+		return
+	}
+	api, ok := apim.findAPIByID(s, request.APIID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Requested API with Id '"+request.APIID+"' not found")
+		return
+	}
+	for _, subscription := range apim.applicationSubscriptions(app) {
+		if subscription.apiID == request.APIID {
+			writeError(w, http.StatusConflict, "The application is already subscribed to the API")
+			return
+		}
+	}
+	if request.ThrottlingPolicy == "" {
+		request.ThrottlingPolicy = defaultThrottlingPolicy
+	}
+	writeJSON(w, http.StatusCreated, apim.addSubscription(app, api, request.ThrottlingPolicy).info())
+}
+
+// exportApplication : Export an application with its subscriptions and optionally its keys. Users without the
+// import export scope can only export their own applications
+func (apim *APIM) exportApplication(w http.ResponseWriter, r *http.Request, s *session) {
+	query := r.URL.Query()
+	name, owner := query.Get("appName"), query.Get("appOwner")
+	if owner == "" {
+		owner = s.username()
+	}
+	ownerName, ownerDomain := splitUsername(owner)
+	owner = qualifiedUsername(ownerName, ownerDomain)
+	if ownerDomain != s.tenantDomain || (owner != s.username() && !s.hasAnyScope(scopeAppImportExport)) {
+		writeError(w, http.StatusForbidden, "User is not authorized to export the applications of "+owner)
+		return
+	}
+	app, ok := apim.findApplication(s.tenantDomain, name, owner)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Requested application "+name+" of "+owner+" not found")
+		return
+	}
+
+	info := make(map[string]interface{})
+	for key, value := range app.data {
+		info[key] = value
+	}
+	if withKeys, _ := strconv.ParseBool(query.Get("withKeys")); withKeys {
+		keys := make([]interface{}, len(app.keys))
+		for i, key := range app.keys {
+			exported := make(map[string]interface{})
+			for field, value := range key {
+				exported[field] = value
+			}
+			secret, _ := key["consumerSecret"].(string)
+			exported["consumerSecret"] = base64.StdEncoding.EncodeToString([]byte(secret))
+			keys[i] = exported
+		}
+		info["keys"] = keys
+	}
+	subscribedAPIs := []interface{}{}
+	for _, subscription := range apim.applicationSubscriptions(app) {
+		api := apim.apis[subscription.apiID]
+		subscribedAPIs = append(subscribedAPIs, map[string]interface{}{
+			"apiId": map[string]interface{}{
+				"apiName":      api.field("name"),
+				"version":      api.field("version"),
+				"providerName": api.field("provider"),
+			},
+			"throttlingPolicy": subscription.throttlingPolicy,
+		})
+	}
+	artifact := map[string]interface{}{
+		"type":    "application",
+		"version": ArtifactVersion,
+		"data":    map[string]interface{}{"applicationInfo": info, "subscribedAPIs": subscribedAPIs},
+	}
+
+	projectDir := replaceUserStoreDelimiter(owner) + "-" + name + "/"
+	files := make(map[string][]byte)
+	var err error
+	if strings.EqualFold(query.Get("format"), formatJSON) {
+		files[projectDir+"application.json"], err = json.MarshalIndent(artifact, "", "  ")
+	} else {
+		files[projectDir+"application.yaml"], err = yaml.Marshal(artifact)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeZip(w, files)
+}
+
+// importApplication : Import an application archive for its original owner, the given owner or the importing user,
+// restoring the keys and the subscriptions to APIs that exist in the tenant unless they are skipped
+func (apim *APIM) importApplication(w http.ResponseWriter, r *http.Request, s *session) {
+	files, err := readUploadedZip(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid application archive: "+err.Error())
+		return
+	}
+	content, ok := findProjectFile(files, "application.yaml", "application.json")
+	if !ok {
+		writeError(w, http.StatusBadRequest, "The application archive does not contain application.yaml or "+
+			"application.json")
+		return
+	}
+	var artifact struct {
+		Data struct {
+			ApplicationInfo map[string]interface{} `json:"applicationInfo"`
+			SubscribedAPIs  []struct {
+				APIID struct {
+					APIName      string `json:"apiName"`
+					Version      string `json:"version"`
+					ProviderName string `json:"providerName"`
+				} `json:"apiId"`
+				ThrottlingPolicy string `json:"throttlingPolicy"`
+			} `json:"subscribedAPIs"`
+		} `json:"data"`
+	}
+	if err := yaml.Unmarshal(content, &artifact); err != nil || artifact.Data.ApplicationInfo == nil {
+		writeError(w, http.StatusBadRequest, "Invalid application definition in the archive")
+		return
+	}
+	data := artifact.Data.ApplicationInfo
+	name, _ := data["name"].(string)
+	exportedKeys, _ := data["keys"].([]interface{})
+
+	query := r.URL.Query()
+	owner := s.username()
+	if preserveOwner, _ := strconv.ParseBool(query.Get("preserveOwner")); preserveOwner {
+		owner, _ = data["owner"].(string)
+	} else if appOwner := query.Get("appOwner"); appOwner != "" {
+		owner = appOwner
+	}
+	ownerName, ownerDomain := splitUsername(owner)
+	owner = qualifiedUsername(ownerName, ownerDomain)
+	if owner != s.username() && !s.hasAnyScope(scopeAppImportExport) {
+		writeError(w, http.StatusForbidden, "User is not authorized to import applications for "+owner)
+		return
+	}
+	if t, ok := apim.tenants[ownerDomain]; !ok || ownerDomain != s.tenantDomain || t.users[ownerName] == nil {
+		writeError(w, http.StatusBadRequest, "The owner "+owner+" of the application does not exist in the "+
+			"tenant "+s.tenantDomain)
+		return
+	}
+
+	app, exists := apim.findApplication(s.tenantDomain, name, owner)
+	if exists {
+		if update, _ := strconv.ParseBool(query.Get("update")); !update {
+			writeError(w, http.StatusConflict, "An application with the name "+name+" already exists for "+owner)
+			return
+		}
+		for _, field := range []string{"description", "throttlingPolicy", "tokenType", "attributes"} {
+			if value, ok := data[field]; ok {
+				app.data[field] = value
+			}
+		}
+	} else {
+		delete(data, "keys")
+		app = apim.addApplication(s.tenantDomain, owner, data)
+	}
+
+	if skipKeys, _ := strconv.ParseBool(query.Get("skipApplicationKeys")); !skipKeys {
+		for _, exportedKey := range exportedKeys {
+			key, ok := exportedKey.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// Keys of an updated application keep their credentials and take the new OAuth properties
+			if existing := findKey(app, key["keyType"]); existing != nil {
+				existing["additionalProperties"] = key["additionalProperties"]
+			} else {
+				app.keys = append(app.keys, key)
+			}
+		}
+	}
+	if skipSubscriptions, _ := strconv.ParseBool(query.Get("skipSubscriptions")); !skipSubscriptions {
+		for _, subscribedAPI := range artifact.Data.SubscribedAPIs {
+			api, ok := apim.findAPI(s.tenantDomain, subscribedAPI.APIID.APIName, subscribedAPI.APIID.Version,
+				subscribedAPI.APIID.ProviderName)
+			if ok && !isSubscribed(apim.applicationSubscriptions(app), api) {
+				apim.addSubscription(app, api, subscribedAPI.ThrottlingPolicy)
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, app.data)
+}
+
+func findKey(app *applicationRecord, keyType interface{}) map[string]interface{} {
+	for _, key := range app.keys {
+		if key["keyType"] == keyType {
+			return key
+		}
+	}
+	return nil
+}
+
+func isSubscribed(subscriptions []*subscriptionRecord, api *apiRecord) bool {
+	for _, subscription := range subscriptions {
+		if subscription.apiID == api.field("id") {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceUserStoreDelimiter : Replace the user store domain separator which cannot be used in file names
+func replaceUserStoreDelimiter(username string) string {
+	return strings.ReplaceAll(username, "/", "#")
+}
+
+func (apim *APIM) serveAdmin(w http.ResponseWriter, r *http.Request, s *session, segments []string) {
+	switch {
+	case matchPath(segments, "applications") && r.Method == http.MethodGet:
+		if !requireScope(w, s, scopeAdmin, scopeAppImportExport, scopeAppOwnerChange) {
+			return
+		}
+		query := r.URL.Query()
+		userFilter := query.Get("user")
+		if userFilter != "" && !strings.Contains(userFilter, "@") {
+			userFilter = qualifiedUsername(userFilter, s.tenantDomain)
+		}
+		var infos []interface{}
+		for _, app := range apim.tenantApplications(s) {
+			if (userFilter != "" && app.field("owner") != userFilter) ||
+				(query.Get("name") != "" && app.field("name") != query.Get("name")) {
+				continue
+			}
+			infos = append(infos, map[string]interface{}{
+				"applicationId": app.field("applicationId"),
+				"name":          app.field("name"),
+				"owner":         app.field("owner"),
+				"status":        app.field("status"),
+				"groupId":       "",
+			})
+		}
+		writeJSON(w, http.StatusOK, paginate(r, infos))
+	case matchPath(segments, "applications", "*") && r.Method == http.MethodDelete:
+		if !requireScope(w, s, scopeAdmin, scopeAppImportExport) {
+			return
+		}
+		app, ok := apim.applications[segments[1]]
+		if !ok || app.tenantDomain != s.tenantDomain {
+			writeError(w, http.StatusNotFound, "Requested application with Id '"+segments[1]+"' not found")
+			return
+		}
+		apim.deleteApplication(app)
+		w.WriteHeader(http.StatusOK)
+	case matchPath(segments, "environments") && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"count": 0, "list": []interface{}{}})
+	default:
+		writeError(w, http.StatusNotFound, "No matching resource found for "+r.URL.Path)
+	}
+}
@@ -0,0 +1,287 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+// Package fake provides in-process fakes of the API Manager, Micro Integrator and Microgateway adapter REST APIs
+// used by apictl. The fakes keep their state in memory and serve over TLS through httptest, so that the
+// integration suites can run with nothing more than 'go test'.
+package fake
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultTenantDomain : Tenant domain of users without a domain suffix
+	DefaultTenantDomain = "carbon.super"
+
+	headerContentType     = "Content-Type"
+	contentTypeJSON       = "application/json"
+	contentTypeZip        = "application/zip"
+	contentTypeXML        = "text/xml"
+	authorizationBearer   = "Bearer "
+	tenantContextPrefix   = "/t/"
+	multipartFileParam    = "file"
+	maxMultipartMemory    = 32 << 20
+	defaultListLimitValue = 25
+)
+
+// errorResponse : Error body returned by the WSO2 REST APIs
+type errorResponse struct {
+	Code        int    `json:"code"`
+	Message     string `json:"message"`
+	Description string `json:"description"`
+}
+
+// listResponse : Paginated list body returned by the WSO2 REST APIs
+type listResponse struct {
+	Count      int           `json:"count"`
+	List       []interface{} `json:"list"`
+	Pagination pagination    `json:"pagination"`
+}
+
+type pagination struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+	Total  int `json:"total"`
+}
+
+// newTLSServer : Start an httptest TLS server on the loopback address, which is covered by the httptest certificate
+func newTLSServer(handler http.Handler) *httptest.Server {
+	server := httptest.NewUnstartedServer(handler)
+	// Clients that do not trust the test certificate fail the handshake, which is expected and not worth logging
+	server.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	server.StartTLS()
+	return server
+}
+
+// hostAndPort : Split the address of a started httptest server
+func hostAndPort(server *httptest.Server) (string, int) {
+	host, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+	return host, portNumber
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set(headerContentType, contentTypeJSON)
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+func jsonDecode(body io.Reader, v interface{}) error {
+	return json.NewDecoder(body).Decode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	data, _ := json.Marshal(errorResponse{Code: status, Message: http.StatusText(status), Description: message})
+	w.Header().Set(headerContentType, contentTypeJSON)
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+func writeZip(w http.ResponseWriter, files map[string][]byte) {
+	data, err := zipFiles(files)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set(headerContentType, contentTypeZip)
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// zipFiles : Create an in-memory zip archive from file names and their content
+func zipFiles(files map[string][]byte) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := zip.NewWriter(buffer)
+	for name, content := range files {
+		entry, err := writer.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := entry.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// parseMultipartForm : Parse a multipart request body, taking the boundary from the first delimiter line of the
+// body when the client sent a bare multipart/form-data Content-Type the way the real servers accept it
+func parseMultipartForm(r *http.Request) error {
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err == nil && strings.HasPrefix(mediaType, "multipart/") && params["boundary"] == "" {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		firstLine := string(body)
+		if i := strings.Index(firstLine, "\n"); i >= 0 {
+			firstLine = firstLine[:i]
+		}
+		boundary := strings.TrimPrefix(strings.TrimSpace(firstLine), "--")
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.Header.Set("Content-Type", mime.FormatMediaType(mediaType, map[string]string{"boundary": boundary}))
+	}
+	return r.ParseMultipartForm(maxMultipartMemory)
+}
+
+// readUploadedZip : Read the zip archive uploaded as the multipart 'file' parameter into its files keyed by their
+// slash separated path
+func readUploadedZip(r *http.Request) (map[string][]byte, error) {
+	if err := parseMultipartForm(r); err != nil {
+		return nil, err
+	}
+	file, _, err := r.FormFile(multipartFileParam)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+	for _, entry := range reader.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+		content, err := entry.Open()
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(strings.ReplaceAll(entry.Name, "\\", "/"))], err = ioutil.ReadAll(content)
+		content.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// findProjectFile : Content of the shallowest file among the given names, which is the definition file of
+// a project regardless of the directory the project was zipped from
+func findProjectFile(files map[string][]byte, names ...string) ([]byte, bool) {
+	var found []byte
+	depth := -1
+	for filePath, content := range files {
+		for _, name := range names {
+			if path.Base(filePath) != name {
+				continue
+			}
+			if d := strings.Count(filePath, "/"); depth == -1 || d < depth {
+				found, depth = content, d
+			}
+		}
+	}
+	return found, depth != -1
+}
+
+// matchPath : Check whether the path segments match the pattern, where a '*' segment matches any value
+func matchPath(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+	for i, segment := range pattern {
+		if segment != "*" && segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// splitPath : Split the path of a request into its non empty segments
+func splitPath(requestPath string) []string {
+	var segments []string
+	for _, segment := range strings.Split(requestPath, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// paginate : Apply the limit and offset query parameters of a list request
+func paginate(r *http.Request, items []interface{}) listResponse {
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultListLimitValue
+	}
+	offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	total := len(items)
+	if offset > total {
+		offset = total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	page := items[offset:end]
+	if page == nil {
+		page = []interface{}{}
+	}
+	return listResponse{Count: len(page), List: page, Pagination: pagination{Offset: offset, Limit: limit, Total: total}}
+}
+
+// splitUsername : Split a username into the name and the tenant domain
+func splitUsername(username string) (string, string) {
+	if i := strings.LastIndex(username, "@"); i > 0 {
+		return username[:i], username[i+1:]
+	}
+	return username, DefaultTenantDomain
+}
+
+// qualifiedUsername : Username as shown by API Manager, which carries the tenant domain only for tenants
+func qualifiedUsername(name, tenantDomain string) string {
+	if tenantDomain == DefaultTenantDomain {
+		return name
+	}
+	return name + "@" + tenantDomain
+}
+
+func newID() string {
+	return uuid.New().String()
+}
@@ -0,0 +1,296 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package fake
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	// MGAdapterContext : Context of the REST API of the Microgateway adapter
+	MGAdapterContext = "/api/mgw/adapter/0.1"
+
+	mgDefaultVhost         = "localhost"
+	mgDefaultGatewayEnv    = "Default"
+	mgTokenValidity        = time.Hour
+	mgEnvironmentsSplitter = ":"
+)
+
+// MG : Fake Microgateway adapter serving the token and APIs resources over TLS
+type MG struct {
+	server *httptest.Server
+	mutex  sync.Mutex
+
+	tokens map[string]bool
+	apis   map[string]*mgAPI
+}
+
+type mgAPI struct {
+	name        string
+	version     string
+	apiType     string
+	context     string
+	vhost       string
+	gatewayEnvs []string
+	// productionEndpoint is the production endpoint URL in the deployed api.yaml
+	productionEndpoint string
+}
+
+// NewMG : Start a fake Microgateway adapter accepting the admin user and without deployed APIs
+func NewMG() *MG {
+	mg := &MG{
+		tokens: make(map[string]bool),
+		apis:   make(map[string]*mgAPI),
+	}
+	mg.server = newTLSServer(mg)
+	return mg
+}
+
+// URL : Base URL of the fake Microgateway adapter, which is the adapter endpoint of an apictl environment
+func (mg *MG) URL() string {
+	return mg.server.URL
+}
+
+// Close : Shut down the fake Microgateway adapter
+func (mg *MG) Close() {
+	mg.server.Close()
+}
+
+// ServeHTTP : Route a request to the token resource or to the authenticated APIs resource
+func (mg *MG) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mg.mutex.Lock()
+	defer mg.mutex.Unlock()
+
+	if !strings.HasPrefix(r.URL.Path, MGAdapterContext+"/") {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	segments := splitPath(strings.TrimPrefix(r.URL.Path, MGAdapterContext))
+	switch {
+	case matchPath(segments, "oauth2", "token") && r.Method == http.MethodPost:
+		mg.issueToken(w, r)
+	case !mg.tokens[strings.TrimPrefix(r.Header.Get("Authorization"), authorizationBearer)]:
+		writeError(w, http.StatusUnauthorized, "Invalid Credentials")
+	case matchPath(segments, "apis") && r.Method == http.MethodGet:
+		mg.listAPIs(w, r)
+	case matchPath(segments, "apis") && r.Method == http.MethodPost:
+		mg.deployAPI(w, r)
+	case matchPath(segments, "apis") && r.Method == http.MethodDelete:
+		mg.undeployAPI(w, r)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// issueToken : Issue a JWT access token, with an expiry, for the admin credentials sent as JSON
+func (mg *MG) issueToken(w http.ResponseWriter, r *http.Request) {
+	var credentials struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if err := jsonDecode(r.Body, &credentials); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid credentials payload")
+		return
+	}
+	if credentials.Username != AdminUsername || credentials.Password != AdminPassword {
+		writeError(w, http.StatusUnauthorized, "Invalid Credentials")
+		return
+	}
+	token := newJWT(credentials.Username, time.Now().Add(mgTokenValidity))
+	mg.tokens[token] = true
+	writeJSON(w, http.StatusOK, map[string]string{"accessToken": token})
+}
+
+// listAPIs : List the deployed APIs, filtered by a 'field:value' query, applying the limit and offset
+func (mg *MG) listAPIs(w http.ResponseWriter, r *http.Request) {
+	var list []interface{}
+	for _, key := range mg.sortedAPIKeys() {
+		api := mg.apis[key]
+		if !api.matches(r.URL.Query().Get("query")) {
+			continue
+		}
+		list = append(list, map[string]interface{}{
+			"apiName":      api.name,
+			"version":      api.version,
+			"apiType":      api.apiType,
+			"context":      api.context,
+			"gateway-envs": api.gatewayEnvs,
+			"vhost":        api.vhost,
+		})
+	}
+	page := paginate(r, list)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"total": page.Pagination.Total,
+		"count": page.Count,
+		"list":  page.List,
+	})
+}
+
+// deployAPI : Deploy an API project archive. An API with the same name, version and vhost is replaced only when
+// overriding
+func (mg *MG) deployAPI(w http.ResponseWriter, r *http.Request) {
+	files, err := readUploadedZip(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid API project archive: "+err.Error())
+		return
+	}
+	content, ok := findProjectFile(files, "api.yaml", "api.json")
+	if !ok {
+		writeError(w, http.StatusBadRequest, "The API project archive does not contain api.yaml or api.json")
+		return
+	}
+	var artifact struct {
+		Data struct {
+			Name           string `json:"name"`
+			Version        string `json:"version"`
+			Type           string `json:"type"`
+			Context        string `json:"context"`
+			EndpointConfig struct {
+				ProductionEndpoints struct {
+					URL string `json:"url"`
+				} `json:"production_endpoints"`
+			} `json:"endpointConfig"`
+		} `json:"data"`
+	}
+	if err := yaml.Unmarshal(content, &artifact); err != nil || artifact.Data.Name == "" ||
+		artifact.Data.Version == "" {
+		writeError(w, http.StatusBadRequest, "Invalid API definition in the project archive")
+		return
+	}
+	api := &mgAPI{
+		name:        artifact.Data.Name,
+		version:     artifact.Data.Version,
+		apiType:     artifact.Data.Type,
+		context:     artifact.Data.Context,
+		vhost:       mgDefaultVhost,
+		gatewayEnvs: []string{mgDefaultGatewayEnv},
+
+		productionEndpoint: artifact.Data.EndpointConfig.ProductionEndpoints.URL,
+	}
+	if api.apiType == "" {
+		api.apiType = "HTTP"
+	}
+	key := api.key()
+	if _, exists := mg.apis[key]; exists {
+		if override, _ := strconv.ParseBool(r.URL.Query().Get("override")); !override {
+			writeError(w, http.StatusConflict, "API already exists: "+api.name+" "+api.version)
+			return
+		}
+	}
+	mg.apis[key] = api
+	w.WriteHeader(http.StatusOK)
+}
+
+// undeployAPI : Undeploy an API identified by its name, version and vhost from the given gateway environments, or
+// from all of them
+func (mg *MG) undeployAPI(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	vhost := query.Get("vhost")
+	if vhost == "" {
+		vhost = mgDefaultVhost
+	}
+	key := (&mgAPI{name: query.Get("apiName"), version: query.Get("version"), vhost: vhost}).key()
+	api, exists := mg.apis[key]
+	if !exists {
+		writeError(w, http.StatusNotFound, "API not found")
+		return
+	}
+	if environments := query.Get("environments"); environments != "" {
+		var remaining []string
+		for _, env := range api.gatewayEnvs {
+			if !containsValue(strings.Split(environments, mgEnvironmentsSplitter), env) {
+				remaining = append(remaining, env)
+			}
+		}
+		if len(remaining) > 0 {
+			api.gatewayEnvs = remaining
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	delete(mg.apis, key)
+	w.WriteHeader(http.StatusOK)
+}
+
+// ProductionEndpoint : Production endpoint URL of a deployed API in the default vhost, or empty if it is not deployed
+func (mg *MG) ProductionEndpoint(name, version string) string {
+	mg.mutex.Lock()
+	defer mg.mutex.Unlock()
+	if api, exists := mg.apis[(&mgAPI{name: name, version: version, vhost: mgDefaultVhost}).key()]; exists {
+		return api.productionEndpoint
+	}
+	return ""
+}
+
+func (mg *MG) sortedAPIKeys() []string {
+	keys := make([]string, 0, len(mg.apis))
+	for key := range mg.apis {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (api *mgAPI) key() string {
+	return api.vhost + "/" + api.name + "/" + api.version
+}
+
+// matches : Check whether the API matches a 'field:value' query on its name, version, type or context, where a
+// query without a field matches the name
+func (api *mgAPI) matches(query string) bool {
+	if query == "" {
+		return true
+	}
+	field, value := "name", query
+	if i := strings.Index(query, ":"); i != -1 {
+		field, value = query[:i], query[i+1:]
+	}
+	switch field {
+	case "name":
+		return strings.Contains(api.name, value)
+	case "version":
+		return api.version == value
+	case "type":
+		return strings.EqualFold(api.apiType, value)
+	case "context":
+		return strings.Contains(api.context, value)
+	}
+	return false
+}
+
+// newJWT : Unsigned JWT carrying the subject and the expiry, which is all apictl reads from an access token
+func newJWT(subject string, expiry time.Time) string {
+	encode := func(v interface{}) string {
+		data, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	header := encode(map[string]string{"alg": "none", "typ": "JWT"})
+	claims := encode(map[string]interface{}{"sub": subject, "exp": expiry.Unix(), "jti": newID()})
+	return header + "." + claims + "." + base64.RawURLEncoding.EncodeToString([]byte(newID()))
+}
@@ -0,0 +1,526 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// MIDefaultPort : Port of the Micro Integrator management API without a port offset, used to express the fake
+	// as a port offset
+	MIDefaultPort = 9164
+
+	// MIProductVersion : Version the fake Micro Integrator reports for itself
+	MIProductVersion = "4.1.0"
+
+	miManagementContext = "management"
+	miAdminRole         = "admin"
+	miEveryoneRole      = "Internal/everyone"
+	miErrorTag          = "Error"
+	miMessageTag        = "Message"
+	miCarbonLogFile     = "wso2carbon.log"
+	miErrorLogFile      = "wso2error.log"
+)
+
+// miLogLevels : Log levels accepted by the logging resource
+var miLogLevels = map[string]bool{
+	"OFF": true, "TRACE": true, "DEBUG": true, "INFO": true, "WARN": true, "ERROR": true, "FATAL": true,
+}
+
+// MI : Fake Micro Integrator serving the management API over TLS
+type MI struct {
+	server *httptest.Server
+	mutex  sync.Mutex
+
+	users     map[string]*miUser
+	roles     map[string]bool
+	tokens    map[string]string
+	loggers   map[string]*miLogger
+	logFiles  map[string]*strings.Builder
+	cApps     []*cApp
+	artifacts map[string]map[string]*synapseArtifact
+}
+
+type miUser struct {
+	password string
+	roles    []string
+}
+
+type miLogger struct {
+	component string
+	level     string
+}
+
+// NewMI : Start a fake Micro Integrator with the admin user, the default loggers and no deployed CApps
+func NewMI() *MI {
+	mi := &MI{
+		users:     make(map[string]*miUser),
+		roles:     map[string]bool{miAdminRole: true, miEveryoneRole: true},
+		tokens:    make(map[string]string),
+		loggers:   make(map[string]*miLogger),
+		logFiles:  map[string]*strings.Builder{miCarbonLogFile: {}, miErrorLogFile: {}},
+		artifacts: make(map[string]map[string]*synapseArtifact),
+	}
+	mi.users[AdminUsername] = &miUser{password: AdminPassword, roles: []string{miAdminRole, miEveryoneRole}}
+	mi.loggers["org-apache-coyote"] = &miLogger{component: "org.apache.coyote", level: "WARN"}
+	mi.loggers["org-apache-synapse"] = &miLogger{component: "org.apache.synapse", level: "INFO"}
+	mi.loggers["org-apache-axis2"] = &miLogger{component: "org.apache.axis2", level: "INFO"}
+	mi.log("INFO", "StartupFinalizer", "WSO2 Micro Integrator started")
+	mi.server = newTLSServer(mi)
+	return mi
+}
+
+// URL : Base URL of the fake Micro Integrator
+func (mi *MI) URL() string {
+	return mi.server.URL
+}
+
+// Host : Host of the fake Micro Integrator
+func (mi *MI) Host() string {
+	host, _ := hostAndPort(mi.server)
+	return host
+}
+
+// PortOffset : Port offset of the fake Micro Integrator, relative to the default management API port
+func (mi *MI) PortOffset() int {
+	_, port := hostAndPort(mi.server)
+	return port - MIDefaultPort
+}
+
+// Close : Shut down the fake Micro Integrator
+func (mi *MI) Close() {
+	mi.server.Close()
+}
+
+// ServeHTTP : Route a request to the login and logout resources or to an authenticated management resource
+func (mi *MI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mi.mutex.Lock()
+	defer mi.mutex.Unlock()
+
+	segments := splitPath(r.URL.Path)
+	if len(segments) < 2 || segments[0] != miManagementContext {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if segments[1] == "login" {
+		mi.login(w, r)
+		return
+	}
+	username, ok := mi.authenticate(r)
+	if !ok {
+		writeMIError(w, http.StatusUnauthorized, "Invalid User")
+		return
+	}
+	admin := hasMIRole(mi.users[username], miAdminRole)
+
+	switch resource := segments[1]; {
+	case resource == "logout":
+		delete(mi.tokens, strings.TrimPrefix(r.Header.Get("Authorization"), authorizationBearer))
+		writeMIMessage(w, "Logout successful")
+	case resource == "users" || resource == "roles":
+		if !admin {
+			// The management API rejects user management of non admin users without a body
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if resource == "users" {
+			mi.serveUsers(w, r, username, segments[2:])
+		} else {
+			mi.serveRoles(w, r, segments[2:])
+		}
+	case resource == "logging" && len(segments) == 2:
+		mi.serveLogging(w, r)
+	case resource == "logs" && len(segments) == 2:
+		mi.serveLogs(w, r)
+	case resource == "server" && len(segments) == 2:
+		mi.serveServer(w)
+	case resource == "transactions" && len(segments) == 3:
+		mi.serveTransactions(w, r, segments[2])
+	default:
+		mi.serveArtifacts(w, r, segments[1:])
+	}
+}
+
+// login : Issue an access token for a user authenticated with basic auth
+func (mi *MI) login(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if u, found := mi.users[username]; !ok || !found || u.password != password {
+		writeMIError(w, http.StatusUnauthorized, "User "+username+" not authenticated")
+		return
+	}
+	token := newID()
+	mi.tokens[token] = username
+	writeJSON(w, http.StatusOK, map[string]string{"AccessToken": token})
+}
+
+func (mi *MI) authenticate(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, authorizationBearer) {
+		return "", false
+	}
+	username, ok := mi.tokens[strings.TrimPrefix(header, authorizationBearer)]
+	if _, exists := mi.users[username]; !exists {
+		return "", false
+	}
+	return username, ok
+}
+
+// serveUsers : Handle the users resource, available to admin users only
+func (mi *MI) serveUsers(w http.ResponseWriter, r *http.Request, loggedInUser string, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		role, pattern := r.URL.Query().Get("role"), r.URL.Query().Get("pattern")
+		var list []interface{}
+		for _, name := range sortedMIUsers(mi.users) {
+			if role != "" && !hasMIRole(mi.users[name], role) {
+				continue
+			}
+			if pattern != "" && !strings.Contains(name, pattern) {
+				continue
+			}
+			list = append(list, map[string]string{"userId": name})
+		}
+		writeMIList(w, list)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var body struct {
+			UserID   string `json:"userId"`
+			Password string `json:"password"`
+			IsAdmin  string `json:"isAdmin"`
+		}
+		if err := jsonDecode(r.Body, &body); err != nil || body.UserID == "" {
+			writeMIError(w, http.StatusBadRequest, "Missing user details in the payload")
+			return
+		}
+		if _, exists := mi.users[body.UserID]; exists {
+			writeMIError(w, http.StatusInternalServerError, "User "+body.UserID+" already exists")
+			return
+		}
+		u := &miUser{password: body.Password, roles: []string{miEveryoneRole}}
+		if body.IsAdmin == "true" {
+			u.roles = append(u.roles, miAdminRole)
+		}
+		mi.users[body.UserID] = u
+		writeJSON(w, http.StatusOK, map[string]string{"userId": body.UserID, "status": "Added"})
+	case len(segments) == 1:
+		u, exists := mi.users[segments[0]]
+		if !exists {
+			writeMIError(w, http.StatusNotFound, "Requested resource not found. User: "+segments[0]+
+				" cannot be found.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"userId":  segments[0],
+				"isAdmin": hasMIRole(u, miAdminRole),
+				"roles":   u.roles,
+			})
+		case http.MethodDelete:
+			if segments[0] == loggedInUser {
+				writeMIError(w, http.StatusBadRequest, "Attempt to delete the logged in user. Operation not allowed. "+
+					"Please login from another user.")
+				return
+			}
+			delete(mi.users, segments[0])
+			writeJSON(w, http.StatusOK, map[string]string{"userId": segments[0], "status": "Deleted"})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// serveRoles : Handle the roles resource, available to admin users only
+func (mi *MI) serveRoles(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		var list []interface{}
+		for _, role := range sortedMIRoles(mi.roles) {
+			list = append(list, map[string]string{"role": role})
+		}
+		writeMIList(w, list)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var body struct {
+			Role string `json:"role"`
+		}
+		if err := jsonDecode(r.Body, &body); err != nil || body.Role == "" {
+			writeMIError(w, http.StatusBadRequest, "Missing role details in the payload")
+			return
+		}
+		if mi.roles[body.Role] {
+			writeMIError(w, http.StatusInternalServerError, "Role "+body.Role+" already exists")
+			return
+		}
+		mi.roles[body.Role] = true
+		writeJSON(w, http.StatusOK, map[string]string{"role": body.Role, "status": "Added"})
+	case len(segments) == 0 && r.Method == http.MethodPut:
+		var body struct {
+			UserID       string   `json:"userId"`
+			AddedRoles   []string `json:"addedRoles"`
+			RemovedRoles []string `json:"removedRoles"`
+		}
+		if err := jsonDecode(r.Body, &body); err != nil {
+			writeMIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		u, exists := mi.users[body.UserID]
+		if !exists {
+			writeMIError(w, http.StatusNotFound, "Requested resource not found. User: "+body.UserID+
+				" cannot be found.")
+			return
+		}
+		for _, role := range body.AddedRoles {
+			if !mi.roles[role] {
+				writeMIError(w, http.StatusNotFound, "Role "+role+" does not exist")
+				return
+			}
+		}
+		var roles []string
+		for _, role := range u.roles {
+			if !containsValue(body.RemovedRoles, role) && !containsValue(body.AddedRoles, role) {
+				roles = append(roles, role)
+			}
+		}
+		u.roles = append(roles, body.AddedRoles...)
+		writeJSON(w, http.StatusOK, map[string]string{"userId": body.UserID, "status": "Added/removed the roles"})
+	case len(segments) == 1:
+		if !mi.roles[segments[0]] {
+			writeMIError(w, http.StatusNotFound, "Requested resource not found. Role: "+segments[0]+
+				" cannot be found.")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			users := []string{}
+			for _, name := range sortedMIUsers(mi.users) {
+				if hasMIRole(mi.users[name], segments[0]) {
+					users = append(users, name)
+				}
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"role": segments[0], "users": users})
+		case http.MethodDelete:
+			if segments[0] == miAdminRole || segments[0] == miEveryoneRole {
+				writeMIError(w, http.StatusBadRequest, "Role "+segments[0]+" cannot be deleted")
+				return
+			}
+			delete(mi.roles, segments[0])
+			for _, u := range mi.users {
+				var roles []string
+				for _, role := range u.roles {
+					if role != segments[0] {
+						roles = append(roles, role)
+					}
+				}
+				u.roles = roles
+			}
+			writeJSON(w, http.StatusOK, map[string]string{"role": segments[0], "status": "Deleted"})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// serveLogging : Get a logger, or add a logger or change its level
+func (mi *MI) serveLogging(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		name := r.URL.Query().Get("loggerName")
+		logger, exists := mi.loggers[name]
+		if !exists {
+			writeMIError(w, http.StatusNotFound, "Logger name ('"+name+"') not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{
+			"loggerName":    name,
+			"componentName": logger.component,
+			"level":         logger.level,
+		})
+	case http.MethodPatch:
+		var body struct {
+			LoggerName   string `json:"loggerName"`
+			LoggingLevel string `json:"loggingLevel"`
+			LoggerClass  string `json:"loggerClass"`
+		}
+		if err := jsonDecode(r.Body, &body); err != nil {
+			writeMIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if !miLogLevels[body.LoggingLevel] {
+			writeMIError(w, http.StatusBadRequest, "Invalid log level "+body.LoggingLevel)
+			return
+		}
+		logger, exists := mi.loggers[body.LoggerName]
+		message := "Successfully added logger for ('" + body.LoggerName + "') with level " + body.LoggingLevel
+		switch {
+		case body.LoggerClass != "" && exists:
+			writeMIError(w, http.StatusConflict, "Specified logger name ('"+body.LoggerName+
+				"') already exists, try updating the level instead")
+			return
+		case body.LoggerClass != "":
+			mi.loggers[body.LoggerName] = &miLogger{component: body.LoggerClass, level: body.LoggingLevel}
+			message += " for class " + body.LoggerClass
+		case !exists:
+			writeMIError(w, http.StatusNotFound, "Specified logger ('"+body.LoggerName+"') not found")
+			return
+		default:
+			logger.level = body.LoggingLevel
+		}
+		// The logging resource reports its result in a lower case message field, unlike the other resources
+		writeJSON(w, http.StatusOK, map[string]string{"message": message})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveLogs : List the log files of the server or download one of them
+func (mi *MI) serveLogs(w http.ResponseWriter, r *http.Request) {
+	fileName := r.URL.Query().Get("file")
+	if fileName == "" {
+		var list []interface{}
+		for _, name := range []string{miCarbonLogFile, miErrorLogFile} {
+			list = append(list, map[string]string{
+				"FileName": name,
+				"size":     strconv.Itoa(mi.logFiles[name].Len()) + " B",
+			})
+		}
+		writeMIList(w, list)
+		return
+	}
+	content, exists := mi.logFiles[fileName]
+	if !exists {
+		// The management API fails to open a missing file instead of reporting it as not found
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(headerContentType, "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(content.String()))
+}
+
+func (mi *MI) serveServer(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]string{
+		"productName":    "WSO2 Micro Integrator",
+		"productVersion": MIProductVersion,
+		"carbonHome":     "/home/wso2carbon/wso2mi-" + MIProductVersion,
+		"javaVersion":    "11.0.16",
+		"javaVendor":     "Eclipse Adoptium",
+		"osName":         "Linux",
+		"osVersion":      "5.15.0",
+	})
+}
+
+// serveTransactions : Report the inbound transaction count of a month or of a period, which is always zero as the
+// fake does not serve integration traffic
+func (mi *MI) serveTransactions(w http.ResponseWriter, r *http.Request, resource string) {
+	switch resource {
+	case "count":
+		now := time.Now()
+		year, month := now.Year(), int(now.Month())
+		if value, err := strconv.Atoi(r.URL.Query().Get("year")); err == nil {
+			year = value
+		}
+		if value, err := strconv.Atoi(r.URL.Query().Get("month")); err == nil {
+			month = value
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"Year": year, "Month": month, "TransactionCount": 0})
+	case "report":
+		start, errStart := time.Parse("2006-01", r.URL.Query().Get("start"))
+		end, errEnd := time.Parse("2006-01", r.URL.Query().Get("end"))
+		if errStart != nil || errEnd != nil || end.Before(start) {
+			writeMIError(w, http.StatusBadRequest, "Invalid period. Start and end months are expected as yyyy-mm")
+			return
+		}
+		rows := [][]string{{"Year", "Month", "Transaction Count"}}
+		for month := start; !month.After(end); month = month.AddDate(0, 1, 0) {
+			rows = append(rows, []string{strconv.Itoa(month.Year()), strconv.Itoa(int(month.Month())), "0"})
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"TransactionCountData": rows})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// log : Append a line to the carbon log, and to the error log for errors
+func (mi *MI) log(level, component, message string) {
+	line := fmt.Sprintf("[%s] %5s {%s} - %s\n", time.Now().Format("2006-01-02 15:04:05,000"), level, component,
+		message)
+	mi.logFiles[miCarbonLogFile].WriteString(line)
+	if level == "ERROR" {
+		mi.logFiles[miErrorLogFile].WriteString(line)
+	}
+}
+
+// writeMIError : Write an error the way the management API does, which apictl reads from the Error field
+func writeMIError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{miErrorTag: message})
+}
+
+func writeMIMessage(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusOK, map[string]string{miMessageTag: message})
+}
+
+// writeMIList : Write a list in the count and list form of the management API
+func writeMIList(w http.ResponseWriter, list []interface{}) {
+	if list == nil {
+		list = []interface{}{}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(list), "list": list})
+}
+
+func hasMIRole(u *miUser, role string) bool {
+	return u != nil && containsValue(u.roles, role)
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedMIUsers(users map[string]*miUser) []string {
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedMIRoles(roles map[string]bool) []string {
+	names := make([]string, 0, len(roles))
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
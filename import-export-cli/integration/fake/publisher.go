@@ -0,0 +1,604 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package fake
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	// ArtifactVersion : Version of the API Manager artifacts exported by the fake
+	ArtifactVersion = "v4.1.0"
+
+	lifeCycleStatusCreated = "CREATED"
+	formatJSON             = "JSON"
+	scopeValidationFailed  = "User is NOT authorized to access the Resource. Scope validation failed."
+)
+
+// lifeCycleTransitions : States reached by the API lifecycle actions
+var lifeCycleTransitions = map[string]string{
+	"publish":               "PUBLISHED",
+	"re-publish":            "PUBLISHED",
+	"deploy as a prototype": "PROTOTYPED",
+	"demote to created":     "CREATED",
+	"block":                 "BLOCKED",
+	"deprecate":             "DEPRECATED",
+	"retire":                "RETIRED",
+}
+
+// apiRecord : API stored as the JSON object it was created with, so that fields round trip unchanged
+type apiRecord struct {
+	sequence     int
+	tenantDomain string
+	data         map[string]interface{}
+	revisions    []map[string]interface{}
+}
+
+func (api *apiRecord) field(name string) string {
+	value, _ := api.data[name].(string)
+	return value
+}
+
+// info : Summary of the API returned in API lists
+func (api *apiRecord) info() map[string]interface{} {
+	info := make(map[string]interface{})
+	for _, field := range []string{"id", "name", "description", "context", "version", "provider", "type",
+		"lifeCycleStatus", "hasThumbnail", "securityScheme"} {
+		if value, ok := api.data[field]; ok {
+			info[field] = value
+		}
+	}
+	return info
+}
+
+// requireScope : Reject the request unless the token was granted one of the scopes
+func requireScope(w http.ResponseWriter, s *session, scopes ...string) bool {
+	if !s.hasAnyScope(scopes...) {
+		writeError(w, http.StatusUnauthorized, scopeValidationFailed)
+		return false
+	}
+	return true
+}
+
+func timestamp() string {
+	return strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
+}
+
+func (apim *APIM) servePublisher(w http.ResponseWriter, r *http.Request, s *session, segments []string) {
+	viewScopes := []string{scopeAPIView, scopeAPICreate, scopeAPIPublish, scopeAPIImportExport}
+	switch {
+	case matchPath(segments, "apis") && r.Method == http.MethodGet:
+		if requireScope(w, s, viewScopes...) {
+			writeJSON(w, http.StatusOK, paginate(r, apim.apiInfos(s, r.URL.Query().Get("query"), false)))
+		}
+	case matchPath(segments, "apis") && r.Method == http.MethodPost:
+		if requireScope(w, s, scopeAPICreate, scopeAPIImportExport) {
+			apim.createAPI(w, r, s)
+		}
+	case matchPath(segments, "apis", "import-openapi") && r.Method == http.MethodPost:
+		if requireScope(w, s, scopeAPICreate, scopeAPIImportExport) {
+			apim.importOpenAPI(w, r, s)
+		}
+	case matchPath(segments, "apis", "export") && r.Method == http.MethodGet:
+		if requireScope(w, s, scopeAPIView, scopeAPIImportExport) {
+			apim.exportAPI(w, r, s)
+		}
+	case matchPath(segments, "apis", "import") && r.Method == http.MethodPost:
+		if requireScope(w, s, scopeAPIImportExport) {
+			apim.importAPI(w, r, s)
+		}
+	case matchPath(segments, "apis", "change-lifecycle") && r.Method == http.MethodPost:
+		if requireScope(w, s, scopeAPIPublish, scopeAPIImportExport) {
+			apim.changeLifeCycle(w, r, s)
+		}
+	case matchPath(segments, "apis", "*"):
+		api, ok := apim.findAPIByID(s, segments[1])
+		if !ok {
+			writeError(w, http.StatusNotFound, "Requested API with Id '"+segments[1]+"' not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if requireScope(w, s, viewScopes...) {
+				writeJSON(w, http.StatusOK, api.data)
+			}
+		case http.MethodPut:
+			if requireScope(w, s, scopeAPICreate, scopeAPIImportExport) {
+				apim.updateAPI(w, r, api)
+			}
+		case http.MethodDelete:
+			if requireScope(w, s, scopeAPIDelete, scopeAPIImportExport) {
+				apim.deleteAPI(w, api)
+			}
+		default:
+			writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported on "+r.URL.Path)
+		}
+	case matchPath(segments, "apis", "*", "swagger") && r.Method == http.MethodGet:
+		api, ok := apim.findAPIByID(s, segments[1])
+		if !ok {
+			writeError(w, http.StatusNotFound, "Requested API with Id '"+segments[1]+"' not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, openAPIDefinition(api))
+	case matchPath(segments, "apis", "*", "revisions"), matchPath(segments, "apis", "*", "deploy-revision"):
+		api, ok := apim.findAPIByID(s, segments[1])
+		if !ok {
+			writeError(w, http.StatusNotFound, "Requested API with Id '"+segments[1]+"' not found")
+			return
+		}
+		if requireScope(w, s, scopeAPICreate, scopeAPIPublish, scopeAPIImportExport) {
+			apim.serveRevisions(w, r, api, segments[2])
+		}
+	case matchPath(segments, "api-products") && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, paginate(r, nil))
+	case matchPath(segments, "search") && r.Method == http.MethodGet:
+		if requireScope(w, s, viewScopes...) {
+			writeJSON(w, http.StatusOK, paginate(r, apim.apiInfos(s, r.URL.Query().Get("query"), true)))
+		}
+	case matchPath(segments, "endpoint-certificates") && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"count": 0, "certificates": []interface{}{}})
+	default:
+		writeError(w, http.StatusNotFound, "No matching resource found for "+r.URL.Path)
+	}
+}
+
+// tenantAPIs : APIs of the tenant of the session in the order they were created
+func (apim *APIM) tenantAPIs(s *session) []*apiRecord {
+	var apis []*apiRecord
+	for _, api := range apim.apis {
+		if api.tenantDomain == s.tenantDomain {
+			apis = append(apis, api)
+		}
+	}
+	sort.Slice(apis, func(i, j int) bool { return apis[i].sequence < apis[j].sequence })
+	return apis
+}
+
+// apiInfos : Summaries of the APIs of the tenant matching a search query. Unified search results carry the
+// artifact type in addition
+func (apim *APIM) apiInfos(s *session, query string, unifiedSearch bool) []interface{} {
+	var infos []interface{}
+	for _, api := range apim.tenantAPIs(s) {
+		if !matchesQuery(api.data, query) {
+			continue
+		}
+		info := api.info()
+		if unifiedSearch {
+			info["type"] = "API"
+			info["transportType"] = api.field("type")
+			info["status"] = api.field("lifeCycleStatus")
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+func (apim *APIM) findAPIByID(s *session, id string) (*apiRecord, bool) {
+	api, ok := apim.apis[id]
+	if !ok || api.tenantDomain != s.tenantDomain {
+		return nil, false
+	}
+	return api, true
+}
+
+func (apim *APIM) findAPI(tenantDomain, name, version, provider string) (*apiRecord, bool) {
+	for _, api := range apim.apis {
+		if api.tenantDomain == tenantDomain && api.field("name") == name && api.field("version") == version &&
+			(provider == "" || strings.EqualFold(api.field("provider"), provider)) {
+			return api, true
+		}
+	}
+	return nil, false
+}
+
+func (apim *APIM) addAPI(tenantDomain string, data map[string]interface{}) *apiRecord {
+	now := timestamp()
+	data["id"] = newID()
+	data["createdTime"] = now
+	data["lastUpdatedTime"] = now
+	if status, _ := data["lifeCycleStatus"].(string); status == "" {
+		data["lifeCycleStatus"] = lifeCycleStatusCreated
+	}
+	apim.nextSequence++
+	api := &apiRecord{sequence: apim.nextSequence, tenantDomain: tenantDomain, data: data}
+	apim.apis[data["id"].(string)] = api
+	return api
+}
+
+func (apim *APIM) createAPI(w http.ResponseWriter, r *http.Request, s *session) {
+	data := make(map[string]interface{})
+	if err := jsonDecode(r.Body, &data); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	apim.storeNewAPI(w, r, s, data)
+}
+
+// importOpenAPI : Create an API from an OpenAPI definition and the API properties sent along with it. The
+// operations are taken from the definition unless given in the properties
+func (apim *APIM) importOpenAPI(w http.ResponseWriter, r *http.Request, s *session) {
+	if err := parseMultipartForm(r); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	data := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(r.FormValue("additionalProperties")), &data); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid additionalProperties: "+err.Error())
+		return
+	}
+	if _, ok := data["operations"]; !ok {
+		file, _, err := r.FormFile(multipartFileParam)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "The OpenAPI definition is required")
+			return
+		}
+		defer file.Close()
+		content, err := ioutil.ReadAll(file)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var definition struct {
+			Paths map[string]interface{} `json:"paths"`
+		}
+		if err := yaml.Unmarshal(content, &definition); err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid OpenAPI definition: "+err.Error())
+			return
+		}
+		operations := []interface{}{}
+		for _, target := range sortedKeys(definition.Paths) {
+			methods, _ := definition.Paths[target].(map[string]interface{})
+			for _, verb := range sortedKeys(methods) {
+				operations = append(operations, map[string]interface{}{"target": target,
+					"verb": strings.ToUpper(verb), "authType": "Application & Application User",
+					"throttlingPolicy": defaultThrottlingPolicy})
+			}
+		}
+		data["operations"] = operations
+	}
+	apim.storeNewAPI(w, r, s, data)
+}
+
+// storeNewAPI : Validate and store a new API of the user of the session
+func (apim *APIM) storeNewAPI(w http.ResponseWriter, r *http.Request, s *session, data map[string]interface{}) {
+	name, _ := data["name"].(string)
+	version, _ := data["version"].(string)
+	context, _ := data["context"].(string)
+	if name == "" || version == "" || context == "" {
+		writeError(w, http.StatusBadRequest, "The name, version and context of the API are required")
+		return
+	}
+	if _, exists := apim.findAPI(s.tenantDomain, name, version, ""); exists {
+		writeError(w, http.StatusConflict, "The API "+name+" "+version+" already exists")
+		return
+	}
+	if provider, _ := data["provider"].(string); provider == "" {
+		data["provider"] = s.username()
+	}
+	data["lifeCycleStatus"] = lifeCycleStatusCreated
+	api := apim.addAPI(s.tenantDomain, data)
+	w.Header().Set("Location", path.Join(path.Dir(r.URL.Path), "apis", api.field("id")))
+	writeJSON(w, http.StatusCreated, api.data)
+}
+
+func (apim *APIM) updateAPI(w http.ResponseWriter, r *http.Request, api *apiRecord) {
+	data := make(map[string]interface{})
+	if err := jsonDecode(r.Body, &data); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	for _, field := range []string{"id", "provider", "createdTime", "lifeCycleStatus"} {
+		data[field] = api.data[field]
+	}
+	data["lastUpdatedTime"] = timestamp()
+	api.data = data
+	writeJSON(w, http.StatusOK, api.data)
+}
+
+func (apim *APIM) deleteAPI(w http.ResponseWriter, api *apiRecord) {
+	for _, subscription := range apim.subscriptions {
+		if subscription.apiID == api.field("id") {
+			writeError(w, http.StatusConflict, "Cannot remove the API as active subscriptions exist")
+			return
+		}
+	}
+	delete(apim.apis, api.field("id"))
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveRevisions : List and create the revisions of an API and deploy them to gateway environments
+func (apim *APIM) serveRevisions(w http.ResponseWriter, r *http.Request, api *apiRecord, resource string) {
+	switch {
+	case resource == "revisions" && r.Method == http.MethodGet:
+		revisions := make([]interface{}, len(api.revisions))
+		for i, revision := range api.revisions {
+			revisions[i] = revision
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(revisions), "list": revisions})
+	case resource == "revisions" && r.Method == http.MethodPost:
+		var request struct {
+			Description string `json:"description"`
+		}
+		if err := jsonDecode(r.Body, &request); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		revision := map[string]interface{}{
+			"id":             newID(),
+			"displayName":    "Revision " + strconv.Itoa(len(api.revisions)+1),
+			"description":    request.Description,
+			"createdTime":    timestamp(),
+			"apiInfo":        map[string]interface{}{"id": api.field("id")},
+			"deploymentInfo": []interface{}{},
+		}
+		api.revisions = append(api.revisions, revision)
+		writeJSON(w, http.StatusCreated, revision)
+	case resource == "deploy-revision" && r.Method == http.MethodPost:
+		revisionID := r.URL.Query().Get("revisionId")
+		var deployments []map[string]interface{}
+		if err := jsonDecode(r.Body, &deployments); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, revision := range api.revisions {
+			if revision["id"] != revisionID {
+				continue
+			}
+			deploymentInfo := make([]interface{}, len(deployments))
+			for i, deployment := range deployments {
+				deployment["revisionUuid"] = revisionID
+				deployment["status"] = "APPROVED"
+				deploymentInfo[i] = deployment
+			}
+			revision["deploymentInfo"] = deploymentInfo
+			writeJSON(w, http.StatusCreated, deploymentInfo)
+			return
+		}
+		writeError(w, http.StatusNotFound, "Requested revision with Id '"+revisionID+"' not found")
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported on "+r.URL.Path)
+	}
+}
+
+func (apim *APIM) changeLifeCycle(w http.ResponseWriter, r *http.Request, s *session) {
+	query := r.URL.Query()
+	api, ok := apim.findAPIByID(s, query.Get("apiId"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "Requested API with Id '"+query.Get("apiId")+"' not found")
+		return
+	}
+	state, ok := lifeCycleTransitions[strings.ToLower(query.Get("action"))]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "Invalid lifecycle action "+query.Get("action"))
+		return
+	}
+	api.data["lifeCycleStatus"] = state
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"workflowStatus": "APPROVED",
+		"lifecycleState": map[string]interface{}{"state": state},
+	})
+}
+
+// exportAPI : Export an API as a project archive holding the API definition and its OpenAPI definition
+func (apim *APIM) exportAPI(w http.ResponseWriter, r *http.Request, s *session) {
+	query := r.URL.Query()
+	name, version := query.Get("name"), query.Get("version")
+	api, ok := apim.findAPI(s.tenantDomain, name, version, query.Get("providerName"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "Requested API "+name+" "+version+" not found")
+		return
+	}
+	data := make(map[string]interface{})
+	for key, value := range api.data {
+		data[key] = value
+	}
+	if preserveStatus, err := strconv.ParseBool(query.Get("preserveStatus")); err == nil && !preserveStatus {
+		data["lifeCycleStatus"] = lifeCycleStatusCreated
+	}
+	artifact := map[string]interface{}{"type": "api", "version": ArtifactVersion, "data": data}
+
+	projectDir := name + "-" + version + "/"
+	files := make(map[string][]byte)
+	var err error
+	if strings.EqualFold(query.Get("format"), formatJSON) {
+		files[projectDir+"api.json"], err = json.MarshalIndent(artifact, "", "  ")
+	} else {
+		files[projectDir+"api.yaml"], err = yaml.Marshal(artifact)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if files[projectDir+"Definitions/swagger.yaml"], err = yaml.Marshal(openAPIDefinition(api)); err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeZip(w, files)
+}
+
+// importAPI : Import an API project archive. The provider is kept only when it belongs to the tenant of the user,
+// and an existing API with the same name and version is replaced only when overwriting
+func (apim *APIM) importAPI(w http.ResponseWriter, r *http.Request, s *session) {
+	files, err := readUploadedZip(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid API project archive: "+err.Error())
+		return
+	}
+	content, ok := findProjectFile(files, "api.yaml", "api.json")
+	if !ok {
+		writeError(w, http.StatusBadRequest, "The API project archive does not contain api.yaml or api.json")
+		return
+	}
+	var artifact struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := yaml.Unmarshal(content, &artifact); err != nil || artifact.Data == nil {
+		writeError(w, http.StatusBadRequest, "Invalid API definition in the project archive")
+		return
+	}
+	data := artifact.Data
+	name, _ := data["name"].(string)
+	version, _ := data["version"].(string)
+	if name == "" || version == "" {
+		writeError(w, http.StatusBadRequest, "The name and version of the API are required")
+		return
+	}
+
+	query := r.URL.Query()
+	provider, _ := data["provider"].(string)
+	if preserveProvider, err := strconv.ParseBool(query.Get("preserveProvider")); err != nil || preserveProvider {
+		if _, providerDomain := splitUsername(provider); provider != "" && providerDomain != s.tenantDomain {
+			writeError(w, http.StatusInternalServerError, "Tenant mismatch! The provider "+provider+" of the API "+
+				"does not belong to the tenant "+s.tenantDomain+". Import the API without preserving the provider")
+			return
+		}
+	} else {
+		data["provider"] = s.username()
+		context, _ := data["context"].(string)
+		data["context"] = tenantContext(context, s.tenantDomain)
+	}
+
+	if existing, exists := apim.findAPI(s.tenantDomain, name, version, ""); exists {
+		if overwrite, _ := strconv.ParseBool(query.Get("overwrite")); !overwrite {
+			writeError(w, http.StatusConflict, "The API "+name+" "+version+" already exists. Use the update "+
+				"option to overwrite it")
+			return
+		}
+		for _, field := range []string{"id", "createdTime"} {
+			data[field] = existing.data[field]
+		}
+		data["lastUpdatedTime"] = timestamp()
+		existing.data = data
+	} else {
+		apim.addAPI(s.tenantDomain, data)
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("API imported successfully."))
+}
+
+// tenantContext : Move an API context into the context space of a tenant
+func tenantContext(context, tenantDomain string) string {
+	if strings.HasPrefix(context, tenantContextPrefix) {
+		if i := strings.Index(context[len(tenantContextPrefix):], "/"); i >= 0 {
+			context = context[len(tenantContextPrefix)+i:]
+		}
+	}
+	if tenantDomain == DefaultTenantDomain {
+		return context
+	}
+	return tenantContextPrefix + tenantDomain + context
+}
+
+// openAPIDefinition : OpenAPI definition describing the operations of an API
+func openAPIDefinition(api *apiRecord) map[string]interface{} {
+	paths := make(map[string]interface{})
+	operations, _ := api.data["operations"].([]interface{})
+	for _, operation := range operations {
+		fields, _ := operation.(map[string]interface{})
+		target, _ := fields["target"].(string)
+		verb, _ := fields["verb"].(string)
+		if target == "" || verb == "" {
+			continue
+		}
+		methods, ok := paths[target].(map[string]interface{})
+		if !ok {
+			methods = make(map[string]interface{})
+			paths[target] = methods
+		}
+		methods[strings.ToLower(verb)] = map[string]interface{}{
+			"responses": map[string]interface{}{"200": map[string]interface{}{"description": "OK"}},
+		}
+	}
+	return map[string]interface{}{
+		"openapi": "3.0.1",
+		"info":    map[string]interface{}{"title": api.field("name"), "version": api.field("version")},
+		"paths":   paths,
+	}
+}
+
+// matchesQuery : Check an artifact against a search query made of 'field:value' terms and plain terms matched
+// against the name. Quoted values have to match exactly while others only have to be contained. Terms starting
+// with '-' are treated as operators by the search index and do not restrict the results
+func matchesQuery(data map[string]interface{}, query string) bool {
+	for _, term := range splitQuery(query) {
+		if strings.HasPrefix(term, "-") {
+			continue
+		}
+		field, value := "name", term
+		if i := strings.Index(term, ":"); i > 0 {
+			field, value = term[:i], term[i+1:]
+		}
+		if field == "status" {
+			field = "lifeCycleStatus"
+		}
+		actual, _ := data[field].(string)
+		if strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"") && len(value) > 1 {
+			if !strings.EqualFold(actual, value[1:len(value)-1]) {
+				return false
+			}
+		} else if !strings.Contains(strings.ToLower(actual), strings.ToLower(value)) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitQuery : Split a search query on spaces outside quotes
+func splitQuery(query string) []string {
+	var terms []string
+	var term strings.Builder
+	quoted := false
+	for _, c := range query {
+		switch {
+		case c == '"':
+			quoted = !quoted
+			term.WriteRune(c)
+		case c == ' ' && !quoted:
+			if term.Len() > 0 {
+				terms = append(terms, term.String())
+				term.Reset()
+			}
+		default:
+			term.WriteRune(c)
+		}
+	}
+	if term.Len() > 0 {
+		terms = append(terms, term.String())
+	}
+	return terms
+}
+
+// sortedKeys : Keys of a map in lexical order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
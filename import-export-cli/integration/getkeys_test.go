@@ -69,6 +69,8 @@ func TestGetKeysNonAdminTenantUser(t *testing.T) {
 }
 
 func TestGetKeysForAPI(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -90,6 +92,8 @@ func TestGetKeysForAPI(t *testing.T) {
 }
 
 func TestGetKeysConsecutivelyAdminSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -119,6 +123,8 @@ func TestGetKeysConsecutivelyAdminSuperTenantUser(t *testing.T) {
 }
 
 func TestGetKeysAdminTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := superAdminUser + "@" + TENANT1
 	adminPassword := superAdminPassword
 
@@ -147,6 +153,8 @@ func TestGetKeysAdminTenantUser(t *testing.T) {
 /*
 TODO: Uncomment these when secondary user store automation is supported
 func TestGetKeysSecondaryUserStoreAdminSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	username := "SECOND.COM/super"
 	password := "admin"
 
@@ -177,6 +185,8 @@ func TestGetKeysSecondaryUserStoreAdminSuperTenantUser(t *testing.T) {
 */
 
 func TestGetKeysNonPublishedAPI(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := superAdminUser
 	adminPassword := superAdminPassword
 
@@ -197,6 +207,8 @@ func TestGetKeysNonPublishedAPI(t *testing.T) {
 }
 
 func TestGetKeysForAPIProductNonAdminSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apiPublisher := publisher.UserName
 	apiPublisherPassword := publisher.Password
 
@@ -232,6 +244,8 @@ func TestGetKeysForAPIProductNonAdminSuperTenantUser(t *testing.T) {
 }
 
 func TestGetKeysForAPIProductNonAdminTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	apiPublisher := publisher.UserName + "@" + TENANT1
 	apiPublisherPassword := publisher.Password
 
@@ -267,6 +281,8 @@ func TestGetKeysForAPIProductNonAdminTenantUser(t *testing.T) {
 }
 
 func TestGetKeysForAPIProduct(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 			dev := GetDevClient()
@@ -306,6 +322,8 @@ func TestGetKeysForAPIProduct(t *testing.T) {
 }
 
 func TestGetKeysConsecutivelyForAPIProductAdminSuperTenantUser(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	adminUser := superAdminUser
 	adminPassword := superAdminPassword
 
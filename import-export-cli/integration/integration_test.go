@@ -20,6 +20,7 @@ package integration
 
 import (
 	"flag"
+	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/integration/adminservices"
 	"github.com/wso2/product-apim-tooling/import-export-cli/integration/apim"
 	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/fake"
 	"github.com/wso2/product-apim-tooling/import-export-cli/integration/testutils"
 	"gopkg.in/yaml.v2"
 )
@@ -84,6 +86,9 @@ var (
 
 	apimClients = map[string]*apim.Client{}
 
+	// Fake API Manager per environment, started when no apictl archive is given
+	fakeAPIMs = map[string]*fake.APIM{}
+
 	// Table driven testing user combinations
 	testCaseUsers = []testutils.TestCaseUsers{
 		{
@@ -134,7 +139,12 @@ func TestMain(m *testing.M) {
 
 	readConfigs()
 
-	base.ExtractArchiveFile("../build/target/")
+	binaryDir := ""
+	if base.IsArchiveProvided() {
+		base.ExtractArchiveFile("../build/target/")
+	} else {
+		binaryDir = startFakeAPIMs()
+	}
 
 	for _, env := range envs {
 		client := apim.Client{}
@@ -149,9 +159,53 @@ func TestMain(m *testing.M) {
 
 	exitVal := m.Run()
 
+	stopFakeAPIMs(binaryDir)
+
 	os.Exit(exitVal)
 }
 
+// startFakeAPIMs : Build apictl and point each environment to a fake API Manager, so that the tests covered by the
+// fakes can run without a distribution archive or running servers. The configuration of apictl is kept next to the
+// binary instead of the home directory of the user. Returns the directory holding the binary and the configuration
+func startFakeAPIMs() string {
+	binaryDir, err := ioutil.TempDir("", "apictl")
+	if err != nil {
+		base.Fatal(err)
+	}
+	base.BuildBinary("..", binaryDir, yamlConfig.APICTLVersion)
+	base.UseConfigHome(binaryDir)
+
+	for name, env := range envs {
+		fakeAPIM := fake.NewAPIM()
+		fakeAPIMs[name] = fakeAPIM
+		env.Host = fakeAPIM.Host()
+		env.Offset = fakeAPIM.PortOffset()
+		envs[name] = env
+	}
+	base.SetIndexingDelay(0)
+
+	base.Log("running against fake API Managers:", envs)
+	return binaryDir
+}
+
+// skipOnFakeAPIM : Skip a test using REST APIs which are not implemented by the fake API Managers, when running
+// without a distribution archive
+func skipOnFakeAPIM(t *testing.T) {
+	t.Helper()
+	if len(fakeAPIMs) > 0 {
+		t.Skip("not supported by fake")
+	}
+}
+
+func stopFakeAPIMs(binaryDir string) {
+	for _, fakeAPIM := range fakeAPIMs {
+		fakeAPIM.Close()
+	}
+	if binaryDir != "" {
+		os.RemoveAll(binaryDir)
+	}
+}
+
 func readConfigs() {
 	reader, err := os.Open("config.yaml")
 
@@ -0,0 +1,146 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package integration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/fake"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/testutils"
+)
+
+const mgEnvName = "mg-adapter"
+
+// Deploy an API project to a fake microgateway adapter, list it and undeploy it
+func TestMgDeployGetUndeployApi(t *testing.T) {
+	if base.IsArchiveProvided() {
+		t.Skip("no microgateway adapter is configured for the integration tests")
+	}
+	adapter := fake.NewMG()
+	defer adapter.Close()
+
+	base.SetupMGEnv(t, mgEnvName, adapter.URL())
+	base.MGLogin(t, mgEnvName, fake.AdminUsername, fake.AdminPassword)
+
+	projectPath := createMGProject(t)
+
+	output, err := base.Execute(t, "mg", "deploy", "api", "-f", projectPath, "-e", mgEnvName, "-k")
+	assert.Nil(t, err, "Error while deploying the API")
+	assert.Contains(t, output, "Successfully deployed API to microgateway")
+
+	output, err = base.Execute(t, "mg", "deploy", "api", "-f", projectPath, "-e", mgEnvName, "-k")
+	assert.NotNil(t, err, "Deploying an existing API without overriding should fail")
+	assert.Contains(t, output, "API already exists")
+
+	_, err = base.Execute(t, "mg", "deploy", "api", "-f", projectPath, "-e", mgEnvName, "-o", "-k")
+	assert.Nil(t, err, "Error while overriding the API")
+
+	output, err = base.Execute(t, "mg", "get", "apis", "-e", mgEnvName, "-k")
+	assert.Nil(t, err, "Error while listing the APIs")
+	assert.Contains(t, output, "PizzaShackAPI")
+
+	_, err = base.Execute(t, "mg", "undeploy", "api", "-n", "PizzaShackAPI", "-v", "1.0.0", "-e", mgEnvName, "-k")
+	assert.Nil(t, err, "Error while undeploying the API")
+
+	output, err = base.Execute(t, "mg", "undeploy", "api", "-n", "PizzaShackAPI", "-v", "1.0.0", "-e", mgEnvName, "-k")
+	assert.NotNil(t, err, "Undeploying a missing API should fail")
+	assert.True(t, strings.Contains(output, "the API does not exist"), "Unexpected output: "+output)
+}
+
+// Deploy an API project with a params file and check that the endpoint of the environment reaches the adapter
+func TestMgDeployApiWithParams(t *testing.T) {
+	if base.IsArchiveProvided() {
+		t.Skip("no microgateway adapter is configured for the integration tests")
+	}
+	adapter := fake.NewMG()
+	defer adapter.Close()
+
+	base.SetupMGEnv(t, mgEnvName, adapter.URL())
+	base.MGLogin(t, mgEnvName, fake.AdminUsername, fake.AdminPassword)
+
+	projectPath := createMGProject(t)
+	paramsPath := filepath.Join(projectPath, "..", filepath.Base(projectPath)+"_params.yaml")
+	params := "environments:\n  - name: " + mgEnvName + "\n    configs:\n      endpoints:\n" +
+		"        production:\n          url: https://prod.mg.example.com\n"
+	if err := ioutil.WriteFile(paramsPath, []byte(params), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Remove(paramsPath)
+	})
+
+	_, err := base.Execute(t, "mg", "deploy", "api", "-f", projectPath, "-e", mgEnvName, "--params", paramsPath, "-k")
+	assert.Nil(t, err, "Error while deploying the API with params")
+	assert.Equal(t, "https://prod.mg.example.com", adapter.ProductionEndpoint("PizzaShackAPI", "1.0.0"))
+}
+
+// Sync a directory of API projects with a params file and check that the endpoint of the environment reaches the
+// adapter
+func TestMgSyncApisWithParams(t *testing.T) {
+	if base.IsArchiveProvided() {
+		t.Skip("no microgateway adapter is configured for the integration tests")
+	}
+	adapter := fake.NewMG()
+	defer adapter.Close()
+
+	base.SetupMGEnv(t, mgEnvName, adapter.URL())
+	base.MGLogin(t, mgEnvName, fake.AdminUsername, fake.AdminPassword)
+
+	projectPath := createMGProject(t)
+	paramsPath := filepath.Join(projectPath, "..", filepath.Base(projectPath)+"_params.yaml")
+	params := "environments:\n  - name: " + mgEnvName + "\n    configs:\n      endpoints:\n" +
+		"        production:\n          url: https://sync.mg.example.com\n"
+	if err := ioutil.WriteFile(paramsPath, []byte(params), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Remove(paramsPath)
+	})
+
+	output, err := base.Execute(t, "mg", "sync", "-e", mgEnvName, "--source", projectPath, "--params", paramsPath,
+		"-k")
+	assert.Nil(t, err, "Error while syncing the APIs with params")
+	assert.Contains(t, output, "PizzaShackAPI")
+	assert.Equal(t, "https://sync.mg.example.com", adapter.ProductionEndpoint("PizzaShackAPI", "1.0.0"))
+}
+
+// createMGProject : Create an API project holding the sample api.yaml, removed when the test ends
+func createMGProject(t *testing.T) string {
+	projectPath, err := ioutil.TempDir("", "mg-project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(projectPath)
+	})
+	content, err := ioutil.ReadFile(testutils.SampleAPIYamlFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(projectPath, "api.yaml"), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return projectPath
+}
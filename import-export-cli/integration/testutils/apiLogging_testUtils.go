@@ -152,6 +152,6 @@ func validateSetAPILogLevel(t *testing.T, output string, response *apim.APILogLe
 }
 
 func validateInvalidPermissionError(t *testing.T, output string, err error) {
-	assert.Equal(t, "Exit status 1\n", output)
+	assert.Equal(t, "Exit status 5\n", output)
 	assert.Contains(t, string(err.Error()), "403")
 }
@@ -45,7 +45,17 @@ func InitApictl(t *testing.T) (string, error) {
 func SetApictlWithCustomDirectory(t *testing.T, customDirPath string) {
 
 	t.Log("Setting up the environment variable value for " + EnvVariableNameOfCustomCustomDirectoryAtInit)
+	previousDirPath, isSet := os.LookupEnv(EnvVariableNameOfCustomCustomDirectoryAtInit)
 	os.Setenv(EnvVariableNameOfCustomCustomDirectoryAtInit, customDirPath)
+
+	t.Cleanup(func() {
+		// Restore the directory used by the rest of the tests
+		if isSet {
+			os.Setenv(EnvVariableNameOfCustomCustomDirectoryAtInit, previousDirPath)
+		} else {
+			os.Unsetenv(EnvVariableNameOfCustomCustomDirectoryAtInit)
+		}
+	})
 }
 
 // ValidateApictlInit : Check and verify whether the apictl is initialized properly
@@ -68,7 +78,6 @@ func ValidateCustomDirectoryChangeAtInit(t *testing.T, customDirPath string) {
 
 	t.Cleanup(func() {
 		// Remove created custom directory directory
-		os.Unsetenv(EnvVariableNameOfCustomCustomDirectoryAtInit)
 		base.RemoveDir(customDirPath)
 	})
 }
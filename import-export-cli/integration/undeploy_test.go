@@ -29,6 +29,8 @@ const revisionNumber = "1"
 
 // Undeploy an API revision from one gateway
 func TestUndeployAPIRevisionSingleGateway(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -63,6 +65,8 @@ func TestUndeployAPIRevisionSingleGateway(t *testing.T) {
 
 // Undeploy an API revision from multiple gateways but not all
 func TestUndeployAPIRevisionMulitpleGateways(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -100,6 +104,8 @@ func TestUndeployAPIRevisionMulitpleGateways(t *testing.T) {
 
 // Undeploy an API revision from all the gateways
 func TestUndeployAPIRevisionAllGateways(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -136,6 +142,8 @@ func TestUndeployAPIRevisionAllGateways(t *testing.T) {
 
 // Undeploy an API revision from a gateway that does not exist
 func TestUndeployAPIRevisionFailure(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -163,6 +171,8 @@ func TestUndeployAPIRevisionFailure(t *testing.T) {
 
 // Undeploy an API Product revision from one gateway
 func TestUndeployAPIProductRevisionSingleGateway(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -198,6 +208,8 @@ func TestUndeployAPIProductRevisionSingleGateway(t *testing.T) {
 
 // Undeploy an API Product revision from multiple specified gateways but not all
 func TestUndeployAPIProductRevisionMulitpleGateways(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -236,6 +248,8 @@ func TestUndeployAPIProductRevisionMulitpleGateways(t *testing.T) {
 
 // Undeploy an API Product revision from all the gateways
 func TestUndeployAPIProductRevisionAllGateways(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
@@ -273,6 +287,8 @@ func TestUndeployAPIProductRevisionAllGateways(t *testing.T) {
 
 // Undeploy an API Product revision from a gateway that does not exist
 func TestUndeployAPIProductRevisionFailure(t *testing.T) {
+	skipOnFakeAPIM(t)
+
 	for _, user := range testCaseUsers {
 		t.Run(user.Description, func(t *testing.T) {
 
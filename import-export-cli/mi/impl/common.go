@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -33,9 +34,6 @@ import (
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
-// miHTTPRetryCount default retry count for HTTP calls
-const miHTTPRetryCount = 2
-
 type updateArtifactRequestBody struct {
 	Name   string `json:"name"`
 	Status string `json:"status"`
@@ -47,12 +45,13 @@ type updateArtifactRequestBody struct {
 // @param env: environment of the micro integrator instance
 // @param model: struct object
 // @return struct object
-// @return error
-func unmarshalData(url string, params map[string]string, env string, model interface{}) (interface{}, error) {
-	resp, err := invokeGETRequestWithRetry(url, params, env)
+// @return error, a *utils.ResponseError if the micro integrator did not respond with 200 OK
+func unmarshalData(ctx context.Context, url string, params map[string]string, env string,
+	model interface{}) (interface{}, error) {
+	resp, err := invokeGETRequestWithRetry(ctx, url, params, env)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+url, err)
+		return nil, fmt.Errorf("unable to connect to %s: %w", url, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -62,26 +61,18 @@ func unmarshalData(url string, params map[string]string, env string, model inter
 		unmarshalError := json.Unmarshal(resp.Body(), &response)
 
 		if unmarshalError != nil {
-			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+			return nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 		}
 		return response, nil
 	}
-	if resp.StatusCode() == http.StatusUnauthorized {
-		fmt.Println("Invalid credentials. Please login to the current Micro Integrator instance")
-		utils.HandleErrorAndExit("Execute 'apictl mi login --help' for more information", nil)
-	}
-	if len(resp.Body()) == 0 {
-		return nil, errors.New(resp.Status())
-	}
-	data := unmarshalJSONToStringMap(resp.Body())
-	return data["Error"], errors.New(resp.Status())
+	return nil, newMIResponseError(resp, "Error")
 }
 
 func downloadLogFileData(url string, params map[string]string, env string) ([]byte, error) {
-	resp, err := invokeGETRequestWithRetry(url, params, env)
+	resp, err := invokeGETRequestWithRetry(context.Background(), url, params, env)
 
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+url, err)
+		return nil, fmt.Errorf("unable to connect to %s: %w", url, err)
 	}
 
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
@@ -89,106 +80,122 @@ func downloadLogFileData(url string, params map[string]string, env string) ([]by
 	if resp.StatusCode() == http.StatusOK {
 		return resp.Body(), nil
 	}
-	if resp.StatusCode() == http.StatusUnauthorized {
-		fmt.Println("Invalid credentials. Please login to the current Micro Integrator instance")
-		utils.HandleErrorAndExit("Execute 'apictl mi login --help' for more information", nil)
-	}
-	return nil, errors.New(resp.Status())
+	return nil, newMIResponseError(resp, "")
 }
 
 func handleResponse(resp *resty.Response, err error, url, messageTag, errorTag string) (string, error) {
 	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+url, err)
+		return "", fmt.Errorf("unable to connect to %s: %w", url, err)
 	}
 	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
 
-	if resp.StatusCode() == http.StatusUnauthorized {
-		fmt.Println("Invalid credentials. Please login to the current Micro Integrator instance")
-		utils.HandleErrorAndExit("Execute 'apictl mi login --help' for more information", nil)
+	if resp.StatusCode() == http.StatusUnauthorized || len(resp.Body()) == 0 {
+		return "", newMIResponseError(resp, errorTag)
 	}
-	if len(resp.Body()) == 0 {
-		return "", errors.New(resp.Status())
+	data, err := unmarshalJSONToStringMap(resp.Body())
+	if err != nil {
+		return "", err
 	}
-	data := unmarshalJSONToStringMap(resp.Body())
 	if data[messageTag] != "" {
 		return data[messageTag], nil
 	}
-	return "", errors.New(data[errorTag])
+	return "", newMIResponseError(resp, errorTag)
 }
 
-func retryHTTPCall(attempts int, env string, f func(string) (*resty.Response, error)) (*resty.Response, error) {
-	cred, err := credentials.GetMICredentials(env)
-	resp, err := f(cred.AccessToken)
+// newMIResponseError creates the error of a failed call to the management API. The message is read from the errorTag
+// of the response body if it is available
+func newMIResponseError(resp *resty.Response, errorTag string) error {
 	if resp.StatusCode() == http.StatusUnauthorized {
-		if attempts--; attempts > 0 {
-			token, err := credentials.GetOAuthAccessTokenForMI(cred.Username, cred.Password, env)
-			if err != nil {
-				return nil, err
-			}
-			credentials.UpdateMIAccessToken(env, token)
-			return retryHTTPCall(attempts, env, f)
+		return utils.NewResponseError(resp, "Invalid credentials. Please login to the current Micro Integrator "+
+			"instance. Execute 'apictl mi login --help' for more information")
+	}
+	msg := resp.Status()
+	if errorTag != "" && len(resp.Body()) > 0 {
+		if data, err := unmarshalJSONToStringMap(resp.Body()); err == nil && data[errorTag] != "" {
+			msg = data[errorTag]
 		}
 	}
-	return resp, err
+	return utils.NewResponseError(resp, msg)
+}
+
+// retryHTTPCall invokes the HTTP call with the access token of the environment and retries it once with a new access
+// token if the token is expired. Connection errors and unavailable servers are retried by the shared HTTP client
+func retryHTTPCall(ctx context.Context, env string, f func(string) (*resty.Response, error)) (*resty.Response, error) {
+	cred, err := credentials.GetMICredentials(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f(cred.AccessToken)
+	if err != nil || resp.StatusCode() != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	token, err := credentials.GetOAuthAccessTokenForMI(ctx, cred.Username, cred.Password, env)
+	if err != nil {
+		return nil, err
+	}
+	credentials.UpdateMIAccessToken(env, token)
+	return f(token)
 }
 
-func invokeGETRequestWithRetry(url string, params map[string]string, env string) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+func invokeGETRequestWithRetry(ctx context.Context, url string, params map[string]string, env string) (*resty.Response,
+	error) {
+	return retryHTTPCall(ctx, env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-		return utils.InvokeGETRequestWithMultipleQueryParams(params, url, headers)
+		return utils.InvokeGETRequestWithMultipleQueryParams(ctx, params, url, headers)
 	})
 }
 
 func invokePATCHRequestWithRetry(url string, body map[string]string, env string) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(context.Background(), env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-		return utils.InvokePATCHRequest(url, headers, body)
+		return utils.InvokePATCHRequest(context.Background(), url, headers, body)
 	})
 }
 
 func invokePOSTRequestWithRetry(env, url string, body interface{}) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(context.Background(), env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 		headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
-		return utils.InvokePOSTRequest(url, headers, body)
+		return utils.InvokePOSTRequest(context.Background(), url, headers, body)
 	})
 }
 
 func invokeDELETERequestWithRetry(url string, env string) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(context.Background(), env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-		return utils.InvokeDELETERequest(url, headers)
+		return utils.InvokeDELETERequest(context.Background(), url, headers)
 	})
 }
 
 func invokeDELETERequestWithRetryAndParams(url, env string, params map[string]string) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(context.Background(), env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
-		return utils.InvokeDELETERequestWithParams(url, params, headers)
+		return utils.InvokeDELETERequestWithParams(context.Background(), url, params, headers)
 	})
 }
 
 func invokePUTRequestWithRetry(env, url string, body interface{}) (*resty.Response, error) {
-	return retryHTTPCall(miHTTPRetryCount, env, func(accessToken string) (*resty.Response, error) {
+	return retryHTTPCall(context.Background(), env, func(accessToken string) (*resty.Response, error) {
 		headers := make(map[string]string)
 		headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 		headers[utils.HeaderContentType] = utils.HeaderValueApplicationJSON
-		return utils.InvokePUTRequestWithoutQueryParams(url, headers, body)
+		return utils.InvokePUTRequestWithoutQueryParams(context.Background(), url, headers, body)
 	})
 }
 
-func unmarshalJSONToStringMap(body []byte) map[string]string {
+func unmarshalJSONToStringMap(body []byte) (map[string]string, error) {
 	var data map[string]string
 	unmarshalError := json.Unmarshal(body, &data)
 	if unmarshalError != nil {
-		utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
+		return nil, fmt.Errorf("invalid JSON response: %w", unmarshalError)
 	}
-	return data
+	return data, nil
 }
 
 func getItemRenderer(data interface{}) func(w io.Writer, t *template.Template) error {
@@ -211,38 +218,27 @@ func getRenderer(data interface{}, newLine bool) func(w io.Writer, t *template.T
 	}
 }
 
-func getArtifactInfo(resource, artifactKey, artifactName, env string, model interface{}) (interface{}, error) {
+func getArtifactInfo(ctx context.Context, resource, artifactKey, artifactName, env string,
+	model interface{}) (interface{}, error) {
 	params := make(map[string]string)
 	params[artifactKey] = artifactName
 
-	return callMIManagementEndpointOfResource(resource, params, env, model)
+	return callMIManagementEndpointOfResource(ctx, resource, params, env, model)
 }
 
-func getArtifactList(resource, env string, model interface{}) (interface{}, error) {
-	return callMIManagementEndpointOfResource(resource, nil, env, model)
+func getArtifactList(ctx context.Context, resource, env string, model interface{}) (interface{}, error) {
+	return callMIManagementEndpointOfResource(ctx, resource, nil, env, model)
 }
 
-func callMIManagementEndpointOfResource(resource string, params map[string]string, env string, model interface{}) (interface{}, error) {
+func callMIManagementEndpointOfResource(ctx context.Context, resource string, params map[string]string, env string,
+	model interface{}) (interface{}, error) {
 	url := utils.GetMIManagementEndpointOfResource(resource, env, utils.MainConfigFilePath)
 
-	resp, err := unmarshalData(url, params, env, model)
-	if err != nil {
-		if resp != nil {
-			errBody := resp.(string)
-			if len(errBody) > 0 {
-				return nil, errors.New(errBody)
-			}
-		}
-		return nil, err
-	}
-	return resp, nil
+	return unmarshalData(ctx, url, params, env, model)
 }
 
 func getContextWithFormat(format, defaultformat string) *formatter.Context {
-	if format == "" {
-		format = defaultformat
-	}
-	return formatter.NewContext(os.Stdout, format)
+	return formatter.NewListContext(os.Stdout, format, defaultformat)
 }
 
 func putNonEmptyValueToMap(dataMap map[string]string, key, value string) {
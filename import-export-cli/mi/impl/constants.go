@@ -42,3 +42,7 @@ const yearHeader = "YEAR"
 const transactionCountHeader = "TRANSACTION COUNT"
 const userIDHeader = "USER ID"
 const roleHeader = "ROLE"
+const messageIDHeader = "MESSAGE ID"
+const contentHeader = "CONTENT"
+const vaultHeader = "VAULT"
+const aliasHeader = "ALIAS"
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -40,8 +41,8 @@ const (
 )
 
 // GetCompositeAppList returns a list of composite apps deployed in the micro integrator in a given environment
-func GetCompositeAppList(env string) (*artifactutils.CompositeAppList, error) {
-	resp, err := getArtifactList(utils.MiManagementCarbonAppResource, env, &artifactutils.CompositeAppList{})
+func GetCompositeAppList(ctx context.Context, env string) (*artifactutils.CompositeAppList, error) {
+	resp, err := getArtifactList(ctx, utils.MiManagementCarbonAppResource, env, &artifactutils.CompositeAppList{})
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +69,7 @@ func PrintCompositeAppList(appList *artifactutils.CompositeAppList, format strin
 			"Version": versionHeader,
 		}
 		if err := appListContext.Write(renderer, appListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Composite Apps found")
@@ -77,7 +78,8 @@ func PrintCompositeAppList(appList *artifactutils.CompositeAppList, format strin
 
 // GetCompositeApp returns a information about a specific composite app deployed in the micro integrator in a given environment
 func GetCompositeApp(env, appname string) (*artifactutils.CompositeApp, error) {
-	resp, err := getArtifactInfo(utils.MiManagementCarbonAppResource, "carbonAppName", appname, env, &artifactutils.CompositeApp{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementCarbonAppResource, "carbonAppName", appname,
+		env, &artifactutils.CompositeApp{})
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +96,6 @@ func PrintCompositeAppDetails(app *artifactutils.CompositeApp, format string) {
 	renderer := getItemRenderer(app)
 
 	if err := appContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
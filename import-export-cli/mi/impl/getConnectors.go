@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/template"
@@ -31,7 +32,8 @@ const defaultConnectorListTableFormat = "table {{.Name}}\t{{.Status}}\t{{.Packag
 
 // GetConnectorList returns a list of connector artifacts deployed in the micro integrator in a given environment
 func GetConnectorList(env string) (*artifactutils.ConnectorList, error) {
-	resp, err := getArtifactList(utils.MiManagementConnectorResource, env, &artifactutils.ConnectorList{})
+	resp, err := getArtifactList(context.Background(), utils.MiManagementConnectorResource, env,
+		&artifactutils.ConnectorList{})
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +62,7 @@ func PrintConnectorList(connectorList *artifactutils.ConnectorList, format strin
 			"Description": descriptionHeader,
 		}
 		if err := connectorListContext.Write(renderer, connectorListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Connectors found")
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -43,8 +44,8 @@ const (
 )
 
 // GetDataServiceList returns a list of data services deployed in the micro integrator in a given environment
-func GetDataServiceList(env string) (*artifactutils.DataServicesList, error) {
-	resp, err := getArtifactList(utils.MiManagementDataServiceResource, env, &artifactutils.DataServicesList{})
+func GetDataServiceList(ctx context.Context, env string) (*artifactutils.DataServicesList, error) {
+	resp, err := getArtifactList(ctx, utils.MiManagementDataServiceResource, env, &artifactutils.DataServicesList{})
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +73,7 @@ func PrintDataServiceList(dataServiceList *artifactutils.DataServicesList, forma
 			"Wsdl20":      wsdl20Header,
 		}
 		if err := dataserviceListContext.Write(renderer, dataserviceListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Data Services found")
@@ -81,7 +82,8 @@ func PrintDataServiceList(dataServiceList *artifactutils.DataServicesList, forma
 
 // GetDataService returns information about a specific data service deployed in the micro integrator in a given environment
 func GetDataService(env, dataserviceName string) (*artifactutils.DataServiceInfo, error) {
-	resp, err := getArtifactInfo(utils.MiManagementDataServiceResource, "dataServiceName", dataserviceName, env, &artifactutils.DataServiceInfo{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementDataServiceResource, "dataServiceName",
+		dataserviceName, env, &artifactutils.DataServiceInfo{})
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +100,6 @@ func PrintDataServiceDetails(ds *artifactutils.DataServiceInfo, format string) {
 	renderer := getItemRenderer(ds)
 
 	if err := dataserviceContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -43,8 +44,8 @@ const (
 )
 
 // GetEndpointList returns a list of endpoints
-func GetEndpointList(env string) (*artifactutils.EndpointList, error) {
-	resp, err := getArtifactList(utils.MiManagementEndpointResource, env, &artifactutils.EndpointList{})
+func GetEndpointList(ctx context.Context, env string) (*artifactutils.EndpointList, error) {
+	resp, err := getArtifactList(ctx, utils.MiManagementEndpointResource, env, &artifactutils.EndpointList{})
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +73,7 @@ func PrintEndpointList(endpointList *artifactutils.EndpointList, format string)
 			"Active": activeHeader,
 		}
 		if err := endpointListContext.Write(renderer, endpointListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Endpoints found")
@@ -81,7 +82,8 @@ func PrintEndpointList(endpointList *artifactutils.EndpointList, format string)
 
 // GetEndpoint returns information about a specific endpoint
 func GetEndpoint(env, endpointName string) (*artifactutils.Endpoint, error) {
-	resp, err := getArtifactInfo(utils.MiManagementEndpointResource, "endpointName", endpointName, env, &artifactutils.Endpoint{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementEndpointResource, "endpointName", endpointName,
+		env, &artifactutils.Endpoint{})
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +100,6 @@ func PrintEndpointDetails(endpoint *artifactutils.Endpoint, format string) {
 	renderer := getItemRenderer(endpoint)
 
 	if err := endpointContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
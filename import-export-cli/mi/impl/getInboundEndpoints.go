@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -43,7 +44,8 @@ const (
 
 // GetInboundEndpointList returns a list of inbound endpoints deployed in the micro integrator in a given environment
 func GetInboundEndpointList(env string) (*artifactutils.InboundEndpointList, error) {
-	resp, err := getArtifactList(utils.MiManagementInboundEndpointResource, env, &artifactutils.InboundEndpointList{})
+	resp, err := getArtifactList(context.Background(), utils.MiManagementInboundEndpointResource, env,
+		&artifactutils.InboundEndpointList{})
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +72,7 @@ func PrintInboundEndpointList(inboundEPList *artifactutils.InboundEndpointList,
 			"Type": typeHeader,
 		}
 		if err := inboundEPListContext.Write(renderer, inboundEPListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Inbound Endpoints found")
@@ -79,8 +81,8 @@ func PrintInboundEndpointList(inboundEPList *artifactutils.InboundEndpointList,
 
 // GetInboundEndpoint returns a information about a specific inbound endpoint deployed in the micro integrator in a given environment
 func GetInboundEndpoint(env, inboundEPName string) (*artifactutils.InboundEndpoint, error) {
-	resp, err := getArtifactInfo(utils.MiManagementInboundEndpointResource, "inboundEndpointName", inboundEPName, env,
-		&artifactutils.InboundEndpoint{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementInboundEndpointResource, "inboundEndpointName",
+		inboundEPName, env, &artifactutils.InboundEndpoint{})
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +99,6 @@ func PrintInboundEndpointDetails(inboundEP *artifactutils.InboundEndpoint, forma
 	renderer := getItemRenderer(inboundEP)
 
 	if err := inboundEPContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -43,8 +44,8 @@ const (
 )
 
 // GetIntegrationAPIList returns a list of apis deployed in the micro integrator in a given environment
-func GetIntegrationAPIList(env string) (*artifactutils.IntegrationAPIList, error) {
-	resp, err := getArtifactList(utils.MiManagementAPIResource, env, &artifactutils.IntegrationAPIList{})
+func GetIntegrationAPIList(ctx context.Context, env string) (*artifactutils.IntegrationAPIList, error) {
+	resp, err := getArtifactList(ctx, utils.MiManagementAPIResource, env, &artifactutils.IntegrationAPIList{})
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +72,7 @@ func PrintIntegrationAPIList(apiList *artifactutils.IntegrationAPIList, format s
 			"Url":  urlHeader,
 		}
 		if err := apiListContext.Write(renderer, apiListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No APIs found")
@@ -79,8 +80,9 @@ func PrintIntegrationAPIList(apiList *artifactutils.IntegrationAPIList, format s
 }
 
 // GetIntegrationAPI returns a information about a specific api deployed in the micro integrator in a given environment
-func GetIntegrationAPI(env, apiName string) (*artifactutils.IntegrationAPI, error) {
-	resp, err := getArtifactInfo(utils.MiManagementAPIResource, "apiName", apiName, env, &artifactutils.IntegrationAPI{})
+func GetIntegrationAPI(ctx context.Context, env, apiName string) (*artifactutils.IntegrationAPI, error) {
+	resp, err := getArtifactInfo(ctx, utils.MiManagementAPIResource, "apiName", apiName, env,
+		&artifactutils.IntegrationAPI{})
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +99,6 @@ func PrintIntegrationAPIDetails(api *artifactutils.IntegrationAPI, format string
 	renderer := getItemRenderer(api)
 
 	if err := apiContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/template"
@@ -36,7 +37,8 @@ const (
 
 // GetLocalEntryList returns a list of local entries deployed in the micro integrator in a given environment
 func GetLocalEntryList(env string) (*artifactutils.LocalEntryList, error) {
-	resp, err := getArtifactList(utils.MiManagementLocalEntrieResource, env, &artifactutils.LocalEntryList{})
+	resp, err := getArtifactList(context.Background(), utils.MiManagementLocalEntrieResource, env,
+		&artifactutils.LocalEntryList{})
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +65,7 @@ func PrintLocalEntryList(localEntryList *artifactutils.LocalEntryList, format st
 			"Type": typeHeader,
 		}
 		if err := localEntryListContext.Write(renderer, localEntryListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Local Entries found")
@@ -72,7 +74,8 @@ func PrintLocalEntryList(localEntryList *artifactutils.LocalEntryList, format st
 
 // GetLocalEntry returns a information about a specific local entry deployed in the micro integrator in a given environment
 func GetLocalEntry(env, localEntryName string) (*artifactutils.LocalEntryData, error) {
-	resp, err := getArtifactInfo(utils.MiManagementLocalEntrieResource, "name", localEntryName, env, &artifactutils.LocalEntryData{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementLocalEntrieResource, "name", localEntryName,
+		env, &artifactutils.LocalEntryData{})
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +88,6 @@ func PrintLocalEntryDetails(localEntry *artifactutils.LocalEntryData, format str
 	renderer := getItemRendererEndsWithNewLine(localEntry)
 
 	if err := localEntryContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -35,7 +36,7 @@ const (
 
 // GetLogFileList returns a list of log files created by the micro integrator in a given environment
 func GetLogFileList(env string) (*artifactutils.LogFileList, error) {
-	resp, err := getArtifactList(utils.MiManagementLogResource, env, &artifactutils.LogFileList{})
+	resp, err := getArtifactList(context.Background(), utils.MiManagementLogResource, env, &artifactutils.LogFileList{})
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +63,7 @@ func PrintLogFileList(logFileList *artifactutils.LogFileList, format string) {
 			"Size":     sizeHeader,
 		}
 		if err := logFileListContext.Write(renderer, logFileListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Log Files found")
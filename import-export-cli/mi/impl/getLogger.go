@@ -19,7 +19,7 @@
 package impl
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
@@ -31,7 +31,8 @@ const (
 
 // GetLoggerInfo returns information about a specific logger
 func GetLoggerInfo(env, loggerName string) (*artifactutils.Logger, error) {
-	resp, err := getArtifactInfo(utils.MiManagementLoggingResource, "loggerName", loggerName, env, &artifactutils.Logger{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementLoggingResource, "loggerName", loggerName, env,
+		&artifactutils.Logger{})
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +50,6 @@ func PrintLoggerInfo(logger *artifactutils.Logger, format string) {
 		"ComponentName": componentHeader,
 	}
 	if err := loggerContext.Write(renderer, loggerInfoTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -50,7 +51,8 @@ const (
 
 // GetMessageProcessorList returns a list of message processors deployed in the micro integrator in a given environment
 func GetMessageProcessorList(env string) (*artifactutils.MessageProcessorList, error) {
-	resp, err := getArtifactList(utils.MiManagementMessageProcessorResource, env, &artifactutils.MessageProcessorList{})
+	resp, err := getArtifactList(context.Background(), utils.MiManagementMessageProcessorResource, env,
+		&artifactutils.MessageProcessorList{})
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +80,7 @@ func PrintMessageProcessorList(messageProcessorList *artifactutils.MessageProces
 			"Status": statusHeader,
 		}
 		if err := messageProcessorListContext.Write(renderer, messageProcessorListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Message Processors found")
@@ -87,7 +89,8 @@ func PrintMessageProcessorList(messageProcessorList *artifactutils.MessageProces
 
 // GetMessageProcessor returns a information about a specific message processor deployed in the micro integrator in a given environment
 func GetMessageProcessor(env, messageProcessorName string) (*artifactutils.MessageProcessorData, error) {
-	resp, err := getArtifactInfo(utils.MiManagementMessageProcessorResource, "name", messageProcessorName, env, &artifactutils.MessageProcessorData{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementMessageProcessorResource, "name",
+		messageProcessorName, env, &artifactutils.MessageProcessorData{})
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +107,6 @@ func PrintMessageProcessorDetails(messageProcessor *artifactutils.MessageProcess
 	renderer := getItemRenderer(messageProcessor)
 
 	if err := messageProcessorContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -35,6 +36,8 @@ const (
 	defaultMessageStoreDetailedFormat  = "detail Name - {{.Name}}\n" +
 		"File Name - {{.FileName}}\n" +
 		"Container - {{.Container}}\n" +
+		"Producer - {{.Producer}}\n" +
+		"Consumer - {{.Consumer}}\n" +
 		"Size - {{.Size}}\n" +
 		"Properties :\n" +
 		"{{ if eq (len .Properties) 0 }}" +
@@ -48,7 +51,8 @@ const (
 
 // GetMessageStoreList returns a list of message stores deployed in the micro integrator in a given environment
 func GetMessageStoreList(env string) (*artifactutils.MessageStoreList, error) {
-	resp, err := getArtifactList(utils.MiManagementMessageStoreResource, env, &artifactutils.MessageStoreList{})
+	resp, err := getArtifactList(context.Background(), utils.MiManagementMessageStoreResource, env,
+		&artifactutils.MessageStoreList{})
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +80,7 @@ func PrintMessageStoreList(messageStoreList *artifactutils.MessageStoreList, for
 			"Size": sizeHeader,
 		}
 		if err := messageStoreListContext.Write(renderer, messageStoreListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Message Stores found")
@@ -85,7 +89,8 @@ func PrintMessageStoreList(messageStoreList *artifactutils.MessageStoreList, for
 
 // GetMessageStore returns a information about a specific message store deployed in the micro integrator in a given environment
 func GetMessageStore(env, messageStoreName string) (*artifactutils.MessageStoreData, error) {
-	resp, err := getArtifactInfo(utils.MiManagementMessageStoreResource, "name", messageStoreName, env, &artifactutils.MessageStoreData{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementMessageStoreResource, "name", messageStoreName,
+		env, &artifactutils.MessageStoreData{})
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +107,6 @@ func PrintMessageStoreDetails(messageStore *artifactutils.MessageStoreData, form
 	renderer := getItemRenderer(messageStore)
 
 	if err := messageStoreContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
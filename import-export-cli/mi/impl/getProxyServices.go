@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -39,8 +40,8 @@ const (
 )
 
 // GetProxyServiceList returns a list of proxy serives deployed in the micro integrator in a given environment
-func GetProxyServiceList(env string) (*artifactutils.ProxyServiceList, error) {
-	resp, err := getArtifactList(utils.MiManagementProxyServiceResource, env, &artifactutils.ProxyServiceList{})
+func GetProxyServiceList(ctx context.Context, env string) (*artifactutils.ProxyServiceList, error) {
+	resp, err := getArtifactList(ctx, utils.MiManagementProxyServiceResource, env, &artifactutils.ProxyServiceList{})
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +69,7 @@ func PrintProxyServiceList(proxyList *artifactutils.ProxyServiceList, format str
 			"Wsdl20": wsdl20Header,
 		}
 		if err := proxyListContext.Write(renderer, proxyListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Proxy Services found")
@@ -76,8 +77,9 @@ func PrintProxyServiceList(proxyList *artifactutils.ProxyServiceList, format str
 }
 
 // GetProxyService returns a information about a specific proxy deployed in the micro integrator in a given environment
-func GetProxyService(env, proxyName string) (*artifactutils.Proxy, error) {
-	resp, err := getArtifactInfo(utils.MiManagementProxyServiceResource, "proxyServiceName", proxyName, env, &artifactutils.Proxy{})
+func GetProxyService(ctx context.Context, env, proxyName string) (*artifactutils.Proxy, error) {
+	resp, err := getArtifactInfo(ctx, utils.MiManagementProxyServiceResource, "proxyServiceName", proxyName, env,
+		&artifactutils.Proxy{})
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +96,6 @@ func PrintProxyServiceDetails(proxy *artifactutils.Proxy, format string) {
 	renderer := getItemRendererEndsWithNewLine(proxy)
 
 	if err := proxyContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -42,7 +43,8 @@ const (
 
 // GetRoleList returns a list of roles in the micro integrator in a given environment
 func GetRoleList(env string) (*artifactutils.RoleList, error) {
-	resp, err := callMIManagementEndpointOfResource(utils.MiManagementRoleResource, nil, env, &artifactutils.RoleList{})
+	resp, err := callMIManagementEndpointOfResource(context.Background(), utils.MiManagementRoleResource, nil, env,
+		&artifactutils.RoleList{})
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +70,7 @@ func PrintRoleList(roleList *artifactutils.RoleList, format string) {
 			"Role": roleHeader,
 		}
 		if err := roleListContext.Write(renderer, roleListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No roles found")
@@ -80,7 +82,8 @@ func GetRoleInfo(env, role, domain string) (*artifactutils.RoleSummary, error) {
 	var roleInfoResource = utils.MiManagementRoleResource + "/" + role
 	params := make(map[string]string)
 	putNonEmptyValueToMap(params, "domain", domain)
-	resp, err := callMIManagementEndpointOfResource(roleInfoResource, params, env, &artifactutils.RoleSummary{})
+	resp, err := callMIManagementEndpointOfResource(context.Background(), roleInfoResource, params, env,
+		&artifactutils.RoleSummary{})
 	if err != nil {
 		return nil, err
 	}
@@ -97,6 +100,6 @@ func PrintRoleDetails(roleInfo *artifactutils.RoleSummary, format string) {
 	renderer := getItemRendererEndsWithNewLine(roleInfo)
 
 	if err := roleInfoContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -44,8 +45,8 @@ const (
 )
 
 // GetSequenceList returns a list of sequences deployed in the micro integrator in a given environment
-func GetSequenceList(env string) (*artifactutils.SequenceList, error) {
-	resp, err := getArtifactList(utils.MiManagementSequenceResource, env, &artifactutils.SequenceList{})
+func GetSequenceList(ctx context.Context, env string) (*artifactutils.SequenceList, error) {
+	resp, err := getArtifactList(ctx, utils.MiManagementSequenceResource, env, &artifactutils.SequenceList{})
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +74,7 @@ func PrintSequenceList(sequenceList *artifactutils.SequenceList, format string)
 			"Tracing": tracingHeader,
 		}
 		if err := sequenceListContext.Write(renderer, sequenceListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Sequences found")
@@ -82,7 +83,8 @@ func PrintSequenceList(sequenceList *artifactutils.SequenceList, format string)
 
 // GetSequence returns a information about a specific sequence deployed in the micro integrator in a given environment
 func GetSequence(env, sequenceName string) (*artifactutils.Sequence, error) {
-	resp, err := getArtifactInfo(utils.MiManagementSequenceResource, "sequenceName", sequenceName, env, &artifactutils.Sequence{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementSequenceResource, "sequenceName", sequenceName,
+		env, &artifactutils.Sequence{})
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +101,6 @@ func PrintSequenceDetails(sequence *artifactutils.Sequence, format string) {
 	renderer := getItemRendererEndsWithNewLine(sequence)
 
 	if err := sequenceContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -43,7 +44,7 @@ const (
 
 // GetTaskList returns a list of Tasks deployed in the micro integrator in a given environment
 func GetTaskList(env string) (*artifactutils.TaskList, error) {
-	resp, err := getArtifactList(utils.MiManagementTaskResource, env, &artifactutils.TaskList{})
+	resp, err := getArtifactList(context.Background(), utils.MiManagementTaskResource, env, &artifactutils.TaskList{})
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +70,7 @@ func PrintTaskList(taskList *artifactutils.TaskList, format string) {
 			"Name": nameHeader,
 		}
 		if err := taskListContext.Write(renderer, taskListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Tasks found")
@@ -78,7 +79,8 @@ func PrintTaskList(taskList *artifactutils.TaskList, format string) {
 
 // GetTask returns a information about a specific Task deployed in the micro integrator in a given environment
 func GetTask(env, taskName string) (*artifactutils.Task, error) {
-	resp, err := getArtifactInfo(utils.MiManagementTaskResource, "taskName", taskName, env, &artifactutils.Task{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementTaskResource, "taskName", taskName, env,
+		&artifactutils.Task{})
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +97,6 @@ func PrintTaskDetails(task *artifactutils.Task, format string) {
 	renderer := getItemRendererEndsWithNewLine(task)
 
 	if err := taskContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
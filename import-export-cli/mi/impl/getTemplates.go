@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -81,7 +82,8 @@ func (a *templateArtifact) MarshalJSON() ([]byte, error) {
 
 // GetTemplateList returns a list of Templates deployed in the micro integrator in a given environment
 func GetTemplateList(env string) (*artifactutils.TemplateList, error) {
-	resp, err := getArtifactList(utils.MiManagementTemplateResource, env, &artifactutils.TemplateList{})
+	resp, err := getArtifactList(context.Background(), utils.MiManagementTemplateResource, env,
+		&artifactutils.TemplateList{})
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +122,7 @@ func PrintTemplateList(templateList *artifactutils.TemplateList, format string)
 			"TemplateType": typeHeader,
 		}
 		if err := templateListContext.Write(renderer, templateListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Templates found")
@@ -129,7 +131,8 @@ func PrintTemplateList(templateList *artifactutils.TemplateList, format string)
 
 // GetTemplatesByType returns a list of Templates of specified type deployed in the micro integrator in a given environment
 func GetTemplatesByType(env, templateType string) (*artifactutils.TemplateListByType, error) {
-	resp, err := getArtifactInfo(utils.MiManagementTemplateResource, "type", templateType, env, &artifactutils.TemplateListByType{})
+	resp, err := getArtifactInfo(context.Background(), utils.MiManagementTemplateResource, "type", templateType, env,
+		&artifactutils.TemplateListByType{})
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +158,7 @@ func PrintTemplatesByType(templateList *artifactutils.TemplateListByType, format
 			"Name": nameHeader,
 		}
 		if err := templateListByTypeContext.Write(renderer, templateListByTypeTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Templates found for the given type")
@@ -185,7 +188,8 @@ func getTemplate(env, templateType, templateName string, model interface{}) (int
 	params["type"] = templateType
 	params["name"] = templateName
 
-	resp, err := callMIManagementEndpointOfResource(utils.MiManagementTemplateResource, params, env, model)
+	resp, err := callMIManagementEndpointOfResource(context.Background(), utils.MiManagementTemplateResource, params,
+		env, model)
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +206,7 @@ func PrintSequenceTemplateDetails(sequenceTemplate *artifactutils.TemplateSequen
 	renderer := getItemRenderer(sequenceTemplate)
 
 	if err := templateContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
 
@@ -216,6 +220,6 @@ func PrintEndpointTemplateDetails(endpointTemplate *artifactutils.TemplateEndpoi
 	renderer := getItemRendererEndsWithNewLine(endpointTemplate)
 
 	if err := templateContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
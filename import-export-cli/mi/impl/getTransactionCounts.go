@@ -19,7 +19,7 @@
 package impl
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
@@ -40,7 +40,8 @@ func GetTransactionCount(env string, period []string) (*artifactutils.Transactio
 	}
 
 	var transactionCountResource = utils.MiManagementTransactionResource + "/" + utils.MiManagementTransactionCountResource
-	resp, err := callMIManagementEndpointOfResource(transactionCountResource, params, env, &artifactutils.TransactionCount{})
+	resp, err := callMIManagementEndpointOfResource(context.Background(), transactionCountResource, params, env,
+		&artifactutils.TransactionCount{})
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +59,6 @@ func PrintTransactionCount(transactionCount *artifactutils.TransactionCount, for
 		"TransactionCount": transactionCountHeader,
 	}
 	if err := transactionContext.Write(renderer, transactionCountTableHeaders); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,17 +19,65 @@
 package impl
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 const transactionReportFilePrefix = "transaction-count-summary-"
 
+// transactionReportPrometheusFileName is kept constant so that the node exporter textfile collector always reads the
+// latest counts from the same file
+const transactionReportPrometheusFileName = "apictl_mi_transactions.prom"
+const transactionReportPrometheusMetric = "apictl_mi_transaction_count"
+
+// Supported groupings of the aggregated transaction report
+const (
+	TransactionReportGroupByMonth   = "month"
+	TransactionReportGroupByQuarter = "quarter"
+	TransactionReportGroupByYear    = "year"
+)
+
+// Supported output formats of the aggregated transaction report
+const (
+	TransactionReportFormatCSV        = "csv"
+	TransactionReportFormatJSON       = "json"
+	TransactionReportFormatYAML       = "yaml"
+	TransactionReportFormatPrometheus = "prometheus"
+)
+
+// Supported period presets of the transaction report
+const (
+	TransactionReportPresetLastMonth   = "last-month"
+	TransactionReportPresetLastQuarter = "last-quarter"
+	TransactionReportPresetLastYear    = "last-year"
+)
+
+const transactionReportMonthLayout = "2006-01"
+
+// layouts in which the timestamp column of the transaction report may be returned by the Micro Integrator
+var transactionReportTimestampLayouts = []string{
+	"2006-01-02 15:04:05.0",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+	"2006-01-02",
+	transactionReportMonthLayout,
+}
+
 // GetTransactionReport returns inbound transactions received by the micro integrator in a given environment as a report
 func GetTransactionReport(env string, period []string) (*artifactutils.TransactionCountInfo, error) {
 	params := make(map[string]string)
@@ -37,7 +85,8 @@ func GetTransactionReport(env string, period []string) (*artifactutils.Transacti
 	params["end"] = period[1]
 
 	var transactionReportResource = utils.MiManagementTransactionResource + "/" + utils.MiManagementTransactionReportResource
-	resp, err := callMIManagementEndpointOfResource(transactionReportResource, params, env, &artifactutils.TransactionCountInfo{})
+	resp, err := callMIManagementEndpointOfResource(context.Background(), transactionReportResource, params, env,
+		&artifactutils.TransactionCountInfo{})
 	if err != nil {
 		return nil, err
 	}
@@ -56,3 +105,214 @@ func WriteTransactionReportAsCSV(transactions *artifactutils.TransactionCountInf
 		fmt.Println("Transaction Count Report created in", destinationFilePath)
 	}
 }
+
+// GetTransactionReportPeriodForPreset returns the start and end months of the given period preset relative to now
+func GetTransactionReportPeriodForPreset(preset string, now time.Time) ([]string, error) {
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	var start, end time.Time
+	switch preset {
+	case TransactionReportPresetLastMonth:
+		start = currentMonth.AddDate(0, -1, 0)
+		end = start
+	case TransactionReportPresetLastQuarter:
+		currentQuarterStart := time.Date(now.Year(), time.Month((int(now.Month())-1)/3*3+1), 1, 0, 0, 0, 0, time.UTC)
+		start = currentQuarterStart.AddDate(0, -3, 0)
+		end = currentQuarterStart.AddDate(0, -1, 0)
+	case TransactionReportPresetLastYear:
+		start = time.Date(now.Year()-1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end = time.Date(now.Year()-1, time.December, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return nil, errors.New("unsupported period preset " + preset)
+	}
+	return []string{start.Format(transactionReportMonthLayout), end.Format(transactionReportMonthLayout)}, nil
+}
+
+// AggregateTransactionReports sums up the transaction report rows of each environment per month, quarter or year
+func AggregateTransactionReports(reports map[string]*artifactutils.TransactionCountInfo, groupBy string,
+	period []string) (*artifactutils.TransactionReportSummary, error) {
+	summary := &artifactutils.TransactionReportSummary{
+		GroupBy: groupBy,
+		Entries: []artifactutils.TransactionReportEntry{},
+	}
+	if len(period) > 0 {
+		summary.Start = period[0]
+	}
+	if len(period) > 1 {
+		summary.End = period[1]
+	}
+	for env, report := range reports {
+		counts, err := aggregateTransactionCountRows(report.TransactionCounts, groupBy)
+		if err != nil {
+			return nil, errors.New("Error aggregating the transaction report of environment " + env + ". " + err.Error())
+		}
+		for periodKey, count := range counts {
+			summary.Entries = append(summary.Entries, artifactutils.TransactionReportEntry{
+				Environment:      env,
+				Period:           periodKey,
+				TransactionCount: count,
+			})
+			summary.Total += count
+		}
+	}
+	sort.Slice(summary.Entries, func(i, j int) bool {
+		if summary.Entries[i].Environment != summary.Entries[j].Environment {
+			return summary.Entries[i].Environment < summary.Entries[j].Environment
+		}
+		return summary.Entries[i].Period < summary.Entries[j].Period
+	})
+	return summary, nil
+}
+
+// aggregateTransactionCountRows sums up the counts of the raw report rows. The first row holds the column names.
+func aggregateTransactionCountRows(rows [][]string, groupBy string) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	if len(rows) == 0 {
+		return counts, nil
+	}
+	timestampColumn, yearColumn, monthColumn, countColumn := -1, -1, -1, -1
+	for i, column := range rows[0] {
+		name := strings.ToUpper(strings.NewReplacer(" ", "", "_", "").Replace(column))
+		switch {
+		case strings.Contains(name, "COUNT"):
+			countColumn = i
+		case name == "YEAR":
+			yearColumn = i
+		case name == "MONTH":
+			monthColumn = i
+		case strings.Contains(name, "TIME") || strings.Contains(name, "DATE"):
+			timestampColumn = i
+		}
+	}
+	if countColumn == -1 || (timestampColumn == -1 && (yearColumn == -1 || monthColumn == -1)) {
+		return nil, errors.New("unrecognized report columns " + strings.Join(rows[0], ", "))
+	}
+
+	for _, row := range rows[1:] {
+		if len(row) <= countColumn {
+			continue
+		}
+		count, err := strconv.ParseInt(strings.TrimSpace(row[countColumn]), 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid transaction count " + row[countColumn])
+		}
+		var date time.Time
+		if yearColumn != -1 && monthColumn != -1 {
+			date, err = time.Parse("2006-1", strings.TrimSpace(row[yearColumn])+"-"+strings.TrimSpace(row[monthColumn]))
+		} else {
+			date, err = parseTransactionReportTimestamp(row[timestampColumn])
+		}
+		if err != nil {
+			return nil, err
+		}
+		counts[getTransactionReportPeriodKey(date, groupBy)] += count
+	}
+	return counts, nil
+}
+
+func parseTransactionReportTimestamp(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if epochMillis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, epochMillis*int64(time.Millisecond)).UTC(), nil
+	}
+	for _, layout := range transactionReportTimestampLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, errors.New("invalid timestamp " + value)
+}
+
+func getTransactionReportPeriodKey(date time.Time, groupBy string) string {
+	switch groupBy {
+	case TransactionReportGroupByYear:
+		return strconv.Itoa(date.Year())
+	case TransactionReportGroupByQuarter:
+		return fmt.Sprintf("%d-Q%d", date.Year(), (int(date.Month())-1)/3+1)
+	default:
+		return date.Format(transactionReportMonthLayout)
+	}
+}
+
+// WriteTransactionReportSummary writes the aggregated transaction report in the given format to the target directory
+func WriteTransactionReportSummary(summary *artifactutils.TransactionReportSummary, format, targetDirectory string) {
+	content, err := renderTransactionReportSummary(summary, format)
+	if err != nil {
+		fmt.Println("Error writing the transaction report", err.Error())
+		return
+	}
+	var destinationFilePath string
+	if format == TransactionReportFormatPrometheus {
+		destinationFilePath = filepath.Join(targetDirectory, transactionReportPrometheusFileName)
+		err = writeFileAtomically(destinationFilePath, content)
+	} else {
+		fileName := transactionReportFilePrefix + strconv.FormatInt(time.Now().UnixNano(), 10) + "." + format
+		destinationFilePath = filepath.Join(targetDirectory, fileName)
+		err = ioutil.WriteFile(destinationFilePath, content, 0644)
+	}
+	if err != nil {
+		fmt.Println("Error writing the transaction report", err.Error())
+	} else {
+		fmt.Println("Transaction Count Report created in", destinationFilePath)
+	}
+}
+
+func renderTransactionReportSummary(summary *artifactutils.TransactionReportSummary, format string) ([]byte, error) {
+	switch format {
+	case TransactionReportFormatJSON:
+		return json.MarshalIndent(summary, "", "  ")
+	case TransactionReportFormatYAML:
+		return yaml.Marshal(summary)
+	case TransactionReportFormatPrometheus:
+		return renderTransactionReportAsPrometheusMetrics(summary), nil
+	case TransactionReportFormatCSV:
+		var buffer bytes.Buffer
+		csvWriter := csv.NewWriter(&buffer)
+		lines := [][]string{{"Environment", "Period", "TransactionCount"}}
+		for _, entry := range summary.Entries {
+			lines = append(lines, []string{entry.Environment, entry.Period, strconv.FormatInt(entry.TransactionCount, 10)})
+		}
+		if err := csvWriter.WriteAll(lines); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	default:
+		return nil, errors.New("unsupported report format " + format)
+	}
+}
+
+// renderTransactionReportAsPrometheusMetrics renders the report in the Prometheus text exposition format
+func renderTransactionReportAsPrometheusMetrics(summary *artifactutils.TransactionReportSummary) []byte {
+	var buffer bytes.Buffer
+	buffer.WriteString("# HELP " + transactionReportPrometheusMetric +
+		" Inbound transactions received by the Micro Integrator.\n")
+	buffer.WriteString("# TYPE " + transactionReportPrometheusMetric + " gauge\n")
+	labelEscaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	for _, entry := range summary.Entries {
+		fmt.Fprintf(&buffer, "%s{environment=\"%s\",period=\"%s\",group_by=\"%s\"} %d\n",
+			transactionReportPrometheusMetric, labelEscaper.Replace(entry.Environment),
+			labelEscaper.Replace(entry.Period), summary.GroupBy, entry.TransactionCount)
+	}
+	return buffer.Bytes()
+}
+
+// writeFileAtomically writes to a temporary file and renames it, so that readers never observe a partial file
+func writeFileAtomically(path string, content []byte) error {
+	tmpFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmpFile.Write(content); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err = tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err = os.Chmod(tmpFile.Name(), 0644); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
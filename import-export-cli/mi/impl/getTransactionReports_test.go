@@ -0,0 +1,93 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
+)
+
+func TestGetTransactionReportPeriodForPreset(t *testing.T) {
+	now := time.Date(2021, time.February, 15, 10, 0, 0, 0, time.UTC)
+
+	period, err := GetTransactionReportPeriodForPreset(TransactionReportPresetLastMonth, now)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2021-01", "2021-01"}, period)
+
+	period, err = GetTransactionReportPeriodForPreset(TransactionReportPresetLastQuarter, now)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2020-10", "2020-12"}, period)
+
+	period, err = GetTransactionReportPeriodForPreset(TransactionReportPresetLastYear, now)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2020-01", "2020-12"}, period)
+
+	_, err = GetTransactionReportPeriodForPreset("last-decade", now)
+	assert.NotNil(t, err)
+}
+
+func TestAggregateTransactionReports(t *testing.T) {
+	reports := map[string]*artifactutils.TransactionCountInfo{
+		"dev": {TransactionCounts: [][]string{
+			{"TIME_STAMP", "NODE_ID", "TRANSACTION_COUNT"},
+			{"2020-01-01", "node1", "10"},
+			{"2020-02-01", "node1", "20"},
+			{"2020-04-01", "node2", "5"},
+		}},
+		"prod": {TransactionCounts: [][]string{
+			{"TIME_STAMP", "NODE_ID", "TRANSACTION_COUNT"},
+			{"2020-03-01 00:00:00", "node1", "7"},
+		}},
+	}
+
+	summary, err := AggregateTransactionReports(reports, TransactionReportGroupByQuarter, []string{"2020-01", "2020-06"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), summary.Total)
+	assert.Equal(t, []artifactutils.TransactionReportEntry{
+		{Environment: "dev", Period: "2020-Q1", TransactionCount: 30},
+		{Environment: "dev", Period: "2020-Q2", TransactionCount: 5},
+		{Environment: "prod", Period: "2020-Q1", TransactionCount: 7},
+	}, summary.Entries)
+}
+
+func TestAggregateTransactionReportsWithUnknownColumns(t *testing.T) {
+	reports := map[string]*artifactutils.TransactionCountInfo{
+		"dev": {TransactionCounts: [][]string{{"NODE_ID"}, {"node1"}}},
+	}
+	_, err := AggregateTransactionReports(reports, TransactionReportGroupByMonth, nil)
+	assert.NotNil(t, err)
+}
+
+func TestRenderTransactionReportAsPrometheusMetrics(t *testing.T) {
+	summary := &artifactutils.TransactionReportSummary{
+		GroupBy: TransactionReportGroupByYear,
+		Entries: []artifactutils.TransactionReportEntry{
+			{Environment: "dev", Period: "2020", TransactionCount: 42},
+		},
+	}
+	content, err := renderTransactionReportSummary(summary, TransactionReportFormatPrometheus)
+	assert.Nil(t, err)
+	assert.True(t, strings.Contains(string(content), "# TYPE apictl_mi_transaction_count gauge"))
+	assert.True(t, strings.Contains(string(content),
+		`apictl_mi_transaction_count{environment="dev",period="2020",group_by="year"} 42`))
+}
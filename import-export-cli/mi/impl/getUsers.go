@@ -19,6 +19,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -47,7 +48,8 @@ func GetUserList(env, role, pattern string) (*artifactutils.UserList, error) {
 	putNonEmptyValueToMap(params, "role", role)
 	putNonEmptyValueToMap(params, "pattern", pattern)
 
-	resp, err := callMIManagementEndpointOfResource(utils.MiManagementUserResource, params, env, &artifactutils.UserList{})
+	resp, err := callMIManagementEndpointOfResource(context.Background(), utils.MiManagementUserResource, params, env,
+		&artifactutils.UserList{})
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +75,7 @@ func PrintUserList(userList *artifactutils.UserList, format string) {
 			"UserId": userIDHeader,
 		}
 		if err := userListContext.Write(renderer, userListTableHeaders); err != nil {
-			fmt.Println("Error executing template:", err.Error())
+			utils.HandleErrorAndExit("Error executing template", err)
 		}
 	} else {
 		fmt.Println("No Users found")
@@ -85,7 +87,8 @@ func GetUserInfo(env, userID, domain string) (*artifactutils.UserSummary, error)
 	var userInfoResource = utils.MiManagementUserResource + "/" + userID
 	params := make(map[string]string)
 	putNonEmptyValueToMap(params, "domain", domain)
-	resp, err := callMIManagementEndpointOfResource(userInfoResource, params, env, &artifactutils.UserSummary{})
+	resp, err := callMIManagementEndpointOfResource(context.Background(), userInfoResource, params, env,
+		&artifactutils.UserSummary{})
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +105,6 @@ func PrintUserDetails(userInfo *artifactutils.UserSummary, format string) {
 	renderer := getItemRendererEndsWithNewLine(userInfo)
 
 	if err := userInfoContext.Write(renderer, nil); err != nil {
-		fmt.Println("Error executing template:", err.Error())
+		utils.HandleErrorAndExit("Error executing template", err)
 	}
 }
@@ -19,9 +19,30 @@
 package impl
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
+const (
+	defaultHashiCorpVaultStatusDetailedFormat = "detail Address - {{.Address}}\n" +
+		"Namespace - {{.Namespace}}\n" +
+		"Engine Version - {{.EngineVersion}}\n" +
+		"Auth Method - {{.AuthMethod}}\n" +
+		"Role ID - {{.RoleID}}\n" +
+		"Status - {{.Status}}"
+)
+
+type updateHashiCorpAppRoleRequestBody struct {
+	RoleID   string `json:"roleId"`
+	SecretID string `json:"secretId"`
+}
+
 // UpdateHashiCorpSecretID updates the secretID of the HashiCorp vault configuration in the micro integrator in a given environment
 func UpdateHashiCorpSecretID(env, secretID string) (interface{}, error) {
 	body := `{"secretId":"` + secretID + `"}`
@@ -34,3 +55,41 @@ func updateHarshiCorpSecret(env, url, body string) (string, error) {
 	resp, err := invokePOSTRequestWithRetry(env, url, body)
 	return handleResponse(resp, err, url, "Message", "Error")
 }
+
+// UpdateHashiCorpAppRole updates both the role ID and the secret ID of the AppRole authentication of the HashiCorp
+// vault configuration in the micro integrator in a given environment
+func UpdateHashiCorpAppRole(env, roleID, secretID string) (interface{}, error) {
+	body := updateHashiCorpAppRoleRequestBody{
+		RoleID:   roleID,
+		SecretID: secretID,
+	}
+	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementExternalVaultsResource, env, utils.MainConfigFilePath) + "/" +
+		utils.MiManagementExternalVaultHashiCorpResource
+	resp, err := invokePOSTRequestWithRetry(env, url, body)
+	return handleResponse(resp, err, url, "Message", "Error")
+}
+
+// GetHashiCorpVaultStatus returns the HashiCorp vault configuration of the micro integrator in a given environment
+func GetHashiCorpVaultStatus(env string) (*artifactutils.HashiCorpVaultStatus, error) {
+	var hashiCorpResource = utils.MiManagementExternalVaultsResource + "/" + utils.MiManagementExternalVaultHashiCorpResource
+	resp, err := callMIManagementEndpointOfResource(context.Background(), hashiCorpResource, nil, env,
+		&artifactutils.HashiCorpVaultStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*artifactutils.HashiCorpVaultStatus), nil
+}
+
+// PrintHashiCorpVaultStatus prints the HashiCorp vault configuration according to the given format
+func PrintHashiCorpVaultStatus(vaultStatus *artifactutils.HashiCorpVaultStatus, format string) {
+	if format == "" || strings.HasPrefix(format, formatter.TableFormatKey) {
+		format = defaultHashiCorpVaultStatusDetailedFormat
+	}
+
+	vaultStatusContext := formatter.NewContext(os.Stdout, format)
+	renderer := getItemRendererEndsWithNewLine(vaultStatus)
+
+	if err := vaultStatusContext.Write(renderer, nil); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
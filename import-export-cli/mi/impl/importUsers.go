@@ -0,0 +1,426 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Column names of the CSV file accepted by the user import
+const (
+	userImportColumnUserName = "username"
+	userImportColumnPassword = "password"
+	userImportColumnIsAdmin  = "admin"
+	userImportColumnDomain   = "domain"
+	userImportColumnRoles    = "roles"
+)
+
+// userImportRoleSeparator separates the roles of a user in a single CSV column
+const userImportRoleSeparator = ";"
+
+const generatedPasswordLength = 16
+const generatedPasswordCharacters = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789!@#$%^&*-_=+"
+
+// everyoneRole is assigned to every user by the user store and is never revoked while reconciling roles
+const everyoneRole = "Internal/everyone"
+const adminRole = "admin"
+
+// UserImportEntry holds the details of a user read from a CSV or LDIF file
+type UserImportEntry struct {
+	UserName string
+	Password string
+	IsAdmin  bool
+	Domain   string
+	Roles    []string
+}
+
+// UserImportResult holds the outcome of importing users to the micro integrator
+type UserImportResult struct {
+	Created            []string
+	Updated            []string
+	Unchanged          []string
+	Failed             map[string]error
+	GeneratedPasswords map[string]string
+}
+
+// ReadUsersFromFile reads the users to be imported from a CSV file, or an LDIF file if the file has the .ldif extension
+func ReadUsersFromFile(filePath string) ([]UserImportEntry, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(filePath), ".ldif") {
+		return parseUsersFromLDIF(file)
+	}
+	return parseUsersFromCSV(file)
+}
+
+// parseUsersFromCSV reads users from CSV content. The first line should contain the column names, among which the
+// username column is mandatory. Multiple roles in the roles column are separated by semicolons.
+func parseUsersFromCSV(reader io.Reader) ([]UserImportEntry, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+	lines, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, errors.New("no column names found in the CSV file")
+	}
+	columns := make(map[string]int)
+	for i, column := range lines[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	if _, ok := columns[userImportColumnUserName]; !ok {
+		return nil, errors.New("mandatory column " + userImportColumnUserName + " not found in the CSV file")
+	}
+	getValue := func(line []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(line) {
+			return strings.TrimSpace(line[i])
+		}
+		return ""
+	}
+
+	var users []UserImportEntry
+	for lineNumber, line := range lines[1:] {
+		user := UserImportEntry{
+			UserName: getValue(line, userImportColumnUserName),
+			Password: getValue(line, userImportColumnPassword),
+			IsAdmin:  isTrueValue(getValue(line, userImportColumnIsAdmin)),
+			Domain:   getValue(line, userImportColumnDomain),
+			Roles:    splitRoles(getValue(line, userImportColumnRoles)),
+		}
+		if user.UserName == "" {
+			return nil, errors.New("missing username in line " + strconv.Itoa(lineNumber+2))
+		}
+		users = append(users, user)
+	}
+	if err := checkDuplicateUsers(users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// parseUsersFromLDIF reads users from LDIF content. The uid (or cn) attribute is used as the user name, and the
+// common name of each memberOf entry, or the value of each role attribute, is used as a role.
+func parseUsersFromLDIF(reader io.Reader) ([]UserImportEntry, error) {
+	var users []UserImportEntry
+	var current *UserImportEntry
+	var commonName string
+	flush := func() error {
+		if current == nil {
+			return nil
+		}
+		if current.UserName == "" {
+			current.UserName = commonName
+		}
+		if current.UserName == "" {
+			return errors.New("missing uid or cn attribute in an LDIF entry")
+		}
+		users = append(users, *current)
+		current = nil
+		commonName = ""
+		return nil
+	}
+
+	scanner := bufio.NewScanner(reader)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		// a line starting with a single space continues the previous line
+		if strings.HasPrefix(line, " ") && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		separator := strings.Index(line, ":")
+		if separator == -1 {
+			return nil, errors.New("invalid LDIF line " + line)
+		}
+		attribute := strings.ToLower(strings.TrimSpace(line[:separator]))
+		value := line[separator+1:]
+		if strings.HasPrefix(value, ":") {
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value[1:]))
+			if err != nil {
+				return nil, errors.New("invalid base64 value of attribute " + attribute)
+			}
+			value = string(decoded)
+		}
+		value = strings.TrimSpace(value)
+		if current == nil {
+			current = &UserImportEntry{}
+		}
+		switch attribute {
+		case "uid":
+			current.UserName = value
+		case "cn":
+			commonName = value
+		case "userpassword":
+			current.Password = value
+		case "domain":
+			current.Domain = value
+		case "admin", "isadmin":
+			current.IsAdmin = isTrueValue(value)
+		case "role":
+			current.Roles = append(current.Roles, value)
+		case "memberof":
+			current.Roles = append(current.Roles, getCommonNameOfDN(value))
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if err := checkDuplicateUsers(users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// checkDuplicateUsers returns an error if a user is listed more than once, since the details of one of the entries
+// would be silently overridden by the other
+func checkDuplicateUsers(users []UserImportEntry) error {
+	userIDs := make(map[string]bool)
+	for _, user := range users {
+		userID := strings.ToLower(getQualifiedUserID(user))
+		if userIDs[userID] {
+			return errors.New("user " + getQualifiedUserID(user) + " is listed more than once")
+		}
+		userIDs[userID] = true
+	}
+	return nil
+}
+
+// getCommonNameOfDN returns the value of the first cn component of a distinguished name, or the name itself
+func getCommonNameOfDN(dn string) string {
+	for _, component := range strings.Split(dn, ",") {
+		keyValue := strings.SplitN(strings.TrimSpace(component), "=", 2)
+		if len(keyValue) == 2 && strings.EqualFold(keyValue[0], "cn") {
+			return keyValue[1]
+		}
+	}
+	return dn
+}
+
+func splitRoles(roles string) []string {
+	var roleList []string
+	for _, role := range strings.Split(roles, userImportRoleSeparator) {
+		if role = strings.TrimSpace(role); role != "" {
+			roleList = append(roleList, role)
+		}
+	}
+	return roleList
+}
+
+func isTrueValue(value string) bool {
+	return containsString([]string{"y", "yes", "true", "1"}, strings.TrimSpace(value))
+}
+
+// GenerateRandomPassword generates a random password of the given length
+func GenerateRandomPassword(length int) (string, error) {
+	password := make([]byte, length)
+	max := big.NewInt(int64(len(generatedPasswordCharacters)))
+	for i := range password {
+		index, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		password[i] = generatedPasswordCharacters[index.Int64()]
+	}
+	return string(password), nil
+}
+
+// ImportMIUsers creates the users that do not exist in the micro integrator in a given environment and assigns the
+// roles listed for each user. Existing users listed as admins are assigned the admin role. If reconcileRoles is set,
+// roles not listed for a user, including the admin role of users not listed as admins, are revoked. If createRoles is
+// set, roles that do not exist are created before they are assigned.
+func ImportMIUsers(env string, users []UserImportEntry, reconcileRoles, createRoles bool) (*UserImportResult, error) {
+	result := &UserImportResult{
+		Failed:             make(map[string]error),
+		GeneratedPasswords: make(map[string]string),
+	}
+	existingUserList, err := GetUserList(env, "", "")
+	if err != nil {
+		return nil, err
+	}
+	existingUsers := make(map[string]bool)
+	for _, user := range existingUserList.Users {
+		existingUsers[strings.ToLower(user.UserId)] = true
+	}
+	if createRoles {
+		if err := createMissingMIRoles(env, users); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, user := range users {
+		userID := getQualifiedUserID(user)
+		if existingUsers[strings.ToLower(userID)] {
+			changed, err := updateImportedMIUserRoles(env, user, reconcileRoles)
+			if err != nil {
+				result.Failed[userID] = err
+			} else if changed {
+				result.Updated = append(result.Updated, userID)
+			} else {
+				result.Unchanged = append(result.Unchanged, userID)
+			}
+			continue
+		}
+
+		password := user.Password
+		if password == "" {
+			if password, err = GenerateRandomPassword(generatedPasswordLength); err != nil {
+				return nil, err
+			}
+			result.GeneratedPasswords[userID] = password
+		}
+		isAdmin := "n"
+		if user.IsAdmin {
+			isAdmin = "y"
+		}
+		if _, err := AddMIUser(env, user.UserName, password, isAdmin, user.Domain); err != nil {
+			delete(result.GeneratedPasswords, userID)
+			result.Failed[userID] = err
+			continue
+		}
+		if roles := filterAssignableRoles(user.Roles); len(roles) > 0 {
+			if _, err := UpdateMIUser(env, user.UserName, user.Domain, roles, []string{}); err != nil {
+				result.Failed[userID] = errors.New("user created, but assigning roles failed: " + err.Error())
+				continue
+			}
+		}
+		result.Created = append(result.Created, userID)
+	}
+	return result, nil
+}
+
+func updateImportedMIUserRoles(env string, user UserImportEntry, reconcileRoles bool) (bool, error) {
+	userInfo, err := GetUserInfo(env, user.UserName, user.Domain)
+	if err != nil {
+		return false, err
+	}
+	addedRoles, removedRoles := diffRoles(userInfo.Roles, user.Roles, user.IsAdmin)
+	if !reconcileRoles {
+		removedRoles = []string{}
+	}
+	if len(addedRoles) == 0 && len(removedRoles) == 0 {
+		return false, nil
+	}
+	_, err = UpdateMIUser(env, user.UserName, user.Domain, addedRoles, removedRoles)
+	return err == nil, err
+}
+
+// diffRoles returns the roles to be assigned and revoked to change the current roles of a user to the wanted roles.
+// The admin role is assigned to admin users as well
+func diffRoles(currentRoles, wantedRoles []string, isAdmin bool) ([]string, []string) {
+	addedRoles := []string{}
+	removedRoles := []string{}
+	assignableRoles := filterAssignableRoles(wantedRoles)
+	if isAdmin && !containsRoleIgnoreCase(assignableRoles, adminRole) {
+		assignableRoles = append(assignableRoles, adminRole)
+	}
+	for _, role := range assignableRoles {
+		if !containsRoleIgnoreCase(currentRoles, role) {
+			addedRoles = append(addedRoles, role)
+		}
+	}
+	for _, role := range currentRoles {
+		if containsRoleIgnoreCase(wantedRoles, role) || strings.EqualFold(role, everyoneRole) ||
+			(isAdmin && strings.EqualFold(role, adminRole)) {
+			continue
+		}
+		removedRoles = append(removedRoles, role)
+	}
+	return addedRoles, removedRoles
+}
+
+func containsRoleIgnoreCase(roles []string, role string) bool {
+	for _, r := range roles {
+		if strings.EqualFold(r, role) {
+			return true
+		}
+	}
+	return false
+}
+
+func filterAssignableRoles(roles []string) []string {
+	var assignableRoles []string
+	for _, role := range roles {
+		if !strings.EqualFold(role, everyoneRole) {
+			assignableRoles = append(assignableRoles, role)
+		}
+	}
+	return assignableRoles
+}
+
+func createMissingMIRoles(env string, users []UserImportEntry) error {
+	roleList, err := GetRoleList(env)
+	if err != nil {
+		return err
+	}
+	var existingRoles []string
+	for _, role := range roleList.Roles {
+		existingRoles = append(existingRoles, role.Role)
+	}
+	for _, user := range users {
+		for _, role := range filterAssignableRoles(user.Roles) {
+			if containsRoleIgnoreCase(existingRoles, role) {
+				continue
+			}
+			if _, err := AddMIRole(env, role, user.Domain); err != nil {
+				return errors.New("creating role " + role + " failed: " + err.Error())
+			}
+			existingRoles = append(existingRoles, role)
+		}
+	}
+	return nil
+}
+
+func getQualifiedUserID(user UserImportEntry) string {
+	if user.Domain == "" || strings.EqualFold(user.Domain, "primary") {
+		return user.UserName
+	}
+	return strings.ToUpper(user.Domain) + "/" + user.UserName
+}
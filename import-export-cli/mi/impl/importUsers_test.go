@@ -0,0 +1,206 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestParseUsersFromCSV(t *testing.T) {
+	content := "username,password,admin,domain,roles\n" +
+		"alice,secret,yes,,developer;tester\n" +
+		"bob,,no,SECONDARY,\n"
+	users, err := parseUsersFromCSV(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, []UserImportEntry{
+		{UserName: "alice", Password: "secret", IsAdmin: true, Roles: []string{"developer", "tester"}},
+		{UserName: "bob", Domain: "SECONDARY"},
+	}, users)
+}
+
+func TestParseUsersFromCSVWithoutUserNameColumn(t *testing.T) {
+	_, err := parseUsersFromCSV(strings.NewReader("password,roles\nsecret,developer\n"))
+	assert.NotNil(t, err)
+}
+
+func TestParseUsersFromCSVWithDuplicateUsers(t *testing.T) {
+	_, err := parseUsersFromCSV(strings.NewReader("username,roles\nalice,developer\nbob,\nAlice,tester\n"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "user Alice is listed more than once")
+
+	// the same user name in different domains belongs to different users
+	users, err := parseUsersFromCSV(strings.NewReader("username,domain\nalice,\nalice,SECONDARY\n"))
+	assert.Nil(t, err)
+	assert.Len(t, users, 2)
+
+	// the primary domain is the default domain
+	_, err = parseUsersFromCSV(strings.NewReader("username,domain\nalice,\nalice,PRIMARY\n"))
+	assert.NotNil(t, err)
+}
+
+func TestParseUsersFromLDIFWithDuplicateUsers(t *testing.T) {
+	content := "dn: uid=alice,ou=people,dc=example,dc=com\n" +
+		"uid: alice\n" +
+		"\n" +
+		"dn: cn=alice,ou=admins,dc=example,dc=com\n" +
+		"cn: alice\n" +
+		"admin: true\n"
+	_, err := parseUsersFromLDIF(strings.NewReader(content))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "user alice is listed more than once")
+}
+
+func TestParseUsersFromLDIF(t *testing.T) {
+	content := "dn: uid=alice,ou=people,dc=example,dc=com\n" +
+		"uid: alice\n" +
+		"userPassword:: c2VjcmV0\n" +
+		"memberOf: cn=developer,ou=groups,dc=example,dc=com\n" +
+		"memberOf: cn=tester,ou=groups,\n" +
+		" dc=example,dc=com\n" +
+		"\n" +
+		"# entry without uid\n" +
+		"dn: cn=bob,ou=people,dc=example,dc=com\n" +
+		"cn: bob\n" +
+		"role: monitor\n"
+	users, err := parseUsersFromLDIF(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, []UserImportEntry{
+		{UserName: "alice", Password: "secret", Roles: []string{"developer", "tester"}},
+		{UserName: "bob", Roles: []string{"monitor"}},
+	}, users)
+}
+
+func TestDiffRoles(t *testing.T) {
+	added, removed := diffRoles([]string{"Internal/everyone", "admin", "tester"}, []string{"developer", "tester"}, true)
+	assert.Equal(t, []string{"developer"}, added)
+	assert.Equal(t, []string{}, removed)
+
+	added, removed = diffRoles([]string{"Internal/everyone", "admin", "tester"}, []string{"tester"}, false)
+	assert.Equal(t, []string{}, added)
+	assert.Equal(t, []string{"admin"}, removed)
+
+	// users listed as admins are assigned the admin role
+	added, removed = diffRoles([]string{"Internal/everyone", "tester"}, []string{"tester"}, true)
+	assert.Equal(t, []string{"admin"}, added)
+	assert.Equal(t, []string{}, removed)
+
+	added, _ = diffRoles([]string{"Internal/everyone", "Admin"}, []string{"admin"}, true)
+	assert.Equal(t, []string{}, added)
+
+	// roles are matched ignoring case when they are revoked as well
+	added, removed = diffRoles([]string{"Internal/everyone", "admin"}, []string{"Admin"}, false)
+	assert.Equal(t, []string{}, added)
+	assert.Equal(t, []string{}, removed)
+}
+
+func TestImportMIUsersReconcilesAdmins(t *testing.T) {
+	existingUsers := map[string][]string{
+		"alice": {"Internal/everyone", "developer"},
+		"bob":   {"Internal/everyone", "admin", "developer"},
+	}
+	var updates []updateUserRolesRequestBody
+	setUpMIEnv(t, func(w http.ResponseWriter, r *http.Request) {
+		usersPath := "/" + utils.MiManagementAPIContext + "/" + utils.MiManagementUserResource
+		switch {
+		case r.URL.Path == usersPath:
+			var list []map[string]string
+			for userID := range existingUsers {
+				list = append(list, map[string]string{"userId": userID})
+			}
+			writeJSON(t, w, http.StatusOK, map[string]interface{}{"count": len(list), "list": list})
+		case strings.HasPrefix(r.URL.Path, usersPath+"/"):
+			userID := strings.TrimPrefix(r.URL.Path, usersPath+"/")
+			writeJSON(t, w, http.StatusOK, map[string]interface{}{"userId": userID, "roles": existingUsers[userID]})
+		case r.URL.Path == "/"+utils.MiManagementAPIContext+"/"+utils.MiManagementRoleResource:
+			assert.Equal(t, http.MethodPut, r.Method)
+			var body updateUserRolesRequestBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			updates = append(updates, body)
+			writeJSON(t, w, http.StatusOK, map[string]string{"status": "Updated"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	users := []UserImportEntry{
+		{UserName: "alice", IsAdmin: true, Roles: []string{"developer"}},
+		{UserName: "bob", Roles: []string{"developer"}},
+	}
+
+	result, err := ImportMIUsers(testMIEnv, users, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"alice"}, result.Updated)
+	assert.Equal(t, []string{"bob"}, result.Unchanged)
+	assert.Equal(t, []updateUserRolesRequestBody{
+		{UserID: "alice", AddedRoles: []string{"admin"}, RemovedRoles: []string{}},
+	}, updates)
+
+	updates = nil
+	result, err = ImportMIUsers(testMIEnv, users, true, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, result.Updated)
+	assert.Equal(t, []updateUserRolesRequestBody{
+		{UserID: "alice", AddedRoles: []string{"admin"}, RemovedRoles: []string{}},
+		{UserID: "bob", AddedRoles: []string{}, RemovedRoles: []string{"admin"}},
+	}, updates)
+}
+
+func TestImportMIUsersMatchesRolesIgnoringCase(t *testing.T) {
+	var updates []updateUserRolesRequestBody
+	setUpMIEnv(t, func(w http.ResponseWriter, r *http.Request) {
+		usersPath := "/" + utils.MiManagementAPIContext + "/" + utils.MiManagementUserResource
+		switch {
+		case r.URL.Path == usersPath:
+			writeJSON(t, w, http.StatusOK, map[string]interface{}{"count": 1,
+				"list": []map[string]string{{"userId": "carol"}}})
+		case r.URL.Path == usersPath+"/carol":
+			writeJSON(t, w, http.StatusOK, map[string]interface{}{"userId": "carol",
+				"roles": []string{"Internal/everyone", "admin"}})
+		case r.URL.Path == "/"+utils.MiManagementAPIContext+"/"+utils.MiManagementRoleResource:
+			var body updateUserRolesRequestBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			updates = append(updates, body)
+			writeJSON(t, w, http.StatusOK, map[string]string{"status": "Updated"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	users, err := parseUsersFromCSV(strings.NewReader("username,roles\ncarol,Admin\n"))
+	assert.Nil(t, err)
+
+	result, err := ImportMIUsers(testMIEnv, users, true, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"carol"}, result.Unchanged)
+	assert.Empty(t, updates)
+}
+
+func TestGenerateRandomPassword(t *testing.T) {
+	password, err := GenerateRandomPassword(generatedPasswordLength)
+	assert.Nil(t, err)
+	assert.Len(t, password, generatedPasswordLength)
+}
@@ -19,9 +19,18 @@
 package impl
 
 import (
+	"errors"
+
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
+const messageProcessorStateInactive = "inactive"
+
+type messageProcessorHeadMessageRequestBody struct {
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
 // ActivateMessageProcessor activates a message processor deployed in the micro integrator in a given environment
 func ActivateMessageProcessor(env, messageProcessorName string) (interface{}, error) {
 	return updateMessageProcessorSerivceState(env, messageProcessorName, "active")
@@ -29,10 +38,41 @@ func ActivateMessageProcessor(env, messageProcessorName string) (interface{}, er
 
 // DeactivateMessageProcessor deactivates a message processor service deployed in the micro integrator in a given environment
 func DeactivateMessageProcessor(env, messageProcessorName string) (interface{}, error) {
-	return updateMessageProcessorSerivceState(env, messageProcessorName, "inactive")
+	return updateMessageProcessorSerivceState(env, messageProcessorName, messageProcessorStateInactive)
 }
 
 func updateMessageProcessorSerivceState(env, messageProcessorName, state string) (interface{}, error) {
 	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementMessageProcessorResource, env, utils.MainConfigFilePath)
 	return updateArtifactState(url, messageProcessorName, state, env)
 }
+
+// RetryMessageProcessorHeadMessage dispatches the first message in the store of a deactivated message processor
+// deployed in the micro integrator in a given environment once more
+func RetryMessageProcessorHeadMessage(env, messageProcessorName string) (interface{}, error) {
+	return handleMessageProcessorHeadMessage(env, messageProcessorName, "retry")
+}
+
+// DropMessageProcessorHeadMessage removes the first message in the store of a deactivated message processor
+// deployed in the micro integrator in a given environment, so that the processor can continue with the next message
+func DropMessageProcessorHeadMessage(env, messageProcessorName string) (interface{}, error) {
+	return handleMessageProcessorHeadMessage(env, messageProcessorName, "drop")
+}
+
+func handleMessageProcessorHeadMessage(env, messageProcessorName, action string) (interface{}, error) {
+	messageProcessor, err := GetMessageProcessor(env, messageProcessorName)
+	if err != nil {
+		return nil, err
+	}
+	if messageProcessor.Status != messageProcessorStateInactive {
+		return nil, errors.New("message processor is " + messageProcessor.Status +
+			". Deactivate the message processor before handling its head message")
+	}
+	body := messageProcessorHeadMessageRequestBody{
+		Name:   messageProcessorName,
+		Action: action,
+	}
+	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementMessageProcessorResource, env, utils.MainConfigFilePath) +
+		"/" + utils.MiManagementMessageProcessorHeadMessageResource
+	resp, err := invokePOSTRequestWithRetry(env, url, body)
+	return handleResponse(resp, err, url, "Message", "Error")
+}
@@ -0,0 +1,82 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const testMessageProcessorsPath = "/" + utils.MiManagementAPIContext + "/" + utils.MiManagementMessageProcessorResource
+
+// setUpMessageProcessor serves a message processor with the status and records the head message requests
+func setUpMessageProcessor(t *testing.T, status string) *[]messageProcessorHeadMessageRequestBody {
+	var requests []messageProcessorHeadMessageRequestBody
+	setUpMIEnv(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case testMessageProcessorsPath:
+			assert.Equal(t, "OrderProcessor", r.URL.Query().Get("name"))
+			writeJSON(t, w, http.StatusOK, map[string]string{"name": "OrderProcessor", "status": status})
+		case testMessageProcessorsPath + "/" + utils.MiManagementMessageProcessorHeadMessageResource:
+			assert.Equal(t, http.MethodPost, r.Method)
+			var body messageProcessorHeadMessageRequestBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			requests = append(requests, body)
+			writeJSON(t, w, http.StatusOK, map[string]string{"Message": "Head message " + body.Action + " done"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return &requests
+}
+
+func TestHandleMessageProcessorHeadMessage(t *testing.T) {
+	requests := setUpMessageProcessor(t, messageProcessorStateInactive)
+
+	resp, err := RetryMessageProcessorHeadMessage(testMIEnv, "OrderProcessor")
+	assert.Nil(t, err)
+	assert.Equal(t, "Head message retry done", resp)
+
+	resp, err = DropMessageProcessorHeadMessage(testMIEnv, "OrderProcessor")
+	assert.Nil(t, err)
+	assert.Equal(t, "Head message drop done", resp)
+
+	assert.Equal(t, []messageProcessorHeadMessageRequestBody{
+		{Name: "OrderProcessor", Action: "retry"},
+		{Name: "OrderProcessor", Action: "drop"},
+	}, *requests)
+}
+
+func TestHandleHeadMessageOfActiveMessageProcessor(t *testing.T) {
+	requests := setUpMessageProcessor(t, "active")
+
+	_, err := RetryMessageProcessorHeadMessage(testMIEnv, "OrderProcessor")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Deactivate the message processor")
+
+	_, err = DropMessageProcessorHeadMessage(testMIEnv, "OrderProcessor")
+	assert.NotNil(t, err)
+	assert.Empty(t, *requests)
+}
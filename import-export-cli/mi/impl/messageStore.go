@@ -0,0 +1,96 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"text/template"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	defaultMessageStoreMessageListTableFormat = "table {{.MessageID}}\t{{.Content}}"
+)
+
+// GetMessageStoreMessages returns the first messages in a message store deployed in the micro integrator in a given environment
+// without removing them from the store
+func GetMessageStoreMessages(env, messageStoreName string, count int) (*artifactutils.MessageStoreMessageList, error) {
+	params := make(map[string]string)
+	params["name"] = messageStoreName
+	params["count"] = strconv.Itoa(count)
+
+	var messagesResource = utils.MiManagementMessageStoreResource + "/" + utils.MiManagementMessageStoreMessagesResource
+	resp, err := callMIManagementEndpointOfResource(context.Background(), messagesResource, params, env,
+		&artifactutils.MessageStoreMessageList{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*artifactutils.MessageStoreMessageList), nil
+}
+
+// PrintMessageStoreMessages prints a list of messages in a message store according to the given format
+func PrintMessageStoreMessages(messageList *artifactutils.MessageStoreMessageList, format string) {
+	if messageList.Count > 0 {
+		messages := messageList.Messages
+		messageListContext := getContextWithFormat(format, defaultMessageStoreMessageListTableFormat)
+
+		renderer := func(w io.Writer, t *template.Template) error {
+			for _, message := range messages {
+				if err := t.Execute(w, message); err != nil {
+					return err
+				}
+				_, _ = w.Write([]byte{'\n'})
+			}
+			return nil
+		}
+		messageListTableHeaders := map[string]string{
+			"MessageID": messageIDHeader,
+			"Content":   contentHeader,
+		}
+		if err := messageListContext.Write(renderer, messageListTableHeaders); err != nil {
+			utils.HandleErrorAndExit("Error executing template", err)
+		}
+	} else {
+		fmt.Println("No Messages found")
+	}
+}
+
+// GetMessageStoreMessageCount returns the number of messages in a message store deployed in the micro integrator in a given environment
+func GetMessageStoreMessageCount(env, messageStoreName string) (int, error) {
+	messageStore, err := GetMessageStore(env, messageStoreName)
+	if err != nil {
+		return 0, err
+	}
+	return messageStore.Size, nil
+}
+
+// PurgeMessageStore removes all the messages in a message store deployed in the micro integrator in a given environment
+func PurgeMessageStore(env, messageStoreName string) (interface{}, error) {
+	url := utils.GetMIManagementEndpointOfResource(utils.MiManagementMessageStoreResource, env, utils.MainConfigFilePath) +
+		"/" + utils.MiManagementMessageStoreMessagesResource
+	params := make(map[string]string)
+	params["name"] = messageStoreName
+	resp, err := invokeDELETERequestWithRetryAndParams(url, env, params)
+	return handleResponse(resp, err, url, "Message", "Error")
+}
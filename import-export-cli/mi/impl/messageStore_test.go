@@ -0,0 +1,149 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const testMIEnv = "dev"
+const testMIAccessToken = "mi-access-token"
+
+// setUpMIEnv points the main config and the credential store to a temporary directory with a logged in micro
+// integrator environment whose management endpoint is served by handler
+func setUpMIEnv(t *testing.T, handler http.HandlerFunc) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(utils.HeaderAuthorization) != utils.HeaderValueAuthBearerPrefix+" "+testMIAccessToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		handler(w, r)
+	}))
+	dir, err := ioutil.TempDir("", "mi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainConfigFilePath, credentialsDirPath := utils.MainConfigFilePath, utils.LocalCredentialsDirectoryPath
+	utils.MainConfigFilePath = filepath.Join(dir, utils.MainConfigFileName)
+	utils.LocalCredentialsDirectoryPath = dir
+	utils.ResetHttpClients()
+	t.Cleanup(func() {
+		utils.MainConfigFilePath, utils.LocalCredentialsDirectoryPath = mainConfigFilePath, credentialsDirPath
+		utils.ResetHttpClients()
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	utils.WriteConfigFile(&utils.MainConfig{
+		Config:       utils.Config{HttpRequestTimeout: utils.DefaultHttpRequestTimeout},
+		Environments: map[string]utils.EnvEndpoints{testMIEnv: {MiManagementEndpoint: server.URL}},
+	}, utils.MainConfigFilePath)
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.SetMICredentials(testMIEnv, "admin", "admin", testMIAccessToken); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set(utils.HeaderContentType, utils.HeaderValueApplicationJSON)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		t.Error(err)
+	}
+}
+
+const testMessageStoresPath = "/" + utils.MiManagementAPIContext + "/" + utils.MiManagementMessageStoreResource
+
+func TestGetMessageStoreMessages(t *testing.T) {
+	setUpMIEnv(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, testMessageStoresPath+"/"+utils.MiManagementMessageStoreMessagesResource, r.URL.Path)
+		assert.Equal(t, "OrderStore", r.URL.Query().Get("name"))
+		assert.Equal(t, "2", r.URL.Query().Get("count"))
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{
+			"count": 2,
+			"list": []map[string]string{
+				{"messageId": "urn:uuid:1", "content": "<order>1</order>"},
+				{"messageId": "urn:uuid:2", "content": "<order>2</order>"},
+			},
+		})
+	})
+
+	messages, err := GetMessageStoreMessages(testMIEnv, "OrderStore", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), messages.Count)
+	assert.Equal(t, "urn:uuid:2", messages.Messages[1].MessageID)
+	assert.Equal(t, "<order>1</order>", messages.Messages[0].Content)
+}
+
+func TestGetMessageStoreMessagesOfMissingStore(t *testing.T) {
+	setUpMIEnv(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, map[string]string{"Error": "Message store MissingStore not found"})
+	})
+
+	_, err := GetMessageStoreMessages(testMIEnv, "MissingStore", 1)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Message store MissingStore not found")
+	assert.Equal(t, utils.ExitCodeNotFound, utils.ExitCodeOf(err))
+}
+
+func TestGetMessageStoreMessageCount(t *testing.T) {
+	setUpMIEnv(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testMessageStoresPath, r.URL.Path)
+		assert.Equal(t, "OrderStore", r.URL.Query().Get("name"))
+		writeJSON(t, w, http.StatusOK, map[string]interface{}{"name": "OrderStore", "size": 7})
+	})
+
+	count, err := GetMessageStoreMessageCount(testMIEnv, "OrderStore")
+	assert.Nil(t, err)
+	assert.Equal(t, 7, count)
+}
+
+func TestPurgeMessageStore(t *testing.T) {
+	setUpMIEnv(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, testMessageStoresPath+"/"+utils.MiManagementMessageStoreMessagesResource, r.URL.Path)
+		if r.URL.Query().Get("name") != "OrderStore" {
+			writeJSON(t, w, http.StatusNotFound, map[string]string{"Error": "Message store not found"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, map[string]string{"Message": "All messages in OrderStore were removed"})
+	})
+
+	resp, err := PurgeMessageStore(testMIEnv, "OrderStore")
+	assert.Nil(t, err)
+	assert.Equal(t, "All messages in OrderStore were removed", resp)
+
+	_, err = PurgeMessageStore(testMIEnv, "MissingStore")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Message store not found")
+}
@@ -0,0 +1,36 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"context"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// GetServerSummary returns the product and runtime information of the micro integrator
+func GetServerSummary(env string) (*artifactutils.ServerSummary, error) {
+	resp, err := getArtifactList(context.Background(), utils.MiManagementServerResource, env,
+		&artifactutils.ServerSummary{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*artifactutils.ServerSummary), nil
+}
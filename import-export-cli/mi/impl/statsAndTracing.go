@@ -0,0 +1,252 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"context"
+	"errors"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Artifact types of which the statistics and tracing can be enabled or disabled
+const (
+	ArtifactTypeAPI             = "api"
+	ArtifactTypeProxyService    = "proxy-service"
+	ArtifactTypeSequence        = "sequence"
+	ArtifactTypeEndpoint        = "endpoint"
+	ArtifactTypeInboundEndpoint = "inbound-endpoint"
+)
+
+const artifactStateEnable = "enable"
+const artifactStateDisable = "disable"
+
+// statsAndTracingArtifactResources maps the supported artifact types to their management API resources
+var statsAndTracingArtifactResources = map[string]string{
+	ArtifactTypeAPI:             utils.MiManagementAPIResource,
+	ArtifactTypeProxyService:    utils.MiManagementProxyServiceResource,
+	ArtifactTypeSequence:        utils.MiManagementSequenceResource,
+	ArtifactTypeEndpoint:        utils.MiManagementEndpointResource,
+	ArtifactTypeInboundEndpoint: utils.MiManagementInboundEndpointResource,
+}
+
+// artifactLabelsRegex matches the labels of an artifact, which are given as a comma separated list following "labels:"
+// in the description of the artifact. i.e. <description>labels: payments, critical</description>
+var artifactLabelsRegex = regexp.MustCompile(`(?i)<description>[^<]*?labels:([^<\n]*)`)
+
+type updateArtifactStatisticsRequestBody struct {
+	Name       string `json:"name"`
+	Statistics string `json:"statistics"`
+}
+
+type updateArtifactTracingRequestBody struct {
+	Name  string `json:"name"`
+	Trace string `json:"trace"`
+}
+
+// GetStatsAndTracingArtifactTypes returns the artifact types of which the statistics and tracing can be changed
+func GetStatsAndTracingArtifactTypes() []string {
+	artifactTypes := make([]string, 0, len(statsAndTracingArtifactResources))
+	for artifactType := range statsAndTracingArtifactResources {
+		artifactTypes = append(artifactTypes, artifactType)
+	}
+	sort.Strings(artifactTypes)
+	return artifactTypes
+}
+
+// EnableArtifactStatistics enables statistics of an artifact deployed in the micro integrator in a given environment
+func EnableArtifactStatistics(env, artifactType, artifactName string) (interface{}, error) {
+	return updateArtifactStatistics(env, artifactType, artifactName, artifactStateEnable)
+}
+
+// DisableArtifactStatistics disables statistics of an artifact deployed in the micro integrator in a given environment
+func DisableArtifactStatistics(env, artifactType, artifactName string) (interface{}, error) {
+	return updateArtifactStatistics(env, artifactType, artifactName, artifactStateDisable)
+}
+
+// EnableArtifactTracing enables tracing of an artifact deployed in the micro integrator in a given environment
+func EnableArtifactTracing(env, artifactType, artifactName string) (interface{}, error) {
+	return updateArtifactTracing(env, artifactType, artifactName, artifactStateEnable)
+}
+
+// DisableArtifactTracing disables tracing of an artifact deployed in the micro integrator in a given environment
+func DisableArtifactTracing(env, artifactType, artifactName string) (interface{}, error) {
+	return updateArtifactTracing(env, artifactType, artifactName, artifactStateDisable)
+}
+
+func updateArtifactStatistics(env, artifactType, artifactName, state string) (string, error) {
+	url, err := getStatsAndTracingArtifactURL(env, artifactType)
+	if err != nil {
+		return "", err
+	}
+	body := updateArtifactStatisticsRequestBody{
+		Name:       artifactName,
+		Statistics: state,
+	}
+	resp, err := invokePOSTRequestWithRetry(env, url, body)
+	return handleResponse(resp, err, url, "Message", "Error")
+}
+
+func updateArtifactTracing(env, artifactType, artifactName, state string) (string, error) {
+	url, err := getStatsAndTracingArtifactURL(env, artifactType)
+	if err != nil {
+		return "", err
+	}
+	body := updateArtifactTracingRequestBody{
+		Name:  artifactName,
+		Trace: state,
+	}
+	resp, err := invokePOSTRequestWithRetry(env, url, body)
+	return handleResponse(resp, err, url, "Message", "Error")
+}
+
+func getStatsAndTracingArtifactURL(env, artifactType string) (string, error) {
+	resource, ok := statsAndTracingArtifactResources[artifactType]
+	if !ok {
+		return "", errors.New("unsupported artifact type " + artifactType + ". Supported types are " +
+			strings.Join(GetStatsAndTracingArtifactTypes(), ", "))
+	}
+	return utils.GetMIManagementEndpointOfResource(resource, env, utils.MainConfigFilePath), nil
+}
+
+// GetArtifactNamesMatchingPattern returns the names of the artifacts of the given type deployed in the micro integrator
+// in a given environment that match the given shell file name pattern
+func GetArtifactNamesMatchingPattern(env, artifactType, pattern string) ([]string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, errors.New("invalid name pattern " + pattern)
+	}
+	artifactNames, err := getArtifactNamesOfType(env, artifactType)
+	if err != nil {
+		return nil, err
+	}
+	var matchingNames []string
+	for _, artifactName := range artifactNames {
+		if matched, _ := path.Match(pattern, artifactName); matched {
+			matchingNames = append(matchingNames, artifactName)
+		}
+	}
+	return matchingNames, nil
+}
+
+// GetArtifactNamesWithLabel returns the names of the artifacts of the given type deployed in the micro integrator
+// in a given environment that have the given label in their description
+func GetArtifactNamesWithLabel(env, artifactType, label string) ([]string, error) {
+	artifactNames, err := getArtifactNamesOfType(env, artifactType)
+	if err != nil {
+		return nil, err
+	}
+	var matchingNames []string
+	for _, artifactName := range artifactNames {
+		resp, err := getArtifactInfo(context.Background(), statsAndTracingArtifactResources[artifactType],
+			artifactQueryKeys[artifactType], artifactName, env, &artifactConfiguration{})
+		if err != nil {
+			return nil, err
+		}
+		if hasArtifactLabel(resp.(*artifactConfiguration).Configuration, label) {
+			matchingNames = append(matchingNames, artifactName)
+		}
+	}
+	return matchingNames, nil
+}
+
+func hasArtifactLabel(configuration, label string) bool {
+	match := artifactLabelsRegex.FindStringSubmatch(configuration)
+	if match == nil {
+		return false
+	}
+	for _, artifactLabel := range strings.Split(match[1], ",") {
+		if strings.TrimSpace(artifactLabel) == label {
+			return true
+		}
+	}
+	return false
+}
+
+// GetArtifactNamesOfCompositeApp returns the names of the artifacts of the given type packed in a composite app
+// deployed in the micro integrator in a given environment
+func GetArtifactNamesOfCompositeApp(env, artifactType, compositeAppName string) ([]string, error) {
+	if _, ok := statsAndTracingArtifactResources[artifactType]; !ok {
+		return nil, errors.New("unsupported artifact type " + artifactType)
+	}
+	compositeApp, err := GetCompositeApp(env, compositeAppName)
+	if err != nil {
+		return nil, err
+	}
+	var artifactNames []string
+	for _, artifact := range compositeApp.Artifacts {
+		// composite app artifact types are of the form synapse/<artifact-type>
+		if strings.HasSuffix(artifact.Type, "/"+artifactType) || artifact.Type == artifactType {
+			artifactNames = append(artifactNames, artifact.Name)
+		}
+	}
+	return artifactNames, nil
+}
+
+func getArtifactNamesOfType(env, artifactType string) ([]string, error) {
+	var artifactNames []string
+	switch artifactType {
+	case ArtifactTypeAPI:
+		list, err := GetIntegrationAPIList(context.Background(), env)
+		if err != nil {
+			return nil, err
+		}
+		for _, api := range list.Apis {
+			artifactNames = append(artifactNames, api.Name)
+		}
+	case ArtifactTypeProxyService:
+		list, err := GetProxyServiceList(context.Background(), env)
+		if err != nil {
+			return nil, err
+		}
+		for _, proxy := range list.Proxies {
+			artifactNames = append(artifactNames, proxy.Name)
+		}
+	case ArtifactTypeSequence:
+		list, err := GetSequenceList(context.Background(), env)
+		if err != nil {
+			return nil, err
+		}
+		for _, sequence := range list.Sequences {
+			artifactNames = append(artifactNames, sequence.Name)
+		}
+	case ArtifactTypeEndpoint:
+		list, err := GetEndpointList(context.Background(), env)
+		if err != nil {
+			return nil, err
+		}
+		for _, endpoint := range list.Endpoints {
+			artifactNames = append(artifactNames, endpoint.Name)
+		}
+	case ArtifactTypeInboundEndpoint:
+		list, err := GetInboundEndpointList(env)
+		if err != nil {
+			return nil, err
+		}
+		for _, inboundEndpoint := range list.InboundEndpoints {
+			artifactNames = append(artifactNames, inboundEndpoint.Name)
+		}
+	default:
+		return nil, errors.New("unsupported artifact type " + artifactType)
+	}
+	return artifactNames, nil
+}
@@ -0,0 +1,108 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// setUpSequences serves the sequences with the given configurations
+func setUpSequences(t *testing.T, configurations map[string]string, names ...string) {
+	setUpMIEnv(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+utils.MiManagementAPIContext+"/"+utils.MiManagementSequenceResource, r.URL.Path)
+		name := r.URL.Query().Get(artifactQueryKeys[ArtifactTypeSequence])
+		if name == "" {
+			var list []map[string]string
+			for _, name := range names {
+				list = append(list, map[string]string{"name": name})
+			}
+			writeJSON(t, w, http.StatusOK, map[string]interface{}{"count": len(list), "list": list})
+			return
+		}
+		configuration, ok := configurations[name]
+		if !ok {
+			writeJSON(t, w, http.StatusNotFound, map[string]string{"Error": "Sequence " + name + " not found"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, map[string]string{"name": name, "configuration": configuration})
+	})
+}
+
+func TestGetArtifactNamesMatchingPattern(t *testing.T) {
+	setUpSequences(t, nil, "OrderIn", "OrderOut", "PaymentIn", "order")
+
+	tests := []struct {
+		pattern string
+		names   []string
+	}{
+		{pattern: "Order*", names: []string{"OrderIn", "OrderOut"}},
+		{pattern: "*In", names: []string{"OrderIn", "PaymentIn"}},
+		{pattern: "[Oo]rder", names: []string{"order"}},
+		{pattern: "Order?ut", names: []string{"OrderOut"}},
+		{pattern: "Invoice*", names: nil},
+	}
+	for _, test := range tests {
+		names, err := GetArtifactNamesMatchingPattern(testMIEnv, ArtifactTypeSequence, test.pattern)
+		assert.Nil(t, err, test.pattern)
+		assert.Equal(t, test.names, names, test.pattern)
+	}
+
+	_, err := GetArtifactNamesMatchingPattern(testMIEnv, ArtifactTypeSequence, "[Order")
+	assert.NotNil(t, err)
+	_, err = GetArtifactNamesMatchingPattern(testMIEnv, "task", "*")
+	assert.NotNil(t, err)
+}
+
+func TestGetArtifactNamesWithLabel(t *testing.T) {
+	setUpSequences(t, map[string]string{
+		"OrderIn":   `<sequence name="OrderIn"><description>Order intake. labels: payments, critical</description></sequence>`,
+		"OrderOut":  `<sequence name="OrderOut"><description>Labels: orders</description></sequence>`,
+		"PaymentIn": `<sequence name="PaymentIn"><description>payments</description><log/></sequence>`,
+	}, "OrderIn", "OrderOut", "PaymentIn")
+
+	names, err := GetArtifactNamesWithLabel(testMIEnv, ArtifactTypeSequence, "payments")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"OrderIn"}, names)
+
+	names, err = GetArtifactNamesWithLabel(testMIEnv, ArtifactTypeSequence, "orders")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"OrderOut"}, names)
+}
+
+func TestGetArtifactNamesWithLabelOfMissingArtifact(t *testing.T) {
+	setUpSequences(t, map[string]string{}, "OrderIn")
+
+	_, err := GetArtifactNamesWithLabel(testMIEnv, ArtifactTypeSequence, "payments")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Sequence OrderIn not found")
+}
+
+func TestHasArtifactLabel(t *testing.T) {
+	configuration := "<api name=\"Orders\">\n<description>Orders API\nlabels: payments,critical </description>\n" +
+		"<resource><description>labels: internal</description></resource></api>"
+	assert.True(t, hasArtifactLabel(configuration, "payments"))
+	assert.True(t, hasArtifactLabel(configuration, "critical"))
+	assert.False(t, hasArtifactLabel(configuration, "payment"))
+	assert.False(t, hasArtifactLabel(configuration, "internal"))
+	assert.False(t, hasArtifactLabel(`<api name="Orders"/>`, "payments"))
+}
@@ -0,0 +1,219 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/magiconair/properties"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+const (
+	defaultVaultAliasUsageTableFormat = "table {{.ArtifactType}}\t{{.ArtifactName}}\t{{.Vault}}\t{{.Alias}}\t{{.Status}}"
+)
+
+// Status of an alias used in a deployed artifact
+const (
+	VaultAliasStatusResolved   = "resolved"
+	VaultAliasStatusUnresolved = "unresolved"
+	// VaultAliasStatusExternal is used for aliases in an external vault, which cannot be checked locally
+	VaultAliasStatusExternal = "external"
+)
+
+const vaultSecureVault = "secure-vault"
+const vaultHashiCorp = "hashicorp"
+
+var secureVaultLookupRegex = regexp.MustCompile(`wso2:vault-lookup\(\s*'([^']+)'`)
+var hashiCorpVaultLookupRegex = regexp.MustCompile(`hashicorp:vault-lookup\(\s*'([^']+)'\s*(?:,\s*'([^']+)'\s*)?(?:,\s*'([^']+)'\s*)?\)`)
+
+// artifactConfiguration holds the synapse configuration of a deployed artifact
+type artifactConfiguration struct {
+	Name          string `json:"name"`
+	Configuration string `json:"configuration"`
+}
+
+// artifactQueryKeys maps artifact types to the query parameter used to get a single artifact of that type
+var artifactQueryKeys = map[string]string{
+	ArtifactTypeAPI:             "apiName",
+	ArtifactTypeProxyService:    "proxyServiceName",
+	ArtifactTypeSequence:        "sequenceName",
+	ArtifactTypeEndpoint:        "endpointName",
+	ArtifactTypeInboundEndpoint: "inboundEndpointName",
+}
+
+// GetVaultAliasUsages returns the vault aliases looked up in the configurations of the apis, proxy services,
+// sequences, endpoints and inbound endpoints deployed in the micro integrator in a given environment
+func GetVaultAliasUsages(env string) ([]artifactutils.VaultAliasUsage, error) {
+	usages := []artifactutils.VaultAliasUsage{}
+	for _, artifactType := range GetStatsAndTracingArtifactTypes() {
+		artifactNames, err := getArtifactNamesOfType(env, artifactType)
+		if err != nil {
+			return nil, err
+		}
+		for _, artifactName := range artifactNames {
+			resp, err := getArtifactInfo(context.Background(), statsAndTracingArtifactResources[artifactType],
+				artifactQueryKeys[artifactType], artifactName, env, &artifactConfiguration{})
+			if err != nil {
+				return nil, err
+			}
+			configuration := resp.(*artifactConfiguration).Configuration
+			usages = append(usages, findVaultAliasUsages(artifactType, artifactName, configuration)...)
+		}
+	}
+	return usages, nil
+}
+
+func findVaultAliasUsages(artifactType, artifactName, configuration string) []artifactutils.VaultAliasUsage {
+	var usages []artifactutils.VaultAliasUsage
+	for _, match := range secureVaultLookupRegex.FindAllStringSubmatch(configuration, -1) {
+		usages = append(usages, artifactutils.VaultAliasUsage{
+			ArtifactType: artifactType,
+			ArtifactName: artifactName,
+			Vault:        vaultSecureVault,
+			Alias:        match[1],
+		})
+	}
+	for _, match := range hashiCorpVaultLookupRegex.FindAllStringSubmatch(configuration, -1) {
+		var parts []string
+		for _, part := range match[1:] {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		usages = append(usages, artifactutils.VaultAliasUsage{
+			ArtifactType: artifactType,
+			ArtifactName: artifactName,
+			Vault:        vaultHashiCorp,
+			Alias:        strings.Join(parts, "/"),
+		})
+	}
+	return usages
+}
+
+// ValidateVaultAliases sets the status of each alias usage based on the known secure vault aliases and returns the
+// number of unresolved aliases
+func ValidateVaultAliases(usages []artifactutils.VaultAliasUsage, knownAliases map[string]bool) int {
+	unresolved := 0
+	for i := range usages {
+		switch {
+		case usages[i].Vault != vaultSecureVault:
+			usages[i].Status = VaultAliasStatusExternal
+		case knownAliases[usages[i].Alias]:
+			usages[i].Status = VaultAliasStatusResolved
+		default:
+			usages[i].Status = VaultAliasStatusUnresolved
+			unresolved++
+		}
+	}
+	return unresolved
+}
+
+// ReadSecureVaultAliases reads the aliases defined in the [secrets] section of a deployment.toml file, or in a
+// .properties file such as the one created by '<apictl> secret create -o file'
+func ReadSecureVaultAliases(filePath string) (map[string]bool, error) {
+	aliases := make(map[string]bool)
+	if strings.EqualFold(filepath.Ext(filePath), ".toml") {
+		file, err := os.Open(filePath)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		return readSecureVaultAliasesFromTOML(file)
+	}
+	props, err := properties.LoadFile(filePath, properties.UTF8)
+	if err != nil {
+		return nil, err
+	}
+	for _, alias := range props.Keys() {
+		aliases[alias] = true
+	}
+	return aliases, nil
+}
+
+func readSecureVaultAliasesFromTOML(reader io.Reader) (map[string]bool, error) {
+	aliases := make(map[string]bool)
+	inSecretsTable := false
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			inSecretsTable = line == "[secrets]"
+			continue
+		}
+		if separator := strings.Index(line, "="); inSecretsTable && separator > 0 {
+			aliases[strings.Trim(strings.TrimSpace(line[:separator]), `"`)] = true
+		}
+	}
+	return aliases, scanner.Err()
+}
+
+// PrintVaultAliasUsages prints the vault alias usages according to the given format
+func PrintVaultAliasUsages(usages []artifactutils.VaultAliasUsage, format string) {
+	if len(usages) == 0 {
+		fmt.Println("No vault aliases found in the deployed artifacts")
+		return
+	}
+	sort.SliceStable(usages, func(i, j int) bool {
+		return usages[i].Status > usages[j].Status
+	})
+	usageContext := getContextWithFormat(format, defaultVaultAliasUsageTableFormat)
+	renderer := func(w io.Writer, t *template.Template) error {
+		for _, usage := range usages {
+			if err := t.Execute(w, usage); err != nil {
+				return err
+			}
+			_, _ = w.Write([]byte{'\n'})
+		}
+		return nil
+	}
+	usageTableHeaders := map[string]string{
+		"ArtifactType": typeHeader,
+		"ArtifactName": nameHeader,
+		"Vault":        vaultHeader,
+		"Alias":        aliasHeader,
+		"Status":       statusHeader,
+	}
+	if err := usageContext.Write(renderer, usageTableHeaders); err != nil {
+		fmt.Println("Error executing template:", err.Error())
+	}
+}
+
+// EncryptSecureVaultSecrets encrypts secrets for the secure vault of the micro integrator using the key store
+// initialized with '<apictl> secret init'
+func EncryptSecureVaultSecrets(secretConfig utils.SecretConfig) error {
+	keyStoreConfig, err := utils.GetKeyStoreConfigFromFile(utils.GetKeyStoreConfigFilePath())
+	if err != nil {
+		return err
+	}
+	return utils.EncryptSecrets(keyStoreConfig, secretConfig)
+}
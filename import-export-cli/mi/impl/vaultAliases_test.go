@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package impl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindAndValidateVaultAliasUsages(t *testing.T) {
+	configuration := `<property name="pwd" expression="wso2:vault-lookup('db.password')"/>` +
+		`<property name="key" expression="hashicorp:vault-lookup('payments', 'apiKey')"/>` +
+		`<property name="token" expression="wso2:vault-lookup( 'missing.token' )"/>`
+	usages := findVaultAliasUsages(ArtifactTypeSequence, "PaymentSequence", configuration)
+	assert.Len(t, usages, 3)
+
+	unresolved := ValidateVaultAliases(usages, map[string]bool{"db.password": true})
+	assert.Equal(t, 1, unresolved)
+	assert.Equal(t, VaultAliasStatusResolved, usages[0].Status)
+	assert.Equal(t, VaultAliasStatusUnresolved, usages[1].Status)
+	assert.Equal(t, "missing.token", usages[1].Alias)
+	assert.Equal(t, VaultAliasStatusExternal, usages[2].Status)
+	assert.Equal(t, "payments/apiKey", usages[2].Alias)
+}
+
+func TestReadSecureVaultAliasesFromTOML(t *testing.T) {
+	content := "[server]\nhostname = \"localhost\"\n\n[secrets]\ndb.password = \"[encrypted]\"\n# comment\n\"api.key\" = \"[encrypted]\"\n\n[keystore.primary]\nalias = \"wso2carbon\"\n"
+	aliases, err := readSecureVaultAliasesFromTOML(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]bool{"db.password": true, "api.key": true}, aliases)
+}
@@ -20,10 +20,12 @@ package integration
 
 import (
 	"flag"
+	"io/ioutil"
 	"os"
 	"testing"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/fake"
 	"github.com/wso2/product-apim-tooling/import-export-cli/mi/integration/testutils"
 	"gopkg.in/yaml.v2"
 )
@@ -32,11 +34,18 @@ var environment testutils.Environment
 var miClient testutils.MiRESTClient
 var config *testutils.MiConfig
 var nonAdminConfig *testutils.MiConfig
+var fakeMI *fake.MI
 
 func TestMain(m *testing.M) {
 	flag.Parse()
 	readConfigs()
-	base.ExtractArchiveFile("../../build/target/")
+
+	binaryDir := ""
+	if base.IsArchiveProvided() {
+		base.ExtractArchiveFile("../../build/target/")
+	} else {
+		binaryDir = startFakeMI()
+	}
 
 	miClient = testutils.MiRESTClient{}
 	miClient.SetupMI(testutils.AdminUserName, testutils.AdminPassword, environment.Name, environment.Host, environment.Offset)
@@ -50,12 +59,47 @@ func TestMain(m *testing.M) {
 		Password: nonAdminUserPassword,
 		MIClient: miClient,
 	}
-	testutils.DeployCApps()
-	testutils.WaitForDeployment()
+	if fakeMI == nil {
+		testutils.DeployCApps()
+		testutils.WaitForDeployment()
+	}
 	exitVal := m.Run()
+
+	stopFakeMI(binaryDir)
+
 	os.Exit(exitVal)
 }
 
+// startFakeMI : Build apictl and point the environment to a fake Micro Integrator with the test CApps deployed, so
+// that the tests can run without a distribution archive or a running server. The configuration of apictl is kept next
+// to the binary instead of the home directory of the user. Returns the directory holding the binary and the
+// configuration
+func startFakeMI() string {
+	binaryDir, err := ioutil.TempDir("", "apictl")
+	if err != nil {
+		base.Fatal(err)
+	}
+	base.BuildBinary("../..", binaryDir, "")
+	base.UseConfigHome(binaryDir)
+
+	fakeMI = fake.NewMI()
+	testutils.DeployCAppsToFake(fakeMI)
+	environment.Host = fakeMI.Host()
+	environment.Offset = fakeMI.PortOffset()
+
+	base.Log("running against a fake Micro Integrator:", environment)
+	return binaryDir
+}
+
+func stopFakeMI(binaryDir string) {
+	if fakeMI != nil {
+		fakeMI.Close()
+	}
+	if binaryDir != "" {
+		os.RemoveAll(binaryDir)
+	}
+}
+
 func readConfigs() {
 	reader, err := os.Open("config.yaml")
 	if err != nil {
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/integration/base"
+	"github.com/wso2/product-apim-tooling/import-export-cli/integration/fake"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
@@ -143,6 +144,15 @@ func DeployCApps() {
 	}
 }
 
+// DeployCAppsToFake deploy capps to an in-process fake micro integrator
+func DeployCAppsToFake(fakeMI *fake.MI) {
+	for _, capp := range cappList {
+		if err := fakeMI.DeployCApp(capp); err != nil {
+			base.Fatal(err)
+		}
+	}
+}
+
 func execDockerCmd(args ...string) (string, error) {
 	cmd := exec.Command("docker", args...)
 	output, err := cmd.Output()
@@ -19,6 +19,10 @@
 package integration
 
 import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -137,3 +141,29 @@ func TestDeleteUser(t *testing.T) {
 	expected := "Deleting user [ " + newUserName + " ] status: Deleted"
 	assert.Contains(t, response, expected)
 }
+
+func TestImportUsersWithPartialFailure(t *testing.T) {
+	testutils.SetupAndLoginToMI(t, config)
+	dir, err := ioutil.TempDir("", "apictl-users")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	usersFile := filepath.Join(dir, "users.csv")
+	content := "username,password,admin,domain,roles\n" +
+		"import-tester,password,,,\n" +
+		"import-tester-2,password,,,missing-role\n"
+	assert.Nil(t, ioutil.WriteFile(usersFile, []byte(content), 0644))
+	t.Cleanup(func() {
+		base.Execute(t, "mi", "delete", "user", "import-tester", "-e", miClient.GetEnvName(), "-k")
+		base.Execute(t, "mi", "delete", "user", "import-tester-2", "-e", miClient.GetEnvName(), "-k")
+	})
+
+	response, err := base.Execute(t, "mi", "users", "import", "-f", usersFile, "-e", miClient.GetEnvName(), "-k",
+		"-o", "json")
+	base.Log(response)
+	exitErr, ok := err.(*exec.ExitError)
+	if assert.True(t, ok, "the import should fail") {
+		assert.Equal(t, 7, exitErr.ExitCode())
+	}
+	assert.Contains(t, response, "Importing 1 of 2 users failed")
+	assert.Contains(t, response, `"status": "partial"`)
+}
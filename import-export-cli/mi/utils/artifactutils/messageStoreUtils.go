@@ -33,6 +33,18 @@ type MessageStoreData struct {
 	Name       string            `json:"name"`
 	FileName   string            `json:"file"`
 	Container  string            `json:"container"`
+	Producer   string            `json:"producer"`
+	Consumer   string            `json:"consumer"`
 	Properties map[string]string `json:"properties"`
 	Size       int               `json:"size"`
 }
+
+type MessageStoreMessageList struct {
+	Count    int32                 `json:"count"`
+	Messages []MessageStoreMessage `json:"list"`
+}
+
+type MessageStoreMessage struct {
+	MessageID string `json:"messageId"`
+	Content   string `json:"content"`
+}
@@ -27,3 +27,19 @@ type TransactionCount struct {
 type TransactionCountInfo struct {
 	TransactionCounts [][]string `json:"TransactionCountData"`
 }
+
+// TransactionReportEntry holds the aggregated transaction count of an environment for a single period
+type TransactionReportEntry struct {
+	Environment      string `json:"environment"`
+	Period           string `json:"period"`
+	TransactionCount int64  `json:"transactionCount"`
+}
+
+// TransactionReportSummary holds the transaction counts aggregated over one or more environments
+type TransactionReportSummary struct {
+	Start   string                   `json:"start"`
+	End     string                   `json:"end"`
+	GroupBy string                   `json:"groupBy"`
+	Total   int64                    `json:"total"`
+	Entries []TransactionReportEntry `json:"entries"`
+}
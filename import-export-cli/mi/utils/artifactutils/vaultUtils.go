@@ -0,0 +1,36 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package artifactutils
+
+type HashiCorpVaultStatus struct {
+	Address       string `json:"address"`
+	Namespace     string `json:"namespace"`
+	EngineVersion string `json:"engineVersion"`
+	AuthMethod    string `json:"authMethod"`
+	RoleID        string `json:"roleId"`
+	Status        string `json:"status"`
+}
+
+type VaultAliasUsage struct {
+	ArtifactType string `json:"artifactType"`
+	ArtifactName string `json:"artifactName"`
+	Vault        string `json:"vault"`
+	Alias        string `json:"alias"`
+	Status       string `json:"status"`
+}
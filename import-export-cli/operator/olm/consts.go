@@ -23,7 +23,7 @@ const CrdUrlTemplate = "https://github.com/operator-framework/operator-lifecycle
 const OlmUrlTemplate = "https://github.com/operator-framework/operator-lifecycle-manager/releases/download/%s/olm.yaml"
 const OlmVersionValidationUrlTemplate = "https://github.com/operator-framework/operator-lifecycle-manager/tree/%s"
 const OlmVersionFindVersionUrl = "https://github.com/operator-framework/operator-lifecycle-manager/releases"
-const OperatorCsv = "csv"
+const OperatorCsv = "clusterserviceversions.operators.coreos.com"
 const DefaultVersion = "0.13.0"
 const VersionEnvVariable = "WSO2_OLM_VERSION"
 
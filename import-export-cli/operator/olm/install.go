@@ -19,12 +19,19 @@
 package olm
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
+
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
-	"time"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+const rolloutTimeout = 5 * time.Minute
+const csvPhaseTimeout = 50 * time.Second
+
 // installOLM installs Operator Lifecycle Manager (OLM) with the given version
 // this implements the logic in
 // https://github.com/operator-framework/operator-lifecycle-manager/releases/download/0.13.0/install.sh
@@ -49,35 +56,41 @@ func InstallOLM(version string) {
 		utils.HandleErrorAndExit("Error installing OLM", err)
 	}
 
-	// rolling out
-	if err := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sRollOut, "status", "-w", "deployment/olm-operator", "-n", olmNamespace); err != nil {
-		utils.HandleErrorAndExit("Error installing OLM: Rolling out deployment OLM Operator", err)
-	}
-	if err := k8sUtils.ExecuteCommand(k8sUtils.Kubectl, k8sUtils.K8sRollOut, "status", "-w", "deployment/catalog-operator", "-n", olmNamespace); err != nil {
-		utils.HandleErrorAndExit("Error installing OLM: Rolling out deployment Catalog Operator", err)
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		utils.HandleErrorAndExit("Error installing OLM", err)
 	}
 
-	// wait max 50s to csv phase to be succeeded
-	csvPhase := ""
-	for i := 50; i > 0 && csvPhase != csvPhaseSucceeded; i-- {
-		newCsvPhase, err := k8sUtils.GetCommandOutput(k8sUtils.Kubectl, k8sUtils.K8sGet, OperatorCsv, "-n", olmNamespace, "packageserver", "-o", `jsonpath={.status.phase}`)
+	// rolling out
+	for _, deployment := range []string{"olm-operator", "catalog-operator"} {
+		fmt.Printf("Waiting for deployment \"%s\" rollout to finish\n", deployment)
+		ctx, cancel := context.WithTimeout(context.Background(), rolloutTimeout)
+		err := client.WaitForRollout(ctx, olmNamespace, deployment)
+		cancel()
 		if err != nil {
-			utils.HandleErrorAndExit("Error installing OLM: Getting csv phase", err)
+			utils.HandleErrorAndExit("Error installing OLM: Rolling out deployment "+deployment, err)
 		}
+	}
 
+	// wait max 50s to csv phase to be succeeded
+	ctx, cancel := context.WithTimeout(context.Background(), csvPhaseTimeout)
+	defer cancel()
+	csvPhase := ""
+	err = client.WaitFor(ctx, OperatorCsv, olmNamespace, "packageserver", func(csv *unstructured.Unstructured) (bool, error) {
+		newCsvPhase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
 		// only print new phase
 		if csvPhase != newCsvPhase {
 			fmt.Println("Package server phase: " + newCsvPhase)
 			csvPhase = newCsvPhase
 		}
-
-		// sleep 1 second
-		time.Sleep(1e9)
-	}
-
-	if csvPhase != csvPhaseSucceeded {
+		return csvPhase == csvPhaseSucceeded, nil
+	})
+	if errors.Is(err, k8sUtils.ErrK8sWaitTimeout) {
 		utils.HandleErrorAndExit("Error installing OLM: CSV Package Server failed to reach phase succeeded", nil)
 	}
+	if err != nil {
+		utils.HandleErrorAndExit("Error installing OLM: Getting csv phase", err)
+	}
 }
 
 // InstallOperator installs an operator from Operator-Hub
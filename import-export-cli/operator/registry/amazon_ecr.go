@@ -1,12 +1,20 @@
 package registry
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
-	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
-	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 )
 
 // validation regex for repository URI validation
@@ -15,6 +23,7 @@ const amazonRepoRegex = `\.amazonaws\.com\/.*$`
 // AmazonEcrRegistry represents Amazon ECR registry
 var AmazonEcrRegistry = &Registry{
 	Name:       "AMAZON_ECR",
+	Type:       HttpsRegistry.Name, // the operator uses the minted token as the credentials of a private registry
 	Caption:    "Amazon ECR",
 	Repository: Repository{},
 	Option:     2,
@@ -31,11 +40,8 @@ var AmazonEcrRegistry = &Registry{
 			credFile = (*flagValues)[k8sUtils.FlagBmKeyFile].Value.(string)
 
 			// validate required inputs
-			if !utils.ValidateValue(repository, amazonRepoRegex) {
-				utils.HandleErrorAndExit("Invalid repository uri: "+repository, nil)
-			}
-			if !utils.IsFileExist(credFile) {
-				utils.HandleErrorAndExit("Invalid credential file: "+credFile, nil)
+			if err := validateAmazonEcrInputs(repository, credFile); err != nil {
+				utils.HandleErrorAndExit(err.Error(), nil)
 			}
 		}
 
@@ -43,18 +49,34 @@ var AmazonEcrRegistry = &Registry{
 		reg.Repository.KeyFile = credFile
 	},
 	Run: func(reg *Registry) {
-		createAmazonEcrConfig()
-		k8sUtils.K8sCreateSecretFromFile(
-			k8sUtils.AwsCredentialsSecret, k8sUtils.ApiOpWso2Namespace,
-			reg.Repository.KeyFile, k8sUtils.AwsCredentialsFile,
+		token, err := getAmazonEcrAuthToken(reg.Repository.Name, reg.Repository.KeyFile)
+		if err != nil {
+			utils.HandleErrorAndExit("Error getting authorization token from Amazon ECR", err)
+		}
+		k8sUtils.K8sCreateSecretFromInputs(
+			k8sUtils.DockerRegCredSecret, k8sUtils.ApiOpWso2Namespace,
+			token.server, token.username, token.password,
 		)
+		fmt.Printf("Amazon ECR authorization tokens are valid for 12 hours. The token expires at %s, "+
+			"change the registry again to renew the token\n", token.expiresAt.Format(time.RFC1123))
 	},
 	Flags: Flags{
-		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true, k8sUtils.FlagBmKeyFile: true},
-		OptionalFlags: &map[string]bool{},
+		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true},
+		OptionalFlags: &map[string]bool{k8sUtils.FlagBmKeyFile: true},
 	},
 }
 
+// validateAmazonEcrInputs validates the repository URI and the credential file given in batch mode
+func validateAmazonEcrInputs(repository, credFile string) error {
+	if !utils.ValidateValue(repository, amazonRepoRegex) {
+		return errors.New("Invalid repository uri: " + repository)
+	}
+	if credFile != "" && !utils.IsFileExist(credFile) {
+		return errors.New("Invalid credential file: " + credFile)
+	}
+	return nil
+}
+
 // readAmazonEcrInputs reads file path for amazon credential file
 func readAmazonEcrInputs() (string, string) {
 	isConfirm := false
@@ -76,8 +98,9 @@ func readAmazonEcrInputs() (string, string) {
 			defaultLocation = filepath.Join(defaultLocation, ".aws", "credentials")
 		} // else ignore and make defaultLocation = ""
 
-		credFile, err = utils.ReadInput("Amazon credential file", utils.Default{Value: defaultLocation, IsDefault: true},
-			utils.IsFileExist, "Invalid file", true)
+		credFile, err = utils.ReadInput("Amazon credential file (leave empty to use the default credential chain)",
+			utils.Default{Value: defaultLocation, IsDefault: true},
+			func(file string) bool { return file == "" || utils.IsFileExist(file) }, "Invalid file", true)
 		if err != nil {
 			utils.HandleErrorAndExit("Error reading amazon credential file from user", err)
 		}
@@ -97,31 +120,59 @@ func readAmazonEcrInputs() (string, string) {
 	return repository, credFile
 }
 
-// createAmazonEcrConfig creates K8S secret with credentials for Amazon ECR
-func createAmazonEcrConfig() {
-	configJson := `{ "credsStore": "ecr-login" }`
+// amazonEcrAuthToken represents an authorization token of Amazon ECR
+type amazonEcrAuthToken struct {
+	server    string
+	username  string
+	password  string
+	expiresAt time.Time
+}
+
+// getAmazonEcrAuthToken gets an authorization token for the registry of the repository from Amazon ECR.
+// Credentials are read from the credential file if given, otherwise from the default credential chain of the AWS SDK
+func getAmazonEcrAuthToken(repository, credFile string) (*amazonEcrAuthToken, error) {
+	// repository: <aws_account_id>.dkr.ecr.<region>.amazonaws.com/repository
+	registry := getRegistryUrl(repository)
+	registryParts := strings.Split(registry, ".")
+	if len(registryParts) < 4 {
+		return nil, errors.New("invalid repository uri: " + repository)
+	}
+	accountId, region := registryParts[0], registryParts[3]
 
-	tempFile, err := utils.CreateTempFile("config-*.json", []byte(configJson))
+	config := aws.NewConfig().WithRegion(region)
+	if credFile != "" {
+		config = config.WithCredentials(credentials.NewSharedCredentials(credFile, os.Getenv("AWS_PROFILE")))
+	}
+	sess, err := session.NewSession(config)
 	if err != nil {
-		utils.HandleErrorAndExit("Error writing configs to temporary file", err)
+		return nil, err
 	}
-	defer os.Remove(tempFile)
-
-	// render config map
-	configMap, err := k8sUtils.GetCommandOutput(
-		k8sUtils.Kubectl, k8sUtils.K8sCreate, k8sUtils.ConfigMap,
-		k8sUtils.AmazonCredHelperConfMap, "--from-file=config.json="+tempFile,
-		"-n", k8sUtils.ApiOpWso2Namespace,
-		"--dry-run", "-o", "yaml",
-	)
+	output, err := ecr.New(sess).GetAuthorizationToken(&ecr.GetAuthorizationTokenInput{
+		RegistryIds: []*string{aws.String(accountId)},
+	})
 	if err != nil {
-		utils.HandleErrorAndExit("Error creating docker config for Amazon ECR", err)
+		return nil, err
+	}
+	if len(output.AuthorizationData) == 0 {
+		return nil, errors.New("no authorization data found for the registry: " + registry)
 	}
 
-	// apply config map
-	if err = k8sUtils.K8sApplyFromStdin(configMap); err != nil {
-		utils.HandleErrorAndExit("Error creating docker config for Amazon ECR", err)
+	authData := output.AuthorizationData[0]
+	decodedToken, err := base64.StdEncoding.DecodeString(aws.StringValue(authData.AuthorizationToken))
+	if err != nil {
+		return nil, err
+	}
+	// decoded token: AWS:<password>
+	credential := strings.SplitN(string(decodedToken), ":", 2)
+	if len(credential) != 2 {
+		return nil, errors.New("invalid authorization token received from Amazon ECR")
 	}
+	return &amazonEcrAuthToken{
+		server:    strings.TrimPrefix(aws.StringValue(authData.ProxyEndpoint), "https://"),
+		username:  credential[0],
+		password:  credential[1],
+		expiresAt: aws.TimeValue(authData.ExpiresAt),
+	}, nil
 }
 
 func init() {
@@ -0,0 +1,142 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// validation regex for repository URI validation
+const azureRepoRegex = `\.azurecr\.io\/.*$`
+
+// AzureAcrRegistry represents Azure Container Registry authenticated with a service principal
+var AzureAcrRegistry = &Registry{
+	Name:       "ACR",
+	Type:       HttpsRegistry.Name,
+	Caption:    "Azure Container Registry",
+	Repository: Repository{},
+	Option:     7,
+	Read: func(reg *Registry, flagValues *map[string]FlagValue) {
+		var repository, appId, password string
+
+		// check input mode: interactive or batch
+		if flagValues == nil {
+			// get inputs in interactive mode
+			repository, appId, password = readAzureAcrInputs()
+		} else {
+			// get inputs in batch mode
+			repository = (*flagValues)[k8sUtils.FlagBmRepository].Value.(string)
+			appId = (*flagValues)[k8sUtils.FlagBmUsername].Value.(string)
+			password = (*flagValues)[k8sUtils.FlagBmPassword].Value.(string)
+
+			// if "--password-stdin" is supplied get password from stdin
+			if (*flagValues)[k8sUtils.FlagBmPasswordStdin].Value.(bool) {
+				pwStdin, err := utils.ReadPassword("Enter service principal password")
+				if err != nil {
+					utils.HandleErrorAndExit("Error reading password from user", err)
+				}
+				password = pwStdin
+			}
+
+			// validate required inputs
+			if err := validateAzureAcrInputs(repository, password); err != nil {
+				utils.HandleErrorAndExit(err.Error(), nil)
+			}
+		}
+
+		reg.Repository.Name = repository
+		reg.Repository.Username = appId
+		reg.Repository.Password = password
+	},
+	Run: func(reg *Registry) {
+		k8sUtils.K8sCreateSecretFromInputs(
+			k8sUtils.DockerRegCredSecret, k8sUtils.ApiOpWso2Namespace,
+			getRegistryUrl(reg.Repository.Name), reg.Repository.Username, reg.Repository.Password,
+		)
+		reg.Repository.Password = "" // clear password
+	},
+	Flags: Flags{
+		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true, k8sUtils.FlagBmUsername: true},
+		OptionalFlags: &map[string]bool{k8sUtils.FlagBmPassword: true, k8sUtils.FlagBmPasswordStdin: true},
+	},
+}
+
+// validateAzureAcrInputs validates the repository URI and the service principal password given in batch mode
+func validateAzureAcrInputs(repository, password string) error {
+	if !utils.ValidateValue(repository, azureRepoRegex) {
+		return errors.New("Invalid repository uri: " + repository)
+	}
+	if password == "" {
+		return errors.New("Password of the service principal is required. Use the flag: " +
+			k8sUtils.FlagBmPassword + " or " + k8sUtils.FlagBmPasswordStdin)
+	}
+	return nil
+}
+
+// readAzureAcrInputs reads repository URI, service principal application ID and password from the user
+func readAzureAcrInputs() (string, string, string) {
+	isConfirm := false
+	repository := ""
+	appId := ""
+	password := ""
+	var err error
+
+	for !isConfirm {
+		repository, err = utils.ReadInputString(
+			"Enter Repository URI (<registry_name>.azurecr.io/repository)",
+			utils.Default{IsDefault: false}, azureRepoRegex, true,
+		)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading ACR repository name from user", err)
+		}
+
+		appId, err = utils.ReadInputString("Enter service principal application ID",
+			utils.Default{Value: "", IsDefault: false}, "", true)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading service principal application ID from user", err)
+		}
+
+		password, err = utils.ReadPassword("Enter service principal password")
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading password from user", err)
+		}
+
+		fmt.Println("\nRepository                   : " + repository)
+		fmt.Println("Service principal application: " + appId)
+
+		isConfirmStr, err := utils.ReadInputString("Confirm configurations",
+			utils.Default{Value: "Y", IsDefault: true}, "", false)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+		}
+
+		isConfirm = strings.EqualFold(isConfirmStr, "y") || strings.EqualFold(isConfirmStr, "yes")
+	}
+
+	return repository, appId, password
+}
+
+func init() {
+	add(AzureAcrRegistry)
+}
@@ -0,0 +1,131 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// DockerConfigRegistry represents a registry with the credentials in a docker config json file,
+// e.g. ~/.docker/config.json
+var DockerConfigRegistry = &Registry{
+	Name:       "DOCKER_CONFIG",
+	Type:       HttpsRegistry.Name,
+	Caption:    "Docker config json file",
+	Repository: Repository{},
+	Option:     10,
+	Read: func(reg *Registry, flagValues *map[string]FlagValue) {
+		var repository, configFile string
+
+		// check input mode: interactive or batch
+		if flagValues == nil {
+			// get inputs in interactive mode
+			repository, configFile = readDockerConfigInputs()
+		} else {
+			// get inputs in batch mode
+			repository = (*flagValues)[k8sUtils.FlagBmRepository].Value.(string)
+			configFile = (*flagValues)[k8sUtils.FlagBmKeyFile].Value.(string)
+
+			// validate required inputs
+			if !utils.IsFileExist(configFile) {
+				utils.HandleErrorAndExit("Invalid docker config json file: "+configFile, nil)
+			}
+		}
+
+		reg.Repository.Name = repository
+		reg.Repository.KeyFile = configFile
+	},
+	Run: func(reg *Registry) {
+		data, err := ioutil.ReadFile(reg.Repository.KeyFile)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading docker config json file", err)
+		}
+		if err = validateDockerConfig(data, getRegistryUrl(reg.Repository.Name)); err != nil {
+			utils.HandleErrorAndExit("Invalid docker config json file: "+reg.Repository.KeyFile, err)
+		}
+
+		k8sUtils.K8sCreateDockerConfigSecret(k8sUtils.DockerRegCredSecret, k8sUtils.ApiOpWso2Namespace, data)
+	},
+	Flags: Flags{
+		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true, k8sUtils.FlagBmKeyFile: true},
+		OptionalFlags: &map[string]bool{},
+	},
+}
+
+// validateDockerConfig validates the docker config json has credentials of the registry
+func validateDockerConfig(data []byte, registry string) error {
+	dockerConfig := struct {
+		Auths map[string]interface{} `json:"auths"`
+	}{}
+	if err := json.Unmarshal(data, &dockerConfig); err != nil {
+		return err
+	}
+	for server := range dockerConfig.Auths {
+		// server can be a host or a URL, e.g. "https://index.docker.io/v1/"
+		if getRegistryUrl(strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")) == registry {
+			return nil
+		}
+	}
+	return fmt.Errorf("credentials of the registry \"%s\" not found in \"auths\"", registry)
+}
+
+// readDockerConfigInputs reads repository and docker config json file from the user
+func readDockerConfigInputs() (string, string) {
+	isConfirm := false
+	repository := ""
+	configFile := ""
+	var err error
+
+	for !isConfirm {
+		repository, err = utils.ReadInputString("Enter repository (<registry>/repository)",
+			utils.Default{Value: "", IsDefault: false}, "", true)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading registry repository name from user", err)
+		}
+
+		configFile, err = utils.ReadInput("Docker config json file", utils.Default{IsDefault: false},
+			utils.IsFileExist, "Invalid file", true)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading docker config json file from user", err)
+		}
+
+		fmt.Println("\nRepository        : " + repository)
+		fmt.Println("Docker config file: " + configFile)
+
+		isConfirmStr, err := utils.ReadInputString("Confirm configurations",
+			utils.Default{Value: "Y", IsDefault: true}, "", false)
+		if err != nil {
+			utils.HandleErrorAndExit("Error reading user input Confirmation", err)
+		}
+
+		isConfirm = strings.EqualFold(isConfirmStr, "y") || strings.EqualFold(isConfirmStr, "yes")
+	}
+
+	return repository, configFile
+}
+
+func init() {
+	add(DockerConfigRegistry)
+}
@@ -0,0 +1,49 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+)
+
+// GhcrRegistry represents GitHub Container Registry authenticated with a personal access token
+// copy of HttpsRegistry
+var GhcrRegistry = *HttpsRegistry
+
+func init() {
+	GhcrRegistry.Name = "GHCR"
+	GhcrRegistry.Type = HttpsRegistry.Name
+	GhcrRegistry.Caption = "GitHub Container Registry"
+	GhcrRegistry.Option = 8
+	GhcrRegistry.Repository.ServerUrl = "ghcr.io/"
+	GhcrRegistry.Run = func(reg *Registry) {
+		// registry host without the trailing "/" of the server URL
+		k8sUtils.K8sCreateSecretFromInputs(
+			k8sUtils.DockerRegCredSecret, k8sUtils.ApiOpWso2Namespace,
+			getRegistryUrl(reg.Repository.Name), reg.Repository.Username, reg.Repository.Password,
+		)
+		reg.Repository.Password = "" // clear password
+	}
+	GhcrRegistry.Flags = Flags{
+		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true, k8sUtils.FlagBmUsername: true},
+		OptionalFlags: &map[string]bool{k8sUtils.FlagBmPassword: true, k8sUtils.FlagBmPasswordStdin: true},
+	}
+
+	add(&GhcrRegistry)
+}
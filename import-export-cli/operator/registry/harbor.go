@@ -0,0 +1,53 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	"fmt"
+	"strings"
+
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+)
+
+// harborRobotAccountPrefix is the default prefix of the names of Harbor robot accounts
+const harborRobotAccountPrefix = "robot"
+
+// HarborRegistry represents Harbor registry authenticated with a robot account
+// copy of HttpsRegistry
+var HarborRegistry = *HttpsRegistry
+
+func init() {
+	HarborRegistry.Name = "HARBOR"
+	HarborRegistry.Type = HttpsRegistry.Name
+	HarborRegistry.Caption = "Harbor (robot account)"
+	HarborRegistry.Option = 9
+	HarborRegistry.Read = func(reg *Registry, flagValues *map[string]FlagValue) {
+		HttpsRegistry.Read(reg, flagValues)
+		if !strings.HasPrefix(reg.Repository.Username, harborRobotAccountPrefix) {
+			fmt.Printf("[WARNING] \"%s\" is not a robot account, use a robot account of the Harbor project\n",
+				reg.Repository.Username)
+		}
+	}
+	HarborRegistry.Flags = Flags{
+		RequiredFlags: &map[string]bool{k8sUtils.FlagBmRepository: true, k8sUtils.FlagBmUsername: true},
+		OptionalFlags: &map[string]bool{k8sUtils.FlagBmPassword: true, k8sUtils.FlagBmPasswordStdin: true},
+	}
+
+	add(&HarborRegistry)
+}
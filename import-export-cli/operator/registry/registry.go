@@ -19,18 +19,21 @@
 package registry
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/wso2/product-apim-tooling/import-export-cli/box"
 	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sort"
 )
 
 // Registry represents Docker Registry
 type Registry struct {
 	Name       string                                                // Unique Name
+	Type       string                                                // Registry type configured in the API Operator, Name is used if empty
 	Caption    string                                                // Text to display in the CLI about registry details
 	Repository Repository                                            // Repository name
 	Option     int                                                   // Option to be choose the CLI registry list
@@ -81,11 +84,33 @@ func ReadInputsFromFlags(flagValues *map[string]FlagValue) {
 // UpdateConfigsSecrets updates controller config with registry type and creates secrets with credentials
 func UpdateConfigsSecrets() {
 	// set registry first since this can throw error if api operator not installed. If error occur no need to rollback secret.
-	updateDockerRegistryConfig(registries[optionToExec].Name, registries[optionToExec].Repository.Name)
+	updateDockerRegistryConfig(registries[optionToExec].registryType(), registries[optionToExec].Repository.Name)
 	// create secret
 	registries[optionToExec].Run(registries[optionToExec])
 }
 
+// GetRegistryConfig returns the registry type and the repository configured in the config: `controller-config`
+func GetRegistryConfig() (string, string, error) {
+	client, err := k8sUtils.GetK8sClient()
+	if err != nil {
+		return "", "", err
+	}
+	configMap, err := client.Get(context.Background(), k8sUtils.ConfigMaps, k8sUtils.ApiOpWso2Namespace,
+		k8sUtils.ApiOpControllerConfigMap)
+	if err != nil {
+		return "", "", err
+	}
+	registryType, _, _ := unstructured.NestedString(configMap.Object, "data", k8sUtils.CtrlConfigRegType)
+	repository, _, _ := unstructured.NestedString(configMap.Object, "data", k8sUtils.CtrlConfigReg)
+	return registryType, repository, nil
+}
+
+// SetRegistryConfig sets the registry type and the repository in the config: `controller-config` without changing
+// the registry secrets, e.g. to keep the registry configs when upgrading the API Operator
+func SetRegistryConfig(registryType string, repository string) {
+	updateDockerRegistryConfig(registryType, repository)
+}
+
 // ChooseRegistryInteractive lists registries in the CLI and reads a choice from user
 func ChooseRegistryInteractive() {
 	keys := make([]int, 0, len(registries))
@@ -124,23 +149,31 @@ func SetRegistry(registryType string) {
 // ValidateFlags validates if any additional flag is given or any required flag is missing
 // throw error if invalid
 func ValidateFlags(flagsValues *map[string]FlagValue) {
+	if err := validateFlags(registries[optionToExec], flagsValues); err != nil {
+		utils.HandleErrorAndExit(err.Error(), nil)
+	}
+	// flag validation success and continue the flow
+}
+
+// validateFlags returns an error if a required flag of the registry is missing or a flag not supported by the
+// registry is given
+func validateFlags(reg *Registry, flagsValues *map[string]FlagValue) error {
 	// check for required flags
-	for flg, flgRequired := range *registries[optionToExec].Flags.RequiredFlags {
+	for flg, flgRequired := range *reg.Flags.RequiredFlags {
 		if flgRequired && !(*flagsValues)[flg].IsProvided {
 			// required flag is missing
-			utils.HandleErrorAndExit("Required flag is missing in batch mode. Flag: "+flg, nil)
+			return errors.New("Required flag is missing in batch mode. Flag: " + flg)
 		}
 	}
 
 	// check for additional flags
 	for flg, flgVal := range *flagsValues {
-		if flgVal.IsProvided && !(*registries[optionToExec].Flags.RequiredFlags)[flg] && !(*registries[optionToExec].Flags.OptionalFlags)[flg] {
+		if flgVal.IsProvided && !(*reg.Flags.RequiredFlags)[flg] && !(*reg.Flags.OptionalFlags)[flg] {
 			// additional, not supported flag
-			utils.HandleErrorAndExit("Invalid, not supported flag found in batch mode. Flag: "+flg, nil)
+			return errors.New("Invalid, not supported flag found in batch mode. Flag: " + flg)
 		}
 	}
-
-	// flag validation success and continue the flow
+	return nil
 }
 
 // updateDockerRegistryConfig sets the repository type value and the repository in the config: `controller-config`
@@ -167,6 +200,14 @@ func updateDockerRegistryConfig(registryType string, repository string) {
 	}
 }
 
+// registryType returns the registry type configured in the API Operator
+func (reg *Registry) registryType() string {
+	if reg.Type == "" {
+		return reg.Name
+	}
+	return reg.Type
+}
+
 // add adds a registry to the registries maps
 // using pointers for memory optimization
 func add(registry *Registry) {
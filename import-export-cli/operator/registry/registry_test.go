@@ -0,0 +1,184 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package registry
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8sUtils "github.com/wso2/product-apim-tooling/import-export-cli/operator/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// newFlagValues returns the values of the registry flags as given by the change registry command
+func newFlagValues(repository, username, password, keyFile string) *map[string]FlagValue {
+	return &map[string]FlagValue{
+		k8sUtils.FlagBmRepository:    {Value: repository, IsProvided: repository != ""},
+		k8sUtils.FlagBmUsername:      {Value: username, IsProvided: username != ""},
+		k8sUtils.FlagBmPassword:      {Value: password, IsProvided: password != ""},
+		k8sUtils.FlagBmPasswordStdin: {Value: false, IsProvided: false},
+		k8sUtils.FlagBmKeyFile:       {Value: keyFile, IsProvided: keyFile != ""},
+	}
+}
+
+// runWithDryRun runs the registry and returns the docker config json of the registry secret that would be applied
+func runWithDryRun(t *testing.T, reg *Registry) map[string]map[string]map[string]string {
+	var out bytes.Buffer
+	k8sUtils.SetK8sClient(&k8sUtils.K8sClient{})
+	k8sUtils.K8sDryRunOut = &out
+	defer func() {
+		k8sUtils.SetK8sClient(nil)
+		k8sUtils.K8sDryRunOut = nil
+	}()
+	reg.Run(reg)
+
+	secret := struct {
+		Kind     string            `yaml:"kind"`
+		Type     string            `yaml:"type"`
+		Metadata map[string]string `yaml:"metadata"`
+		Data     map[string]string `yaml:"data"`
+	}{}
+	if err := yaml.Unmarshal(bytes.TrimPrefix(out.Bytes(), []byte("---\n")), &secret); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Secret", secret.Kind)
+	assert.Equal(t, "kubernetes.io/dockerconfigjson", secret.Type)
+	assert.Equal(t, k8sUtils.DockerRegCredSecret, secret.Metadata["name"])
+	assert.Equal(t, k8sUtils.ApiOpWso2Namespace, secret.Metadata["namespace"])
+
+	dockerConfigJson, err := base64.StdEncoding.DecodeString(secret.Data[".dockerconfigjson"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dockerConfig map[string]map[string]map[string]string
+	if err = json.Unmarshal(dockerConfigJson, &dockerConfig); err != nil {
+		t.Fatal(err)
+	}
+	return dockerConfig
+}
+
+func assertRegistryCredentials(t *testing.T, dockerConfig map[string]map[string]map[string]string, server,
+	username, password string) {
+	assert.Equal(t, map[string]map[string]string{
+		server: {
+			"username": username,
+			"password": password,
+			"auth":     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+		},
+	}, dockerConfig["auths"])
+}
+
+func TestValidateFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		reg        *Registry
+		flagValues *map[string]FlagValue
+		valid      bool
+	}{
+		{"ACR", AzureAcrRegistry, newFlagValues("wso2.azurecr.io/apis", "app-id", "secret", ""), true},
+		{"ACR without username", AzureAcrRegistry, newFlagValues("wso2.azurecr.io/apis", "", "secret", ""), false},
+		{"ACR with key file", AzureAcrRegistry, newFlagValues("wso2.azurecr.io/apis", "app-id", "", "key.json"), false},
+		{"GHCR", &GhcrRegistry, newFlagValues("wso2/apis", "wso2user", "token", ""), true},
+		{"GHCR without username", &GhcrRegistry, newFlagValues("wso2/apis", "", "token", ""), false},
+		{"Harbor", &HarborRegistry, newFlagValues("harbor.wso2.com/apis", "robot$ci", "secret", ""), true},
+		{"Harbor without repository", &HarborRegistry, newFlagValues("", "robot$ci", "secret", ""), false},
+		{"Docker config", DockerConfigRegistry, newFlagValues("harbor.wso2.com/apis", "", "", "config.json"), true},
+		{"Docker config without file", DockerConfigRegistry, newFlagValues("harbor.wso2.com/apis", "", "", ""), false},
+		{"Docker config with username", DockerConfigRegistry, newFlagValues("harbor.wso2.com/apis", "admin", "", "config.json"), false},
+		{"Amazon ECR", AmazonEcrRegistry, newFlagValues("1234.dkr.ecr.us-east-1.amazonaws.com/apis", "", "", ""), true},
+		{"Amazon ECR with password", AmazonEcrRegistry, newFlagValues("1234.dkr.ecr.us-east-1.amazonaws.com/apis", "", "secret", ""), false},
+	}
+	for _, test := range tests {
+		err := validateFlags(test.reg, test.flagValues)
+		assert.Equal(t, test.valid, err == nil, test.name)
+	}
+}
+
+func TestValidateAzureAcrInputs(t *testing.T) {
+	assert.Nil(t, validateAzureAcrInputs("wso2.azurecr.io/apis", "secret"))
+	assert.NotNil(t, validateAzureAcrInputs("wso2.azurecr.io/apis", ""))
+	assert.NotNil(t, validateAzureAcrInputs("docker.io/wso2/apis", "secret"))
+}
+
+func TestValidateAmazonEcrInputs(t *testing.T) {
+	assert.Nil(t, validateAmazonEcrInputs("1234.dkr.ecr.us-east-1.amazonaws.com/apis", ""))
+	assert.NotNil(t, validateAmazonEcrInputs("wso2.azurecr.io/apis", ""))
+	assert.NotNil(t, validateAmazonEcrInputs("1234.dkr.ecr.us-east-1.amazonaws.com/apis", "missing-credentials"))
+}
+
+func TestAzureAcrRegistrySecret(t *testing.T) {
+	reg := *AzureAcrRegistry
+	reg.Read(&reg, newFlagValues("wso2.azurecr.io/apis", "app-id", "secret", ""))
+	assert.Equal(t, "wso2.azurecr.io/apis", reg.Repository.Name)
+	assert.Equal(t, HttpsRegistry.Name, reg.registryType())
+
+	assertRegistryCredentials(t, runWithDryRun(t, &reg), "wso2.azurecr.io", "app-id", "secret")
+	assert.Empty(t, reg.Repository.Password)
+}
+
+func TestGhcrRegistrySecret(t *testing.T) {
+	for _, repository := range []string{"wso2/apis", "ghcr.io/wso2/apis"} {
+		reg := GhcrRegistry
+		reg.Read(&reg, newFlagValues(repository, "wso2user", "token", ""))
+		assert.Equal(t, "ghcr.io/wso2/apis", reg.Repository.Name)
+
+		assertRegistryCredentials(t, runWithDryRun(t, &reg), "ghcr.io", "wso2user", "token")
+	}
+}
+
+func TestHarborRegistrySecret(t *testing.T) {
+	reg := HarborRegistry
+	reg.Read(&reg, newFlagValues("harbor.wso2.com/library/apis", "robot$ci", "secret", ""))
+	assert.Equal(t, HttpsRegistry.Name, reg.registryType())
+
+	assertRegistryCredentials(t, runWithDryRun(t, &reg), "harbor.wso2.com", "robot$ci", "secret")
+}
+
+func TestDockerConfigRegistrySecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config.json")
+	auth := base64.StdEncoding.EncodeToString([]byte("admin:secret"))
+	content := `{"auths":{"https://harbor.wso2.com":{"auth":"` + auth + `"}}}`
+	if err = ioutil.WriteFile(configFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	reg := *DockerConfigRegistry
+	reg.Read(&reg, newFlagValues("harbor.wso2.com/library/apis", "", "", configFile))
+	dockerConfig := runWithDryRun(t, &reg)
+	assert.Equal(t, map[string]string{"auth": auth}, dockerConfig["auths"]["https://harbor.wso2.com"])
+}
+
+func TestValidateDockerConfig(t *testing.T) {
+	content := []byte(`{"auths":{"https://index.docker.io/v1/":{},"harbor.wso2.com:8443":{}}}`)
+	assert.Nil(t, validateDockerConfig(content, "index.docker.io"))
+	assert.Nil(t, validateDockerConfig(content, "harbor.wso2.com:8443"))
+	assert.NotNil(t, validateDockerConfig(content, "harbor.wso2.com"))
+	assert.NotNil(t, validateDockerConfig([]byte("auths"), "harbor.wso2.com"))
+}
@@ -0,0 +1,278 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Operator bundle layout
+//
+//	bundle.yaml                 operator name and version of the bundle
+//	configs/                    operator configs (CRDs, namespaces, controller configs, ...)
+//	migrations/<version>/       configs applied after the operator configs when upgrading to the <version>
+const BundleManifestFile = "bundle.yaml"
+const BundleConfigsDir = "configs"
+const BundleMigrationsDir = "migrations"
+
+// OCI image layout files
+const ociLayoutFile = "oci-layout"
+const ociIndexFile = "index.json"
+
+// OperatorBundle is a release of an operator with all configs to install and upgrade it in air-gapped clusters
+type OperatorBundle struct {
+	Operator string `yaml:"operator"`
+	Version  string `yaml:"version"`
+	// Dir is the directory of the bundle, a temporary directory if the bundle is extracted from an archive
+	Dir string `yaml:"-"`
+
+	tempDir string
+}
+
+// OpenOperatorBundle opens the operator bundle of the given path which is a directory, a tar archive (.tar, .tar.gz
+// or .tgz) of the bundle directory or an OCI image layout archive with the bundle directory in the image layers.
+// Close should be called to remove the extracted files
+func OpenOperatorBundle(path string) (*OperatorBundle, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &OperatorBundle{Dir: path}
+	if !stat.IsDir() {
+		if bundle.tempDir, err = ioutil.TempDir("", "apictl-bundle"); err != nil {
+			return nil, err
+		}
+		bundle.Dir = bundle.tempDir
+		if err = extractBundleArchive(path, bundle.Dir); err != nil {
+			bundle.Close()
+			return nil, fmt.Errorf("error extracting operator bundle %s: %w", path, err)
+		}
+	}
+
+	manifest, err := ioutil.ReadFile(filepath.Join(bundle.Dir, BundleManifestFile))
+	if err != nil {
+		bundle.Close()
+		return nil, fmt.Errorf("invalid operator bundle %s: %w", path, err)
+	}
+	if err = yaml.Unmarshal(manifest, bundle); err != nil {
+		bundle.Close()
+		return nil, fmt.Errorf("invalid operator bundle %s: %w", path, err)
+	}
+	if bundle.Operator == "" || bundle.Version == "" {
+		bundle.Close()
+		return nil, fmt.Errorf("invalid operator bundle %s: 'operator' and 'version' are required in %s",
+			path, BundleManifestFile)
+	}
+	return bundle, nil
+}
+
+// Close removes the files extracted from the bundle archive
+func (b *OperatorBundle) Close() {
+	if b.tempDir != "" {
+		_ = os.RemoveAll(b.tempDir)
+	}
+}
+
+// ConfigsPath returns the directory of the operator configs
+func (b *OperatorBundle) ConfigsPath() string {
+	return filepath.Join(b.Dir, BundleConfigsDir)
+}
+
+// MigrationPaths returns the migration directories of the versions newer than fromVersion and not newer than
+// toVersion, ordered by the version
+func (b *OperatorBundle) MigrationPaths(fromVersion, toVersion string) ([]string, error) {
+	migrationsDir := filepath.Join(b.Dir, BundleMigrationsDir)
+	files, err := ioutil.ReadDir(migrationsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, file := range files {
+		if file.IsDir() && CompareVersions(file.Name(), fromVersion) > 0 &&
+			CompareVersions(file.Name(), toVersion) <= 0 {
+			versions = append(versions, file.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return CompareVersions(versions[i], versions[j]) < 0
+	})
+
+	paths := make([]string, 0, len(versions))
+	for _, version := range versions {
+		paths = append(paths, filepath.Join(migrationsDir, version))
+	}
+	return paths, nil
+}
+
+// CompareVersions compares versions of the form "v1.2.0" or "1.2.0" and returns a negative number if v1 is older than
+// v2, a positive number if v1 is newer than v2 and 0 if both are the same
+func CompareVersions(v1, v2 string) int {
+	parts1 := strings.Split(strings.TrimPrefix(v1, "v"), ".")
+	parts2 := strings.Split(strings.TrimPrefix(v2, "v"), ".")
+	for i := 0; i < len(parts1) || i < len(parts2); i++ {
+		var n1, n2 int
+		if i < len(parts1) {
+			n1, _ = strconv.Atoi(parts1[i])
+		}
+		if i < len(parts2) {
+			n2, _ = strconv.Atoi(parts2[i])
+		}
+		if n1 != n2 {
+			return n1 - n2
+		}
+	}
+	return 0
+}
+
+// extractBundleArchive extracts the tar archive or the OCI image layout archive to the directory
+func extractBundleArchive(archive, dir string) error {
+	file, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if err = extractTar(file, dir); err != nil {
+		return err
+	}
+
+	// layers of the OCI image are the bundle
+	if _, err = os.Stat(filepath.Join(dir, ociLayoutFile)); err == nil {
+		return extractOciImageLayers(dir)
+	}
+	return nil
+}
+
+// extractOciImageLayers extracts the layers of the first image in the OCI image layout directory to the directory
+func extractOciImageLayers(dir string) error {
+	type descriptor struct {
+		Digest string `json:"digest"`
+	}
+	index := struct {
+		Manifests []descriptor `json:"manifests"`
+	}{}
+	if err := readJSONFile(filepath.Join(dir, ociIndexFile), &index); err != nil {
+		return err
+	}
+	if len(index.Manifests) == 0 {
+		return fmt.Errorf("no images found in %s", ociIndexFile)
+	}
+
+	manifest := struct {
+		Layers []descriptor `json:"layers"`
+	}{}
+	if err := readJSONFile(ociBlobPath(dir, index.Manifests[0].Digest), &manifest); err != nil {
+		return err
+	}
+	for _, layer := range manifest.Layers {
+		blob, err := os.Open(ociBlobPath(dir, layer.Digest))
+		if err != nil {
+			return err
+		}
+		err = extractTar(blob, dir)
+		blob.Close()
+		if err != nil {
+			return fmt.Errorf("error extracting layer %s: %w", layer.Digest, err)
+		}
+	}
+	return nil
+}
+
+// ociBlobPath returns the path of the blob with the digest, e.g. "sha256:<hex>", in the OCI image layout directory
+func ociBlobPath(dir, digest string) string {
+	return filepath.Join(dir, "blobs", strings.Replace(digest, ":", string(os.PathSeparator), 1))
+}
+
+func readJSONFile(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// extractTar extracts the tar archive, which may be gzip compressed, to the directory
+func extractTar(r io.Reader, dir string) error {
+	reader := bufio.NewReader(r)
+	if magic, err := reader.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		r = gzipReader
+	} else {
+		r = reader
+	}
+
+	tarReader := tar.NewReader(r)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, header.Name)
+		// Check for ZipSlip.
+		if path != filepath.Clean(dir) && !strings.HasPrefix(path, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("%s: illegal file path", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(path, os.ModePerm); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+				return err
+			}
+			outFile, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.FileMode(header.Mode))
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(outFile, tarReader)
+			outFile.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
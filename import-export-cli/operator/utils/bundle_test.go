@@ -0,0 +1,185 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var bundleFiles = map[string]string{
+	BundleManifestFile:                             "operator: api-operator\nversion: v1.2.0\n",
+	"configs/controller_configs.yaml":              "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: wso2-system\n",
+	"migrations/v1.1.0/migration.yaml":             "apiVersion: v1\nkind: ConfigMap\n",
+	"migrations/v1.1.1/migration.yaml":             "apiVersion: v1\nkind: ConfigMap\n",
+	"migrations/v1.2.0/migration.yaml":             "apiVersion: v1\nkind: ConfigMap\n",
+	"migrations/v1.10.0/unreleased_migration.yaml": "apiVersion: v1\nkind: ConfigMap\n",
+}
+
+func createTarGz(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tarWriter.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func assertBundle(t *testing.T, bundle *OperatorBundle) {
+	if bundle.Operator != "api-operator" || bundle.Version != "v1.2.0" {
+		t.Errorf("got bundle of %s %s, want api-operator v1.2.0", bundle.Operator, bundle.Version)
+	}
+	if _, err := os.Stat(filepath.Join(bundle.ConfigsPath(), "controller_configs.yaml")); err != nil {
+		t.Errorf("configs not found in the bundle: %v", err)
+	}
+
+	migrations, err := bundle.MigrationPaths("v1.1.0", bundle.Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var versions []string
+	for _, migration := range migrations {
+		versions = append(versions, filepath.Base(migration))
+	}
+	if fmt.Sprint(versions) != "[v1.1.1 v1.2.0]" {
+		t.Errorf("got migrations %v, want [v1.1.1 v1.2.0]", versions)
+	}
+}
+
+func TestOpenOperatorBundleFromTarGz(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	archive := filepath.Join(dir, "api-operator-bundle.tar.gz")
+	if err = ioutil.WriteFile(archive, createTarGz(t, bundleFiles), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	bundle, err := OpenOperatorBundle(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertBundle(t, bundle)
+
+	extractedDir := bundle.Dir
+	bundle.Close()
+	if _, err = os.Stat(extractedDir); !os.IsNotExist(err) {
+		t.Errorf("extracted bundle is not removed when closing")
+	}
+}
+
+func TestOpenOperatorBundleFromOciImage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	blob := func(content []byte) (string, string) {
+		digest := fmt.Sprintf("%x", sha256.Sum256(content))
+		return "sha256:" + digest, "blobs/sha256/" + digest
+	}
+	layer := createTarGz(t, bundleFiles)
+	layerDigest, layerPath := blob(layer)
+	manifest, _ := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"layers":        []map[string]string{{"digest": layerDigest}},
+	})
+	manifestDigest, manifestPath := blob(manifest)
+	index, _ := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"manifests":     []map[string]string{{"digest": manifestDigest}},
+	})
+
+	archive := filepath.Join(dir, "api-operator-bundle.oci.tar")
+	ociImage := createTarGz(t, map[string]string{
+		ociLayoutFile: `{"imageLayoutVersion": "1.0.0"}`,
+		ociIndexFile:  string(index),
+		manifestPath:  string(manifest),
+		layerPath:     string(layer),
+	})
+	if err = ioutil.WriteFile(archive, ociImage, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	bundle, err := OpenOperatorBundle(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bundle.Close()
+	assertBundle(t, bundle)
+}
+
+func TestOpenOperatorBundleWithIllegalPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	archive := filepath.Join(dir, "bundle.tar.gz")
+	if err = ioutil.WriteFile(archive, createTarGz(t, map[string]string{"../bundle.yaml": ""}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = OpenOperatorBundle(archive); err == nil {
+		t.Errorf("bundle with a file outside the bundle directory should not be opened")
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		v1, v2 string
+		want   int
+	}{
+		{"v1.2.0", "1.2.0", 0},
+		{"v1.2", "v1.2.0", 0},
+		{"v1.10.0", "v1.9.0", 1},
+		{"v1.1.0", "v1.2.0", -1},
+	}
+	for _, test := range tests {
+		got := CompareVersions(test.v1, test.v2)
+		if (got > 0) != (test.want > 0) || (got < 0) != (test.want < 0) {
+			t.Errorf("CompareVersions(%s, %s) = %d, want sign of %d", test.v1, test.v2, got, test.want)
+		}
+	}
+}
@@ -20,39 +20,38 @@ package utils
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"time"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// K8sWaitForResourceType waits until the CRDs of the given resource types, e.g. "apis.wso2.com", are established
 func K8sWaitForResourceType(maxTimeSec int, resourceTypes ...string) error {
 	if maxTimeSec < 0 {
 		return errors.New("'maxTimeSec' should be non negative")
 	}
 
-	noErrors := false
-	for i := maxTimeSec; i > 0 && !noErrors; i-- {
-		for _, resourceType := range resourceTypes {
-			noErrors = true
-			if err := ExecuteCommandWithoutPrintingErrors(Kubectl, K8sGet, resourceType); err != nil {
-				noErrors = false
-				continue
-			}
-		}
-
-		time.Sleep(1e9) // sleep 1 second
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
 	}
-
-	if !noErrors {
-		return errors.New("kubernetes resources not installed")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(maxTimeSec)*time.Second)
+	defer cancel()
+	if err := client.WaitForCrdsEstablished(ctx, resourceTypes...); err != nil {
+		return fmt.Errorf("kubernetes resources not installed: %w", err)
 	}
-
 	return nil
 }
 
@@ -62,80 +61,99 @@ func K8sCreateSecretFromInputs(secretName string, namespace string, server strin
 		username = "N/A"
 		password = "N/A"
 	}
-	dockerSecret, err := GetCommandOutput(
-		Kubectl, K8sCreate, Secret, K8sSecretDockerRegType, secretName,
-		"--docker-server", server,
-		"--docker-username", username,
-		"--docker-password", password,
-		"-n", namespace,
-		"--dry-run", "-o", "yaml",
-	)
-
+	dockerConfig, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{
+			server: map[string]string{
+				"username": username,
+				"password": password,
+				"auth":     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+			},
+		},
+	})
 	if err != nil {
 		utils.HandleErrorAndExit("Error rendering kubernetes secret for Docker Hub", err)
 	}
+	K8sCreateDockerConfigSecret(secretName, namespace, dockerConfig)
+}
 
-	// apply created secret yaml file
-	if err := K8sApplyFromStdin(dockerSecret); err != nil {
+// K8sCreateDockerConfigSecret creates K8S a docker-registry secret with the given docker config json
+func K8sCreateDockerConfigSecret(secretName string, namespace string, dockerConfig []byte) {
+	secret := newSecret(secretName, namespace, corev1.SecretTypeDockerConfigJson)
+	secret.Data[corev1.DockerConfigJsonKey] = dockerConfig
+	if err := K8sApplyObject(secret); err != nil {
 		utils.HandleErrorAndExit("Error creating docker secret credentials", err)
 	}
 }
 
 // K8sCreateSecretFromFile creates K8S a generic secret with give file
 func K8sCreateSecretFromFile(secretName string, namespace string, filePath string, renamedFile string) {
-	var fromFile string
-	if renamedFile == "" {
-		fromFile = fmt.Sprintf("--from-file=%s", filePath)
-	} else {
-		fromFile = fmt.Sprintf("--from-file=%s=%s", renamedFile, filePath)
-	}
-
-	// render secret
-	secret, err := GetCommandOutput(
-		Kubectl, K8sCreate, Secret, "generic",
-		secretName, fromFile,
-		"-n", namespace,
-		"--dry-run", "-o", "yaml",
-	)
+	key := renamedFile
+	if key == "" {
+		key = filepath.Base(filePath)
+	}
+	content, err := ioutil.ReadFile(filePath)
 	if err != nil {
 		utils.HandleErrorAndExit("Error creating secret from file", err)
 	}
 
-	// apply secret
-	if err = K8sApplyFromStdin(secret); err != nil {
+	secret := newSecret(secretName, namespace, corev1.SecretTypeOpaque)
+	secret.Data[key] = content
+	if err = K8sApplyObject(secret); err != nil {
 		utils.HandleErrorAndExit("Error creating secret from file", err)
 	}
 }
 
 // K8sApplyFromFile applies resources from list of files, urls or directories
 func K8sApplyFromFile(fileList ...string) error {
-	kubectlArgs := []string{K8sApply}
 	for _, file := range fileList {
-		kubectlArgs = append(kubectlArgs, "-f", file)
+		for _, data := range *readConfigData(file) {
+			if err := K8sApplyFromBytes([][]byte{data}); err != nil {
+				return err
+			}
+		}
 	}
-
-	return ExecuteCommand(Kubectl, kubectlArgs...)
+	return nil
 }
 
 // K8sApplyFromBytes applies resources by content
 func K8sApplyFromBytes(data [][]byte) error {
-	dir, _ := ioutil.TempDir("", "example")
-	defer os.RemoveAll(dir) // clean up
-
-	for i, d := range data {
-		tmpFile := filepath.Join(dir, fmt.Sprintf("config-file-%v.yml", i))
-		err := ioutil.WriteFile(tmpFile, d, 0666) // permission -rw-rw-rw
-		if err != nil {
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
+	for _, d := range data {
+		if err := client.Apply(context.Background(), d); err != nil {
 			return err
 		}
 	}
-
-	return ExecuteCommand(Kubectl, K8sApply, "-f", dir)
+	return nil
 }
 
 // K8sApplyFromStdin applies resources from standard input
 func K8sApplyFromStdin(stdInputs string) error {
-	return ExecuteCommandFromStdin(stdInputs, Kubectl, K8sApply, "-f", "-")
+	return K8sApplyFromBytes([][]byte{[]byte(stdInputs)})
+}
+
+// K8sApplyObject applies the typed resource (with the TypeMeta set)
+func K8sApplyObject(obj interface{}) error {
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
+	return client.ApplyObject(context.Background(), obj)
+}
+
+// newSecret returns an empty secret of the given type
+func newSecret(name, namespace string, secretType corev1.SecretType) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: secretType,
+		Data: map[string][]byte{},
+	}
 }
 
 // ExecuteCommand executes the command with args and prints output, errors in standard output, error
@@ -36,6 +36,8 @@ const Secret = "secret"
 const ClusterRole = "ClusterRole"
 const ClusterRoleBinding = "ClusterRoleBinding"
 const Api = "api"
+const ConfigMaps = "configmaps"
+const Secrets = "secrets"
 
 // API Operator constants
 const DefaultKubernetesMode = false
@@ -91,3 +93,6 @@ const FlagBmUsername = "username"
 const FlagBmPassword = "password"
 const FlagBmPasswordStdin = "password-stdin"
 const FlagBmKeyFile = "key-file"
+
+// constants of K8s ConfigMap: apictl-operators which records versions of operators installed with apictl
+const InstalledOperatorsConfigMap = "apictl-operators"
@@ -0,0 +1,485 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+// K8sFieldManager is the field manager used in server-side apply
+const K8sFieldManager = "apictl"
+
+// KubeConfig is the path to the kubeconfig file used by the K8s client, kubectl loading rules are used if empty
+var KubeConfig string
+
+// KubeContext is the context of the kubeconfig used by the K8s client, the current context is used if empty
+var KubeContext string
+
+// K8sDryRunOut is set to print the resources that would be applied instead of applying them to the K8s cluster
+var K8sDryRunOut io.Writer
+
+// ErrK8sWaitTimeout is returned when a K8s resource does not reach the expected condition within the given time
+var ErrK8sWaitTimeout = errors.New("timed out waiting for the condition")
+
+// K8sResourceError is returned when an operation on a K8s resource fails
+type K8sResourceError struct {
+	Operation string
+	Resource  string
+	Namespace string
+	Name      string
+	Err       error
+}
+
+func (e *K8sResourceError) Error() string {
+	if e.Namespace != "" {
+		return fmt.Sprintf("error %s %s \"%s\" in the namespace \"%s\": %v",
+			e.Operation, e.Resource, e.Name, e.Namespace, e.Err)
+	}
+	return fmt.Sprintf("error %s %s \"%s\": %v", e.Operation, e.Resource, e.Name, e.Err)
+}
+
+func (e *K8sResourceError) Unwrap() error {
+	return e.Err
+}
+
+// IsK8sNotFound returns whether the error is caused by a K8s resource that does not exist
+func IsK8sNotFound(err error) bool {
+	var statusErr *apierrors.StatusError
+	return errors.As(err, &statusErr) && apierrors.IsNotFound(statusErr)
+}
+
+// IsK8sAlreadyExists returns whether the error is caused by a K8s resource that already exists
+func IsK8sAlreadyExists(err error) bool {
+	var statusErr *apierrors.StatusError
+	return errors.As(err, &statusErr) && apierrors.IsAlreadyExists(statusErr)
+}
+
+// K8sClient is an in-process client for the K8s cluster
+type K8sClient struct {
+	Clientset kubernetes.Interface
+	Dynamic   dynamic.Interface
+	Mapper    meta.RESTMapper
+	// Namespace is used for namespaced resources if a namespace is not given, "default" if empty
+	Namespace string
+	// DryRunOut is set to write the resources that would be applied or created as YAML documents, instead of
+	// changing the cluster. Resources are still read from the cluster
+	DryRunOut io.Writer
+}
+
+var defaultK8sClient *K8sClient
+
+// GetK8sClient returns the K8s client of the configured kubeconfig and context
+func GetK8sClient() (*K8sClient, error) {
+	if defaultK8sClient == nil {
+		client, err := NewK8sClient(KubeConfig, KubeContext)
+		if err != nil {
+			return nil, err
+		}
+		defaultK8sClient = client
+	}
+	defaultK8sClient.DryRunOut = K8sDryRunOut
+	return defaultK8sClient, nil
+}
+
+// SetK8sClient sets the K8s client returned by GetK8sClient, e.g. a client with fake clientsets
+func SetK8sClient(client *K8sClient) {
+	defaultK8sClient = client
+}
+
+// NewK8sClient creates a K8s client from the kubeconfig file and the context. kubectl loading rules are used if
+// kubeConfig is empty and the current context is used if kubeContext is empty
+func NewK8sClient(kubeConfig, kubeContext string) (*K8sClient, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeConfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext})
+
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &K8sClient{
+		Clientset: clientset,
+		Dynamic:   dynamicClient,
+		Mapper:    restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(clientset.Discovery())),
+		Namespace: namespace,
+	}, nil
+}
+
+// Apply applies the resources in the YAML or JSON documents with server-side apply
+func (c *K8sClient) Apply(ctx context.Context, data []byte) error {
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if len(obj.Object) == 0 {
+			continue // empty document
+		}
+		if err := c.applyUnstructured(ctx, obj); err != nil {
+			return err
+		}
+	}
+}
+
+// ApplyObject applies the given typed resource (with the TypeMeta set) with server-side apply
+func (c *K8sClient) ApplyObject(ctx context.Context, obj interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return c.Apply(ctx, data)
+}
+
+// CreateObject creates the given typed resource (with the TypeMeta set)
+func (c *K8sClient) CreateObject(ctx context.Context, obj interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	if c.DryRunOut != nil {
+		return c.printDryRun(u)
+	}
+	resourceClient, namespace, err := c.resourceClientForKind(u.GroupVersionKind(), u.GetNamespace())
+	if err != nil {
+		return err
+	}
+	if _, err = resourceClient.Create(ctx, u, metav1.CreateOptions{FieldManager: K8sFieldManager}); err != nil {
+		return &K8sResourceError{"creating", u.GetKind(), namespace, u.GetName(), err}
+	}
+	return nil
+}
+
+// Get returns the resource of the given type, e.g. "apis.wso2.com", "configmaps" or "deployment"
+func (c *K8sClient) Get(ctx context.Context, resource, namespace, name string) (*unstructured.Unstructured, error) {
+	resourceClient, namespace, err := c.resourceClient(resource, namespace)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, &K8sResourceError{"getting", resource, namespace, name, err}
+	}
+	return obj, nil
+}
+
+// List returns the resources of the given type in the namespace, or in all namespaces if allNamespaces is true
+func (c *K8sClient) List(ctx context.Context, resource, namespace string,
+	allNamespaces bool) (*unstructured.UnstructuredList, error) {
+	var resourceClient dynamic.ResourceInterface
+	if allNamespaces {
+		gvk, err := c.kindFor(resource)
+		if err != nil {
+			return nil, err
+		}
+		mapping, err := c.mappingFor(gvk)
+		if err != nil {
+			return nil, err
+		}
+		resourceClient, namespace = c.Dynamic.Resource(mapping.Resource), ""
+	} else {
+		var err error
+		if resourceClient, namespace, err = c.resourceClient(resource, namespace); err != nil {
+			return nil, err
+		}
+	}
+	list, err := resourceClient.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, &K8sResourceError{"listing", resource, namespace, "", err}
+	}
+	return list, nil
+}
+
+// Delete deletes the resources of the given type, resources not found are ignored if ignoreNotFound is true
+func (c *K8sClient) Delete(ctx context.Context, resource, namespace string, ignoreNotFound bool,
+	names ...string) error {
+	resourceClient, namespace, err := c.resourceClient(resource, namespace)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if c.DryRunOut != nil {
+			fmt.Fprintf(c.DryRunOut, "# %s \"%s\" deleted (dry run)\n", resource, name)
+			continue
+		}
+		err := resourceClient.Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !(ignoreNotFound && apierrors.IsNotFound(err)) {
+			return &K8sResourceError{"deleting", resource, namespace, name, err}
+		}
+		if err == nil {
+			fmt.Printf("%s \"%s\" deleted\n", resource, name)
+		}
+	}
+	return nil
+}
+
+// WaitFor watches the resource until the condition is satisfied or the context is done
+func (c *K8sClient) WaitFor(ctx context.Context, resource, namespace, name string,
+	condition func(obj *unstructured.Unstructured) (bool, error)) error {
+	if c.DryRunOut != nil {
+		// resources are not changed in a dry run
+		return nil
+	}
+	resourceClient, namespace, err := c.resourceClient(resource, namespace)
+	if err != nil {
+		return err
+	}
+	wrapErr := func(err error) error {
+		if err == wait.ErrWaitTimeout {
+			err = ErrK8sWaitTimeout
+		}
+		return &K8sResourceError{"waiting for", resource, namespace, name, err}
+	}
+
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	list, err := resourceClient.List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return wrapErr(err)
+	}
+	for i := range list.Items {
+		if list.Items[i].GetName() != name {
+			continue
+		}
+		done, err := condition(&list.Items[i])
+		if err != nil || done {
+			return err
+		}
+	}
+
+	watcher, err := resourceClient.Watch(ctx, metav1.ListOptions{
+		FieldSelector:   fieldSelector,
+		ResourceVersion: list.GetResourceVersion(),
+	})
+	if err != nil {
+		return wrapErr(err)
+	}
+	_, err = watchtools.UntilWithoutRetry(ctx, watcher, func(event watch.Event) (bool, error) {
+		switch event.Type {
+		case watch.Added, watch.Modified:
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				return false, nil
+			}
+			return condition(obj)
+		case watch.Error:
+			return false, apierrors.FromObject(event.Object)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return wrapErr(err)
+	}
+	return nil
+}
+
+// WaitForCrdsEstablished waits until the CRDs with the given names, e.g. "apis.wso2.com", are established
+func (c *K8sClient) WaitForCrdsEstablished(ctx context.Context, crdNames ...string) error {
+	for _, crdName := range crdNames {
+		err := c.WaitFor(ctx, crdResource, "", crdName, func(obj *unstructured.Unstructured) (bool, error) {
+			conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+			for _, condition := range conditions {
+				condition, _ := condition.(map[string]interface{})
+				if condition["type"] == "Established" && condition["status"] == "True" {
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// new resource types are served by the cluster
+	c.resetMapper()
+	return nil
+}
+
+// WaitForRollout waits until the latest revision of the deployment is available, similar to
+// "kubectl rollout status -w"
+func (c *K8sClient) WaitForRollout(ctx context.Context, namespace, deployment string) error {
+	return c.WaitFor(ctx, "deployments.apps", namespace, deployment, func(obj *unstructured.Unstructured) (bool, error) {
+		generation := obj.GetGeneration()
+		observedGeneration, _, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+		replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		if !found {
+			replicas = 1
+		}
+		updatedReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+		availableReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "availableReplicas")
+		return observedGeneration >= generation && updatedReplicas >= replicas && availableReplicas >= replicas, nil
+	})
+}
+
+const crdResource = "customresourcedefinitions.apiextensions.k8s.io"
+
+// applyUnstructured applies the resource with server-side apply
+func (c *K8sClient) applyUnstructured(ctx context.Context, obj *unstructured.Unstructured) error {
+	if c.DryRunOut != nil {
+		return c.printDryRun(obj)
+	}
+	resourceClient, namespace, err := c.resourceClientForKind(obj.GroupVersionKind(), obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	force := true
+	_, err = resourceClient.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: K8sFieldManager,
+		Force:        &force,
+	})
+	if err != nil {
+		return &K8sResourceError{"applying", obj.GetKind(), namespace, obj.GetName(), err}
+	}
+	fmt.Printf("%s \"%s\" applied\n", obj.GetKind(), obj.GetName())
+	return nil
+}
+
+// printDryRun writes the resource to the dry run output as a YAML document
+func (c *K8sClient) printDryRun(obj *unstructured.Unstructured) error {
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.DryRunOut, "---\n%s", data)
+	return err
+}
+
+// resourceClient returns the dynamic client of the resource type given as in kubectl, e.g. "apis.wso2.com"
+func (c *K8sClient) resourceClient(resource, namespace string) (dynamic.ResourceInterface, string, error) {
+	gvk, err := c.kindFor(resource)
+	if err != nil {
+		return nil, "", err
+	}
+	return c.resourceClientForKind(gvk, namespace)
+}
+
+// kindFor returns the kind of the resource type given as in kubectl, e.g. "apis.wso2.com"
+func (c *K8sClient) kindFor(resource string) (schema.GroupVersionKind, error) {
+	var gvr schema.GroupVersionResource
+	err := c.retryOnNoMatch(func() error {
+		var err error
+		fullySpecifiedGVR, groupResource := schema.ParseResourceArg(resource)
+		if fullySpecifiedGVR != nil {
+			if gvr, err = c.Mapper.ResourceFor(*fullySpecifiedGVR); err == nil {
+				return nil
+			}
+		}
+		gvr, err = c.Mapper.ResourceFor(groupResource.WithVersion(""))
+		return err
+	})
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+	return c.Mapper.KindFor(gvr)
+}
+
+// mappingFor returns the REST mapping of the kind
+func (c *K8sClient) mappingFor(gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	var mapping *meta.RESTMapping
+	err := c.retryOnNoMatch(func() error {
+		var err error
+		mapping, err = c.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		return err
+	})
+	return mapping, err
+}
+
+// resourceClientForKind returns the dynamic client of the kind and the namespace used if it is namespaced
+func (c *K8sClient) resourceClientForKind(gvk schema.GroupVersionKind,
+	namespace string) (dynamic.ResourceInterface, string, error) {
+	mapping, err := c.mappingFor(gvk)
+	if err != nil {
+		return nil, "", err
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return c.Dynamic.Resource(mapping.Resource), "", nil
+	}
+	if namespace == "" {
+		namespace = c.Namespace
+	}
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return c.Dynamic.Resource(mapping.Resource).Namespace(namespace), namespace, nil
+}
+
+// retryOnNoMatch retries fn once with the discovery cache reset if a resource type is not found, since resource
+// types can be added to the cluster after the cache is populated, e.g. by applying CRDs
+func (c *K8sClient) retryOnNoMatch(fn func() error) error {
+	err := fn()
+	if meta.IsNoMatchError(err) {
+		c.resetMapper()
+		err = fn()
+	}
+	return err
+}
+
+// resetMapper resets the discovery cache of the REST mapper if it is cached
+func (c *K8sClient) resetMapper() {
+	if mapper, ok := c.Mapper.(interface{ Reset() }); ok {
+		mapper.Reset()
+	}
+}
@@ -0,0 +1,155 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var (
+	configMapGVK  = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	namespaceGVK  = schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
+	deploymentGVK = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+)
+
+func newFakeK8sClient(objects ...runtime.Object) (*K8sClient, *dynamicfake.FakeDynamicClient) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(configMapGVK, meta.RESTScopeNamespace)
+	mapper.Add(deploymentGVK, meta.RESTScopeNamespace)
+	mapper.Add(namespaceGVK, meta.RESTScopeRoot)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	return &K8sClient{Dynamic: dynamicClient, Mapper: mapper}, dynamicClient
+}
+
+func newUnstructured(gvk schema.GroupVersionKind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestK8sClientApply(t *testing.T) {
+	client, dynamicClient := newFakeK8sClient()
+	var patches []k8stesting.PatchAction
+	dynamicClient.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patches = append(patches, action.(k8stesting.PatchAction))
+		return true, &unstructured.Unstructured{}, nil
+	})
+
+	manifests := "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: wso2-system\n" +
+		"---\n" +
+		"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: controller-config\ndata:\n  registryType: HTTP\n"
+	if err := client.Apply(context.Background(), []byte(manifests)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(patches) != 2 {
+		t.Fatalf("got %d patches, want 2", len(patches))
+	}
+	for _, patch := range patches {
+		if patch.GetPatchType() != types.ApplyPatchType {
+			t.Errorf("got patch type %s, want server-side apply", patch.GetPatchType())
+		}
+	}
+	if patches[0].GetName() != "wso2-system" || patches[0].GetNamespace() != "" {
+		t.Errorf("got namespace applied as %s/%s", patches[0].GetNamespace(), patches[0].GetName())
+	}
+	if patches[1].GetName() != "controller-config" || patches[1].GetNamespace() != "default" {
+		t.Errorf("got configmap applied as %s/%s, want default/controller-config",
+			patches[1].GetNamespace(), patches[1].GetName())
+	}
+}
+
+func TestK8sClientApplyDryRun(t *testing.T) {
+	client, dynamicClient := newFakeK8sClient()
+	out := &bytes.Buffer{}
+	client.DryRunOut = out
+
+	manifests := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: controller-config\ndata:\n  registryType: HTTP\n"
+	if err := client.Apply(context.Background(), []byte(manifests)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dynamicClient.Actions()) != 0 {
+		t.Errorf("got %d actions in dry run, want 0", len(dynamicClient.Actions()))
+	}
+	if !strings.Contains(out.String(), "name: controller-config") {
+		t.Errorf("configmap not printed in dry run, got %q", out.String())
+	}
+}
+
+func TestK8sClientDelete(t *testing.T) {
+	client, _ := newFakeK8sClient(newUnstructured(configMapGVK, "wso2", "petstore-swagger"))
+
+	err := client.Delete(context.Background(), "configmaps", "wso2", true, "petstore-swagger", "petstore-params")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.Delete(context.Background(), "configmap", "wso2", false, "petstore-swagger")
+	if !IsK8sNotFound(err) {
+		t.Errorf("got error %v, want not found error", err)
+	}
+	var resourceErr *K8sResourceError
+	if !errors.As(err, &resourceErr) || resourceErr.Name != "petstore-swagger" || resourceErr.Namespace != "wso2" {
+		t.Errorf("got error %#v, want K8sResourceError of wso2/petstore-swagger", err)
+	}
+}
+
+func TestK8sClientWaitForRollout(t *testing.T) {
+	available := newUnstructured(deploymentGVK, "olm", "olm-operator")
+	available.SetGeneration(1)
+	_ = unstructured.SetNestedField(available.Object, int64(1), "spec", "replicas")
+	_ = unstructured.SetNestedField(available.Object, map[string]interface{}{
+		"observedGeneration": int64(1),
+		"updatedReplicas":    int64(1),
+		"availableReplicas":  int64(1),
+	}, "status")
+	unavailable := newUnstructured(deploymentGVK, "olm", "catalog-operator")
+	unavailable.SetGeneration(1)
+
+	client, _ := newFakeK8sClient(available, unavailable)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := client.WaitForRollout(ctx, "olm", "olm-operator"); err != nil {
+		t.Errorf("got error %v waiting for an available deployment", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := client.WaitForRollout(ctx, "olm", "catalog-operator"); !errors.Is(err, ErrK8sWaitTimeout) {
+		t.Errorf("got error %v, want %v", err, ErrK8sWaitTimeout)
+	}
+}
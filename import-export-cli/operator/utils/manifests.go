@@ -0,0 +1,347 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ghodss/yaml"
+	wso2v1alpha2 "github.com/wso2/k8s-api-operator/api-operator/pkg/apis/wso2/v1alpha2"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ParamsConfigMapKey is the key of the params file in the params ConfigMap of an API
+const ParamsConfigMapKey = "params.yaml"
+
+const (
+	kustomizationFileName    = "kustomization.yaml"
+	swaggerProjectPath       = "Definitions/swagger.yaml"
+	projectDeploymentDirName = "Deployment"
+	yamlDocSeparator         = "---\n"
+)
+
+// APIManifestsConfig holds the inputs used to generate the manifests of an API
+type APIManifestsConfig struct {
+	// Name of the API CR. Names of the ConfigMaps and Secrets are derived from it
+	Name string
+	// NameSuffix is appended to the names of the ConfigMaps and Secrets, optional
+	NameSuffix string
+	// Namespace of the resources, resources are not namespaced if empty
+	Namespace string
+	// SwaggerPath is the path to the swagger file, zip file or API project
+	SwaggerPath string
+	// ParamsPath is the path to the API params file or a deployment directory, optional
+	ParamsPath string
+	// CertsPath is the path to the directory with the certificates of the API, optional
+	CertsPath string
+	// UpdateTimeStamp is set in the API CR to trigger a rolling update, optional
+	UpdateTimeStamp string
+}
+
+// APIManifests holds the Kubernetes resources required to deploy an API with the API Operator
+type APIManifests struct {
+	SwaggerConfigMap *corev1.ConfigMap
+	ParamsConfigMap  *corev1.ConfigMap
+	CertsSecret      *corev1.Secret
+	API              *wso2v1alpha2.API
+}
+
+// manifestFile is a resource in the manifests along with the file name it is written in a kustomize base
+type manifestFile struct {
+	fileName string
+	resource interface{}
+}
+
+// GenerateAPIManifests renders the swagger ConfigMap, the params ConfigMap, the certs Secret and the API CR of an
+// API without connecting to a cluster.
+// If the API is given as a project, its endpoint and client certificates are added to the certs Secret, and the
+// project is added to the swagger ConfigMap as a zip if it has interceptors. The params file and certificates of a
+// deployment directory (given as ParamsPath, or the Deployment directory of the project) are added as well, and
+// override the certificates of the project with the same file names
+func GenerateAPIManifests(config APIManifestsConfig) (*APIManifests, error) {
+	// the lower cased name is kept if it is valid, so that an update of an existing API (e.g. pet.v1) keeps the names
+	// of its resources. Only names that are not accepted by Kubernetes are sanitised
+	name := strings.ToLower(config.Name)
+	if len(validation.IsDNS1123Subdomain(name)) > 0 {
+		name = GetValidK8sResourceName(name)
+	}
+	manifests := &APIManifests{}
+
+	info, err := os.Stat(config.SwaggerPath)
+	if err != nil {
+		return nil, err
+	}
+	var paramsFilePath string
+	var certsDirs []string
+	manifests.SwaggerConfigMap = newConfigMap(name+"-swagger"+config.NameSuffix, config.Namespace)
+	if info.IsDir() {
+		projectPath := config.SwaggerPath
+		if hasInterceptors(projectPath) {
+			err = addProjectZipToConfigMap(manifests.SwaggerConfigMap, projectPath)
+		} else {
+			err = addFileToConfigMap(manifests.SwaggerConfigMap,
+				filepath.Join(projectPath, filepath.FromSlash(swaggerProjectPath)))
+		}
+		if err != nil {
+			return nil, err
+		}
+		certsDirs = append(certsDirs,
+			filepath.Join(projectPath, utils.InitProjectEndpointCertificates),
+			filepath.Join(projectPath, utils.InitProjectClientCertificates))
+		if projectDeploymentDir := filepath.Join(projectPath, projectDeploymentDirName); config.ParamsPath == "" &&
+			utils.IsFileExist(projectDeploymentDir) {
+			paramsFilePath, certsDirs = resolveDeploymentDirectory(projectDeploymentDir, certsDirs)
+		}
+	} else if err = addFileToConfigMap(manifests.SwaggerConfigMap, config.SwaggerPath); err != nil {
+		return nil, err
+	}
+
+	if config.ParamsPath != "" {
+		info, err := os.Stat(config.ParamsPath)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			paramsFilePath, certsDirs = resolveDeploymentDirectory(config.ParamsPath, certsDirs)
+		} else {
+			paramsFilePath = config.ParamsPath
+		}
+	}
+	if paramsFilePath != "" {
+		content, err := ioutil.ReadFile(paramsFilePath)
+		if err != nil {
+			return nil, err
+		}
+		manifests.ParamsConfigMap = newConfigMap(name+"-params"+config.NameSuffix, config.Namespace)
+		manifests.ParamsConfigMap.Data[ParamsConfigMapKey] = string(content)
+	}
+
+	if config.CertsPath != "" {
+		if _, err := os.Stat(config.CertsPath); err != nil {
+			return nil, err
+		}
+		certsDirs = append(certsDirs, config.CertsPath)
+	}
+	manifests.CertsSecret, err = newSecretFromDirectories(name+"-certs"+config.NameSuffix, config.Namespace,
+		certsDirs...)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests.API = &wso2v1alpha2.API{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: wso2v1alpha2.SchemeGroupVersion.String(),
+			Kind:       "API",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: config.Namespace,
+		},
+	}
+	manifests.API.Spec.SwaggerConfigMapName = manifests.SwaggerConfigMap.Name
+	manifests.API.Spec.UpdateTimeStamp = config.UpdateTimeStamp
+	if manifests.ParamsConfigMap != nil {
+		manifests.API.Spec.ParamsValues = manifests.ParamsConfigMap.Name
+	}
+	if manifests.CertsSecret != nil {
+		manifests.API.Spec.CertsValues = manifests.CertsSecret.Name
+	}
+	return manifests, nil
+}
+
+// resolveDeploymentDirectory returns the params file of the deployment directory if exists, and appends its
+// certificates directory to certsDirs
+func resolveDeploymentDirectory(deploymentDir string, certsDirs []string) (string, []string) {
+	var paramsFilePath string
+	if path := filepath.Join(deploymentDir, utils.ParamFile); utils.IsFileExist(path) {
+		paramsFilePath = path
+	}
+	return paramsFilePath, append(certsDirs, filepath.Join(deploymentDir, utils.DeploymentCertificatesDirectory))
+}
+
+// hasInterceptors returns whether the API project has Ballerina or Java interceptors
+func hasInterceptors(projectPath string) bool {
+	for _, dir := range []string{utils.InitProjectInterceptors, utils.InitProjectLibs} {
+		files, err := ioutil.ReadDir(filepath.Join(projectPath, dir))
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			if !file.IsDir() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// files returns the resources in the order they should be applied
+func (m *APIManifests) files() []manifestFile {
+	files := []manifestFile{{fileName: "swagger_cm.yaml", resource: m.SwaggerConfigMap}}
+	if m.ParamsConfigMap != nil {
+		files = append(files, manifestFile{fileName: "params_cm.yaml", resource: m.ParamsConfigMap})
+	}
+	if m.CertsSecret != nil {
+		files = append(files, manifestFile{fileName: "certs_secret.yaml", resource: m.CertsSecret})
+	}
+	return append(files, manifestFile{fileName: "api_crd.yaml", resource: m.API})
+}
+
+// ToMultiDocYAML returns all the resources as a single multi-document YAML
+func (m *APIManifests) ToMultiDocYAML() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	for _, file := range m.files() {
+		content, err := yaml.Marshal(file.resource)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(yamlDocSeparator)
+		buf.Write(content)
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteKustomizeBase writes each resource to a separate file in dir along with a kustomization.yaml listing them
+func (m *APIManifests) WriteKustomizeBase(dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	var resources []string
+	for _, file := range m.files() {
+		content, err := yaml.Marshal(file.resource)
+		if err != nil {
+			return err
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, file.fileName), content, 0644); err != nil {
+			return err
+		}
+		resources = append(resources, file.fileName)
+	}
+
+	kustomization := map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	}
+	if m.API.Namespace != "" {
+		kustomization["namespace"] = m.API.Namespace
+	}
+	content, err := yaml.Marshal(kustomization)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, kustomizationFileName), content, 0644)
+}
+
+func newConfigMap(name, namespace string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: map[string]string{},
+	}
+}
+
+// addFileToConfigMap adds the file to the ConfigMap keyed by its file name, similar to
+// "kubectl create configmap --from-file"
+func addFileToConfigMap(configMap *corev1.ConfigMap, filePath string) error {
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	addContentToConfigMap(configMap, filepath.Base(filePath), content)
+	return nil
+}
+
+// addProjectZipToConfigMap zips the API project and adds it to the ConfigMap
+func addProjectZipToConfigMap(configMap *corev1.ConfigMap, projectPath string) error {
+	zipPath, err, cleanupFunc := utils.CreateZipFileFromProject(projectPath, false)
+	if err != nil {
+		return err
+	}
+	if cleanupFunc != nil {
+		defer cleanupFunc()
+	}
+	content, err := ioutil.ReadFile(zipPath)
+	if err != nil {
+		return err
+	}
+	addContentToConfigMap(configMap, filepath.Base(projectPath)+utils.ZipFileSuffix, content)
+	return nil
+}
+
+// addContentToConfigMap adds the content as data, or as binary data if it is not valid UTF-8
+func addContentToConfigMap(configMap *corev1.ConfigMap, key string, content []byte) {
+	if utf8.Valid(content) {
+		configMap.Data[key] = string(content)
+		return
+	}
+	if configMap.BinaryData == nil {
+		configMap.BinaryData = map[string][]byte{}
+	}
+	configMap.BinaryData[key] = content
+}
+
+// newSecretFromDirectories creates an Opaque Secret with the files in the directories (including subdirectories)
+// keyed by their file names. Files in the latter directories override the files with the same name. Directories that
+// do not exist are skipped, and nil is returned if no files are found
+func newSecretFromDirectories(name, namespace string, dirs ...string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{},
+	}
+	for _, dir := range dirs {
+		if !utils.IsFileExist(dir) {
+			continue
+		}
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			secret.Data[info.Name()] = content
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(secret.Data) == 0 {
+		return nil, nil
+	}
+	return secret, nil
+}
@@ -0,0 +1,233 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func createAPIProject(t *testing.T, dir string) string {
+	projectPath := filepath.Join(dir, "PetstoreAPI")
+	if err := os.MkdirAll(filepath.Join(projectPath, "Definitions"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	swagger := "openapi: 3.0.0\ninfo:\n  title: Petstore\n  version: 1.0.0\n"
+	if err := ioutil.WriteFile(filepath.Join(projectPath, "Definitions", "swagger.yaml"), []byte(swagger), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return projectPath
+}
+
+func TestGenerateAPIManifestsFromProject(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	paramsPath := filepath.Join(dir, "api_params.yaml")
+	if err = ioutil.WriteFile(paramsPath, []byte("environments: []\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	certsPath := filepath.Join(dir, "certificates")
+	if err = os.MkdirAll(certsPath, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(certsPath, "backend.crt"), []byte("cert"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	manifests, err := GenerateAPIManifests(APIManifestsConfig{
+		Name:        "Petstore API",
+		Namespace:   "wso2",
+		SwaggerPath: createAPIProject(t, dir),
+		ParamsPath:  paramsPath,
+		CertsPath:   certsPath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if manifests.API.APIVersion != "wso2.com/v1alpha2" || manifests.API.Kind != "API" {
+		t.Errorf("got API CR of %s %s, want wso2.com/v1alpha2 API", manifests.API.APIVersion, manifests.API.Kind)
+	}
+	if manifests.API.Name != "petstore-api" || manifests.API.Namespace != "wso2" {
+		t.Errorf("got API %s/%s, want wso2/petstore-api", manifests.API.Namespace, manifests.API.Name)
+	}
+	if manifests.API.Spec.SwaggerConfigMapName != "petstore-api-swagger" {
+		t.Errorf("got swagger configmap %s, want petstore-api-swagger", manifests.API.Spec.SwaggerConfigMapName)
+	}
+	if manifests.API.Spec.ParamsValues != "petstore-api-params" {
+		t.Errorf("got params configmap %s, want petstore-api-params", manifests.API.Spec.ParamsValues)
+	}
+	if manifests.API.Spec.CertsValues != "petstore-api-certs" {
+		t.Errorf("got certs secret %s, want petstore-api-certs", manifests.API.Spec.CertsValues)
+	}
+	if _, ok := manifests.SwaggerConfigMap.Data["swagger.yaml"]; !ok {
+		t.Errorf("swagger.yaml not found in swagger configmap")
+	}
+	if string(manifests.CertsSecret.Data["backend.crt"]) != "cert" {
+		t.Errorf("backend.crt not found in certs secret")
+	}
+
+	content, err := manifests.ToMultiDocYAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if docs := strings.Count(string(content), yamlDocSeparator); docs != 4 {
+		t.Errorf("got %d documents, want 4", docs)
+	}
+}
+
+func TestGenerateAPIManifestsFromProjectWithDeploymentDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	projectPath := createAPIProject(t, dir)
+	files := map[string]string{
+		filepath.Join("Deployment", "params.yaml"):                 "environments: []\n",
+		filepath.Join("Deployment", "certificates", "backend.crt"): "deployment-cert",
+		filepath.Join("Endpoint-certificates", "backend.crt"):      "project-cert",
+		filepath.Join("Client-certificates", "client.crt"):         "client-cert",
+		filepath.Join("Interceptors", "interceptor.bal"):           "public function validate() {}",
+	}
+	for file, content := range files {
+		path := filepath.Join(projectPath, file)
+		if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	manifests, err := GenerateAPIManifests(APIManifestsConfig{
+		Name:        "petstore",
+		NameSuffix:  "-1600000000",
+		SwaggerPath: projectPath,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if manifests.API.Spec.SwaggerConfigMapName != "petstore-swagger-1600000000" {
+		t.Errorf("got swagger configmap %s, want petstore-swagger-1600000000",
+			manifests.API.Spec.SwaggerConfigMapName)
+	}
+	if _, ok := manifests.SwaggerConfigMap.BinaryData["PetstoreAPI.zip"]; !ok {
+		t.Errorf("project zip not found in swagger configmap of an API with interceptors")
+	}
+	if manifests.ParamsConfigMap == nil || manifests.ParamsConfigMap.Data[ParamsConfigMapKey] != "environments: []\n" {
+		t.Errorf("params of the Deployment directory not found in params configmap")
+	}
+	if string(manifests.CertsSecret.Data["backend.crt"]) != "deployment-cert" {
+		t.Errorf("got backend.crt %q, want the certificate of the Deployment directory",
+			manifests.CertsSecret.Data["backend.crt"])
+	}
+	if string(manifests.CertsSecret.Data["client.crt"]) != "client-cert" {
+		t.Errorf("client.crt not found in certs secret")
+	}
+}
+
+func TestGenerateAPIManifestsNames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	projectPath := createAPIProject(t, dir)
+
+	tests := []struct {
+		name          string
+		apiName       string
+		nameSuffix    string
+		wantAPI       string
+		wantConfigMap string
+	}{
+		{"new API with a dotted name", "pet.v1", "", "pet.v1", "pet.v1-swagger"},
+		{"update of an API with a dotted name", "pet.v1", "-1600000000", "pet.v1", "pet.v1-swagger-1600000000"},
+		{"upper case name", "Pet.V1", "", "pet.v1", "pet.v1-swagger"},
+		{"name with invalid characters", "Pet_Store API", "", "pet-store-api", "pet-store-api-swagger"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifests, err := GenerateAPIManifests(APIManifestsConfig{
+				Name:        test.apiName,
+				NameSuffix:  test.nameSuffix,
+				SwaggerPath: projectPath,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if manifests.API.Name != test.wantAPI {
+				t.Errorf("got API %s, want %s", manifests.API.Name, test.wantAPI)
+			}
+			if manifests.API.Spec.SwaggerConfigMapName != test.wantConfigMap {
+				t.Errorf("got swagger configmap %s, want %s", manifests.API.Spec.SwaggerConfigMapName,
+					test.wantConfigMap)
+			}
+		})
+	}
+}
+
+func TestWriteKustomizeBase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	manifests, err := GenerateAPIManifests(APIManifestsConfig{
+		Name:        "petstore",
+		SwaggerPath: filepath.Join(createAPIProject(t, dir), "Definitions", "swagger.yaml"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifests.ParamsConfigMap != nil || manifests.CertsSecret != nil {
+		t.Errorf("params configmap and certs secret should not be generated")
+	}
+
+	baseDir := filepath.Join(dir, "base")
+	if err = manifests.WriteKustomizeBase(baseDir); err != nil {
+		t.Fatal(err)
+	}
+	kustomization, err := ioutil.ReadFile(filepath.Join(baseDir, kustomizationFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"swagger_cm.yaml", "api_crd.yaml"} {
+		if !strings.Contains(string(kustomization), file) {
+			t.Errorf("%s not found in %s", file, kustomizationFileName)
+		}
+		if _, err := os.Stat(filepath.Join(baseDir, file)); err != nil {
+			t.Errorf("%s not written: %v", file, err)
+		}
+	}
+	if strings.Contains(string(kustomization), "namespace") {
+		t.Errorf("namespace should not be set in %s", kustomizationFileName)
+	}
+}
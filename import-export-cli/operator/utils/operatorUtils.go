@@ -20,11 +20,15 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -109,7 +113,7 @@ func CreateControllerConfigs(configFile string, maxTimeSec int, resourceTypes ..
 	}
 	if len(nonCrdsData) > 0 {
 		// waiting for resource creation if CRDs are applied
-		if len(crdsData) > 0 {
+		if len(crdsData) > 0 && K8sDryRunOut == nil {
 			fmt.Println("Waiting for resource creation...")
 			// if error then wait for namespace and the resource type security
 			if len(resourceTypes) > 0 {
@@ -175,3 +179,70 @@ func readConfigData(configFile string) *[][]byte {
 		return nil
 	}
 }
+
+// GetInstalledOperatorVersion returns the version of the operator, e.g. "api-operator", installed in the K8s cluster
+// or an empty string if the operator is not installed. The version is read from the image of the operator deployment
+// if the operator is not installed with apictl
+func GetInstalledOperatorVersion(operator string) (string, error) {
+	client, err := GetK8sClient()
+	if err != nil {
+		return "", err
+	}
+
+	configMap, err := client.Get(context.Background(), ConfigMaps, ApiOpWso2Namespace, InstalledOperatorsConfigMap)
+	if err != nil && !IsK8sNotFound(err) {
+		return "", err
+	}
+	if err == nil {
+		if version, _, _ := unstructured.NestedString(configMap.Object, "data", operator); version != "" {
+			return version, nil
+		}
+	}
+
+	deployment, err := client.Get(context.Background(), "deployments.apps", ApiOpWso2Namespace, operator)
+	if IsK8sNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	containers, _, _ := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	for _, container := range containers {
+		container, _ := container.(map[string]interface{})
+		image := fmt.Sprint(container["image"])
+		if i := strings.LastIndex(image, ":"); i > 0 && !strings.Contains(image[i:], "/") {
+			return "v" + strings.TrimPrefix(image[i+1:], "v"), nil
+		}
+	}
+	return "", fmt.Errorf("version of the %s not found in the deployment", operator)
+}
+
+// SetInstalledOperatorVersion records the version of the operator installed in the K8s cluster
+func SetInstalledOperatorVersion(operator, version string) error {
+	client, err := GetK8sClient()
+	if err != nil {
+		return err
+	}
+
+	// keep versions of other operators since fields not given are removed in server-side apply
+	data := map[string]string{}
+	existing, err := client.Get(context.Background(), ConfigMaps, ApiOpWso2Namespace, InstalledOperatorsConfigMap)
+	if err != nil && !IsK8sNotFound(err) {
+		return err
+	}
+	if err == nil {
+		if versions, found, _ := unstructured.NestedStringMap(existing.Object, "data"); found {
+			data = versions
+		}
+	}
+	data[operator] = version
+
+	return client.ApplyObject(context.Background(), &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      InstalledOperatorsConfigMap,
+			Namespace: ApiOpWso2Namespace,
+		},
+		Data: data,
+	})
+}
@@ -0,0 +1,120 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ExportAPIProductOptions are the options of exporting an API Product
+type ExportAPIProductOptions struct {
+	// Provider of the API Product
+	Provider string
+	// Revision number to export. The working copy is exported if it is empty
+	Revision string
+	// LatestRevision exports the latest revision of the API Product instead of the working copy
+	LatestRevision bool
+	// Format of the exported definition files, JSON or YAML (default)
+	Format string
+	// PreserveStatus keeps the lifecycle status of the API Product. Otherwise it is exported in CREATED status
+	PreserveStatus bool
+	// Dir is the directory to write the archive to. Defaults to the exported API Products directory of the
+	// environment
+	Dir string
+}
+
+// ImportAPIProductOptions are the options of importing an API Product
+type ImportAPIProductOptions struct {
+	// ParamsFile is an API Manager params file or a directory generated by 'apictl gen deployment-dir'
+	ParamsFile string
+	// ImportAPIs imports the dependent APIs of the API Product as well
+	ImportAPIs bool
+	// UpdateAPIs updates the dependent APIs if they already exist
+	UpdateAPIs bool
+	// Update updates the API Product if it already exists
+	Update bool
+	// PreserveProvider keeps the provider of the API Product in the project
+	PreserveProvider bool
+	// RotateRevision deletes the oldest revision if the maximum number of revisions is reached
+	RotateRevision bool
+	// SkipDeployments updates only the working copy and skips the deployment steps
+	SkipDeployments bool
+	// SkipCleanup leaves the temporary files created during the import
+	SkipCleanup bool
+}
+
+// ListAPIProducts returns the API Products in the environment which match the query. At most limit API Products are
+// returned if it is positive
+func (c *Client) ListAPIProducts(ctx context.Context, query string, limit int) ([]utils.APIProduct, error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, apiProducts, err := impl.GetAPIProductListFromEnv(ctx, accessToken, c.environment, query, limitParam(limit))
+	return apiProducts, wrapError(err)
+}
+
+// ExportAPIProduct exports the API Product as an archive and returns the path to the archive
+func (c *Client) ExportAPIProduct(ctx context.Context, name, version string, opts ExportAPIProductOptions) (string,
+	error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	resp, err := impl.ExportAPIProductFromEnv(ctx, accessToken, name, version, opts.Revision, opts.Provider,
+		opts.Format, c.environment, opts.LatestRevision, opts.PreserveStatus)
+	if err != nil {
+		return "", err
+	}
+	utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
+	if resp.StatusCode() != http.StatusOK {
+		return "", wrapError(utils.NewResponseError(resp, resp.Status()+" "+string(resp.Body())))
+	}
+	dir := opts.Dir
+	if dir == "" {
+		dir = filepath.Join(utils.ExportDirectory, utils.ExportedApiProductsDirName, c.environment)
+	}
+	return impl.WriteAPIProductToZip(name, version, dir, resp)
+}
+
+// ImportAPIProduct imports the API Product archive or project directory in the path to the environment
+func (c *Client) ImportAPIProduct(ctx context.Context, path string, opts ImportAPIProductOptions) error {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	return wrapError(impl.ImportAPIProductToEnv(ctx, accessToken, c.environment, path, opts.ParamsFile, opts.ImportAPIs,
+		opts.UpdateAPIs, opts.Update, opts.PreserveProvider, opts.SkipCleanup, opts.RotateRevision,
+		opts.SkipDeployments))
+}
+
+// DeleteAPIProduct deletes the API Product with the given name and provider
+func (c *Client) DeleteAPIProduct(ctx context.Context, name, provider string) error {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = impl.DeleteAPIProduct(ctx, accessToken, c.environment, name, provider)
+	return wrapError(err)
+}
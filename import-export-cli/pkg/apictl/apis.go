@@ -0,0 +1,240 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ExportAPIOptions are the options of exporting an API
+type ExportAPIOptions struct {
+	// Provider of the API. Required only if APIs with the same name and version exist for several providers
+	Provider string
+	// Revision number to export. The working copy is exported if it is empty
+	Revision string
+	// LatestRevision exports the latest revision of the API instead of the working copy
+	LatestRevision bool
+	// Format of the exported definition files, JSON or YAML (default)
+	Format string
+	// PreserveStatus keeps the lifecycle status of the API. Otherwise it is exported in CREATED status
+	PreserveStatus bool
+	// Dir is the directory to write the archive to. Defaults to the exported APIs directory of the environment
+	Dir string
+}
+
+// ImportAPIOptions are the options of importing an API
+type ImportAPIOptions struct {
+	// ParamsFile is an API Manager params file or a directory generated by 'apictl gen deployment-dir'
+	ParamsFile string
+	// Update updates the API if it already exists
+	Update bool
+	// PreserveProvider keeps the provider of the API in the project
+	PreserveProvider bool
+	// RotateRevision deletes the oldest revision if the maximum number of revisions is reached
+	RotateRevision bool
+	// SkipDeployments updates only the working copy and skips the deployment steps
+	SkipDeployments bool
+	// SkipCleanup leaves the temporary files created during the import
+	SkipCleanup bool
+}
+
+// ExportAPIsOptions are the options of exporting all the APIs of a tenant for a migration
+type ExportAPIsOptions struct {
+	// TenantDomain of the APIs. The tenant of the logged in user is used if it is empty
+	TenantDomain string
+	// Username recorded in the export metadata
+	Username string
+	// Format of the exported definition files, JSON or YAML (default)
+	Format string
+	// PreserveStatus keeps the lifecycle status of the APIs. Otherwise they are exported in CREATED status
+	PreserveStatus bool
+	// AllRevisions exports the working copy and all the revisions of the APIs instead of the deployed revisions
+	AllRevisions bool
+	// Force cleans the previously exported APIs and starts from the beginning instead of resuming a halted export
+	Force bool
+	// Dir is the directory of the migration artifacts. Defaults to the migration directory of the export directory
+	Dir string
+}
+
+// ListAPIs returns the APIs in the environment which match the query. At most limit APIs are returned if it is
+// positive
+func (c *Client) ListAPIs(ctx context.Context, query string, limit int) ([]utils.API, error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, apis, err := impl.GetAPIListFromEnv(ctx, accessToken, c.environment, query, limitParam(limit))
+	return apis, wrapError(err)
+}
+
+// GetAPIID returns the id of the API with the given name, version and provider
+func (c *Client) GetAPIID(ctx context.Context, name, version, provider string) (string, error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	id, err := impl.GetAPIId(ctx, accessToken, c.environment, name, version, provider)
+	return id, wrapError(err)
+}
+
+// ExportAPI exports the API as an archive and returns the path to the archive
+func (c *Client) ExportAPI(ctx context.Context, name, version string, opts ExportAPIOptions) (string, error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	resp, err := impl.ExportAPIFromEnv(ctx, accessToken, name, version, opts.Revision, opts.Provider, opts.Format,
+		c.environment, opts.PreserveStatus, opts.LatestRevision)
+	if err != nil {
+		return "", err
+	}
+	utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
+	if resp.StatusCode() != http.StatusOK {
+		return "", wrapError(utils.NewResponseError(resp, resp.Status()+" "+string(resp.Body())))
+	}
+	dir := opts.Dir
+	if dir == "" {
+		dir = filepath.Join(utils.ExportDirectory, utils.ExportedApisDirName, c.environment)
+	}
+	return impl.WriteToZip(name, version, opts.Revision, dir, resp)
+}
+
+// ExportAPIs exports all the APIs of the tenant into the migration artifacts directory and returns the directory the
+// APIs are written to. A halted export is resumed unless opts.Force is set. Progress messages are written to the
+// output of the client. Only one export can be run at a time in a process
+func (c *Client) ExportAPIs(ctx context.Context, opts ExportAPIsOptions) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	credential, err := c.getCredential()
+	if err != nil {
+		return "", err
+	}
+	dir := opts.Dir
+	if dir == "" {
+		dir = filepath.Join(utils.ExportDirectory, utils.ExportedMigrationArtifactsDirName)
+	}
+	apiExportDir, err := impl.CreateExportAPIsDirStructure(dir, opts.TenantDomain, c.environment, opts.Force)
+	if err != nil {
+		return "", err
+	}
+	//e.g. /home/samithac/.wso2apictl/exported/migration/production-2.5/wso2-dot-org
+	exportRelatedFilesPath := filepath.Join(dir, c.environment, utils.GetMigrationExportTenantDirName(opts.TenantDomain))
+
+	fmt.Fprintln(c.out, "\nExporting APIs for the migration...")
+	if utils.IsFileExist(filepath.Join(exportRelatedFilesPath, utils.LastSucceededApiFileName)) && !opts.Force {
+		err = impl.PrepareResumption(ctx, credential, exportRelatedFilesPath, opts.TenantDomain, opts.Username,
+			c.environment, c.out)
+	} else {
+		err = impl.PrepareStartFromBeginning(ctx, credential, exportRelatedFilesPath, opts.TenantDomain, opts.Username,
+			c.environment, c.out)
+	}
+	if err != nil {
+		return "", wrapError(err)
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	err = impl.ExportAPIs(ctx, credential, exportRelatedFilesPath, c.environment, opts.TenantDomain, opts.Format,
+		opts.Username, apiExportDir, opts.PreserveStatus, opts.AllRevisions, c.out)
+	return apiExportDir, wrapError(err)
+}
+
+// ImportAPI imports the API archive or project directory in the path to the environment
+func (c *Client) ImportAPI(ctx context.Context, path string, opts ImportAPIOptions) error {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	return wrapError(impl.ImportAPIToEnv(ctx, accessToken, c.environment, path, opts.ParamsFile, opts.Update,
+		opts.PreserveProvider, opts.SkipCleanup, opts.RotateRevision, opts.SkipDeployments))
+}
+
+// DeleteAPI deletes the API with the given name, version and provider
+func (c *Client) DeleteAPI(ctx context.Context, name, version, provider string) error {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = impl.DeleteAPI(ctx, accessToken, c.environment, name, version, provider)
+	return wrapError(err)
+}
+
+// ChangeAPIStatus performs the lifecycle action, such as "Publish", on the API
+func (c *Client) ChangeAPIStatus(ctx context.Context, action, name, version, provider string) error {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := impl.ChangeAPIStatusInEnv(ctx, accessToken, c.environment, action, name, version, provider)
+	if err != nil {
+		return wrapError(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return wrapError(utils.NewResponseError(resp, resp.Status()+" "+string(resp.Body())))
+	}
+	return nil
+}
+
+// ListAPIRevisions returns the revisions of the API which match the query
+func (c *Client) ListAPIRevisions(ctx context.Context, name, version, provider, query string) ([]utils.Revisions,
+	error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, revisions, err := impl.GetRevisionListFromEnv(ctx, accessToken, c.environment, name, version, provider, query)
+	return revisions, wrapError(err)
+}
+
+// GetKeys subscribes a test application of apictl to the API or API Product and returns an access token to invoke
+// it. The token endpoint of the environment is used if tokenEndpoint is empty
+func (c *Client) GetKeys(ctx context.Context, name, version, provider, tokenEndpoint string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	credential, err := c.getCredential()
+	if err != nil {
+		return "", err
+	}
+	credential.ClientId, credential.ClientSecret, err = impl.CallDCREndpoint(ctx, credential, c.environment)
+	if err != nil {
+		return "", wrapError(err)
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	token, err := impl.GetKeys(ctx, credential, c.environment, name, version, provider, tokenEndpoint)
+	return token, wrapError(err)
+}
+
+// limitParam formats the limit query parameter of the list operations
+func limitParam(limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+	return strconv.Itoa(limit)
+}
@@ -0,0 +1,108 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ExportAppOptions are the options of exporting an application
+type ExportAppOptions struct {
+	// Format of the exported definition files, JSON or YAML (default)
+	Format string
+	// WithKeys exports the keys of the application as well
+	WithKeys bool
+	// Dir is the directory to write the archive to. Defaults to the exported applications directory of the
+	// environment
+	Dir string
+}
+
+// ImportAppOptions are the options of importing an application
+type ImportAppOptions struct {
+	// Owner of the application after the import. The importing user is the owner if it is empty
+	Owner string
+	// Update updates the application if it already exists
+	Update bool
+	// PreserveOwner keeps the owner of the application in the archive
+	PreserveOwner bool
+	// SkipSubscriptions skips importing the subscriptions of the application
+	SkipSubscriptions bool
+	// SkipKeys skips importing the keys of the application
+	SkipKeys bool
+	// SkipCleanup leaves the temporary files created during the import
+	SkipCleanup bool
+}
+
+// ListApps returns the applications in the environment owned by the owner, or all of them if owner is empty. At
+// most limit applications are returned if it is positive
+func (c *Client) ListApps(ctx context.Context, owner string, limit int) ([]utils.Application, error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, apps, err := impl.GetApplicationListFromEnv(ctx, accessToken, c.environment, owner, limitParam(limit))
+	return apps, wrapError(err)
+}
+
+// ExportApp exports the application as an archive and returns the path to the archive
+func (c *Client) ExportApp(ctx context.Context, name, owner string, opts ExportAppOptions) (string, error) {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	resp, err := impl.ExportAppFromEnv(ctx, accessToken, name, owner, opts.Format, c.environment, opts.WithKeys)
+	if err != nil {
+		return "", wrapError(err)
+	}
+	utils.Logf(utils.LogPrefixInfo+"ResponseStatus: %v\n", resp.Status())
+	if resp.StatusCode() != http.StatusOK {
+		return "", wrapError(utils.NewResponseError(resp, resp.Status()+" "+string(resp.Body())))
+	}
+	dir := opts.Dir
+	if dir == "" {
+		dir = filepath.Join(utils.ExportDirectory, utils.ExportedAppsDirName, c.environment)
+	}
+	return impl.WriteApplicationToZip(name, owner, dir, resp)
+}
+
+// ImportApp imports the application archive or directory in the path to the environment
+func (c *Client) ImportApp(ctx context.Context, path string, opts ImportAppOptions) error {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = impl.ImportApplicationToEnv(ctx, accessToken, c.environment, path, opts.Owner, opts.Update,
+		opts.PreserveOwner, opts.SkipSubscriptions, opts.SkipKeys, opts.SkipCleanup)
+	return wrapError(err)
+}
+
+// DeleteApp deletes the application with the given name and owner
+func (c *Client) DeleteApp(ctx context.Context, name, owner string) error {
+	accessToken, err := c.getAccessToken(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = impl.DeleteApplication(ctx, accessToken, c.environment, name, owner)
+	return wrapError(err)
+}
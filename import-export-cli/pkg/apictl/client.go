@@ -0,0 +1,147 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// Client performs operations against a single environment of the apictl configuration
+type Client struct {
+	environment string
+	credential  *credentials.Credential
+	out         io.Writer
+
+	mutex       sync.Mutex
+	accessToken string
+}
+
+// Option configures a Client or an MGClient
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	credential *credentials.Credential
+	out        io.Writer
+}
+
+// WithCredential makes the client use the given credential instead of the one stored by 'apictl login'. It has no
+// effect on an MGClient, which uses the token stored by 'apictl mg login'
+func WithCredential(credential credentials.Credential) Option {
+	return func(o *clientOptions) {
+		o.credential = &credential
+	}
+}
+
+// WithOutput sets the writer which receives the progress messages of long running operations. The messages are
+// discarded by default
+func WithOutput(out io.Writer) Option {
+	return func(o *clientOptions) {
+		o.out = out
+	}
+}
+
+// newClientOptions applies the options over the defaults
+func newClientOptions(opts []Option) clientOptions {
+	o := clientOptions{out: ioutil.Discard}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// NewClient creates a client for the given environment. An error of kind ErrNotFound is returned if the environment
+// has not been added
+func NewClient(environment string, opts ...Option) (*Client, error) {
+	if err := loadConfig(); err != nil {
+		return nil, err
+	}
+	if !utils.EnvExistsInMainConfigFile(environment, utils.MainConfigFilePath) {
+		return nil, newError(ErrNotFound, fmt.Errorf("environment '%s' does not exist", environment))
+	}
+	o := newClientOptions(opts)
+	return &Client{environment: environment, credential: o.credential, out: o.out}, nil
+}
+
+// Environment returns the name of the environment of the client
+func (c *Client) Environment() string {
+	return c.environment
+}
+
+// loadConfig reads the main config once, unless it has already been read by the apictl command line
+func loadConfig() error {
+	if !utils.IsFileExist(utils.MainConfigFilePath) {
+		return fmt.Errorf("config file %s does not exist", utils.MainConfigFilePath)
+	}
+	if utils.ExportDirectory != "" {
+		return nil
+	}
+	return utils.SetConfigVars(utils.MainConfigFilePath)
+}
+
+// getCredential returns the credential given to the client or the one stored for the environment
+func (c *Client) getCredential() (credentials.Credential, error) {
+	if c.credential != nil {
+		return *c.credential, nil
+	}
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil {
+		return credentials.Credential{}, err
+	}
+	if !store.HasAPIM(c.environment) {
+		return credentials.Credential{}, newError(ErrUnauthorized,
+			fmt.Errorf("not logged into API Manager of environment '%s'", c.environment))
+	}
+	return store.GetAPIMCredentials(c.environment)
+}
+
+// getAccessToken returns an access token for the environment. The token is requested once per client
+func (c *Client) getAccessToken(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.accessToken != "" {
+		return c.accessToken, nil
+	}
+	credential, err := c.getCredential()
+	if err != nil {
+		return "", err
+	}
+	accessToken, err := credentials.GetOAuthAccessToken(ctx, credential, c.environment)
+	if err != nil {
+		// the token endpoint rejects invalid credentials with a client error
+		var respErr *utils.ResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode < http.StatusInternalServerError {
+			return "", &Error{Kind: ErrUnauthorized, StatusCode: respErr.StatusCode, Err: err}
+		}
+		return "", err
+	}
+	c.accessToken = accessToken
+	return accessToken, nil
+}
@@ -0,0 +1,39 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+// Package apictl is a client library for the operations of apictl. It can be embedded in Go programs and tests as
+// it never exits the process. Failures are returned as errors which can be matched against the error kinds of this
+// package using errors.Is.
+//
+// Environments and credentials are read from the apictl configuration of the current user, so a user who has added
+// and logged into an environment with the apictl command line can use the same environment from this package.
+//
+//	client, err := apictl.NewClient("dev")
+//	if err != nil {
+//		return err
+//	}
+//	path, err := client.ExportAPI(ctx, "PizzaShackAPI", "1.0.0", apictl.ExportAPIOptions{PreserveStatus: true})
+//	if errors.Is(err, apictl.ErrNotFound) {
+//		// the API does not exist in the environment
+//	}
+//
+// The context passed to an operation is bound to every request the operation sends. Once the context is canceled or
+// its deadline is exceeded, the request in flight is aborted, no further requests or retries are sent and the
+// operation returns an error which matches the error of the context with errors.Is. Each request is also bounded by
+// the timeout of the HTTP client (http_request_timeout).
+package apictl
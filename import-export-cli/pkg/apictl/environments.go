@@ -0,0 +1,66 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// ListEnvironments returns the environments added to the apictl configuration by their names
+func ListEnvironments() (map[string]utils.EnvEndpoints, error) {
+	if err := loadConfig(); err != nil {
+		return nil, err
+	}
+	mainConfig, err := utils.ReadMainConfig(utils.MainConfigFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return mainConfig.Environments, nil
+}
+
+// AddEnvironment adds an environment with the given endpoints. An error of kind ErrConflict is returned if an
+// environment with the same name exists
+func AddEnvironment(name string, endpoints utils.EnvEndpoints) error {
+	if err := loadConfig(); err != nil {
+		return err
+	}
+	if utils.EnvExistsInMainConfigFile(name, utils.MainConfigFilePath) {
+		return newError(ErrConflict, fmt.Errorf("environment '%s' already exists", name))
+	}
+	if err := impl.AddEnv(name, &endpoints, utils.MainConfigFilePath, "env"); err != nil {
+		return newError(ErrValidation, err)
+	}
+	return nil
+}
+
+// RemoveEnvironment removes the environment and logs out of it. An error of kind ErrNotFound is returned if the
+// environment does not exist
+func RemoveEnvironment(name string) error {
+	if err := loadConfig(); err != nil {
+		return err
+	}
+	if !utils.EnvExistsInMainConfigFile(name, utils.MainConfigFilePath) {
+		return newError(ErrNotFound, fmt.Errorf("environment '%s' does not exist", name))
+	}
+	return impl.RemoveEnv(context.Background(), name, utils.MainConfigFilePath, utils.EnvKeysAllFilePath)
+}
@@ -0,0 +1,112 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+var (
+	// ErrNotFound is the kind of the errors returned when the requested resource does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is the kind of the errors returned when the resource already exists or is in a conflicting state
+	ErrConflict = errors.New("conflict")
+	// ErrUnauthorized is the kind of the errors returned when the credentials are missing, invalid or not allowed to
+	// perform the operation
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrValidation is the kind of the errors returned when the request or the given artifact is not valid
+	ErrValidation = errors.New("validation failed")
+)
+
+// Error is returned by the operations of the client when the failure could be classified
+type Error struct {
+	// Kind is one of ErrNotFound, ErrConflict, ErrUnauthorized or ErrValidation
+	Kind error
+	// StatusCode is the HTTP status code of the response, 0 if the error did not come from a response
+	StatusCode int
+	// Err is the underlying error
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Kind.Error() + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error is of the given kind
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+// ExitCode returns the exit code apictl returns for errors of this kind
+func (e *Error) ExitCode() int {
+	switch e.Kind {
+	case ErrNotFound:
+		return utils.ExitCodeNotFound
+	case ErrConflict:
+		return utils.ExitCodeConflict
+	case ErrUnauthorized:
+		return utils.ExitCodeUnauthorized
+	case ErrValidation:
+		return utils.ExitCodeValidation
+	}
+	return utils.ExitCodeError
+}
+
+// newError creates an error of the given kind which is not related to a response
+func newError(kind error, err error) error {
+	return &Error{Kind: kind, Err: err}
+}
+
+// wrapError classifies an error returned by the impl packages using the status code of the response it carries. Errors
+// which cannot be classified are returned as they are
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var kindErr *Error
+	if errors.As(err, &kindErr) {
+		return err
+	}
+	var respErr *utils.ResponseError
+	if !errors.As(err, &respErr) {
+		return err
+	}
+	var kind error
+	switch respErr.StatusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		kind = ErrValidation
+	case http.StatusUnauthorized, http.StatusForbidden:
+		kind = ErrUnauthorized
+	case http.StatusNotFound:
+		kind = ErrNotFound
+	case http.StatusConflict:
+		kind = ErrConflict
+	default:
+		return err
+	}
+	return &Error{Kind: kind, StatusCode: respErr.StatusCode, Err: err}
+}
@@ -0,0 +1,92 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+func TestWrapErrorOfResponse(t *testing.T) {
+	tests := []struct {
+		status int
+		kind   error
+	}{
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusInternalServerError, nil},
+	}
+	for _, test := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(test.status)
+		}))
+		_, _, err := impl.GetAPIList(context.Background(), "token", server.URL, "", "")
+		server.Close()
+
+		err = wrapError(err)
+		if err == nil {
+			t.Fatalf("Expected an error for status %d", test.status)
+		}
+		var respErr *utils.ResponseError
+		if !errors.As(err, &respErr) || respErr.StatusCode != test.status {
+			t.Errorf("Expected the response error of status %d to be wrapped, got %v", test.status, err)
+		}
+		for _, kind := range []error{ErrValidation, ErrUnauthorized, ErrNotFound, ErrConflict} {
+			if errors.Is(err, kind) != (kind == test.kind) {
+				t.Errorf("Unexpected errors.Is(%v) for status %d", kind, test.status)
+			}
+		}
+	}
+}
+
+func TestWrapErrorOfNotFoundAPI(t *testing.T) {
+	err := wrapError(fmt.Errorf("deleting API: %w", utils.NewNotFoundError("API not found")))
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+	var kindErr *Error
+	if !errors.As(err, &kindErr) || kindErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected an error with status %d, got %v", http.StatusNotFound, err)
+	}
+}
+
+func TestWrapErrorKeepsOtherErrors(t *testing.T) {
+	if wrapError(nil) != nil {
+		t.Error("Expected nil for a nil error")
+	}
+	err := errors.New("unable to connect")
+	if wrapError(err) != err {
+		t.Error("Expected an error without a response to be returned as it is")
+	}
+	kindErr := newError(ErrConflict, errors.New("environment exists"))
+	if wrapError(kindErr) != kindErr {
+		t.Error("Expected a classified error to be returned as it is")
+	}
+}
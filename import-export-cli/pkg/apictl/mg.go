@@ -0,0 +1,118 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	mgImpl "github.com/wso2/product-apim-tooling/import-export-cli/impl/mg"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// MGClient performs operations against a microgateway adapter environment added with 'apictl mg add env'
+type MGClient struct {
+	environment string
+	out         io.Writer
+}
+
+// DeployMGAPIOptions are the options of deploying an API to the microgateway
+type DeployMGAPIOptions struct {
+	// ParamsFile is a params file with the configurations of the microgateway environment
+	ParamsFile string
+	// Override updates the API if it is already deployed
+	Override bool
+	// SkipCleanup leaves the temporary files created during the deployment
+	SkipCleanup bool
+}
+
+// UndeployMGAPIOptions are the options of undeploying an API from the microgateway
+type UndeployMGAPIOptions struct {
+	// VHost of the API. The default vhost of the adapter is used if it is empty
+	VHost string
+	// GatewayEnvs to undeploy the API from. The API is undeployed from all gateway environments if it is empty
+	GatewayEnvs []string
+}
+
+// NewMGClient creates a client for the given microgateway adapter environment. An error of kind ErrNotFound is
+// returned if the environment has not been added
+func NewMGClient(environment string, opts ...Option) (*MGClient, error) {
+	if err := loadConfig(); err != nil {
+		return nil, err
+	}
+	mainConfig, err := utils.ReadMainConfig(utils.MainConfigFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := mainConfig.MgwAdapterEnvs[environment]; !ok {
+		return nil, newError(ErrNotFound, fmt.Errorf("microgateway environment '%s' does not exist", environment))
+	}
+	return &MGClient{environment: environment, out: newClientOptions(opts).out}, nil
+}
+
+// ListAPIs returns the APIs deployed in the microgateway which match the query. At most limit APIs are returned if
+// it is positive
+func (c *MGClient) ListAPIs(ctx context.Context, query string, limit int) ([]mgImpl.APIMetaListItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	queryParams := map[string]string{"query": query}
+	if limit > 0 {
+		queryParams["limit"] = strconv.Itoa(limit)
+	}
+	_, _, apis, err := mgImpl.GetAPIsList(ctx, c.environment, queryParams)
+	return apis, wrapError(err)
+}
+
+// DeployAPI deploys the API archive or project directory in the path to the microgateway. An error of kind
+// ErrConflict is returned if the API exists and opts.Override is not set
+func (c *MGClient) DeployAPI(ctx context.Context, path string, opts DeployMGAPIOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Deploying %s to microgateway environment '%s'...\n", path, c.environment)
+	err := mgImpl.DeployAPI(ctx, c.environment, path, opts.ParamsFile, map[string]string{}, opts.SkipCleanup,
+		opts.Override)
+	if err != nil {
+		return wrapError(err)
+	}
+	fmt.Fprintf(c.out, "Deployed %s to microgateway environment '%s'\n", path, c.environment)
+	return nil
+}
+
+// UndeployAPI undeploys the API with the given name and version from the microgateway
+func (c *MGClient) UndeployAPI(ctx context.Context, name, version string, opts UndeployMGAPIOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	queryParams := map[string]string{
+		"apiName": name,
+		"version": version,
+	}
+	if opts.VHost != "" {
+		queryParams["vhost"] = opts.VHost
+	}
+	if len(opts.GatewayEnvs) > 0 {
+		queryParams["environments"] = strings.Join(opts.GatewayEnvs, ":")
+	}
+	return wrapError(mgImpl.UndeployAPI(ctx, c.environment, queryParams))
+}
@@ -0,0 +1,121 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package apictl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
+	miImpl "github.com/wso2/product-apim-tooling/import-export-cli/mi/impl"
+	"github.com/wso2/product-apim-tooling/import-export-cli/mi/utils/artifactutils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+)
+
+// checkMI returns an error if the Micro Integrator of the environment cannot be called without prompting for
+// credentials
+func (c *Client) checkMI(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !utils.MIExistsInEnv(c.environment, utils.MainConfigFilePath) {
+		return newError(ErrNotFound, fmt.Errorf("environment '%s' does not have a Micro Integrator", c.environment))
+	}
+	store, err := credentials.GetDefaultCredentialStore()
+	if err != nil {
+		return err
+	}
+	if !store.HasMI(c.environment) {
+		return newError(ErrUnauthorized,
+			fmt.Errorf("not logged into Micro Integrator of environment '%s'", c.environment))
+	}
+	return nil
+}
+
+// ListMIAPIs returns the integration APIs deployed in the Micro Integrator of the environment
+func (c *Client) ListMIAPIs(ctx context.Context) (*artifactutils.IntegrationAPIList, error) {
+	if err := c.checkMI(ctx); err != nil {
+		return nil, err
+	}
+	list, err := miImpl.GetIntegrationAPIList(ctx, c.environment)
+	return list, wrapError(err)
+}
+
+// ListMIProxyServices returns the proxy services deployed in the Micro Integrator of the environment
+func (c *Client) ListMIProxyServices(ctx context.Context) (*artifactutils.ProxyServiceList, error) {
+	if err := c.checkMI(ctx); err != nil {
+		return nil, err
+	}
+	list, err := miImpl.GetProxyServiceList(ctx, c.environment)
+	return list, wrapError(err)
+}
+
+// ListMIEndpoints returns the endpoints deployed in the Micro Integrator of the environment
+func (c *Client) ListMIEndpoints(ctx context.Context) (*artifactutils.EndpointList, error) {
+	if err := c.checkMI(ctx); err != nil {
+		return nil, err
+	}
+	list, err := miImpl.GetEndpointList(ctx, c.environment)
+	return list, wrapError(err)
+}
+
+// ListMISequences returns the sequences deployed in the Micro Integrator of the environment
+func (c *Client) ListMISequences(ctx context.Context) (*artifactutils.SequenceList, error) {
+	if err := c.checkMI(ctx); err != nil {
+		return nil, err
+	}
+	list, err := miImpl.GetSequenceList(ctx, c.environment)
+	return list, wrapError(err)
+}
+
+// ListMIDataServices returns the data services deployed in the Micro Integrator of the environment
+func (c *Client) ListMIDataServices(ctx context.Context) (*artifactutils.DataServicesList, error) {
+	if err := c.checkMI(ctx); err != nil {
+		return nil, err
+	}
+	list, err := miImpl.GetDataServiceList(ctx, c.environment)
+	return list, wrapError(err)
+}
+
+// ListMICompositeApps returns the composite applications deployed in the Micro Integrator of the environment
+func (c *Client) ListMICompositeApps(ctx context.Context) (*artifactutils.CompositeAppList, error) {
+	if err := c.checkMI(ctx); err != nil {
+		return nil, err
+	}
+	list, err := miImpl.GetCompositeAppList(ctx, c.environment)
+	return list, wrapError(err)
+}
+
+// GetMIAPI returns the integration API with the given name
+func (c *Client) GetMIAPI(ctx context.Context, name string) (*artifactutils.IntegrationAPI, error) {
+	if err := c.checkMI(ctx); err != nil {
+		return nil, err
+	}
+	api, err := miImpl.GetIntegrationAPI(ctx, c.environment, name)
+	return api, wrapError(err)
+}
+
+// GetMIProxyService returns the proxy service with the given name
+func (c *Client) GetMIProxyService(ctx context.Context, name string) (*artifactutils.Proxy, error) {
+	if err := c.checkMI(ctx); err != nil {
+		return nil, err
+	}
+	proxy, err := miImpl.GetProxyService(ctx, c.environment, name)
+	return proxy, wrapError(err)
+}
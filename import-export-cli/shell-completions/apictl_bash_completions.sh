@@ -375,6 +375,22 @@ _apictl_add_env()
     two_word_flags+=("--apim")
     local_nonpersistent_flags+=("--apim")
     local_nonpersistent_flags+=("--apim=")
+    flags+=("--ca-cert=")
+    two_word_flags+=("--ca-cert")
+    local_nonpersistent_flags+=("--ca-cert")
+    local_nonpersistent_flags+=("--ca-cert=")
+    flags+=("--client-cert=")
+    two_word_flags+=("--client-cert")
+    local_nonpersistent_flags+=("--client-cert")
+    local_nonpersistent_flags+=("--client-cert=")
+    flags+=("--client-cert-password=")
+    two_word_flags+=("--client-cert-password")
+    local_nonpersistent_flags+=("--client-cert-password")
+    local_nonpersistent_flags+=("--client-cert-password=")
+    flags+=("--client-key=")
+    two_word_flags+=("--client-key")
+    local_nonpersistent_flags+=("--client-key")
+    local_nonpersistent_flags+=("--client-key=")
     flags+=("--devportal=")
     two_word_flags+=("--devportal")
     local_nonpersistent_flags+=("--devportal")
@@ -383,10 +399,26 @@ _apictl_add_env()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--insecure-skip-tls-verify")
+    local_nonpersistent_flags+=("--insecure-skip-tls-verify")
     flags+=("--mi=")
     two_word_flags+=("--mi")
     local_nonpersistent_flags+=("--mi")
     local_nonpersistent_flags+=("--mi=")
+    flags+=("--no-proxy=")
+    two_word_flags+=("--no-proxy")
+    local_nonpersistent_flags+=("--no-proxy")
+    local_nonpersistent_flags+=("--no-proxy=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--proxy=")
+    two_word_flags+=("--proxy")
+    local_nonpersistent_flags+=("--proxy")
+    local_nonpersistent_flags+=("--proxy=")
     flags+=("--publisher=")
     two_word_flags+=("--publisher")
     local_nonpersistent_flags+=("--publisher")
@@ -395,12 +427,24 @@ _apictl_add_env()
     two_word_flags+=("--registration")
     local_nonpersistent_flags+=("--registration")
     local_nonpersistent_flags+=("--registration=")
+    flags+=("--server-name=")
+    two_word_flags+=("--server-name")
+    local_nonpersistent_flags+=("--server-name")
+    local_nonpersistent_flags+=("--server-name=")
     flags+=("--token=")
     two_word_flags+=("--token")
     local_nonpersistent_flags+=("--token")
     local_nonpersistent_flags+=("--token=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -422,8 +466,16 @@ _apictl_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -452,8 +504,22 @@ _apictl_add()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -475,8 +541,16 @@ _apictl_aws_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -513,14 +587,28 @@ _apictl_aws_init()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--stage=")
     two_word_flags+=("--stage")
     two_word_flags+=("-s")
     local_nonpersistent_flags+=("--stage")
     local_nonpersistent_flags+=("--stage=")
     local_nonpersistent_flags+=("-s")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -552,8 +640,22 @@ _apictl_aws()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -585,14 +687,28 @@ _apictl_bundle()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--source=")
     two_word_flags+=("--source")
     two_word_flags+=("-s")
     local_nonpersistent_flags+=("--source")
     local_nonpersistent_flags+=("--source=")
     local_nonpersistent_flags+=("-s")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -638,6 +754,12 @@ _apictl_change-status_api()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
@@ -650,8 +772,16 @@ _apictl_change-status_api()
     local_nonpersistent_flags+=("--version")
     local_nonpersistent_flags+=("--version=")
     local_nonpersistent_flags+=("-v")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -703,14 +833,28 @@ _apictl_change-status_api-product()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -738,8 +882,16 @@ _apictl_change-status_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -769,8 +921,22 @@ _apictl_change-status()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -778,9 +944,9 @@ _apictl_change-status()
     noun_aliases=()
 }
 
-_apictl_delete_api()
+_apictl_config_current-context()
 {
-    last_command="apictl_delete_api"
+    last_command="apictl_config_current-context"
 
     command_aliases=()
 
@@ -792,52 +958,36 @@ _apictl_delete_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_api-product()
+_apictl_config_delete-context()
 {
-    last_command="apictl_delete_api-product"
+    last_command="apictl_config_delete-context"
 
     command_aliases=()
 
@@ -849,44 +999,36 @@ _apictl_delete_api-product()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_app()
+_apictl_config_get-contexts()
 {
-    last_command="apictl_delete_app"
+    last_command="apictl_config_get-contexts"
 
     command_aliases=()
 
@@ -898,44 +1040,50 @@ _apictl_delete_app()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    local_nonpersistent_flags+=("--filter")
+    local_nonpersistent_flags+=("--filter=")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
+    flags+=("--no-headers")
+    local_nonpersistent_flags+=("--no-headers")
+    flags+=("--output=")
+    two_word_flags+=("--output")
     two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
     local_nonpersistent_flags+=("-o")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    local_nonpersistent_flags+=("--sort-by")
+    local_nonpersistent_flags+=("--sort-by=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_delete_help()
+_apictl_config_help()
 {
-    last_command="apictl_delete_help"
+    last_command="apictl_config_help"
 
     command_aliases=()
 
@@ -947,8 +1095,16 @@ _apictl_delete_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -957,17 +1113,13 @@ _apictl_delete_help()
     noun_aliases=()
 }
 
-_apictl_delete()
+_apictl_config_set-context()
 {
-    last_command="apictl_delete"
+    last_command="apictl_config_set-context"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("app")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -975,12 +1127,46 @@ _apictl_delete()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--apim=")
+    two_word_flags+=("--apim")
+    local_nonpersistent_flags+=("--apim")
+    local_nonpersistent_flags+=("--apim=")
+    flags+=("--default-output=")
+    two_word_flags+=("--default-output")
+    local_nonpersistent_flags+=("--default-output")
+    local_nonpersistent_flags+=("--default-output=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--mg-cluster=")
+    two_word_flags+=("--mg-cluster")
+    local_nonpersistent_flags+=("--mg-cluster")
+    local_nonpersistent_flags+=("--mg-cluster=")
+    flags+=("--mi=")
+    two_word_flags+=("--mi")
+    local_nonpersistent_flags+=("--mi")
+    local_nonpersistent_flags+=("--mi=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--tenant=")
+    two_word_flags+=("--tenant")
+    local_nonpersistent_flags+=("--tenant")
+    local_nonpersistent_flags+=("--tenant=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -988,9 +1174,9 @@ _apictl_delete()
     noun_aliases=()
 }
 
-_apictl_export_api()
+_apictl_config_use-context()
 {
-    last_command="apictl_export_api"
+    last_command="apictl_config_use-context"
 
     command_aliases=()
 
@@ -1002,64 +1188,36 @@ _apictl_export_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--latest")
-    local_nonpersistent_flags+=("--latest")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--rev=")
-    two_word_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev=")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_api-product()
+_apictl_config_view()
 {
-    last_command="apictl_export_api-product"
+    last_command="apictl_config_view"
 
     command_aliases=()
 
@@ -1071,12 +1229,10 @@ _apictl_export_api-product()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    local_nonpersistent_flags+=("--filter")
+    local_nonpersistent_flags+=("--filter=")
     flags+=("--format=")
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
@@ -1085,42 +1241,88 @@ _apictl_export_api-product()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--latest")
-    local_nonpersistent_flags+=("--latest")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--rev=")
-    two_word_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev")
-    local_nonpersistent_flags+=("--rev=")
+    flags+=("--no-headers")
+    local_nonpersistent_flags+=("--no-headers")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--resolved")
+    local_nonpersistent_flags+=("--resolved")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    local_nonpersistent_flags+=("--sort-by")
+    local_nonpersistent_flags+=("--sort-by=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_apis()
+_apictl_config()
 {
-    last_command="apictl_export_apis"
+    last_command="apictl_config"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("current-context")
+    commands+=("delete-context")
+    commands+=("get-contexts")
+    commands+=("help")
+    commands+=("set-context")
+    commands+=("use-context")
+    commands+=("view")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_delete_api()
+{
+    last_command="apictl_delete_api"
 
     command_aliases=()
 
@@ -1132,39 +1334,66 @@ _apictl_export_apis()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--all")
-    local_nonpersistent_flags+=("--all")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--force")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--preserve-status")
-    local_nonpersistent_flags+=("--preserve-status")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_app()
+_apictl_delete_api-product()
 {
-    last_command="apictl_export_app"
+    last_command="apictl_delete_api-product"
 
     command_aliases=()
 
@@ -1182,10 +1411,6 @@ _apictl_export_app()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
@@ -1196,16 +1421,28 @@ _apictl_export_app()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
+    flags+=("--output=")
+    two_word_flags+=("--output")
     two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
     local_nonpersistent_flags+=("-o")
-    flags+=("--with-keys")
-    local_nonpersistent_flags+=("--with-keys")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -1213,15 +1450,13 @@ _apictl_export_app()
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--owner=")
-    must_have_one_flag+=("-o")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_export_help()
+_apictl_delete_app()
 {
-    last_command="apictl_export_help"
+    last_command="apictl_delete_app"
 
     command_aliases=()
 
@@ -1233,28 +1468,60 @@ _apictl_export_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_export()
+_apictl_delete_help()
 {
-    last_command="apictl_export"
+    last_command="apictl_delete_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("apis")
-    commands+=("app")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1262,26 +1529,35 @@ _apictl_export()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_gen_deployment-dir()
+_apictl_delete()
 {
-    last_command="apictl_gen_deployment-dir"
+    last_command="apictl_delete"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1289,36 +1565,36 @@ _apictl_gen_deployment-dir()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_gen_help()
+_apictl_env_check()
 {
-    last_command="apictl_gen_help"
+    last_command="apictl_env_check"
 
     command_aliases=()
 
@@ -1330,25 +1606,66 @@ _apictl_gen_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    local_nonpersistent_flags+=("--filter")
+    local_nonpersistent_flags+=("--filter=")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--mg-cluster=")
+    two_word_flags+=("--mg-cluster")
+    local_nonpersistent_flags+=("--mg-cluster")
+    local_nonpersistent_flags+=("--mg-cluster=")
+    flags+=("--no-headers")
+    local_nonpersistent_flags+=("--no-headers")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    local_nonpersistent_flags+=("--sort-by")
+    local_nonpersistent_flags+=("--sort-by=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_gen()
+_apictl_env_help()
 {
-    last_command="apictl_gen"
+    last_command="apictl_env_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("deployment-dir")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1356,26 +1673,33 @@ _apictl_gen()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_get_api-logging()
+_apictl_env()
 {
-    last_command="apictl_get_api-logging"
+    last_command="apictl_env"
 
     command_aliases=()
 
     commands=()
+    commands+=("check")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1383,44 +1707,36 @@ _apictl_get_api-logging()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--api-id=")
-    two_word_flags+=("--api-id")
-    two_word_flags+=("-i")
-    local_nonpersistent_flags+=("--api-id")
-    local_nonpersistent_flags+=("--api-id=")
-    local_nonpersistent_flags+=("-i")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--tenant-domain=")
-    two_word_flags+=("--tenant-domain")
-    local_nonpersistent_flags+=("--tenant-domain")
-    local_nonpersistent_flags+=("--tenant-domain=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_api-product-revisions()
+_apictl_export_api()
 {
-    last_command="apictl_get_api-product-revisions"
+    last_command="apictl_export_api"
 
     command_aliases=()
 
@@ -1446,26 +1762,48 @@ _apictl_get_api-product-revisions()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--latest")
+    local_nonpersistent_flags+=("--latest")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -1473,64 +1811,15 @@ _apictl_get_api-product-revisions()
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_api-products()
-{
-    last_command="apictl_get_api-products"
-
-    command_aliases=()
-
-    commands=()
-
-    flags=()
-    two_word_flags=()
-    local_nonpersistent_flags=()
-    flags_with_completion=()
-    flags_completion=()
-
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
-    flags+=("--insecure")
-    flags+=("-k")
-    flags+=("--verbose")
-
-    must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_noun=()
-    noun_aliases=()
-}
-
-_apictl_get_api-revisions()
+_apictl_export_api-product()
 {
-    last_command="apictl_get_api-revisions"
+    last_command="apictl_export_api-product"
 
     command_aliases=()
 
@@ -1556,32 +1845,42 @@ _apictl_get_api-revisions()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--latest")
+    local_nonpersistent_flags+=("--latest")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
     local_nonpersistent_flags+=("--provider")
     local_nonpersistent_flags+=("--provider=")
     local_nonpersistent_flags+=("-r")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -1589,15 +1888,13 @@ _apictl_get_api-revisions()
     must_have_one_flag+=("-e")
     must_have_one_flag+=("--name=")
     must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_apis()
+_apictl_export_apis()
 {
-    last_command="apictl_get_apis"
+    last_command="apictl_export_apis"
 
     command_aliases=()
 
@@ -1609,12 +1906,15 @@ _apictl_get_apis()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--all")
+    local_nonpersistent_flags+=("--all")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--force")
     flags+=("--format=")
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
@@ -1623,20 +1923,24 @@ _apictl_get_apis()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--preserve-status")
+    local_nonpersistent_flags+=("--preserve-status")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -1646,9 +1950,9 @@ _apictl_get_apis()
     noun_aliases=()
 }
 
-_apictl_get_apps()
+_apictl_export_app()
 {
-    last_command="apictl_get_apps"
+    last_command="apictl_export_app"
 
     command_aliases=()
 
@@ -1674,32 +1978,50 @@ _apictl_get_apps()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
     flags+=("--owner=")
     two_word_flags+=("--owner")
     two_word_flags+=("-o")
     local_nonpersistent_flags+=("--owner")
     local_nonpersistent_flags+=("--owner=")
     local_nonpersistent_flags+=("-o")
+    flags+=("--with-keys")
+    local_nonpersistent_flags+=("--with-keys")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--owner=")
+    must_have_one_flag+=("-o")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get_envs()
+_apictl_export_help()
 {
-    last_command="apictl_get_envs"
+    last_command="apictl_export_help"
 
     command_aliases=()
 
@@ -1711,30 +2033,36 @@ _apictl_get_envs()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_get_help()
+_apictl_export()
 {
-    last_command="apictl_get_help"
+    last_command="apictl_export"
 
     command_aliases=()
 
     commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("apis")
+    commands+=("app")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -1742,19 +2070,36 @@ _apictl_get_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_get_keys()
+_apictl_gen_deployment-dir()
 {
-    last_command="apictl_get_keys"
+    last_command="apictl_gen_deployment-dir"
 
     command_aliases=()
 
@@ -1766,68 +2111,744 @@ _apictl_get_keys()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--provider=")
-    two_word_flags+=("--provider")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--provider")
-    local_nonpersistent_flags+=("--provider=")
-    local_nonpersistent_flags+=("-r")
-    flags+=("--token=")
-    two_word_flags+=("--token")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--token")
-    local_nonpersistent_flags+=("--token=")
-    local_nonpersistent_flags+=("-t")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_get()
+_apictl_gen_help()
 {
-    last_command="apictl_get"
+    last_command="apictl_gen_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("api-logging")
-    commands+=("api-product-revisions")
-    commands+=("api-products")
-    commands+=("api-revisions")
-    commands+=("apis")
-    commands+=("apps")
-    commands+=("envs")
-    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_gen()
+{
+    last_command="apictl_gen"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("deployment-dir")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-logging()
+{
+    last_command="apictl_get_api-logging"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--api-id=")
+    two_word_flags+=("--api-id")
+    two_word_flags+=("-i")
+    local_nonpersistent_flags+=("--api-id")
+    local_nonpersistent_flags+=("--api-id=")
+    local_nonpersistent_flags+=("-i")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--tenant-domain=")
+    two_word_flags+=("--tenant-domain")
+    local_nonpersistent_flags+=("--tenant-domain")
+    local_nonpersistent_flags+=("--tenant-domain=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-product-revisions()
+{
+    last_command="apictl_get_api-product-revisions"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-products()
+{
+    last_command="apictl_get_api-products"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_api-revisions()
+{
+    last_command="apictl_get_api-revisions"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_apis()
+{
+    last_command="apictl_get_apis"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_apps()
+{
+    last_command="apictl_get_apps"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_envs()
+{
+    last_command="apictl_get_envs"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get_help()
+{
+    last_command="apictl_get_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_get_keys()
+{
+    last_command="apictl_get_keys"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--token=")
+    two_word_flags+=("--token")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--token")
+    local_nonpersistent_flags+=("--token=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_get()
+{
+    last_command="apictl_get"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api-logging")
+    commands+=("api-product-revisions")
+    commands+=("api-products")
+    commands+=("api-revisions")
+    commands+=("apis")
+    commands+=("apps")
+    commands+=("envs")
+    commands+=("help")
     commands+=("keys")
 
     flags=()
@@ -1836,12 +2857,1960 @@ _apictl_get()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--no-headers")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_help()
+{
+    last_command="apictl_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import_api()
+{
+    last_command="apictl_import_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--rotate-revision")
+    local_nonpersistent_flags+=("--rotate-revision")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-deployments")
+    local_nonpersistent_flags+=("--skip-deployments")
+    flags+=("--update")
+    local_nonpersistent_flags+=("--update")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_api-product()
+{
+    last_command="apictl_import_api-product"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--import-apis")
+    local_nonpersistent_flags+=("--import-apis")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--preserve-provider")
+    local_nonpersistent_flags+=("--preserve-provider")
+    flags+=("--rotate-revision")
+    local_nonpersistent_flags+=("--rotate-revision")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-deployments")
+    local_nonpersistent_flags+=("--skip-deployments")
+    flags+=("--update-api-product")
+    local_nonpersistent_flags+=("--update-api-product")
+    flags+=("--update-apis")
+    local_nonpersistent_flags+=("--update-apis")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_app()
+{
+    last_command="apictl_import_app"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    flags+=("--owner=")
+    two_word_flags+=("--owner")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--owner")
+    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--preserve-owner")
+    local_nonpersistent_flags+=("--preserve-owner")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--skip-keys")
+    local_nonpersistent_flags+=("--skip-keys")
+    flags+=("--skip-subscriptions")
+    flags+=("-s")
+    local_nonpersistent_flags+=("--skip-subscriptions")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--update")
+    local_nonpersistent_flags+=("--update")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_import_help()
+{
+    last_command="apictl_import_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_import()
+{
+    last_command="apictl_import"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("api-product")
+    commands+=("app")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_init()
+{
+    last_command="apictl_init"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--definition=")
+    two_word_flags+=("--definition")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--definition")
+    local_nonpersistent_flags+=("--definition=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--force")
+    flags+=("-f")
+    local_nonpersistent_flags+=("--force")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--initial-state=")
+    two_word_flags+=("--initial-state")
+    local_nonpersistent_flags+=("--initial-state")
+    local_nonpersistent_flags+=("--initial-state=")
+    flags+=("--oas=")
+    two_word_flags+=("--oas")
+    local_nonpersistent_flags+=("--oas")
+    local_nonpersistent_flags+=("--oas=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_add_api()
+{
+    last_command="apictl_k8s_add_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_add_help()
+{
+    last_command="apictl_k8s_add_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_add()
+{
+    last_command="apictl_k8s_add"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_change_help()
+{
+    last_command="apictl_k8s_change_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_change_registry()
+{
+    last_command="apictl_k8s_change_registry"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--key-file=")
+    two_word_flags+=("--key-file")
+    two_word_flags+=("-c")
+    local_nonpersistent_flags+=("--key-file")
+    local_nonpersistent_flags+=("--key-file=")
+    local_nonpersistent_flags+=("-c")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--registry-type=")
+    two_word_flags+=("--registry-type")
+    two_word_flags+=("-R")
+    local_nonpersistent_flags+=("--registry-type")
+    local_nonpersistent_flags+=("--registry-type=")
+    local_nonpersistent_flags+=("-R")
+    flags+=("--repository=")
+    two_word_flags+=("--repository")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--repository")
+    local_nonpersistent_flags+=("--repository=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_change()
+{
+    last_command="apictl_k8s_change"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+    commands+=("registry")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_delete_api()
+{
+    last_command="apictl_k8s_delete_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_delete_help()
+{
+    last_command="apictl_k8s_delete_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_delete()
+{
+    last_command="apictl_k8s_delete"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_describe_api()
+{
+    last_command="apictl_k8s_describe_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_describe_help()
+{
+    last_command="apictl_k8s_describe_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_describe()
+{
+    last_command="apictl_k8s_describe"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_gen_deployment-dir()
+{
+    last_command="apictl_k8s_gen_deployment-dir"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--destination=")
+    two_word_flags+=("--destination")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--destination")
+    local_nonpersistent_flags+=("--destination=")
+    local_nonpersistent_flags+=("-d")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    two_word_flags+=("-s")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    local_nonpersistent_flags+=("-s")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--source=")
+    must_have_one_flag+=("-s")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_gen_help()
+{
+    last_command="apictl_k8s_gen_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_gen_manifests()
+{
+    last_command="apictl_k8s_gen_manifests"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--certs=")
+    two_word_flags+=("--certs")
+    local_nonpersistent_flags+=("--certs")
+    local_nonpersistent_flags+=("--certs=")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--kustomize")
+    local_nonpersistent_flags+=("--kustomize")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_gen()
+{
+    last_command="apictl_k8s_gen"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("deployment-dir")
+    commands+=("help")
+    commands+=("manifests")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_get_apis()
+{
+    last_command="apictl_k8s_get_apis"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--all-namespaces")
+    flags+=("-A")
+    local_nonpersistent_flags+=("--all-namespaces")
+    local_nonpersistent_flags+=("-A")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_get_help()
+{
+    last_command="apictl_k8s_get_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_get()
+{
+    last_command="apictl_k8s_get"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("apis")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_help()
+{
+    last_command="apictl_k8s_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_install_api-operator()
+{
+    last_command="apictl_k8s_install_api-operator"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--bundle=")
+    two_word_flags+=("--bundle")
+    two_word_flags+=("-b")
+    local_nonpersistent_flags+=("--bundle")
+    local_nonpersistent_flags+=("--bundle=")
+    local_nonpersistent_flags+=("-b")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--key-file=")
+    two_word_flags+=("--key-file")
+    two_word_flags+=("-c")
+    local_nonpersistent_flags+=("--key-file")
+    local_nonpersistent_flags+=("--key-file=")
+    local_nonpersistent_flags+=("-c")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--registry-type=")
+    two_word_flags+=("--registry-type")
+    two_word_flags+=("-R")
+    local_nonpersistent_flags+=("--registry-type")
+    local_nonpersistent_flags+=("--registry-type=")
+    local_nonpersistent_flags+=("-R")
+    flags+=("--repository=")
+    two_word_flags+=("--repository")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--repository")
+    local_nonpersistent_flags+=("--repository=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--dry-run")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_install_help()
+{
+    last_command="apictl_k8s_install_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--dry-run")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_install_wso2am-operator()
+{
+    last_command="apictl_k8s_install_wso2am-operator"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--bundle=")
+    two_word_flags+=("--bundle")
+    two_word_flags+=("-b")
+    local_nonpersistent_flags+=("--bundle")
+    local_nonpersistent_flags+=("--bundle=")
+    local_nonpersistent_flags+=("-b")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--dry-run")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_install()
+{
+    last_command="apictl_k8s_install"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api-operator")
+    commands+=("help")
+    commands+=("wso2am-operator")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--dry-run")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_uninstall_api-operator()
+{
+    last_command="apictl_k8s_uninstall_api-operator"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--force")
+    local_nonpersistent_flags+=("--force")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_uninstall_help()
+{
+    last_command="apictl_k8s_uninstall_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_uninstall_wso2am-operator()
+{
+    last_command="apictl_k8s_uninstall_wso2am-operator"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--force")
+    local_nonpersistent_flags+=("--force")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_uninstall()
+{
+    last_command="apictl_k8s_uninstall"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api-operator")
+    commands+=("help")
+    commands+=("wso2am-operator")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_update_api()
+{
+    last_command="apictl_k8s_update_api"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--namespace=")
+    two_word_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace")
+    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_k8s_update_help()
+{
+    last_command="apictl_k8s_update_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_k8s_update()
+{
+    last_command="apictl_k8s_update"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("api")
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -1849,9 +4818,9 @@ _apictl_get()
     noun_aliases=()
 }
 
-_apictl_help()
+_apictl_k8s_upgrade_api-operator()
 {
-    last_command="apictl_help"
+    last_command="apictl_k8s_upgrade_api-operator"
 
     command_aliases=()
 
@@ -1863,19 +4832,47 @@ _apictl_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--bundle=")
+    two_word_flags+=("--bundle")
+    two_word_flags+=("-b")
+    local_nonpersistent_flags+=("--bundle")
+    local_nonpersistent_flags+=("--bundle=")
+    local_nonpersistent_flags+=("-b")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--dry-run")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import_api()
+_apictl_k8s_upgrade_help()
 {
-    last_command="apictl_import_api"
+    last_command="apictl_k8s_upgrade_help"
 
     command_aliases=()
 
@@ -1887,52 +4884,32 @@ _apictl_import_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--params=")
-    two_word_flags+=("--params")
-    local_nonpersistent_flags+=("--params")
-    local_nonpersistent_flags+=("--params=")
-    flags+=("--preserve-provider")
-    local_nonpersistent_flags+=("--preserve-provider")
-    flags+=("--rotate-revision")
-    local_nonpersistent_flags+=("--rotate-revision")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-deployments")
-    local_nonpersistent_flags+=("--skip-deployments")
-    flags+=("--update")
-    local_nonpersistent_flags+=("--update")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--dry-run")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import_api-product()
+_apictl_k8s_upgrade_wso2am-operator()
 {
-    last_command="apictl_import_api-product"
+    last_command="apictl_k8s_upgrade_wso2am-operator"
 
     command_aliases=()
 
@@ -1944,60 +4921,54 @@ _apictl_import_api-product()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--bundle=")
+    two_word_flags+=("--bundle")
+    two_word_flags+=("-b")
+    local_nonpersistent_flags+=("--bundle")
+    local_nonpersistent_flags+=("--bundle=")
+    local_nonpersistent_flags+=("-b")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--import-apis")
-    local_nonpersistent_flags+=("--import-apis")
-    flags+=("--params=")
-    two_word_flags+=("--params")
-    local_nonpersistent_flags+=("--params")
-    local_nonpersistent_flags+=("--params=")
-    flags+=("--preserve-provider")
-    local_nonpersistent_flags+=("--preserve-provider")
-    flags+=("--rotate-revision")
-    local_nonpersistent_flags+=("--rotate-revision")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-deployments")
-    local_nonpersistent_flags+=("--skip-deployments")
-    flags+=("--update-api-product")
-    local_nonpersistent_flags+=("--update-api-product")
-    flags+=("--update-apis")
-    local_nonpersistent_flags+=("--update-apis")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--dry-run")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_app()
+_apictl_k8s_upgrade()
 {
-    last_command="apictl_import_app"
+    last_command="apictl_k8s_upgrade"
 
     command_aliases=()
 
     commands=()
+    commands+=("api-operator")
+    commands+=("help")
+    commands+=("wso2am-operator")
 
     flags=()
     two_word_flags=()
@@ -2005,60 +4976,56 @@ _apictl_import_app()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--dry-run")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--owner=")
-    two_word_flags+=("--owner")
+    flags+=("--output=")
+    two_word_flags+=("--output")
     two_word_flags+=("-o")
-    local_nonpersistent_flags+=("--owner")
-    local_nonpersistent_flags+=("--owner=")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
     local_nonpersistent_flags+=("-o")
-    flags+=("--preserve-owner")
-    local_nonpersistent_flags+=("--preserve-owner")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
-    flags+=("--skip-keys")
-    local_nonpersistent_flags+=("--skip-keys")
-    flags+=("--skip-subscriptions")
-    flags+=("-s")
-    local_nonpersistent_flags+=("--skip-subscriptions")
-    local_nonpersistent_flags+=("-s")
-    flags+=("--update")
-    local_nonpersistent_flags+=("--update")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_import_help()
+_apictl_k8s()
 {
-    last_command="apictl_import_help"
+    last_command="apictl_k8s"
 
     command_aliases=()
 
     commands=()
+    commands+=("add")
+    commands+=("change")
+    commands+=("delete")
+    commands+=("describe")
+    commands+=("gen")
+    commands+=("get")
+    commands+=("help")
+    commands+=("install")
+    commands+=("uninstall")
+    commands+=("update")
+    commands+=("upgrade")
 
     flags=()
     two_word_flags=()
@@ -2066,27 +5033,44 @@ _apictl_import_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--kube-context=")
+    two_word_flags+=("--kube-context")
+    flags+=("--kubeconfig=")
+    two_word_flags+=("--kubeconfig")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_import()
+_apictl_login()
 {
-    last_command="apictl_import"
+    last_command="apictl_login"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("api-product")
-    commands+=("app")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2098,8 +5082,36 @@ _apictl_import()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2107,9 +5119,9 @@ _apictl_import()
     noun_aliases=()
 }
 
-_apictl_init()
+_apictl_logout()
 {
-    last_command="apictl_init"
+    last_command="apictl_logout"
 
     command_aliases=()
 
@@ -2121,30 +5133,26 @@ _apictl_init()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--definition=")
-    two_word_flags+=("--definition")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--definition")
-    local_nonpersistent_flags+=("--definition=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--force")
-    flags+=("-f")
-    local_nonpersistent_flags+=("--force")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--initial-state=")
-    two_word_flags+=("--initial-state")
-    local_nonpersistent_flags+=("--initial-state")
-    local_nonpersistent_flags+=("--initial-state=")
-    flags+=("--oas=")
-    two_word_flags+=("--oas")
-    local_nonpersistent_flags+=("--oas")
-    local_nonpersistent_flags+=("--oas=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2152,9 +5160,9 @@ _apictl_init()
     noun_aliases=()
 }
 
-_apictl_k8s_add_api()
+_apictl_mg_add_env()
 {
-    last_command="apictl_k8s_add_api"
+    last_command="apictl_mg_add_env"
 
     command_aliases=()
 
@@ -2166,42 +5174,74 @@ _apictl_k8s_add_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--adapter=")
+    two_word_flags+=("--adapter")
+    two_word_flags+=("-a")
+    local_nonpersistent_flags+=("--adapter")
+    local_nonpersistent_flags+=("--adapter=")
+    local_nonpersistent_flags+=("-a")
+    flags+=("--ca-cert=")
+    two_word_flags+=("--ca-cert")
+    local_nonpersistent_flags+=("--ca-cert")
+    local_nonpersistent_flags+=("--ca-cert=")
+    flags+=("--client-cert=")
+    two_word_flags+=("--client-cert")
+    local_nonpersistent_flags+=("--client-cert")
+    local_nonpersistent_flags+=("--client-cert=")
+    flags+=("--client-cert-password=")
+    two_word_flags+=("--client-cert-password")
+    local_nonpersistent_flags+=("--client-cert-password")
+    local_nonpersistent_flags+=("--client-cert-password=")
+    flags+=("--client-key=")
+    two_word_flags+=("--client-key")
+    local_nonpersistent_flags+=("--client-key")
+    local_nonpersistent_flags+=("--client-key=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--namespace=")
-    two_word_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--insecure-skip-tls-verify")
+    local_nonpersistent_flags+=("--insecure-skip-tls-verify")
+    flags+=("--no-proxy=")
+    two_word_flags+=("--no-proxy")
+    local_nonpersistent_flags+=("--no-proxy")
+    local_nonpersistent_flags+=("--no-proxy=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--proxy=")
+    two_word_flags+=("--proxy")
+    local_nonpersistent_flags+=("--proxy")
+    local_nonpersistent_flags+=("--proxy=")
+    flags+=("--server-name=")
+    two_word_flags+=("--server-name")
+    local_nonpersistent_flags+=("--server-name")
+    local_nonpersistent_flags+=("--server-name=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--adapter=")
+    must_have_one_flag+=("-a")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_add_help()
+_apictl_mg_add_help()
 {
-    last_command="apictl_k8s_add_help"
+    last_command="apictl_mg_add_help"
 
     command_aliases=()
 
@@ -2213,8 +5253,16 @@ _apictl_k8s_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2223,14 +5271,14 @@ _apictl_k8s_add_help()
     noun_aliases=()
 }
 
-_apictl_k8s_add()
+_apictl_mg_add()
 {
-    last_command="apictl_k8s_add"
+    last_command="apictl_mg_add"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
+    commands+=("env")
     commands+=("help")
 
     flags=()
@@ -2243,8 +5291,22 @@ _apictl_k8s_add()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2252,9 +5314,9 @@ _apictl_k8s_add()
     noun_aliases=()
 }
 
-_apictl_k8s_delete_api()
+_apictl_mg_deploy_api()
 {
-    last_command="apictl_k8s_delete_api"
+    last_command="apictl_mg_deploy_api"
 
     command_aliases=()
 
@@ -2266,30 +5328,104 @@ _apictl_k8s_delete_api()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--from-env=")
+    two_word_flags+=("--from-env")
+    local_nonpersistent_flags+=("--from-env")
+    local_nonpersistent_flags+=("--from-env=")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    two_word_flags+=("-g")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--label=")
+    two_word_flags+=("--label")
+    local_nonpersistent_flags+=("--label")
+    local_nonpersistent_flags+=("--label=")
     flags+=("--name=")
     two_word_flags+=("--name")
     two_word_flags+=("-n")
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    flags+=("--override")
+    flags+=("-o")
+    local_nonpersistent_flags+=("--override")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--provider=")
+    two_word_flags+=("--provider")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--provider")
+    local_nonpersistent_flags+=("--provider=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--rev=")
+    two_word_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev")
+    local_nonpersistent_flags+=("--rev=")
+    flags+=("--skip-cleanup")
+    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--tag=")
+    two_word_flags+=("--tag")
+    local_nonpersistent_flags+=("--tag")
+    local_nonpersistent_flags+=("--tag=")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_delete_help()
+_apictl_mg_deploy_help()
 {
-    last_command="apictl_k8s_delete_help"
+    last_command="apictl_mg_deploy_help"
 
     command_aliases=()
 
@@ -2301,8 +5437,16 @@ _apictl_k8s_delete_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2311,9 +5455,9 @@ _apictl_k8s_delete_help()
     noun_aliases=()
 }
 
-_apictl_k8s_delete()
+_apictl_mg_deploy()
 {
-    last_command="apictl_k8s_delete"
+    last_command="apictl_mg_deploy"
 
     command_aliases=()
 
@@ -2331,8 +5475,22 @@ _apictl_k8s_delete()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2340,9 +5498,9 @@ _apictl_k8s_delete()
     noun_aliases=()
 }
 
-_apictl_k8s_gen_deployment-dir()
+_apictl_mg_get_apis()
 {
-    last_command="apictl_k8s_gen_deployment-dir"
+    last_command="apictl_mg_get_apis"
 
     command_aliases=()
 
@@ -2354,36 +5512,115 @@ _apictl_k8s_gen_deployment-dir()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--destination=")
-    two_word_flags+=("--destination")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--destination")
-    local_nonpersistent_flags+=("--destination=")
-    local_nonpersistent_flags+=("-d")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--source=")
-    two_word_flags+=("--source")
-    two_word_flags+=("-s")
-    local_nonpersistent_flags+=("--source")
-    local_nonpersistent_flags+=("--source=")
-    local_nonpersistent_flags+=("-s")
+    flags+=("--limit=")
+    two_word_flags+=("--limit")
+    two_word_flags+=("-l")
+    local_nonpersistent_flags+=("--limit")
+    local_nonpersistent_flags+=("--limit=")
+    local_nonpersistent_flags+=("-l")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--query=")
+    two_word_flags+=("--query")
+    two_word_flags+=("-q")
+    local_nonpersistent_flags+=("--query")
+    local_nonpersistent_flags+=("--query=")
+    local_nonpersistent_flags+=("-q")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--source=")
-    must_have_one_flag+=("-s")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_gen_help()
+_apictl_mg_get_envs()
 {
-    last_command="apictl_k8s_gen_help"
+    last_command="apictl_mg_get_envs"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mg_get_help()
+{
+    last_command="apictl_mg_get_help"
 
     command_aliases=()
 
@@ -2395,8 +5632,21 @@ _apictl_k8s_gen_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2405,14 +5655,15 @@ _apictl_k8s_gen_help()
     noun_aliases=()
 }
 
-_apictl_k8s_gen()
+_apictl_mg_get()
 {
-    last_command="apictl_k8s_gen"
+    last_command="apictl_mg_get"
 
     command_aliases=()
 
     commands=()
-    commands+=("deployment-dir")
+    commands+=("apis")
+    commands+=("envs")
     commands+=("help")
 
     flags=()
@@ -2421,12 +5672,31 @@ _apictl_k8s_gen()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--no-headers")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2434,9 +5704,9 @@ _apictl_k8s_gen()
     noun_aliases=()
 }
 
-_apictl_k8s_help()
+_apictl_mg_help()
 {
-    last_command="apictl_k8s_help"
+    last_command="apictl_mg_help"
 
     command_aliases=()
 
@@ -2448,8 +5718,16 @@ _apictl_k8s_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2458,9 +5736,9 @@ _apictl_k8s_help()
     noun_aliases=()
 }
 
-_apictl_k8s_update_api()
+_apictl_mg_login()
 {
-    last_command="apictl_k8s_update_api"
+    last_command="apictl_mg_login"
 
     command_aliases=()
 
@@ -2472,42 +5750,56 @@ _apictl_k8s_update_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
+    flags+=("--all")
+    local_nonpersistent_flags+=("--all")
+    flags+=("--credentials-file=")
+    two_word_flags+=("--credentials-file")
+    local_nonpersistent_flags+=("--credentials-file")
+    local_nonpersistent_flags+=("--credentials-file=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--namespace=")
-    two_word_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace")
-    local_nonpersistent_flags+=("--namespace=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_k8s_update_help()
+_apictl_mg_logout()
 {
-    last_command="apictl_k8s_update_help"
+    last_command="apictl_mg_logout"
 
     command_aliases=()
 
@@ -2519,25 +5811,40 @@ _apictl_k8s_update_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_k8s_update()
+_apictl_mg_remove_env()
 {
-    last_command="apictl_k8s_update"
+    last_command="apictl_mg_remove_env"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2549,8 +5856,22 @@ _apictl_k8s_update()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2558,18 +5879,13 @@ _apictl_k8s_update()
     noun_aliases=()
 }
 
-_apictl_k8s()
+_apictl_mg_remove_help()
 {
-    last_command="apictl_k8s"
+    last_command="apictl_mg_remove_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("add")
-    commands+=("delete")
-    commands+=("gen")
-    commands+=("help")
-    commands+=("update")
 
     flags=()
     two_word_flags=()
@@ -2577,26 +5893,33 @@ _apictl_k8s()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_login()
+_apictl_mg_remove()
 {
-    last_command="apictl_login"
+    last_command="apictl_mg_remove"
 
     command_aliases=()
 
     commands=()
+    commands+=("env")
+    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2608,22 +5931,22 @@ _apictl_login()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2631,9 +5954,9 @@ _apictl_login()
     noun_aliases=()
 }
 
-_apictl_logout()
+_apictl_mg_sync()
 {
-    last_command="apictl_logout"
+    last_command="apictl_mg_sync"
 
     command_aliases=()
 
@@ -2645,22 +5968,57 @@ _apictl_logout()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--params=")
+    two_word_flags+=("--params")
+    local_nonpersistent_flags+=("--params")
+    local_nonpersistent_flags+=("--params=")
+    flags+=("--source=")
+    two_word_flags+=("--source")
+    local_nonpersistent_flags+=("--source")
+    local_nonpersistent_flags+=("--source=")
+    flags+=("--undeploy-removed")
+    local_nonpersistent_flags+=("--undeploy-removed")
+    flags+=("--vcs")
+    local_nonpersistent_flags+=("--vcs")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--source=")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_add_env()
+_apictl_mg_undeploy_api()
 {
-    last_command="apictl_mg_add_env"
+    last_command="apictl_mg_undeploy_api"
 
     command_aliases=()
 
@@ -2672,30 +6030,72 @@ _apictl_mg_add_env()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--adapter=")
-    two_word_flags+=("--adapter")
-    two_word_flags+=("-a")
-    local_nonpersistent_flags+=("--adapter")
-    local_nonpersistent_flags+=("--adapter=")
-    local_nonpersistent_flags+=("-a")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--gateway-env=")
+    two_word_flags+=("--gateway-env")
+    two_word_flags+=("-g")
+    local_nonpersistent_flags+=("--gateway-env")
+    local_nonpersistent_flags+=("--gateway-env=")
+    local_nonpersistent_flags+=("-g")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--name=")
+    two_word_flags+=("--name")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--name")
+    local_nonpersistent_flags+=("--name=")
+    local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--version=")
+    two_word_flags+=("--version")
+    two_word_flags+=("-v")
+    local_nonpersistent_flags+=("--version")
+    local_nonpersistent_flags+=("--version=")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--vhost=")
+    two_word_flags+=("--vhost")
+    two_word_flags+=("-t")
+    local_nonpersistent_flags+=("--vhost")
+    local_nonpersistent_flags+=("--vhost=")
+    local_nonpersistent_flags+=("-t")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--adapter=")
-    must_have_one_flag+=("-a")
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--name=")
+    must_have_one_flag+=("-n")
+    must_have_one_flag+=("--version=")
+    must_have_one_flag+=("-v")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_add_help()
+_apictl_mg_undeploy_help()
 {
-    last_command="apictl_mg_add_help"
+    last_command="apictl_mg_undeploy_help"
 
     command_aliases=()
 
@@ -2707,8 +6107,16 @@ _apictl_mg_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2717,14 +6125,14 @@ _apictl_mg_add_help()
     noun_aliases=()
 }
 
-_apictl_mg_add()
+_apictl_mg_undeploy()
 {
-    last_command="apictl_mg_add"
+    last_command="apictl_mg_undeploy"
 
     command_aliases=()
 
     commands=()
-    commands+=("env")
+    commands+=("api")
     commands+=("help")
 
     flags=()
@@ -2737,8 +6145,22 @@ _apictl_mg_add()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2746,13 +6168,22 @@ _apictl_mg_add()
     noun_aliases=()
 }
 
-_apictl_mg_deploy_api()
+_apictl_mg()
 {
-    last_command="apictl_mg_deploy_api"
+    last_command="apictl_mg"
 
     command_aliases=()
 
     commands=()
+    commands+=("add")
+    commands+=("deploy")
+    commands+=("get")
+    commands+=("help")
+    commands+=("login")
+    commands+=("logout")
+    commands+=("remove")
+    commands+=("sync")
+    commands+=("undeploy")
 
     flags=()
     two_word_flags=()
@@ -2760,44 +6191,36 @@ _apictl_mg_deploy_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--file=")
-    two_word_flags+=("--file")
-    two_word_flags+=("-f")
-    local_nonpersistent_flags+=("--file")
-    local_nonpersistent_flags+=("--file=")
-    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--override")
-    flags+=("-o")
-    local_nonpersistent_flags+=("--override")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
     local_nonpersistent_flags+=("-o")
-    flags+=("--skip-cleanup")
-    local_nonpersistent_flags+=("--skip-cleanup")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--file=")
-    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_deploy_help()
+_apictl_mi_activate_endpoint()
 {
-    last_command="apictl_mg_deploy_help"
+    last_command="apictl_mi_activate_endpoint"
 
     command_aliases=()
 
@@ -2809,25 +6232,48 @@ _apictl_mg_deploy_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_deploy()
+_apictl_mi_activate_help()
 {
-    last_command="apictl_mg_deploy"
+    last_command="apictl_mi_activate_help"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -2835,22 +6281,27 @@ _apictl_mg_deploy()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_get_apis()
+_apictl_mi_activate_message-processor()
 {
-    last_command="apictl_mg_get_apis"
+    last_command="apictl_mi_activate_message-processor"
 
     command_aliases=()
 
@@ -2872,20 +6323,22 @@ _apictl_mg_get_apis()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--limit=")
-    two_word_flags+=("--limit")
-    two_word_flags+=("-l")
-    local_nonpersistent_flags+=("--limit")
-    local_nonpersistent_flags+=("--limit=")
-    local_nonpersistent_flags+=("-l")
-    flags+=("--query=")
-    two_word_flags+=("--query")
-    two_word_flags+=("-q")
-    local_nonpersistent_flags+=("--query")
-    local_nonpersistent_flags+=("--query=")
-    local_nonpersistent_flags+=("-q")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2895,9 +6348,9 @@ _apictl_mg_get_apis()
     noun_aliases=()
 }
 
-_apictl_mg_get_help()
+_apictl_mi_activate_proxy-service()
 {
-    last_command="apictl_mg_get_help"
+    last_command="apictl_mi_activate_proxy-service"
 
     command_aliases=()
 
@@ -2909,25 +6362,52 @@ _apictl_mg_get_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_get()
+_apictl_mi_activate()
 {
-    last_command="apictl_mg_get"
+    last_command="apictl_mi_activate"
 
     command_aliases=()
 
     commands=()
-    commands+=("apis")
+    commands+=("endpoint")
     commands+=("help")
+    commands+=("message-processor")
+    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -2939,8 +6419,22 @@ _apictl_mg_get()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2948,9 +6442,9 @@ _apictl_mg_get()
     noun_aliases=()
 }
 
-_apictl_mg_help()
+_apictl_mi_add_help()
 {
-    last_command="apictl_mg_help"
+    last_command="apictl_mi_add_help"
 
     command_aliases=()
 
@@ -2962,8 +6456,16 @@ _apictl_mg_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -2972,9 +6474,9 @@ _apictl_mg_help()
     noun_aliases=()
 }
 
-_apictl_mg_login()
+_apictl_mi_add_log-level()
 {
-    last_command="apictl_mg_login"
+    last_command="apictl_mi_add_log-level"
 
     command_aliases=()
 
@@ -2986,36 +6488,44 @@ _apictl_mg_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_logout()
+_apictl_mi_add_role()
 {
-    last_command="apictl_mg_logout"
+    last_command="apictl_mi_add_role"
 
     command_aliases=()
 
@@ -3027,22 +6537,44 @@ _apictl_mg_logout()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_remove_env()
+_apictl_mi_add_user()
 {
-    last_command="apictl_mg_remove_env"
+    last_command="apictl_mi_add_user"
 
     command_aliases=()
 
@@ -3054,26 +6586,52 @@ _apictl_mg_remove_env()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_remove_help()
+_apictl_mi_add()
 {
-    last_command="apictl_mg_remove_help"
+    last_command="apictl_mi_add"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("log-level")
+    commands+=("role")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -3081,25 +6639,40 @@ _apictl_mg_remove_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_remove()
+_apictl_mi_deactivate_endpoint()
 {
-    last_command="apictl_mg_remove"
+    last_command="apictl_mi_deactivate_endpoint"
 
     command_aliases=()
 
     commands=()
-    commands+=("env")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3107,22 +6680,44 @@ _apictl_mg_remove()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg_undeploy_api()
+_apictl_mi_deactivate_help()
 {
-    last_command="apictl_mg_undeploy_api"
+    last_command="apictl_mi_deactivate_help"
 
     command_aliases=()
 
@@ -3134,58 +6729,27 @@ _apictl_mg_undeploy_api()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--gateway-env=")
-    two_word_flags+=("--gateway-env")
-    two_word_flags+=("-g")
-    local_nonpersistent_flags+=("--gateway-env")
-    local_nonpersistent_flags+=("--gateway-env=")
-    local_nonpersistent_flags+=("-g")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
-    flags+=("--name=")
-    two_word_flags+=("--name")
-    two_word_flags+=("-n")
-    local_nonpersistent_flags+=("--name")
-    local_nonpersistent_flags+=("--name=")
-    local_nonpersistent_flags+=("-n")
-    flags+=("--version=")
-    two_word_flags+=("--version")
-    two_word_flags+=("-v")
-    local_nonpersistent_flags+=("--version")
-    local_nonpersistent_flags+=("--version=")
-    local_nonpersistent_flags+=("-v")
-    flags+=("--vhost=")
-    two_word_flags+=("--vhost")
-    two_word_flags+=("-t")
-    local_nonpersistent_flags+=("--vhost")
-    local_nonpersistent_flags+=("--vhost=")
-    local_nonpersistent_flags+=("-t")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
-    must_have_one_flag+=("--name=")
-    must_have_one_flag+=("-n")
-    must_have_one_flag+=("--version=")
-    must_have_one_flag+=("-v")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_undeploy_help()
+_apictl_mi_deactivate_message-processor()
 {
-    last_command="apictl_mg_undeploy_help"
+    last_command="apictl_mi_deactivate_message-processor"
 
     command_aliases=()
 
@@ -3197,25 +6761,48 @@ _apictl_mg_undeploy_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mg_undeploy()
+_apictl_mi_deactivate_proxy-service()
 {
-    last_command="apictl_mg_undeploy"
+    last_command="apictl_mi_deactivate_proxy-service"
 
     command_aliases=()
 
     commands=()
-    commands+=("api")
-    commands+=("help")
 
     flags=()
     two_word_flags=()
@@ -3223,34 +6810,52 @@ _apictl_mg_undeploy()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mg()
+_apictl_mi_deactivate()
 {
-    last_command="apictl_mg"
+    last_command="apictl_mi_deactivate"
 
     command_aliases=()
 
     commands=()
-    commands+=("add")
-    commands+=("deploy")
-    commands+=("get")
+    commands+=("endpoint")
     commands+=("help")
-    commands+=("login")
-    commands+=("logout")
-    commands+=("remove")
-    commands+=("undeploy")
+    commands+=("message-processor")
+    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -3262,8 +6867,22 @@ _apictl_mg()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3271,9 +6890,9 @@ _apictl_mg()
     noun_aliases=()
 }
 
-_apictl_mi_activate_endpoint()
+_apictl_mi_delete_help()
 {
-    last_command="apictl_mi_activate_endpoint"
+    last_command="apictl_mi_delete_help"
 
     command_aliases=()
 
@@ -3285,30 +6904,27 @@ _apictl_mi_activate_endpoint()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_activate_help()
+_apictl_mi_delete_messages()
 {
-    last_command="apictl_mi_activate_help"
+    last_command="apictl_mi_delete_messages"
 
     command_aliases=()
 
@@ -3320,19 +6936,46 @@ _apictl_mi_activate_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--force")
+    local_nonpersistent_flags+=("--force")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_activate_message-processor()
+_apictl_mi_delete_role()
 {
-    last_command="apictl_mi_activate_message-processor"
+    last_command="apictl_mi_delete_role"
 
     command_aliases=()
 
@@ -3344,6 +6987,12 @@ _apictl_mi_activate_message-processor()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -3354,8 +7003,22 @@ _apictl_mi_activate_message-processor()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3365,9 +7028,9 @@ _apictl_mi_activate_message-processor()
     noun_aliases=()
 }
 
-_apictl_mi_activate_proxy-service()
+_apictl_mi_delete_user()
 {
-    last_command="apictl_mi_activate_proxy-service"
+    last_command="apictl_mi_delete_user"
 
     command_aliases=()
 
@@ -3379,6 +7042,12 @@ _apictl_mi_activate_proxy-service()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -3389,8 +7058,22 @@ _apictl_mi_activate_proxy-service()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3400,17 +7083,17 @@ _apictl_mi_activate_proxy-service()
     noun_aliases=()
 }
 
-_apictl_mi_activate()
+_apictl_mi_delete()
 {
-    last_command="apictl_mi_activate"
+    last_command="apictl_mi_delete"
 
     command_aliases=()
 
     commands=()
-    commands+=("endpoint")
     commands+=("help")
-    commands+=("message-processor")
-    commands+=("proxy-service")
+    commands+=("messages")
+    commands+=("role")
+    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -3422,8 +7105,22 @@ _apictl_mi_activate()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3431,9 +7128,9 @@ _apictl_mi_activate()
     noun_aliases=()
 }
 
-_apictl_mi_add_help()
+_apictl_mi_disable-stats()
 {
-    last_command="apictl_mi_add_help"
+    last_command="apictl_mi_disable-stats"
 
     command_aliases=()
 
@@ -3445,19 +7142,56 @@ _apictl_mi_add_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--capp=")
+    two_word_flags+=("--capp")
+    local_nonpersistent_flags+=("--capp")
+    local_nonpersistent_flags+=("--capp=")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--label=")
+    two_word_flags+=("--label")
+    local_nonpersistent_flags+=("--label")
+    local_nonpersistent_flags+=("--label=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_add_log-level()
+_apictl_mi_disable-tracing()
 {
-    last_command="apictl_mi_add_log-level"
+    last_command="apictl_mi_disable-tracing"
 
     command_aliases=()
 
@@ -3469,6 +7203,10 @@ _apictl_mi_add_log-level()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--capp=")
+    two_word_flags+=("--capp")
+    local_nonpersistent_flags+=("--capp")
+    local_nonpersistent_flags+=("--capp=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -3479,8 +7217,30 @@ _apictl_mi_add_log-level()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--label=")
+    two_word_flags+=("--label")
+    local_nonpersistent_flags+=("--label")
+    local_nonpersistent_flags+=("--label=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3490,9 +7250,9 @@ _apictl_mi_add_log-level()
     noun_aliases=()
 }
 
-_apictl_mi_add_role()
+_apictl_mi_enable-stats()
 {
-    last_command="apictl_mi_add_role"
+    last_command="apictl_mi_enable-stats"
 
     command_aliases=()
 
@@ -3504,6 +7264,10 @@ _apictl_mi_add_role()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--capp=")
+    two_word_flags+=("--capp")
+    local_nonpersistent_flags+=("--capp")
+    local_nonpersistent_flags+=("--capp=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -3514,8 +7278,30 @@ _apictl_mi_add_role()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--label=")
+    two_word_flags+=("--label")
+    local_nonpersistent_flags+=("--label")
+    local_nonpersistent_flags+=("--label=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3525,9 +7311,9 @@ _apictl_mi_add_role()
     noun_aliases=()
 }
 
-_apictl_mi_add_user()
+_apictl_mi_enable-tracing()
 {
-    last_command="apictl_mi_add_user"
+    last_command="apictl_mi_enable-tracing"
 
     command_aliases=()
 
@@ -3539,6 +7325,10 @@ _apictl_mi_add_user()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--capp=")
+    two_word_flags+=("--capp")
+    local_nonpersistent_flags+=("--capp")
+    local_nonpersistent_flags+=("--capp=")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -3549,8 +7339,30 @@ _apictl_mi_add_user()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--label=")
+    two_word_flags+=("--label")
+    local_nonpersistent_flags+=("--label")
+    local_nonpersistent_flags+=("--label=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3560,17 +7372,13 @@ _apictl_mi_add_user()
     noun_aliases=()
 }
 
-_apictl_mi_add()
+_apictl_mi_get_apis()
 {
-    last_command="apictl_mi_add"
+    last_command="apictl_mi_get_apis"
 
     command_aliases=()
 
     commands=()
-    commands+=("help")
-    commands+=("log-level")
-    commands+=("role")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -3578,22 +7386,53 @@ _apictl_mi_add()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_endpoint()
+_apictl_mi_get_composite-apps()
 {
-    last_command="apictl_mi_deactivate_endpoint"
+    last_command="apictl_mi_get_composite-apps"
 
     command_aliases=()
 
@@ -3611,12 +7450,35 @@ _apictl_mi_deactivate_endpoint()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3626,9 +7488,9 @@ _apictl_mi_deactivate_endpoint()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_help()
+_apictl_mi_get_connectors()
 {
-    last_command="apictl_mi_deactivate_help"
+    last_command="apictl_mi_get_connectors"
 
     command_aliases=()
 
@@ -3640,19 +7502,53 @@ _apictl_mi_deactivate_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_message-processor()
+_apictl_mi_get_data-services()
 {
-    last_command="apictl_mi_deactivate_message-processor"
+    last_command="apictl_mi_get_data-services"
 
     command_aliases=()
 
@@ -3670,12 +7566,35 @@ _apictl_mi_deactivate_message-processor()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3685,9 +7604,9 @@ _apictl_mi_deactivate_message-processor()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate_proxy-service()
+_apictl_mi_get_endpoints()
 {
-    last_command="apictl_mi_deactivate_proxy-service"
+    last_command="apictl_mi_get_endpoints"
 
     command_aliases=()
 
@@ -3705,12 +7624,35 @@ _apictl_mi_deactivate_proxy-service()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3720,17 +7662,50 @@ _apictl_mi_deactivate_proxy-service()
     noun_aliases=()
 }
 
-_apictl_mi_deactivate()
+_apictl_mi_get_help()
 {
-    last_command="apictl_mi_deactivate"
+    last_command="apictl_mi_get_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    has_completion_function=1
+    noun_aliases=()
+}
+
+_apictl_mi_get_inbound-endpoints()
+{
+    last_command="apictl_mi_get_inbound-endpoints"
 
     command_aliases=()
 
     commands=()
-    commands+=("endpoint")
-    commands+=("help")
-    commands+=("message-processor")
-    commands+=("proxy-service")
 
     flags=()
     two_word_flags=()
@@ -3738,22 +7713,53 @@ _apictl_mi_deactivate()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_delete_help()
+_apictl_mi_get_local-entries()
 {
-    last_command="apictl_mi_delete_help"
+    last_command="apictl_mi_get_local-entries"
 
     command_aliases=()
 
@@ -3765,19 +7771,53 @@ _apictl_mi_delete_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_delete_role()
+_apictl_mi_get_log-levels()
 {
-    last_command="apictl_mi_delete_role"
+    last_command="apictl_mi_get_log-levels"
 
     command_aliases=()
 
@@ -3789,24 +7829,41 @@ _apictl_mi_delete_role()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3816,9 +7873,9 @@ _apictl_mi_delete_role()
     noun_aliases=()
 }
 
-_apictl_mi_delete_user()
+_apictl_mi_get_logs()
 {
-    last_command="apictl_mi_delete_user"
+    last_command="apictl_mi_get_logs"
 
     command_aliases=()
 
@@ -3830,24 +7887,47 @@ _apictl_mi_delete_user()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--path=")
+    two_word_flags+=("--path")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--path")
+    local_nonpersistent_flags+=("--path=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3857,16 +7937,13 @@ _apictl_mi_delete_user()
     noun_aliases=()
 }
 
-_apictl_mi_delete()
+_apictl_mi_get_message-count()
 {
-    last_command="apictl_mi_delete"
+    last_command="apictl_mi_get_message-count"
 
     command_aliases=()
 
     commands=()
-    commands+=("help")
-    commands+=("role")
-    commands+=("user")
 
     flags=()
     two_word_flags=()
@@ -3874,22 +7951,49 @@ _apictl_mi_delete()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_apis()
+_apictl_mi_get_message-processors()
 {
-    last_command="apictl_mi_get_apis"
+    last_command="apictl_mi_get_message-processors"
 
     command_aliases=()
 
@@ -3915,8 +8019,27 @@ _apictl_mi_get_apis()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3926,9 +8049,9 @@ _apictl_mi_get_apis()
     noun_aliases=()
 }
 
-_apictl_mi_get_composite-apps()
+_apictl_mi_get_message-stores()
 {
-    last_command="apictl_mi_get_composite-apps"
+    last_command="apictl_mi_get_message-stores"
 
     command_aliases=()
 
@@ -3954,8 +8077,27 @@ _apictl_mi_get_composite-apps()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -3965,9 +8107,9 @@ _apictl_mi_get_composite-apps()
     noun_aliases=()
 }
 
-_apictl_mi_get_connectors()
+_apictl_mi_get_messages()
 {
-    last_command="apictl_mi_get_connectors"
+    last_command="apictl_mi_get_messages"
 
     command_aliases=()
 
@@ -3979,6 +8121,12 @@ _apictl_mi_get_connectors()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--count=")
+    two_word_flags+=("--count")
+    two_word_flags+=("-n")
+    local_nonpersistent_flags+=("--count")
+    local_nonpersistent_flags+=("--count=")
+    local_nonpersistent_flags+=("-n")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -3993,8 +8141,27 @@ _apictl_mi_get_connectors()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4004,9 +8171,9 @@ _apictl_mi_get_connectors()
     noun_aliases=()
 }
 
-_apictl_mi_get_data-services()
+_apictl_mi_get_proxy-services()
 {
-    last_command="apictl_mi_get_data-services"
+    last_command="apictl_mi_get_proxy-services"
 
     command_aliases=()
 
@@ -4032,8 +8199,27 @@ _apictl_mi_get_data-services()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4043,9 +8229,9 @@ _apictl_mi_get_data-services()
     noun_aliases=()
 }
 
-_apictl_mi_get_endpoints()
+_apictl_mi_get_roles()
 {
-    last_command="apictl_mi_get_endpoints"
+    last_command="apictl_mi_get_roles"
 
     command_aliases=()
 
@@ -4057,6 +8243,12 @@ _apictl_mi_get_endpoints()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -4071,8 +8263,27 @@ _apictl_mi_get_endpoints()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4082,9 +8293,9 @@ _apictl_mi_get_endpoints()
     noun_aliases=()
 }
 
-_apictl_mi_get_help()
+_apictl_mi_get_sequences()
 {
-    last_command="apictl_mi_get_help"
+    last_command="apictl_mi_get_sequences"
 
     command_aliases=()
 
@@ -4096,19 +8307,53 @@ _apictl_mi_get_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
     must_have_one_noun=()
-    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_inbound-endpoints()
+_apictl_mi_get_tasks()
 {
-    last_command="apictl_mi_get_inbound-endpoints"
+    last_command="apictl_mi_get_tasks"
 
     command_aliases=()
 
@@ -4134,8 +8379,27 @@ _apictl_mi_get_inbound-endpoints()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4145,9 +8409,9 @@ _apictl_mi_get_inbound-endpoints()
     noun_aliases=()
 }
 
-_apictl_mi_get_local-entries()
+_apictl_mi_get_templates()
 {
-    last_command="apictl_mi_get_local-entries"
+    last_command="apictl_mi_get_templates"
 
     command_aliases=()
 
@@ -4173,8 +8437,27 @@ _apictl_mi_get_local-entries()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4184,9 +8467,9 @@ _apictl_mi_get_local-entries()
     noun_aliases=()
 }
 
-_apictl_mi_get_log-levels()
+_apictl_mi_get_transaction-counts()
 {
-    last_command="apictl_mi_get_log-levels"
+    last_command="apictl_mi_get_transaction-counts"
 
     command_aliases=()
 
@@ -4212,8 +8495,27 @@ _apictl_mi_get_log-levels()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4223,9 +8525,9 @@ _apictl_mi_get_log-levels()
     noun_aliases=()
 }
 
-_apictl_mi_get_logs()
+_apictl_mi_get_transaction-reports()
 {
-    last_command="apictl_mi_get_logs"
+    last_command="apictl_mi_get_transaction-reports"
 
     command_aliases=()
 
@@ -4247,18 +8549,47 @@ _apictl_mi_get_logs()
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
     local_nonpersistent_flags+=("--format=")
+    flags+=("--group-by=")
+    two_word_flags+=("--group-by")
+    local_nonpersistent_flags+=("--group-by")
+    local_nonpersistent_flags+=("--group-by=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--last-month")
+    local_nonpersistent_flags+=("--last-month")
+    flags+=("--last-quarter")
+    local_nonpersistent_flags+=("--last-quarter")
+    flags+=("--last-year")
+    local_nonpersistent_flags+=("--last-year")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--path=")
     two_word_flags+=("--path")
     two_word_flags+=("-p")
     local_nonpersistent_flags+=("--path")
     local_nonpersistent_flags+=("--path=")
     local_nonpersistent_flags+=("-p")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4268,9 +8599,9 @@ _apictl_mi_get_logs()
     noun_aliases=()
 }
 
-_apictl_mi_get_message-processors()
+_apictl_mi_get_users()
 {
-    last_command="apictl_mi_get_message-processors"
+    last_command="apictl_mi_get_users"
 
     command_aliases=()
 
@@ -4282,6 +8613,12 @@ _apictl_mi_get_message-processors()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--domain=")
+    two_word_flags+=("--domain")
+    two_word_flags+=("-d")
+    local_nonpersistent_flags+=("--domain")
+    local_nonpersistent_flags+=("--domain=")
+    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -4296,8 +8633,39 @@ _apictl_mi_get_message-processors()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--pattern=")
+    two_word_flags+=("--pattern")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--pattern")
+    local_nonpersistent_flags+=("--pattern=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--role=")
+    two_word_flags+=("--role")
+    two_word_flags+=("-r")
+    local_nonpersistent_flags+=("--role")
+    local_nonpersistent_flags+=("--role=")
+    local_nonpersistent_flags+=("-r")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--no-headers")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4307,13 +8675,35 @@ _apictl_mi_get_message-processors()
     noun_aliases=()
 }
 
-_apictl_mi_get_message-stores()
+_apictl_mi_get()
 {
-    last_command="apictl_mi_get_message-stores"
+    last_command="apictl_mi_get"
 
     command_aliases=()
 
     commands=()
+    commands+=("apis")
+    commands+=("composite-apps")
+    commands+=("connectors")
+    commands+=("data-services")
+    commands+=("endpoints")
+    commands+=("help")
+    commands+=("inbound-endpoints")
+    commands+=("local-entries")
+    commands+=("log-levels")
+    commands+=("logs")
+    commands+=("message-count")
+    commands+=("message-processors")
+    commands+=("message-stores")
+    commands+=("messages")
+    commands+=("proxy-services")
+    commands+=("roles")
+    commands+=("sequences")
+    commands+=("tasks")
+    commands+=("templates")
+    commands+=("transaction-counts")
+    commands+=("transaction-reports")
+    commands+=("users")
 
     flags=()
     two_word_flags=()
@@ -4321,34 +8711,41 @@ _apictl_mi_get_message-stores()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
+    flags+=("--filter=")
+    two_word_flags+=("--filter")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--no-headers")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--sort-by=")
+    two_word_flags+=("--sort-by")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_proxy-services()
+_apictl_mi_help()
 {
-    last_command="apictl_mi_get_proxy-services"
+    last_command="apictl_mi_help"
 
     command_aliases=()
 
@@ -4360,34 +8757,27 @@ _apictl_mi_get_proxy-services()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_roles()
+_apictl_mi_login()
 {
-    last_command="apictl_mi_get_roles"
+    last_command="apictl_mi_login"
 
     command_aliases=()
 
@@ -4399,40 +8789,50 @@ _apictl_mi_get_roles()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--password=")
+    two_word_flags+=("--password")
+    two_word_flags+=("-p")
+    local_nonpersistent_flags+=("--password")
+    local_nonpersistent_flags+=("--password=")
+    local_nonpersistent_flags+=("-p")
+    flags+=("--password-stdin")
+    local_nonpersistent_flags+=("--password-stdin")
+    flags+=("--username=")
+    two_word_flags+=("--username")
+    two_word_flags+=("-u")
+    local_nonpersistent_flags+=("--username")
+    local_nonpersistent_flags+=("--username=")
+    local_nonpersistent_flags+=("-u")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_sequences()
+_apictl_mi_logout()
 {
-    last_command="apictl_mi_get_sequences"
+    last_command="apictl_mi_logout"
 
     command_aliases=()
 
@@ -4444,34 +8844,36 @@ _apictl_mi_get_sequences()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_get_tasks()
+_apictl_mi_update_hashicorp-secret()
 {
-    last_command="apictl_mi_get_tasks"
+    last_command="apictl_mi_update_hashicorp-secret"
 
     command_aliases=()
 
@@ -4489,16 +8891,26 @@ _apictl_mi_get_tasks()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4508,9 +8920,9 @@ _apictl_mi_get_tasks()
     noun_aliases=()
 }
 
-_apictl_mi_get_templates()
+_apictl_mi_update_help()
 {
-    last_command="apictl_mi_get_templates"
+    last_command="apictl_mi_update_help"
 
     command_aliases=()
 
@@ -4522,34 +8934,27 @@ _apictl_mi_get_templates()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--environment=")
-    two_word_flags+=("--environment")
-    two_word_flags+=("-e")
-    local_nonpersistent_flags+=("--environment")
-    local_nonpersistent_flags+=("--environment=")
-    local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
-    flags+=("--help")
-    flags+=("-h")
-    local_nonpersistent_flags+=("--help")
-    local_nonpersistent_flags+=("-h")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
-    must_have_one_flag+=("--environment=")
-    must_have_one_flag+=("-e")
     must_have_one_noun=()
+    has_completion_function=1
     noun_aliases=()
 }
 
-_apictl_mi_get_transaction-counts()
+_apictl_mi_update_log-level()
 {
-    last_command="apictl_mi_get_transaction-counts"
+    last_command="apictl_mi_update_log-level"
 
     command_aliases=()
 
@@ -4567,16 +8972,26 @@ _apictl_mi_get_transaction-counts()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4586,9 +9001,9 @@ _apictl_mi_get_transaction-counts()
     noun_aliases=()
 }
 
-_apictl_mi_get_transaction-reports()
+_apictl_mi_update_message-processor()
 {
-    last_command="apictl_mi_get_transaction-reports"
+    last_command="apictl_mi_update_message-processor"
 
     command_aliases=()
 
@@ -4600,6 +9015,8 @@ _apictl_mi_get_transaction-reports()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--drop-head")
+    local_nonpersistent_flags+=("--drop-head")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
@@ -4610,14 +9027,24 @@ _apictl_mi_get_transaction-reports()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--path=")
-    two_word_flags+=("--path")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--path")
-    local_nonpersistent_flags+=("--path=")
-    local_nonpersistent_flags+=("-p")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--retry-head")
+    local_nonpersistent_flags+=("--retry-head")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4627,9 +9054,9 @@ _apictl_mi_get_transaction-reports()
     noun_aliases=()
 }
 
-_apictl_mi_get_users()
+_apictl_mi_update_user()
 {
-    last_command="apictl_mi_get_users"
+    last_command="apictl_mi_update_user"
 
     command_aliases=()
 
@@ -4641,40 +9068,32 @@ _apictl_mi_get_users()
     flags_with_completion=()
     flags_completion=()
 
-    flags+=("--domain=")
-    two_word_flags+=("--domain")
-    two_word_flags+=("-d")
-    local_nonpersistent_flags+=("--domain")
-    local_nonpersistent_flags+=("--domain=")
-    local_nonpersistent_flags+=("-d")
     flags+=("--environment=")
     two_word_flags+=("--environment")
     two_word_flags+=("-e")
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
-    flags+=("--format=")
-    two_word_flags+=("--format")
-    local_nonpersistent_flags+=("--format")
-    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--pattern=")
-    two_word_flags+=("--pattern")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--pattern")
-    local_nonpersistent_flags+=("--pattern=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--role=")
-    two_word_flags+=("--role")
-    two_word_flags+=("-r")
-    local_nonpersistent_flags+=("--role")
-    local_nonpersistent_flags+=("--role=")
-    local_nonpersistent_flags+=("-r")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4684,34 +9103,19 @@ _apictl_mi_get_users()
     noun_aliases=()
 }
 
-_apictl_mi_get()
+_apictl_mi_update()
 {
-    last_command="apictl_mi_get"
+    last_command="apictl_mi_update"
 
     command_aliases=()
 
-    commands=()
-    commands+=("apis")
-    commands+=("composite-apps")
-    commands+=("connectors")
-    commands+=("data-services")
-    commands+=("endpoints")
-    commands+=("help")
-    commands+=("inbound-endpoints")
-    commands+=("local-entries")
-    commands+=("log-levels")
-    commands+=("logs")
-    commands+=("message-processors")
-    commands+=("message-stores")
-    commands+=("proxy-services")
-    commands+=("roles")
-    commands+=("sequences")
-    commands+=("tasks")
-    commands+=("templates")
-    commands+=("transaction-counts")
-    commands+=("transaction-reports")
-    commands+=("users")
-
+    commands=()
+    commands+=("hashicorp-secret")
+    commands+=("help")
+    commands+=("log-level")
+    commands+=("message-processor")
+    commands+=("user")
+
     flags=()
     two_word_flags=()
     local_nonpersistent_flags=()
@@ -4722,8 +9126,22 @@ _apictl_mi_get()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4731,9 +9149,9 @@ _apictl_mi_get()
     noun_aliases=()
 }
 
-_apictl_mi_help()
+_apictl_mi_users_help()
 {
-    last_command="apictl_mi_help"
+    last_command="apictl_mi_users_help"
 
     command_aliases=()
 
@@ -4745,8 +9163,16 @@ _apictl_mi_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4755,9 +9181,9 @@ _apictl_mi_help()
     noun_aliases=()
 }
 
-_apictl_mi_login()
+_apictl_mi_users_import()
 {
-    last_command="apictl_mi_login"
+    last_command="apictl_mi_users_import"
 
     command_aliases=()
 
@@ -4769,40 +9195,72 @@ _apictl_mi_login()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cipher=")
+    two_word_flags+=("--cipher")
+    two_word_flags+=("-c")
+    local_nonpersistent_flags+=("--cipher")
+    local_nonpersistent_flags+=("--cipher=")
+    local_nonpersistent_flags+=("-c")
+    flags+=("--create-roles")
+    local_nonpersistent_flags+=("--create-roles")
+    flags+=("--environment=")
+    two_word_flags+=("--environment")
+    two_word_flags+=("-e")
+    local_nonpersistent_flags+=("--environment")
+    local_nonpersistent_flags+=("--environment=")
+    local_nonpersistent_flags+=("-e")
+    flags+=("--file=")
+    two_word_flags+=("--file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--file")
+    local_nonpersistent_flags+=("--file=")
+    local_nonpersistent_flags+=("-f")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
-    flags+=("--password=")
-    two_word_flags+=("--password")
-    two_word_flags+=("-p")
-    local_nonpersistent_flags+=("--password")
-    local_nonpersistent_flags+=("--password=")
-    local_nonpersistent_flags+=("-p")
-    flags+=("--password-stdin")
-    local_nonpersistent_flags+=("--password-stdin")
-    flags+=("--username=")
-    two_word_flags+=("--username")
-    two_word_flags+=("-u")
-    local_nonpersistent_flags+=("--username")
-    local_nonpersistent_flags+=("--username=")
-    local_nonpersistent_flags+=("-u")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--passwords-file=")
+    two_word_flags+=("--passwords-file")
+    local_nonpersistent_flags+=("--passwords-file")
+    local_nonpersistent_flags+=("--passwords-file=")
+    flags+=("--reconcile-roles")
+    local_nonpersistent_flags+=("--reconcile-roles")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
+    must_have_one_flag+=("--environment=")
+    must_have_one_flag+=("-e")
+    must_have_one_flag+=("--file=")
+    must_have_one_flag+=("-f")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_logout()
+_apictl_mi_users()
 {
-    last_command="apictl_mi_logout"
+    last_command="apictl_mi_users"
 
     command_aliases=()
 
     commands=()
+    commands+=("help")
+    commands+=("import")
 
     flags=()
     two_word_flags=()
@@ -4814,8 +9272,22 @@ _apictl_mi_logout()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4823,9 +9295,9 @@ _apictl_mi_logout()
     noun_aliases=()
 }
 
-_apictl_mi_update_hashicorp-secret()
+_apictl_mi_vault_check-aliases()
 {
-    last_command="apictl_mi_update_hashicorp-secret"
+    last_command="apictl_mi_vault_check-aliases"
 
     command_aliases=()
 
@@ -4843,24 +9315,100 @@ _apictl_mi_update_hashicorp-secret()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--secrets-file=")
+    two_word_flags+=("--secrets-file")
+    local_nonpersistent_flags+=("--secrets-file")
+    local_nonpersistent_flags+=("--secrets-file=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--secrets-file=")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update_help()
+_apictl_mi_vault_encrypt()
 {
-    last_command="apictl_mi_update_help"
+    last_command="apictl_mi_vault_encrypt"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--cipher=")
+    two_word_flags+=("--cipher")
+    two_word_flags+=("-c")
+    local_nonpersistent_flags+=("--cipher")
+    local_nonpersistent_flags+=("--cipher=")
+    local_nonpersistent_flags+=("-c")
+    flags+=("--from-file=")
+    two_word_flags+=("--from-file")
+    two_word_flags+=("-f")
+    local_nonpersistent_flags+=("--from-file")
+    local_nonpersistent_flags+=("--from-file=")
+    local_nonpersistent_flags+=("-f")
+    flags+=("--help")
+    flags+=("-h")
+    local_nonpersistent_flags+=("--help")
+    local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
+    flags+=("--insecure")
+    flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
+    flags+=("--verbose")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_apictl_mi_vault_help()
+{
+    last_command="apictl_mi_vault_help"
 
     command_aliases=()
 
@@ -4872,8 +9420,16 @@ _apictl_mi_update_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4882,9 +9438,9 @@ _apictl_mi_update_help()
     noun_aliases=()
 }
 
-_apictl_mi_update_log-level()
+_apictl_mi_vault_rotate-approle()
 {
-    last_command="apictl_mi_update_log-level"
+    last_command="apictl_mi_vault_rotate-approle"
 
     command_aliases=()
 
@@ -4906,20 +9462,44 @@ _apictl_mi_update_log-level()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--role-id=")
+    two_word_flags+=("--role-id")
+    local_nonpersistent_flags+=("--role-id")
+    local_nonpersistent_flags+=("--role-id=")
+    flags+=("--secret-id=")
+    two_word_flags+=("--secret-id")
+    local_nonpersistent_flags+=("--secret-id")
+    local_nonpersistent_flags+=("--secret-id=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
     must_have_one_flag+=("--environment=")
     must_have_one_flag+=("-e")
+    must_have_one_flag+=("--role-id=")
+    must_have_one_flag+=("--secret-id=")
     must_have_one_noun=()
     noun_aliases=()
 }
 
-_apictl_mi_update_user()
+_apictl_mi_vault_status()
 {
-    last_command="apictl_mi_update_user"
+    last_command="apictl_mi_vault_status"
 
     command_aliases=()
 
@@ -4937,12 +9517,30 @@ _apictl_mi_update_user()
     local_nonpersistent_flags+=("--environment")
     local_nonpersistent_flags+=("--environment=")
     local_nonpersistent_flags+=("-e")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4952,17 +9550,18 @@ _apictl_mi_update_user()
     noun_aliases=()
 }
 
-_apictl_mi_update()
+_apictl_mi_vault()
 {
-    last_command="apictl_mi_update"
+    last_command="apictl_mi_vault"
 
     command_aliases=()
 
     commands=()
-    commands+=("hashicorp-secret")
+    commands+=("check-aliases")
+    commands+=("encrypt")
     commands+=("help")
-    commands+=("log-level")
-    commands+=("user")
+    commands+=("rotate-approle")
+    commands+=("status")
 
     flags=()
     two_word_flags=()
@@ -4974,8 +9573,22 @@ _apictl_mi_update()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -4994,11 +9607,17 @@ _apictl_mi()
     commands+=("add")
     commands+=("deactivate")
     commands+=("delete")
+    commands+=("disable-stats")
+    commands+=("disable-tracing")
+    commands+=("enable-stats")
+    commands+=("enable-tracing")
     commands+=("get")
     commands+=("help")
     commands+=("login")
     commands+=("logout")
     commands+=("update")
+    commands+=("users")
+    commands+=("vault")
 
     flags=()
     two_word_flags=()
@@ -5010,8 +9629,22 @@ _apictl_mi()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5037,8 +9670,22 @@ _apictl_remove_env()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5060,8 +9707,16 @@ _apictl_remove_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5090,8 +9745,22 @@ _apictl_remove()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5135,8 +9804,16 @@ _apictl_secret_create()
     local_nonpersistent_flags+=("--output")
     local_nonpersistent_flags+=("--output=")
     local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5158,8 +9835,16 @@ _apictl_secret_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5186,8 +9871,22 @@ _apictl_secret_init()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5216,8 +9915,22 @@ _apictl_secret()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5259,12 +9972,26 @@ _apictl_set_api-logging()
     two_word_flags+=("--log-level")
     local_nonpersistent_flags+=("--log-level")
     local_nonpersistent_flags+=("--log-level=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--tenant-domain=")
     two_word_flags+=("--tenant-domain")
     local_nonpersistent_flags+=("--tenant-domain")
     local_nonpersistent_flags+=("--tenant-domain=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5291,8 +10018,16 @@ _apictl_set_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5329,6 +10064,16 @@ _apictl_set()
     two_word_flags+=("--http-request-timeout")
     local_nonpersistent_flags+=("--http-request-timeout")
     local_nonpersistent_flags+=("--http-request-timeout=")
+    flags+=("--mgw-credential-helper=")
+    two_word_flags+=("--mgw-credential-helper")
+    local_nonpersistent_flags+=("--mgw-credential-helper")
+    local_nonpersistent_flags+=("--mgw-credential-helper=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--tls-renegotiation-mode=")
     two_word_flags+=("--tls-renegotiation-mode")
     local_nonpersistent_flags+=("--tls-renegotiation-mode")
@@ -5347,8 +10092,16 @@ _apictl_set()
     two_word_flags+=("--vcs-source-repo-path")
     local_nonpersistent_flags+=("--vcs-source-repo-path")
     local_nonpersistent_flags+=("--vcs-source-repo-path=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5392,6 +10145,12 @@ _apictl_undeploy_api()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
@@ -5408,8 +10167,16 @@ _apictl_undeploy_api()
     local_nonpersistent_flags+=("--version")
     local_nonpersistent_flags+=("--version=")
     local_nonpersistent_flags+=("-v")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5460,6 +10227,12 @@ _apictl_undeploy_api-product()
     local_nonpersistent_flags+=("--name")
     local_nonpersistent_flags+=("--name=")
     local_nonpersistent_flags+=("-n")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--provider=")
     two_word_flags+=("--provider")
     two_word_flags+=("-r")
@@ -5470,8 +10243,16 @@ _apictl_undeploy_api-product()
     two_word_flags+=("--rev")
     local_nonpersistent_flags+=("--rev")
     local_nonpersistent_flags+=("--rev=")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5498,8 +10279,16 @@ _apictl_undeploy_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5529,8 +10318,22 @@ _apictl_undeploy()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5562,10 +10365,24 @@ _apictl_vcs_deploy()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
     flags+=("--skip-rollback")
     local_nonpersistent_flags+=("--skip-rollback")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5589,8 +10406,16 @@ _apictl_vcs_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5621,8 +10446,22 @@ _apictl_vcs_init()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5658,8 +10497,22 @@ _apictl_vcs_status()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5691,8 +10544,22 @@ _apictl_vcs()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5718,8 +10585,22 @@ _apictl_version()
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
@@ -5738,7 +10619,9 @@ _apictl_root_command()
     commands+=("aws")
     commands+=("bundle")
     commands+=("change-status")
+    commands+=("config")
     commands+=("delete")
+    commands+=("env")
     commands+=("export")
     commands+=("gen")
     commands+=("get")
@@ -5763,12 +10646,26 @@ _apictl_root_command()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--config=")
+    two_word_flags+=("--config")
+    flags+=("--har=")
+    two_word_flags+=("--har")
     flags+=("--help")
     flags+=("-h")
     local_nonpersistent_flags+=("--help")
     local_nonpersistent_flags+=("-h")
+    flags+=("--include-binary-bodies")
     flags+=("--insecure")
     flags+=("-k")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    two_word_flags+=("-o")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    local_nonpersistent_flags+=("-o")
+    flags+=("--record=")
+    two_word_flags+=("--record")
+    flags+=("--trace-http")
     flags+=("--verbose")
 
     must_have_one_flag=()
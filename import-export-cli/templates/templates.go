@@ -20,8 +20,12 @@ package templates
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
+	"unicode/utf8"
 )
 
 // basicFuncs are used for common data printing
@@ -41,11 +45,21 @@ var basicFuncs = template.FuncMap{
 		_ = encoder.Encode(v)
 		return strings.TrimSpace(buf.String())
 	},
-	"split": strings.Split,
-	"upper": strings.ToUpper,
-	"lower": strings.ToLower,
-	"title": strings.Title,
-	"join":  strings.Join,
+	"split":    strings.Split,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"title":    strings.Title,
+	"join":     strings.Join,
+	"date":     formatDate,
+	"truncate": truncate,
+	"padLeft":  func(n int, v interface{}) string { return fmt.Sprintf("%*v", n, v) },
+	"padRight": func(n int, v interface{}) string { return fmt.Sprintf("%-*v", n, v) },
+	"default": func(d string, v interface{}) string {
+		if s := fmt.Sprint(v); v != nil && s != "" {
+			return s
+		}
+		return d
+	},
 }
 
 // HeaderFuncs are used to format headers in a table
@@ -54,6 +68,9 @@ var HeaderFuncs = template.FuncMap{
 	"json":       func(s string) string { return s },
 	"jsonPretty": func(s string) string { return s },
 	"join":       func(s string) string { return s },
+	"date":       func(layout string, s string) string { return s },
+	"truncate":   func(n int, s string) string { return s },
+	"default":    func(d string, s string) string { return s },
 }
 
 // NewBasicFormatter creates a new template engine with name
@@ -61,3 +78,42 @@ func NewBasicFormatter(name string) *template.Template {
 	tmpl := template.New(name).Funcs(basicFuncs)
 	return tmpl
 }
+
+// formatDate formats a time, an RFC 3339 timestamp or an epoch timestamp in milliseconds using the layout. Values which
+// are not times are returned as they are. Use {{date "2006-01-02 15:04" .CreatedTime}}
+func formatDate(layout string, v interface{}) string {
+	switch t := v.(type) {
+	case time.Time:
+		return t.Format(layout)
+	case *time.Time:
+		if t != nil {
+			return t.Format(layout)
+		}
+		return ""
+	case int64:
+		return time.Unix(0, t*int64(time.Millisecond)).Format(layout)
+	case float64:
+		return time.Unix(0, int64(t)*int64(time.Millisecond)).Format(layout)
+	}
+	s := fmt.Sprint(v)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Format(layout)
+	}
+	if millis, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(0, millis*int64(time.Millisecond)).Format(layout)
+	}
+	return s
+}
+
+// truncate shortens the value to n characters ending with "...". Use {{truncate 20 .Description}}
+func truncate(n int, v interface{}) string {
+	s := fmt.Sprint(v)
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	if n <= 3 {
+		return string(runes[:n])
+	}
+	return string(runes[:n-3]) + "..."
+}
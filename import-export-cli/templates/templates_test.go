@@ -0,0 +1,94 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package templates
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func execute(t *testing.T, format string, data interface{}) string {
+	tmpl, err := NewBasicFormatter("").Parse(format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err = tmpl.Execute(&b, data); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestBasicFuncs(t *testing.T) {
+	created := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	tests := []struct {
+		name   string
+		format string
+		data   interface{}
+		want   string
+	}{
+		{"date of a time", `{{date "2006-01-02 15:04" .}}`, created, "2021-03-04 05:06"},
+		{"date of a time pointer", `{{date "2006-01-02" .}}`, &created, "2021-03-04"},
+		{"date of a nil time pointer", `{{date "2006-01-02" .}}`, (*time.Time)(nil), ""},
+		{"date of an RFC 3339 timestamp", `{{date "2006-01-02" .}}`, "2021-03-04T05:06:07Z", "2021-03-04"},
+		{"date of a value which is not a time", `{{date "2006-01-02" .}}`, "yesterday", "yesterday"},
+		{"truncate a long value", `{{truncate 8 .}}`, "PizzaShackAPI", "Pizza..."},
+		{"truncate a short value", `{{truncate 20 .}}`, "PizzaShackAPI", "PizzaShackAPI"},
+		{"truncate to less than the ellipsis", `{{truncate 2 .}}`, "PizzaShackAPI", "Pi"},
+		{"truncate multi-byte characters", `{{truncate 5 .}}`, "héllo wörld", "hé..."},
+		{"padLeft", `[{{padLeft 6 .}}]`, "abc", "[   abc]"},
+		{"padRight", `[{{padRight 6 .}}]`, "abc", "[abc   ]"},
+		{"padLeft a number", `[{{padLeft 4 .}}]`, 42, "[  42]"},
+		{"default of an empty value", `{{default "-" .}}`, "", "-"},
+		{"default of a nil value", `{{default "-" .}}`, nil, "-"},
+		{"default of a value", `{{default "-" .}}`, "value", "value"},
+		{"upper", `{{upper .}}`, "published", "PUBLISHED"},
+		{"join", `{{join . ","}}`, []string{"a", "b"}, "a,b"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, execute(t, test.format, test.data))
+		})
+	}
+}
+
+func TestFormatDateOfEpochMillis(t *testing.T) {
+	millis := time.Date(2021, 3, 4, 5, 6, 7, 0, time.Local).UnixNano() / int64(time.Millisecond)
+	for _, value := range []interface{}{millis, float64(millis), strconv.FormatInt(millis, 10)} {
+		assert.Equal(t, "2021-03-04 05:06", formatDate("2006-01-02 15:04", value), "%T", value)
+	}
+}
+
+func TestHeaderFuncs(t *testing.T) {
+	format := `{{truncate 5 .Name}} {{date "2006-01-02" .Created}} {{default "-" .Owner}}`
+	tmpl, err := NewBasicFormatter("").Funcs(HeaderFuncs).Parse(format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	headers := map[string]string{"Name": "NAME", "Created": "CREATED TIME", "Owner": "OWNER"}
+	if err = tmpl.Execute(&b, headers); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "NAME CREATED TIME OWNER", b.String(), "Headers should be printed as they are")
+}
@@ -14,6 +14,7 @@ import (
 func main() {
 	log.Println("Generating docs...")
 	cmd.RootCmd.DisableAutoGenTag = true
+	cmd.AddOutputFlags()
 	err := doc.GenMarkdownTree(cmd.RootCmd, "docs")
 	if err != nil {
 		log.Fatal(err)
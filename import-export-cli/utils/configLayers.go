@@ -0,0 +1,367 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigEnvVarPrefix is the prefix of the environment variables which override the fields of the main_config.yaml.
+// e.g. APICTL_HTTP_REQUEST_TIMEOUT overrides config.http_request_timeout
+const ConfigEnvVarPrefix = "APICTL_"
+
+// EnvironmentEnvVarPrefix is the prefix of the environment variables which define environments.
+// e.g. APICTL_ENV_DEV_APIM sets environments.dev.apim
+const EnvironmentEnvVarPrefix = ConfigEnvVarPrefix + "ENV_"
+
+// MgwClusterEnvVarPrefix is the prefix of the environment variables which define Microgateway clusters.
+// e.g. APICTL_MG_DEV_ADAPTER sets mgw-clusters.dev.adapter
+const MgwClusterEnvVarPrefix = ConfigEnvVarPrefix + "MG_"
+
+// LocalConfigFileName is the project local config file merged over the main_config.yaml. It is looked up from the
+// current directory up to the root directory.
+const LocalConfigFileName = ".apictl.yaml"
+
+// ConfigValue is a value of the resolved configuration and where it came from
+type ConfigValue struct {
+	Key    string      `json:"key" yaml:"key"`
+	Value  interface{} `json:"value" yaml:"value"`
+	Source string      `json:"source" yaml:"source"`
+}
+
+// configField is a field of a config struct which can be set from an environment variable
+type configField struct {
+	path []string
+	kind reflect.Kind
+}
+
+// configLayer is a configuration source merged over the previous ones
+type configLayer struct {
+	source string
+	values map[string]interface{}
+}
+
+// ResolveMainConfig merges the project local .apictl.yaml and the APICTL_* environment variables over the content
+// of the main_config.yaml
+// @param filePath : Path to the main_config.yaml
+// @param data : Content of the main_config.yaml
+// @return []byte : merged configuration in yaml
+// @return []ConfigValue : values of the merged configuration and their sources
+// @return error
+func ResolveMainConfig(filePath string, data []byte) ([]byte, []ConfigValue, error) {
+	layers := []configLayer{}
+
+	userValues, err := parseConfigLayer(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", filePath, err)
+	}
+	layers = append(layers, configLayer{source: filePath, values: userValues})
+
+	if localConfigFilePath := FindLocalConfigFile(CurrentDir); localConfigFilePath != "" {
+		localData, err := ioutil.ReadFile(localConfigFilePath)
+		if err != nil {
+			return nil, nil, err
+		}
+		localValues, err := parseConfigLayer(localData)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", localConfigFilePath, err)
+		}
+		layers = append(layers, configLayer{source: localConfigFilePath, values: localValues})
+	}
+
+	layers = append(layers, envVarConfigLayers(os.Environ())...)
+
+	merged := map[string]interface{}{}
+	sources := map[string]string{}
+	for _, layer := range layers {
+		mergeConfigValues(merged, layer.values, layer.source, "", sources)
+	}
+
+	setDefaultTokenEndpoints(merged, sources)
+
+	resolved, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var values []ConfigValue
+	for key, source := range sources {
+		values = append(values, ConfigValue{Key: key, Value: lookupConfigValue(merged, key), Source: source})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Key < values[j].Key
+	})
+	return resolved, values, nil
+}
+
+// GetResolvedConfigValues returns the values of the resolved configuration and where each of them came from
+// @param filePath : Path to the main_config.yaml
+// @return []ConfigValue : values of the merged configuration and their sources
+// @return error
+func GetResolvedConfigValues(filePath string) ([]ConfigValue, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	_, values, err := ResolveMainConfig(filePath, data)
+	return values, err
+}
+
+// FindLocalConfigFile looks up the .apictl.yaml from the given directory up to the root directory
+// @param dir : Directory to start from
+// @return string : path to the .apictl.yaml or "" if it is not found
+func FindLocalConfigFile(dir string) string {
+	if dir == "" {
+		return ""
+	}
+	for {
+		filePath := filepath.Join(dir, LocalConfigFileName)
+		if info, err := os.Stat(filePath); err == nil && !info.IsDir() {
+			return filePath
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// parseConfigLayer reads yaml into nested maps with string keys
+func parseConfigLayer(data []byte) (map[string]interface{}, error) {
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	if values == nil {
+		return map[string]interface{}{}, nil
+	}
+	return normalizeConfigValue(values).(map[string]interface{}), nil
+}
+
+// normalizeConfigValue converts the map[interface{}]interface{} of yaml.v2 to map[string]interface{}
+func normalizeConfigValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = normalizeConfigValue(val)
+		}
+		return m
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = normalizeConfigValue(val)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = normalizeConfigValue(val)
+		}
+		return v
+	}
+	return value
+}
+
+// mergeConfigValues merges src over dst and records the source of each value which is set
+func mergeConfigValues(dst, src map[string]interface{}, source, prefix string, sources map[string]string) {
+	for key, value := range src {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeConfigValues(dstMap, srcMap, source, path, sources)
+			continue
+		}
+		// a value replaced by another value or a map
+		for existing := range sources {
+			if existing == path || strings.HasPrefix(existing, path+".") {
+				delete(sources, existing)
+			}
+		}
+		if srcIsMap {
+			dstMap = map[string]interface{}{}
+			dst[key] = dstMap
+			mergeConfigValues(dstMap, srcMap, source, path, sources)
+			continue
+		}
+		dst[key] = value
+		sources[path] = source
+	}
+}
+
+// setDefaultTokenEndpoints sets the token endpoint of the environments defined without one from the APIM or the
+// publisher endpoint, as done when adding an environment
+func setDefaultTokenEndpoints(merged map[string]interface{}, sources map[string]string) {
+	envs, _ := merged["environments"].(map[string]interface{})
+	for name, value := range envs {
+		env, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if token, _ := env["token"].(string); token != "" {
+			continue
+		}
+		for _, key := range []string{"apim", "publisher"} {
+			endpoint, _ := env[key].(string)
+			if endpoint == "" {
+				continue
+			}
+			if key == "apim" {
+				env["token"] = GetTokenEndPointFromAPIMEndpoint(endpoint)
+			} else {
+				env["token"] = GetTokenEndPointFromPublisherEndpoint(endpoint)
+			}
+			sources["environments."+name+".token"] = sources["environments."+name+"."+key]
+			break
+		}
+	}
+}
+
+// lookupConfigValue returns the value of a dot separated key
+func lookupConfigValue(values map[string]interface{}, key string) interface{} {
+	var value interface{} = values
+	for _, part := range strings.Split(key, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[part]
+	}
+	return value
+}
+
+// envVarConfigLayers returns the layers defined by the APICTL_* environment variables in the given environment
+func envVarConfigLayers(environ []string) []configLayer {
+	configFields := collectConfigFields(reflect.TypeOf(Config{}), nil)
+	envFields := collectConfigFields(reflect.TypeOf(EnvEndpoints{}), nil)
+	mgwFields := collectConfigFields(reflect.TypeOf(MgwEndpoints{}), nil)
+
+	var layers []configLayer
+	sort.Strings(environ)
+	for _, entry := range environ {
+		i := strings.Index(entry, "=")
+		if i < 0 || !strings.HasPrefix(entry, ConfigEnvVarPrefix) {
+			continue
+		}
+		name, value := entry[:i], entry[i+1:]
+
+		var path []string
+		var field *configField
+		if strings.HasPrefix(name, EnvironmentEnvVarPrefix) {
+			env, f := matchEnvVarField(strings.TrimPrefix(name, EnvironmentEnvVarPrefix), envFields)
+			if f != nil {
+				path, field = append([]string{"environments", env}, f.path...), f
+			}
+		} else if strings.HasPrefix(name, MgwClusterEnvVarPrefix) {
+			env, f := matchEnvVarField(strings.TrimPrefix(name, MgwClusterEnvVarPrefix), mgwFields)
+			if f != nil {
+				path, field = append([]string{"mgw-clusters", env}, f.path...), f
+			}
+		} else {
+			for j := range configFields {
+				if envVarSuffixOf(configFields[j].path) == strings.TrimPrefix(name, ConfigEnvVarPrefix) {
+					path, field = append([]string{"config"}, configFields[j].path...), &configFields[j]
+					break
+				}
+			}
+		}
+		if field == nil {
+			continue
+		}
+
+		typedValue, err := convertConfigValue(value, field.kind)
+		if err != nil {
+			Logln(LogPrefixWarning+"Ignoring "+name+":", err)
+			continue
+		}
+		values := map[string]interface{}{}
+		current := values
+		for _, part := range path[:len(path)-1] {
+			next := map[string]interface{}{}
+			current[part] = next
+			current = next
+		}
+		current[path[len(path)-1]] = typedValue
+		layers = append(layers, configLayer{source: "$" + name, values: values})
+	}
+	return layers
+}
+
+// matchEnvVarField splits <NAME>_<FIELD> of an environment variable into the lower case name of the environment and
+// the field. The longest matching field is preferred.
+func matchEnvVarField(nameAndField string, fields []configField) (string, *configField) {
+	var match *configField
+	var env string
+	for i := range fields {
+		suffix := "_" + envVarSuffixOf(fields[i].path)
+		if strings.HasSuffix(nameAndField, suffix) && len(nameAndField) > len(suffix) &&
+			(match == nil || len(envVarSuffixOf(fields[i].path)) > len(envVarSuffixOf(match.path))) {
+			match = &fields[i]
+			env = strings.ToLower(strings.TrimSuffix(nameAndField, suffix))
+		}
+	}
+	return env, match
+}
+
+// envVarSuffixOf returns the environment variable name of a field path. e.g. connection.ca_cert -> CONNECTION_CA_CERT
+func envVarSuffixOf(path []string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(strings.Join(path, "_")))
+}
+
+// collectConfigFields returns the fields of a config struct by their yaml names
+func collectConfigFields(t reflect.Type, prefix []string) []configField {
+	var fields []configField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("yaml"), ",")[0]
+		if name == "-" || name == "" {
+			continue
+		}
+		path := append(append([]string{}, prefix...), name)
+		if f.Type.Kind() == reflect.Struct {
+			fields = append(fields, collectConfigFields(f.Type, path)...)
+			continue
+		}
+		fields = append(fields, configField{path: path, kind: f.Type.Kind()})
+	}
+	return fields
+}
+
+// convertConfigValue converts the value of an environment variable to the type of the field
+func convertConfigValue(value string, kind reflect.Kind) (interface{}, error) {
+	switch kind {
+	case reflect.Int, reflect.Int64, reflect.Int32:
+		return strconv.Atoi(strings.TrimSpace(value))
+	case reflect.Bool:
+		return strconv.ParseBool(strings.TrimSpace(value))
+	}
+	return value, nil
+}
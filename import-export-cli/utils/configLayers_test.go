@@ -0,0 +1,122 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testUserConfig = `config:
+  http_request_timeout: 10000
+  export_directory: /tmp/exported
+environments:
+  dev:
+    apim: https://localhost:9443
+    token: https://localhost:9443/oauth2/token
+`
+
+func sourceOf(values []ConfigValue, key string) string {
+	for _, v := range values {
+		if v.Key == key {
+			return v.Source
+		}
+	}
+	return ""
+}
+
+func TestResolveMainConfigWithEnvVars(t *testing.T) {
+	os.Setenv("APICTL_HTTP_REQUEST_TIMEOUT", "30000")
+	os.Setenv("APICTL_TLS_RENEGOTIATION_MODE", TLSRenegotiationOnce)
+	os.Setenv("APICTL_ENV_CI_APIM", "https://ci:9443")
+	os.Setenv("APICTL_ENV_DEV_CONNECTION_CA_CERT", "/tmp/ca.pem")
+	os.Setenv("APICTL_MG_EDGE_ADAPTER", "https://mg:9843")
+	defer func() {
+		for _, name := range []string{"APICTL_HTTP_REQUEST_TIMEOUT", "APICTL_TLS_RENEGOTIATION_MODE",
+			"APICTL_ENV_CI_APIM", "APICTL_ENV_DEV_CONNECTION_CA_CERT", "APICTL_MG_EDGE_ADAPTER"} {
+			os.Unsetenv(name)
+		}
+	}()
+
+	data, values, err := ResolveMainConfig("main_config.yaml", []byte(testUserConfig))
+	assert.Nil(t, err)
+
+	var mainConfig MainConfig
+	assert.Nil(t, mainConfig.ParseMainConfigFromFile(data))
+	assert.Equal(t, 30000, mainConfig.Config.HttpRequestTimeout)
+	assert.Equal(t, TLSRenegotiationOnce, mainConfig.Config.TLSRenegotiationMode)
+	assert.Equal(t, "/tmp/exported", mainConfig.Config.ExportDirectory)
+	assert.Equal(t, "https://ci:9443/oauth2/token", mainConfig.Environments["ci"].TokenEndpoint)
+	assert.Equal(t, "https://localhost:9443", mainConfig.Environments["dev"].ApiManagerEndpoint)
+	assert.Equal(t, "/tmp/ca.pem", mainConfig.Environments["dev"].Connection.CACertFile)
+	assert.Equal(t, "https://mg:9843", mainConfig.MgwAdapterEnvs["edge"].AdapterEndpoint)
+
+	assert.Equal(t, "$APICTL_HTTP_REQUEST_TIMEOUT", sourceOf(values, "config.http_request_timeout"))
+	assert.Equal(t, "main_config.yaml", sourceOf(values, "config.export_directory"))
+	assert.Equal(t, "$APICTL_ENV_CI_APIM", sourceOf(values, "environments.ci.token"))
+}
+
+func TestResolveMainConfigWithLocalConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	subDir := filepath.Join(dir, "apis")
+	_ = os.MkdirAll(subDir, os.ModePerm)
+	localConfig := "config:\n  http_request_timeout: 5000\nenvironments:\n  dev:\n    mi: https://localhost:9164\n"
+	_ = ioutil.WriteFile(filepath.Join(dir, LocalConfigFileName), []byte(localConfig), 0644)
+
+	currentDir := CurrentDir
+	CurrentDir = subDir
+	defer func() { CurrentDir = currentDir }()
+
+	data, values, err := ResolveMainConfig("main_config.yaml", []byte(testUserConfig))
+	assert.Nil(t, err)
+
+	var mainConfig MainConfig
+	assert.Nil(t, mainConfig.ParseMainConfigFromFile(data))
+	assert.Equal(t, 5000, mainConfig.Config.HttpRequestTimeout)
+	assert.Equal(t, "https://localhost:9443", mainConfig.Environments["dev"].ApiManagerEndpoint)
+	assert.Equal(t, "https://localhost:9164", mainConfig.Environments["dev"].MiManagementEndpoint)
+	assert.Equal(t, filepath.Join(dir, LocalConfigFileName), sourceOf(values, "environments.dev.mi"))
+}
+
+func TestInvalidConfigEnvVarIsIgnored(t *testing.T) {
+	os.Setenv("APICTL_HTTP_REQUEST_TIMEOUT", "soon")
+	defer os.Unsetenv("APICTL_HTTP_REQUEST_TIMEOUT")
+
+	data, _, err := ResolveMainConfig("main_config.yaml", []byte(testUserConfig))
+	assert.Nil(t, err)
+
+	var mainConfig MainConfig
+	assert.Nil(t, mainConfig.ParseMainConfigFromFile(data))
+	assert.Equal(t, 10000, mainConfig.Config.HttpRequestTimeout)
+}
+
+func TestConfigDirFromArgs(t *testing.T) {
+	assert.Equal(t, "/ci/apictl", configDirFromArgs([]string{"get", "apis", "--config", "/ci/apictl"}))
+	assert.Equal(t, "/ci/apictl", configDirFromArgs([]string{"--config=/ci/apictl", "get", "envs"}))
+	assert.Equal(t, "", configDirFromArgs([]string{"get", "envs", "--", "--config", "/ci/apictl"}))
+	assert.Equal(t, "", configDirFromArgs([]string{"get", "envs", "--config"}))
+}
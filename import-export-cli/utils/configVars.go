@@ -28,6 +28,9 @@ import (
 )
 
 var HttpRequestTimeout = DefaultHttpRequestTimeout
+var HttpRetryCount = DefaultHttpRetryCount
+var HttpRetryWaitTime = DefaultHttpRetryWaitTime
+var HttpRetryMaxWaitTime = DefaultHttpRetryMaxWaitTime
 var Insecure bool
 var ExportDirectory string
 
@@ -38,7 +41,10 @@ var TLSRenegotiationMode = tls.RenegotiateNever
 // @param mainConfigFilePath : Path to file where Configuration details are stored
 // @return error
 func SetConfigVars(mainConfigFilePath string) error {
-	mainConfig := GetMainConfigFromFile(mainConfigFilePath)
+	mainConfig, err := ReadMainConfig(mainConfigFilePath)
+	if err != nil {
+		return err
+	}
 	Logln(LogPrefixInfo + " reading '" + mainConfigFilePath + "'")
 
 	// validate config vars
@@ -57,6 +63,8 @@ func SetConfigVars(mainConfigFilePath string) error {
 	HttpRequestTimeout = mainConfig.Config.HttpRequestTimeout
 	Logln(LogPrefixInfo + "Setting HttpTimeoutRequest to " + fmt.Sprint(mainConfig.Config.HttpRequestTimeout))
 
+	setHttpRetryConfigs(mainConfig)
+
 	ExportDirectory = mainConfig.Config.ExportDirectory
 	Logln(LogPrefixInfo + "Setting ExportDirectory " + mainConfig.Config.ExportDirectory)
 
@@ -84,6 +92,23 @@ func IsValid(fp string) bool {
 	return false
 }
 
+// setHttpRetryConfigs sets retry configs of the HTTP client, defaults are used for the configs not given
+func setHttpRetryConfigs(mainConfig *MainConfig) {
+	if mainConfig.Config.HttpRetryCount < 0 {
+		HttpRetryCount = 0
+	} else if mainConfig.Config.HttpRetryCount > 0 {
+		HttpRetryCount = mainConfig.Config.HttpRetryCount
+	}
+	if mainConfig.Config.HttpRetryWaitTime > 0 {
+		HttpRetryWaitTime = mainConfig.Config.HttpRetryWaitTime
+	}
+	if mainConfig.Config.HttpRetryMaxWaitTime > 0 {
+		HttpRetryMaxWaitTime = mainConfig.Config.HttpRetryMaxWaitTime
+	}
+	Logln(fmt.Sprintf("%sSetting HTTP retries to %d with wait time %dms (max %dms)", LogPrefixInfo,
+		HttpRetryCount, HttpRetryWaitTime, HttpRetryMaxWaitTime))
+}
+
 func setTLSRenegotiationMode(mainConfig *MainConfig) {
 	modeMap := map[string]tls.RenegotiationSupport{
 		TLSRenegotiationOnce:   tls.RenegotiateOnceAsClient,
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -20,3 +21,24 @@ func TestIsValid2(t *testing.T) {
 	IsValid(filePath)
 	os.Remove(filePath)
 }
+
+func TestSetConfigVarsReturnsErrorOfInvalidConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apictl-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	missingFilePath := filepath.Join(dir, "main_config.yaml")
+	if err = SetConfigVars(missingFilePath); !os.IsNotExist(err) {
+		t.Errorf("got error %v for a missing config, want a not exist error", err)
+	}
+
+	invalidFilePath := filepath.Join(dir, "invalid_config.yaml")
+	if err = ioutil.WriteFile(invalidFilePath, []byte("config: ["), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = SetConfigVars(invalidFilePath); err == nil {
+		t.Error("got no error for an invalid config, want a parse error")
+	}
+}
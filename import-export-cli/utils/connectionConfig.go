@@ -0,0 +1,242 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// PrepareConnectionConfig validates the TLS and proxy settings of the environment before writing them to the config
+// file. A PKCS#12 client certificate (.p12 or .pfx) is converted to PEM files in the directory
+func PrepareConnectionConfig(conn *ConnectionConfig, clientCertDir string) error {
+	if conn.CACertFile != "" {
+		if !IsFileExist(conn.CACertFile) {
+			return errors.New("CA certificate file not found: " + conn.CACertFile)
+		}
+		conn.CACertFile = absPath(conn.CACertFile)
+	}
+
+	if conn.ClientCertFile != "" {
+		if !IsFileExist(conn.ClientCertFile) {
+			return errors.New("client certificate file not found: " + conn.ClientCertFile)
+		}
+		if isPKCS12File(conn.ClientCertFile) {
+			if conn.ClientKeyFile != "" {
+				return errors.New("client key should not be given with a PKCS#12 client certificate")
+			}
+			if err := convertPKCS12ToPEM(conn, clientCertDir); err != nil {
+				return err
+			}
+		}
+		conn.ClientCertFile = absPath(conn.ClientCertFile)
+		if conn.ClientKeyFile != "" {
+			conn.ClientKeyFile = absPath(conn.ClientKeyFile)
+		}
+		if _, err := loadClientCertificate(conn); err != nil {
+			return fmt.Errorf("invalid client certificate: %w", err)
+		}
+	} else if conn.ClientKeyFile != "" {
+		return errors.New("client key is given without a client certificate")
+	}
+
+	if conn.Proxy != "" {
+		proxyUrl, err := url.Parse(conn.Proxy)
+		if err != nil || proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+			return errors.New("invalid proxy URL: " + conn.Proxy)
+		}
+	}
+	return nil
+}
+
+// isPKCS12File returns whether the file is a PKCS#12 bundle by the file extension
+func isPKCS12File(file string) bool {
+	ext := strings.ToLower(filepath.Ext(file))
+	return ext == ".p12" || ext == ".pfx"
+}
+
+// convertPKCS12ToPEM writes the certificates and the key of the PKCS#12 client certificate to PEM files in the
+// directory and sets them as the client certificate and key
+func convertPKCS12ToPEM(conn *ConnectionConfig, dir string) error {
+	data, err := ioutil.ReadFile(conn.ClientCertFile)
+	if err != nil {
+		return err
+	}
+	blocks, err := pkcs12.ToPEM(data, conn.ClientCertPassword)
+	if err != nil {
+		return fmt.Errorf("error reading PKCS#12 client certificate %s: %w", conn.ClientCertFile, err)
+	}
+
+	var certs, keys []byte
+	for _, block := range blocks {
+		// drop PKCS#12 attributes such as the friendly name
+		block.Headers = nil
+		if block.Type == "PRIVATE KEY" {
+			keys = append(keys, pem.EncodeToMemory(block)...)
+		} else {
+			certs = append(certs, pem.EncodeToMemory(block)...)
+		}
+	}
+
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	if err = ioutil.WriteFile(certFile, certs, 0600); err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(keyFile, keys, 0600); err != nil {
+		return err
+	}
+	conn.ClientCertFile, conn.ClientKeyFile = certFile, keyFile
+	return nil
+}
+
+// loadClientCertificate loads the client certificate and the key, the key is read from the certificate file if the
+// key file is not given
+func loadClientCertificate(conn *ConnectionConfig) (tls.Certificate, error) {
+	keyFile := conn.ClientKeyFile
+	if keyFile == "" {
+		keyFile = conn.ClientCertFile
+	}
+	return tls.LoadX509KeyPair(conn.ClientCertFile, keyFile)
+}
+
+// GetTlsConfigOfConnection returns the TLS config with the trusted certificates in the certs directory and the TLS
+// settings of the environment
+func GetTlsConfigOfConnection(conn *ConnectionConfig) (*tls.Config, error) {
+	if Insecure || conn.Insecure {
+		return &tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
+			Renegotiation: TLSRenegotiationMode, ServerName: conn.ServerName}, nil
+	}
+
+	tlsConfig := GetTlsConfigWithCertificate()
+	tlsConfig.ServerName = conn.ServerName
+	if conn.CACertFile != "" {
+		data, err := ioutil.ReadFile(conn.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(data) {
+			return nil, errors.New("no PEM certificates found in " + conn.CACertFile)
+		}
+	}
+	if conn.ClientCertFile != "" {
+		cert, err := loadClientCertificate(conn)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// getConnectionConfigOfHost returns the TLS and proxy settings of the environment with an endpoint in the host, given
+// as "<scheme>://<host>:<port>". Environments are checked by the name if more than one environment is in the host
+func getConnectionConfigOfHost(host string) *ConnectionConfig {
+	mainConfig := GetMainConfigFromFileSilently(MainConfigFilePath)
+
+	envNames := make([]string, 0, len(mainConfig.Environments))
+	for name := range mainConfig.Environments {
+		envNames = append(envNames, name)
+	}
+	sort.Strings(envNames)
+	for _, name := range envNames {
+		env := mainConfig.Environments[name]
+		endpoints := []string{env.ApiManagerEndpoint, env.PublisherEndpoint, env.DevPortalEndpoint,
+			env.RegistrationEndpoint, env.AdminEndpoint, env.TokenEndpoint, env.MiManagementEndpoint}
+		if (env.Connection != ConnectionConfig{}) && containsHost(endpoints, host) {
+			return &env.Connection
+		}
+	}
+
+	mgwEnvNames := make([]string, 0, len(mainConfig.MgwAdapterEnvs))
+	for name := range mainConfig.MgwAdapterEnvs {
+		mgwEnvNames = append(mgwEnvNames, name)
+	}
+	sort.Strings(mgwEnvNames)
+	for _, name := range mgwEnvNames {
+		env := mainConfig.MgwAdapterEnvs[name]
+		if (env.Connection != ConnectionConfig{}) && containsHost([]string{env.AdapterEndpoint}, host) {
+			return &env.Connection
+		}
+	}
+	return &ConnectionConfig{}
+}
+
+// containsHost returns whether any of the endpoints is in the host, given as "<scheme>://<host>:<port>"
+func containsHost(endpoints []string, host string) bool {
+	for _, endpoint := range endpoints {
+		if endpoint != "" && hostOfUrl(endpoint) == host {
+			return true
+		}
+	}
+	return false
+}
+
+// hostOfUrl returns the scheme and the host of the URL as "<scheme>://<host>:<port>"
+func hostOfUrl(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return rawUrl
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// isNoProxyHost returns whether the host name is in the comma separated no proxy list of hosts, domains (".corp.com"
+// or "*.corp.com") and IPs. "*" matches all hosts
+func isNoProxyHost(hostname, noProxy string) bool {
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+		switch {
+		case entry == "":
+			continue
+		case entry == "*", entry == strings.ToLower(hostname):
+			return true
+		case strings.HasPrefix(entry, "*."), strings.HasPrefix(entry, "."):
+			domain := strings.TrimPrefix(entry, "*")
+			if strings.HasSuffix(strings.ToLower(hostname), domain) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
@@ -0,0 +1,189 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeClientCertificate writes a self-signed client certificate and the key as PEM files to the directory
+func writeClientCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	if err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestGetHttpClientWithEnvironmentTLSSettings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "connection")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err = ioutil.WriteFile(caFile, caCert, 0600); err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := writeClientCertificate(t, dir)
+
+	conn := ConnectionConfig{CACertFile: caFile, ClientCertFile: certFile, ClientKeyFile: keyFile,
+		ServerName: "example.com"}
+	if err = PrepareConnectionConfig(&conn, filepath.Join(dir, "client-certs")); err != nil {
+		t.Fatal(err)
+	}
+	mainConfig := &MainConfig{
+		Config: Config{HttpRequestTimeout: DefaultHttpRequestTimeout, ExportDirectory: dir},
+		Environments: map[string]EnvEndpoints{"prod": {ApiManagerEndpoint: server.URL,
+			TokenEndpoint: server.URL + "/oauth2/token", Connection: conn}},
+	}
+
+	mainConfigFilePath := MainConfigFilePath
+	MainConfigFilePath = filepath.Join(dir, MainConfigFileName)
+	WriteConfigFile(mainConfig, MainConfigFilePath)
+	ResetHttpClients()
+	defer func() {
+		MainConfigFilePath = mainConfigFilePath
+		ResetHttpClients()
+	}()
+
+	resp, err := InvokeGETRequest(context.Background(), server.URL+"/api/am/publisher/v1/apis", make(map[string]string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Errorf("got status %d, want 200 with the client certificate", resp.StatusCode())
+	}
+}
+
+func TestGetHttpClientWithInvalidTLSSettings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "connection")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	if err = ioutil.WriteFile(caFile, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	mainConfig := &MainConfig{
+		Config: Config{HttpRequestTimeout: DefaultHttpRequestTimeout, ExportDirectory: dir},
+		Environments: map[string]EnvEndpoints{"prod": {ApiManagerEndpoint: "https://localhost:9443",
+			Connection: ConnectionConfig{CACertFile: caFile}}},
+	}
+
+	mainConfigFilePath := MainConfigFilePath
+	MainConfigFilePath = filepath.Join(dir, MainConfigFileName)
+	WriteConfigFile(mainConfig, MainConfigFilePath)
+	ResetHttpClients()
+	defer func() {
+		MainConfigFilePath = mainConfigFilePath
+		ResetHttpClients()
+	}()
+
+	_, err = InvokeGETRequest(context.Background(), "https://localhost:9443/api/am/publisher/v3/apis",
+		make(map[string]string))
+	if err == nil || !strings.Contains(err.Error(), "no PEM certificates found in "+caFile) {
+		t.Errorf("got error %v, want the error reading the CA certificate", err)
+	}
+}
+
+func TestPrepareConnectionConfigInvalid(t *testing.T) {
+	tests := []ConnectionConfig{
+		{CACertFile: "does-not-exist.pem"},
+		{ClientKeyFile: "client.key"},
+		{Proxy: "proxy.corp.com"},
+	}
+	for _, conn := range tests {
+		if err := PrepareConnectionConfig(&conn, os.TempDir()); err == nil {
+			t.Errorf("invalid connection config %+v is accepted", conn)
+		}
+	}
+}
+
+func TestIsNoProxyHost(t *testing.T) {
+	noProxy := "localhost, .corp.com,*.internal.io,10.0.0.1:9443"
+	tests := map[string]bool{
+		"localhost":       true,
+		"apim.corp.com":   true,
+		"gw.internal.io":  true,
+		"10.0.0.1":        true,
+		"apim.example.io": false,
+		"corp.com.evil":   false,
+	}
+	for host, want := range tests {
+		if got := isNoProxyHost(host, noProxy); got != want {
+			t.Errorf("isNoProxyHost(%s) = %v, want %v", host, got, want)
+		}
+	}
+	if !isNoProxyHost("apim.example.io", "*") {
+		t.Errorf("* should match all hosts")
+	}
+}
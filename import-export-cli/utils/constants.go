@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
+	"strings"
 )
 
 const ProjectName = "apictl"
@@ -31,10 +32,20 @@ var CurrentDir, _ = os.Getwd()
 
 const ConfigDirName = ".wso2apictl"
 
+// ConfigDirEnvVar is the environment variable of the directory to keep the configuration in instead of $HOME
+const ConfigDirEnvVar = "APICTL_CONFIG_DIR"
+
+// ConfigDirFlag is the global flag of the directory to keep the configuration in, which takes precedence over
+// APICTL_CONFIG_DIR. It is read before the flags are parsed since the configuration is loaded on start up.
+const ConfigDirFlag = "config"
+
 var HomeDirectory = getConfigHomeDir()
 
 func getConfigHomeDir() string {
-	value := os.Getenv("APICTL_CONFIG_DIR")
+	value := configDirFromArgs(os.Args[1:])
+	if len(value) == 0 {
+		value = os.Getenv(ConfigDirEnvVar)
+	}
 	if len(value) == 0 {
 		value, err := os.UserHomeDir()
 		if len(value) == 0 || err != nil {
@@ -50,6 +61,22 @@ func getConfigHomeDir() string {
 	return value
 }
 
+// configDirFromArgs returns the value of --config in the command line arguments
+func configDirFromArgs(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == "--"+ConfigDirFlag && i+1 < len(args) {
+			return args[i+1]
+		}
+		if strings.HasPrefix(arg, "--"+ConfigDirFlag+"=") {
+			return strings.TrimPrefix(arg, "--"+ConfigDirFlag+"=")
+		}
+	}
+	return ""
+}
+
 var ConfigDirPath = filepath.Join(HomeDirectory, ConfigDirName)
 
 const LocalCredentialsDirectoryName = ".wso2apictl.local"
@@ -70,6 +97,7 @@ const ExportedApiProductsDirName = "api-products"
 const ExportedAppsDirName = "apps"
 const ExportedMigrationArtifactsDirName = "migration"
 const CertificatesDirName = "certs"
+const ClientCertificatesDirName = "client-certs"
 
 const (
 	InitProjectDefinitions              = "Definitions"
@@ -91,6 +119,12 @@ const DeploymentCertificatesDirectory = "certificates"
 
 var DefaultExportDirPath = filepath.Join(ConfigDirPath, DefaultExportDirName)
 var DefaultCertDirPath = filepath.Join(ConfigDirPath, CertificatesDirName)
+var DefaultClientCertDirPath = filepath.Join(ConfigDirPath, ClientCertificatesDirName)
+
+// Client certificates converted from PKCS#12 are kept in a directory per environment, separately for the API Manager
+// and the microgateway environments as their names may collide
+var APIMClientCertDirPath = filepath.Join(DefaultClientCertDirPath, "apim")
+var MgwClientCertDirPath = filepath.Join(DefaultClientCertDirPath, "mg")
 
 const defaultApiApplicationImportExportSuffix = "api/am/admin/v3"
 const defaultPublisherApiImportExportSuffix = "api/am/publisher/v3"
@@ -127,6 +161,7 @@ const HeaderAuthorization = "Authorization"
 const HeaderContentType = "Content-Type"
 const HeaderConnection = "Connection"
 const HeaderAccept = "Accept"
+const HeaderRetryAfter = "Retry-After"
 const HeaderProduces = "Produces"
 const HeaderConsumes = "Consumes"
 const HeaderContentEncoding = "Content-Encoding"
@@ -147,6 +182,7 @@ const TokenTypeForRevocation = "&token_type_hint=access_token"
 const LogPrefixInfo = "[INFO]: "
 const LogPrefixWarning = "[WARN]: "
 const LogPrefixError = "[ERROR]: "
+const LogPrefixTrace = "[TRACE]: "
 
 // String Constants
 const SearchAndTag = "&"
@@ -154,6 +190,9 @@ const SearchAndTag = "&"
 // Other
 const DefaultTokenValidityPeriod = 3600
 const DefaultHttpRequestTimeout = 10000
+const DefaultHttpRetryCount = 3
+const DefaultHttpRetryWaitTime = 1000     // milliseconds
+const DefaultHttpRetryMaxWaitTime = 30000 // milliseconds
 
 // TLSRenegotiationNever : never negotiate
 const TLSRenegotiationNever = "never"
@@ -268,6 +307,8 @@ const MiManagementMessageProcessorResource = "message-processors"
 const MiManagementTemplateResource = "templates"
 const MiManagementConnectorResource = "connectors"
 const MiManagementMessageStoreResource = "message-stores"
+const MiManagementMessageStoreMessagesResource = "messages"
+const MiManagementMessageProcessorHeadMessageResource = "head-message"
 const MiManagementLocalEntrieResource = "local-entries"
 const MiManagementSequenceResource = "sequences"
 const MiManagementTaskResource = "tasks"
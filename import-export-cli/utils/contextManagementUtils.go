@@ -0,0 +1,182 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// ContextEnvVar is the environment variable which overrides the current context set in the main_config.yaml
+const ContextEnvVar = "APICTL_CONTEXT"
+
+// GetCurrentContextName returns the name of the context to be used. APICTL_CONTEXT is preferred over the
+// current-context of the main_config.yaml
+// @param filePath : Path to file where contexts are stored
+// @return string : name of the current context or "" if none is set
+func GetCurrentContextName(filePath string) string {
+	if name := strings.TrimSpace(os.Getenv(ContextEnvVar)); name != "" {
+		return name
+	}
+	mainConfig := GetMainConfigFromFileSilently(filePath)
+	if mainConfig == nil {
+		return ""
+	}
+	return mainConfig.CurrentContext
+}
+
+// GetCurrentContext returns the context to be used by the commands which are run without an environment
+// @param filePath : Path to file where contexts are stored
+// @return string : name of the current context
+// @return *Context : the current context or nil if none is set
+// @return error : if the current context is not defined
+func GetCurrentContext(filePath string) (string, *Context, error) {
+	name := GetCurrentContextName(filePath)
+	if name == "" {
+		return "", nil, nil
+	}
+	context, err := GetContext(name, filePath)
+	if err != nil {
+		return name, nil, err
+	}
+	return name, context, nil
+}
+
+// GetContext returns the context with the given name
+// @param name : Name of the context
+// @param filePath : Path to file where contexts are stored
+// @return *Context : the context
+// @return error : if the context is not defined
+func GetContext(name, filePath string) (*Context, error) {
+	mainConfig := GetMainConfigFromFileSilently(filePath)
+	if mainConfig != nil {
+		if context, ok := mainConfig.Contexts[name]; ok {
+			return &context, nil
+		}
+	}
+	return nil, NewMissingError("context '" + name + "' not found in " + filePath)
+}
+
+// ContextExistsInMainConfigFile
+// @param name : Name of the context
+// @param filePath : Path to file where contexts are stored
+// @return bool : true if 'name' exists in the main_config.yaml and false otherwise
+func ContextExistsInMainConfigFile(name, filePath string) bool {
+	_, err := GetContext(name, filePath)
+	return err == nil
+}
+
+// SetContextInMainConfigFile adds or replaces a context in the main_config.yaml
+// @param name : Name of the context
+// @param context : Environments and defaults of the context
+// @param filePath : Path to file where contexts are stored
+func SetContextInMainConfigFile(name string, context Context, filePath string) {
+	mainConfig := ReadMainConfigFile(filePath)
+	if mainConfig.Contexts == nil {
+		mainConfig.Contexts = make(map[string]Context)
+	}
+	mainConfig.Contexts[name] = context
+	WriteConfigFile(mainConfig, filePath)
+}
+
+// SetCurrentContextInMainConfigFile changes the current context of the main_config.yaml
+// @param name : Name of the context or "" to unset the current context
+// @param filePath : Path to file where contexts are stored
+// @return error : if the context is not defined
+func SetCurrentContextInMainConfigFile(name, filePath string) error {
+	if name != "" && !ContextExistsInMainConfigFile(name, filePath) {
+		return NewMissingError("context '" + name + "' not found in " + filePath)
+	}
+	mainConfig := ReadMainConfigFile(filePath)
+	mainConfig.CurrentContext = name
+	WriteConfigFile(mainConfig, filePath)
+	return nil
+}
+
+// RemoveContextFromMainConfigFile removes a context from the main_config.yaml. The current context is unset if it
+// is the removed one
+// @param name : Name of the context
+// @param filePath : Path to file where contexts are stored
+// @return error : if the context is not defined
+func RemoveContextFromMainConfigFile(name, filePath string) error {
+	if !ContextExistsInMainConfigFile(name, filePath) {
+		return NewMissingError("context '" + name + "' not found in " + filePath)
+	}
+	mainConfig := ReadMainConfigFile(filePath)
+	delete(mainConfig.Contexts, name)
+	if mainConfig.CurrentContext == name {
+		mainConfig.CurrentContext = ""
+	}
+	WriteConfigFile(mainConfig, filePath)
+	return nil
+}
+
+// RemoveEnvFromContextsInMainConfigFile unsets the API Manager and Micro Integrator environment of the contexts which
+// refer to the given environment, e.g. once the environment is removed
+// @param envName : Name of the environment
+// @param filePath : Path to file where contexts are stored
+// @return []string : sorted names of the changed contexts
+func RemoveEnvFromContextsInMainConfigFile(envName, filePath string) []string {
+	return updateContextsInMainConfigFile(filePath, func(context *Context) bool {
+		changed := false
+		if context.APIMEnvironment == envName {
+			context.APIMEnvironment = ""
+			changed = true
+		}
+		if context.MIEnvironment == envName {
+			context.MIEnvironment = ""
+			changed = true
+		}
+		return changed
+	})
+}
+
+// RemoveMgwClusterFromContextsInMainConfigFile unsets the microgateway cluster of the contexts which refer to the
+// given microgateway adapter environment, e.g. once the environment is removed
+// @param envName : Name of the microgateway adapter environment
+// @param filePath : Path to file where contexts are stored
+// @return []string : sorted names of the changed contexts
+func RemoveMgwClusterFromContextsInMainConfigFile(envName, filePath string) []string {
+	return updateContextsInMainConfigFile(filePath, func(context *Context) bool {
+		if context.MgwCluster != envName {
+			return false
+		}
+		context.MgwCluster = ""
+		return true
+	})
+}
+
+// updateContextsInMainConfigFile applies the update to each context and writes the main_config.yaml if any of them
+// is changed. Returns the sorted names of the changed contexts
+func updateContextsInMainConfigFile(filePath string, update func(context *Context) bool) []string {
+	mainConfig := ReadMainConfigFile(filePath)
+	var changedContexts []string
+	for name, context := range mainConfig.Contexts {
+		if update(&context) {
+			mainConfig.Contexts[name] = context
+			changedContexts = append(changedContexts, name)
+		}
+	}
+	if len(changedContexts) > 0 {
+		sort.Strings(changedContexts)
+		WriteConfigFile(mainConfig, filePath)
+	}
+	return changedContexts
+}
@@ -0,0 +1,124 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeContextsMainConfig(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "contexts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filePath := filepath.Join(dir, testMainConfigFileName)
+	config := &MainConfig{
+		Environments: map[string]EnvEndpoints{},
+		Contexts: map[string]Context{
+			"dev": {APIMEnvironment: "dev", MIEnvironment: "dev-mi", Tenant: "carbon.super"},
+			"qa":  {APIMEnvironment: "qa", Output: OutputFormatJSON},
+		},
+		CurrentContext: "dev",
+	}
+	WriteConfigFile(config, filePath)
+	return filePath
+}
+
+func TestGetCurrentContext(t *testing.T) {
+	filePath := writeContextsMainConfig(t)
+	defer os.RemoveAll(filepath.Dir(filePath))
+
+	name, context, err := GetCurrentContext(filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, "dev", name)
+	assert.Equal(t, "dev-mi", context.MIEnvironment)
+	assert.Equal(t, "carbon.super", context.Tenant)
+}
+
+func TestGetCurrentContextFromEnvVar(t *testing.T) {
+	filePath := writeContextsMainConfig(t)
+	defer os.RemoveAll(filepath.Dir(filePath))
+	os.Setenv(ContextEnvVar, "qa")
+	defer os.Unsetenv(ContextEnvVar)
+
+	name, context, err := GetCurrentContext(filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, "qa", name)
+	assert.Equal(t, OutputFormatJSON, context.Output)
+
+	os.Setenv(ContextEnvVar, "staging")
+	_, _, err = GetCurrentContext(filePath)
+	assert.NotNil(t, err, "Undefined context should be an error")
+	assert.Equal(t, ExitCodeNotFound, ExitCodeOf(err))
+}
+
+func TestRemoveCurrentContext(t *testing.T) {
+	filePath := writeContextsMainConfig(t)
+	defer os.RemoveAll(filepath.Dir(filePath))
+
+	assert.Nil(t, RemoveContextFromMainConfigFile("dev", filePath))
+	assert.False(t, ContextExistsInMainConfigFile("dev", filePath))
+
+	name, context, err := GetCurrentContext(filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, "", name)
+	assert.Nil(t, context)
+
+	assert.NotNil(t, SetCurrentContextInMainConfigFile("dev", filePath))
+	assert.Nil(t, SetCurrentContextInMainConfigFile("qa", filePath))
+	assert.Equal(t, "qa", GetCurrentContextName(filePath))
+}
+
+func TestRemoveEnvFromContexts(t *testing.T) {
+	filePath := writeContextsMainConfig(t)
+	defer os.RemoveAll(filepath.Dir(filePath))
+	SetContextInMainConfigFile("staging", Context{APIMEnvironment: "qa", MIEnvironment: "dev", MgwCluster: "dev"},
+		filePath)
+
+	assert.Equal(t, []string{"dev", "staging"}, RemoveEnvFromContextsInMainConfigFile("dev", filePath))
+	dev, err := GetContext("dev", filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, Context{MIEnvironment: "dev-mi", Tenant: "carbon.super"}, *dev)
+	staging, err := GetContext("staging", filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, Context{APIMEnvironment: "qa", MgwCluster: "dev"}, *staging)
+	// contexts are kept even when they refer to no environment, as they still hold the other defaults
+	assert.Equal(t, "dev", GetCurrentContextName(filePath))
+
+	assert.Empty(t, RemoveEnvFromContextsInMainConfigFile("prod", filePath))
+}
+
+func TestRemoveMgwClusterFromContexts(t *testing.T) {
+	filePath := writeContextsMainConfig(t)
+	defer os.RemoveAll(filepath.Dir(filePath))
+	SetContextInMainConfigFile("staging", Context{APIMEnvironment: "dev", MgwCluster: "dev"}, filePath)
+
+	assert.Equal(t, []string{"staging"}, RemoveMgwClusterFromContextsInMainConfigFile("dev", filePath))
+	staging, err := GetContext("staging", filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, Context{APIMEnvironment: "dev"}, *staging)
+	dev, err := GetContext("dev", filePath)
+	assert.Nil(t, err)
+	assert.Equal(t, "dev", dev.APIMEnvironment)
+}
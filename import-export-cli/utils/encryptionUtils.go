@@ -83,18 +83,8 @@ func IsValidKeyStoreConfig(config *KeyStoreConfig) bool {
 
 // EncryptSecrets encrypts the secrets using the keystore and write them to a file or console depending on the config map argument
 func EncryptSecrets(keyStoreConfig *KeyStoreConfig, secretConfig SecretConfig) error {
-	encryptionKey, err := getEncryptionKey(keyStoreConfig)
-	if err != nil {
-		return err
-	}
-	var encryptedSecrets map[string]string
 	plainTextSecrets := getPlainTextSecrets(secretConfig)
-
-	if IsPKCS1Encryption(secretConfig.Algorithm) {
-		encryptedSecrets, err = encrypt(encryptionKey, plainTextSecrets, encryptPKCS1v15)
-	} else {
-		encryptedSecrets, err = encrypt(encryptionKey, plainTextSecrets, encryptOAEP)
-	}
+	encryptedSecrets, err := EncryptPlainTextSecrets(keyStoreConfig, plainTextSecrets, secretConfig.Algorithm)
 	if err != nil {
 		return err
 	}
@@ -108,6 +98,52 @@ func EncryptSecrets(keyStoreConfig *KeyStoreConfig, secretConfig SecretConfig) e
 	return nil
 }
 
+// ReadSecretFromConsole prompts for the alias and the plain text secret of the secret config. The secret is prompted
+// again until the repeated secret matches it
+func ReadSecretFromConsole(secretConfig *SecretConfig) error {
+	readAlias := func() (string, error) {
+		return ReadInputString("Enter plain alias for secret", Default{}, ".+", true)
+	}
+	return readSecret(secretConfig, readAlias, ReadPassword)
+}
+
+func readSecret(secretConfig *SecretConfig, readAlias func() (string, error),
+	readPassword func(printText string) (string, error)) error {
+	alias, err := readAlias()
+	if err != nil {
+		return err
+	}
+	for {
+		secret, err := readPassword("Enter plain text secret")
+		if err != nil {
+			return err
+		}
+		repeatSecret, err := readPassword("Repeat plain text secret")
+		if err != nil {
+			return err
+		}
+		if secret == repeatSecret {
+			secretConfig.PlainTextAlias = strings.TrimSpace(alias)
+			secretConfig.PlainTextSecretText = strings.TrimSpace(secret)
+			return nil
+		}
+		fmt.Println("Entered secret values did not match.")
+	}
+}
+
+// EncryptPlainTextSecrets encrypts each of the plain text secrets using the key store and the given algorithm and
+// returns the encrypted secrets against the same aliases
+func EncryptPlainTextSecrets(keyStoreConfig *KeyStoreConfig, plainTextSecrets map[string]string, algorithm string) (map[string]string, error) {
+	encryptionKey, err := getEncryptionKey(keyStoreConfig)
+	if err != nil {
+		return nil, err
+	}
+	if IsPKCS1Encryption(algorithm) {
+		return encrypt(encryptionKey, plainTextSecrets, encryptPKCS1v15)
+	}
+	return encrypt(encryptionKey, plainTextSecrets, encryptOAEP)
+}
+
 // WritePropertiesToFile write a map to a .properties file
 func WritePropertiesToFile(variables map[string]string, fileName string) {
 	props := properties.LoadMap(variables)
@@ -0,0 +1,58 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSecret(t *testing.T) {
+	readAlias := func() (string, error) {
+		return " db.password ", nil
+	}
+	// the secret is prompted again when the repeated secret does not match
+	inputs := []string{"secret ", "secrt", "secret ", "secret "}
+	readPassword := func(printText string) (string, error) {
+		input := inputs[0]
+		inputs = inputs[1:]
+		return input, nil
+	}
+
+	secretConfig := &SecretConfig{}
+	err := readSecret(secretConfig, readAlias, readPassword)
+	assert.Nil(t, err)
+	assert.Empty(t, inputs)
+	assert.Equal(t, "db.password", secretConfig.PlainTextAlias)
+	assert.Equal(t, "secret", secretConfig.PlainTextSecretText)
+}
+
+func TestReadSecretWithReadErrors(t *testing.T) {
+	errRead := errors.New("not a terminal")
+	readPassword := func(printText string) (string, error) {
+		return "", errRead
+	}
+	err := readSecret(&SecretConfig{}, func() (string, error) { return "alias", nil }, readPassword)
+	assert.Equal(t, errRead, err)
+
+	err = readSecret(&SecretConfig{}, func() (string, error) { return "", errRead }, readPassword)
+	assert.Equal(t, errRead, err)
+}
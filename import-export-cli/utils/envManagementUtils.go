@@ -112,8 +112,9 @@ func RemoveEnvFromMainConfigFile(env, endpointsFilePath string) error {
 	if env == "" {
 		return errors.New("environment cannot be blank")
 	}
-	mainConfig := GetMainConfigFromFile(endpointsFilePath)
-	if EnvExistsInMainConfigFile(env, endpointsFilePath) {
+	mainConfig := ReadMainConfigFile(endpointsFilePath)
+	// environments defined in the .apictl.yaml or the environment variables are not in the file
+	if _, ok := mainConfig.Environments[env]; ok {
 		Logln(LogPrefixInfo + "Environment '" + env + "' exists in file " + endpointsFilePath)
 		delete(mainConfig.Environments, env)
 		WriteConfigFile(mainConfig, endpointsFilePath)
@@ -505,8 +506,8 @@ func RemoveMgwAdapterEnvFromMainConfigFile(env, endpointsFilePath string) error
 	if env == "" {
 		return errors.New("Environment cannot be blank")
 	}
-	mainConfig := GetMainConfigFromFile(endpointsFilePath)
-	if MgwAdapterEnvExistsInMainConfigFile(env, endpointsFilePath) {
+	mainConfig := ReadMainConfigFile(endpointsFilePath)
+	if _, ok := mainConfig.MgwAdapterEnvs[env]; ok {
 		delete(mainConfig.MgwAdapterEnvs, env)
 		WriteConfigFile(mainConfig, endpointsFilePath)
 		Logln(LogPrefixInfo + "MgwAdapter Environment '" + env +
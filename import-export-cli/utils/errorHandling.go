@@ -23,12 +23,31 @@ import (
 	"fmt"
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cast"
+	"net/http"
 	"os"
+	"strconv"
 )
 
 func HandleErrorAndExit(msg string, err error) {
+	if OutputFormat != "" {
+		result := NewCommandResult(resultCommand, resultData, msg, err)
+		if writeErr := writeResult(resultWriter, result); writeErr != nil {
+			HandleErrorAndContinue(msg, err)
+		}
+		os.Exit(result.ExitCode)
+	}
 	HandleErrorAndContinue(msg, err)
-	printAndExit()
+	printAndExit(ExitCodeOf(err))
+}
+
+// HandleMissingEnvAndExit reports that the product (APIM or MI) is not added to the environment and exits
+func HandleMissingEnvAndExit(product, env string) {
+	msg := product + " does not exists in " + env + " Add it using add env"
+	if OutputFormat != "" {
+		HandleErrorAndExit(msg, NewMissingError(product+" environment "+env+" not found"))
+	}
+	fmt.Println(msg)
+	os.Exit(ExitCodeNotFound)
 }
 
 func HandleErrorAndContinue(msg string, err error) {
@@ -47,9 +66,9 @@ func HandleErrorAndContinue(msg string, err error) {
 	}
 }
 
-func printAndExit() {
-	fmt.Println("Exit status 1")
-	os.Exit(1)
+func printAndExit(code int) {
+	fmt.Println("Exit status " + strconv.Itoa(code))
+	os.Exit(code)
 }
 
 // Log information of erroneous http response and exit program
@@ -59,11 +78,80 @@ func PrintErrorResponseAndExit(response *resty.Response) {
 	Logf("\nResponse Headers: %v", response.Header())
 	Logf("\nResponse Time:%v", response.Time())
 	Logf("\nResponse Received At:%v", response.ReceivedAt())
-	printAndExit()
+	if OutputFormat != "" {
+		HandleErrorAndExit("Unexpected response from the server", NewResponseError(response, ""))
+	}
+	printAndExit(ExitCodeOfStatus(response.StatusCode()))
+}
+
+// ResponseError is returned instead of exiting when a server responds with an unexpected status code. The status code
+// and the raw body are kept so that the callers can decide how to handle the failure
+type ResponseError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+	Message    string
+}
+
+// NewResponseError creates a ResponseError from the response. msg describes the failure and if it is empty the
+// response body is used as the error message
+func NewResponseError(response *resty.Response, msg string) *ResponseError {
+	Logf("Error: %s\n", response.Error())
+	Logf("Body: %s\n", response.Body())
+	return &ResponseError{
+		StatusCode: response.StatusCode(),
+		Status:     response.Status(),
+		Body:       response.Body(),
+		Message:    msg,
+	}
+}
+
+// NewNotFoundError creates a ResponseError for a resource that could not be found in the server
+func NewNotFoundError(msg string) *ResponseError {
+	return &ResponseError{
+		StatusCode: http.StatusNotFound,
+		Status:     strconv.Itoa(http.StatusNotFound) + " " + http.StatusText(http.StatusNotFound),
+		Message:    msg,
+	}
+}
+
+// MissingError is returned when a resource of the local configuration, e.g. an environment or a context, could not
+// be found. Unlike a ResponseError it does not carry an HTTP status since no server was involved
+type MissingError struct {
+	Message string
+}
+
+// NewMissingError creates a MissingError for a resource that could not be found in the local configuration
+func NewMissingError(msg string) *MissingError {
+	return &MissingError{Message: msg}
+}
+
+func (e *MissingError) Error() string {
+	return e.Message
+}
+
+// ExitCode of a missing resource
+func (e *MissingError) ExitCode() int {
+	return ExitCodeNotFound
+}
+
+func (e *ResponseError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if len(e.Body) > 0 {
+		return string(e.Body)
+	}
+	return e.Status
 }
 
 func GetHttpErrorResponse(err error) error {
 	var errorResponse HttpErrorResponse
-	json.Unmarshal([]byte(err.Error()), &errorResponse)
+	var responseError *ResponseError
+	if errors.As(err, &responseError) && len(responseError.Body) > 0 {
+		json.Unmarshal(responseError.Body, &errorResponse)
+	} else {
+		json.Unmarshal([]byte(err.Error()), &errorResponse)
+	}
 	return errors.New(cast.ToString(errorResponse.Code) + "-" + errorResponse.Status + " : " + errorResponse.Description)
 }
@@ -66,31 +66,64 @@ func GetEnvKeysAllFromFile(envKeysAllFilePath string) *EnvKeysAll {
 	return &envKeysAll
 }
 
-// Read and return MainConfig
+// Read and return MainConfig. The project local .apictl.yaml and the APICTL_* environment variables are merged over
+// the main_config.yaml of the user
 func GetMainConfigFromFile(filePath string) *MainConfig {
-	data, err := ioutil.ReadFile(filePath)
+	mainConfig, err := ReadMainConfig(filePath)
 	if err != nil {
-		HandleErrorAndExit("MainConfig: File Not Found: "+filePath, err)
+		HandleErrorAndExit("MainConfig: Error reading "+filePath, err)
 	}
+	return mainConfig
+}
 
-	var mainConfig MainConfig
-	if err := mainConfig.ParseMainConfigFromFile(data); err != nil {
-		HandleErrorAndExit("MainConfig: Error parsing "+filePath, err)
+// ReadMainConfig reads the MainConfig the same way as GetMainConfigFromFile, but returns an error instead of exiting
+// if the file cannot be read, resolved or parsed
+func ReadMainConfig(filePath string) (*MainConfig, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
 	}
-
-	return &mainConfig
+	return parseMainConfig(filePath, data, true)
 }
 
 // Read and return MainConfig. Silently catch the error  when config file is not found
 func GetMainConfigFromFileSilently(filePath string) *MainConfig {
-	var mainConfig MainConfig
+	data, _ := ioutil.ReadFile(filePath)
+	mainConfig, err := parseMainConfig(filePath, data, true)
+	if err != nil {
+		HandleErrorAndExit("MainConfig: Error reading "+filePath, err)
+	}
+	return mainConfig
+}
+
+// ReadMainConfigFile reads the MainConfig stored in the file without merging the .apictl.yaml and the environment
+// variables. Use it to read the config to be written back to the file.
+func ReadMainConfigFile(filePath string) *MainConfig {
 	data, err := ioutil.ReadFile(filePath)
-	if err == nil {
-		if err := mainConfig.ParseMainConfigFromFile(data); err != nil {
-			HandleErrorAndExit("MainConfig: Error parsing "+filePath, err)
+	if err != nil {
+		HandleErrorAndExit("MainConfig: File Not Found: "+filePath, err)
+	}
+	mainConfig, err := parseMainConfig(filePath, data, false)
+	if err != nil {
+		HandleErrorAndExit("MainConfig: Error reading "+filePath, err)
+	}
+	return mainConfig
+}
+
+func parseMainConfig(filePath string, data []byte, resolve bool) (*MainConfig, error) {
+	if resolve && filePath == MainConfigFilePath {
+		resolved, _, err := ResolveMainConfig(filePath, data)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving the config: %w", err)
 		}
+		data = resolved
+	}
+
+	var mainConfig MainConfig
+	if err := mainConfig.ParseMainConfigFromFile(data); err != nil {
+		return nil, fmt.Errorf("error parsing the config: %w", err)
 	}
-	return &mainConfig
+	return &mainConfig, nil
 }
 
 // Read and validate contents of main_config.yaml
@@ -0,0 +1,156 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+var httpClients = make(map[string]*resty.Client)
+var httpClientsMutex sync.Mutex
+
+// GetHttpClient returns the HTTP client shared by the requests to the host of the URL, i.e. to an environment, so that
+// connections are reused. The client is configured with the TLS and proxy settings of the environment, the timeout and
+// the retry settings of the main config when it is created
+func GetHttpClient(requestUrl string) *resty.Client {
+	key := hostOfUrl(requestUrl)
+
+	httpClientsMutex.Lock()
+	defer httpClientsMutex.Unlock()
+	if client, ok := httpClients[key]; ok {
+		return client
+	}
+	client, err := newHttpClient(key)
+	if err != nil {
+		// the requests fail with the error instead of exiting, so that the caller reports it. The client is not
+		// shared, so that the settings are read again once they are fixed
+		return newFailingHttpClient(err)
+	}
+	httpClients[key] = client
+	return client
+}
+
+// ResetHttpClients removes the shared HTTP clients, so that clients are created with the current configs
+func ResetHttpClients() {
+	httpClientsMutex.Lock()
+	defer httpClientsMutex.Unlock()
+	httpClients = make(map[string]*resty.Client)
+}
+
+// newHttpClient creates an HTTP client for the host, given as "<scheme>://<host>:<port>", which retries failed
+// requests with jittered exponential backoff. An error is returned if the TLS settings of the host cannot be read
+func newHttpClient(host string) (*resty.Client, error) {
+	client := resty.New()
+
+	conn := getConnectionConfigOfHost(host)
+	tlsConfig, err := GetTlsConfigOfConnection(conn)
+	if err != nil {
+		return nil, fmt.Errorf("error reading TLS settings of the environment in %s: %w", host, err)
+	}
+	client.SetTLSClientConfig(tlsConfig)
+
+	if u, err := url.Parse(host); err == nil && conn.NoProxy != "" && isNoProxyHost(u.Hostname(), conn.NoProxy) {
+		client.RemoveProxy()
+	} else if conn.Proxy != "" {
+		client.SetProxy(conn.Proxy)
+	}
+
+	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
+	client.SetRetryCount(HttpRetryCount).
+		SetRetryWaitTime(time.Duration(HttpRetryWaitTime) * time.Millisecond).
+		SetRetryMaxWaitTime(time.Duration(HttpRetryMaxWaitTime) * time.Millisecond).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(isRetryableRequest)
+
+	// the transport is wrapped after it is configured, since resty configures TLS and proxies on an *http.Transport
+	if httpTraceEnabled() {
+		client.SetTransport(&tracingTransport{next: client.GetClient().Transport})
+	}
+	return client, nil
+}
+
+// newFailingHttpClient creates an HTTP client which fails every request with err without sending it
+func newFailingHttpClient(err error) *resty.Client {
+	return resty.New().OnBeforeRequest(func(*resty.Client, *resty.Request) error {
+		return err
+	})
+}
+
+// isRetryableRequest returns whether the request should be retried. Idempotent requests are retried on connection
+// errors and on responses of unavailable servers, e.g. 502 from a load balancer. Any request is retried if the server
+// is rate limiting (429) since the request is not processed
+func isRetryableRequest(resp *resty.Response, err error) bool {
+	if resp == nil || resp.Request == nil {
+		return false
+	}
+
+	retry := false
+	switch {
+	case resp.StatusCode() == http.StatusTooManyRequests:
+		retry = true
+	case !isIdempotentMethod(resp.Request.Method):
+		retry = false
+	case err != nil:
+		retry = true
+	case resp.StatusCode() == http.StatusBadGateway, resp.StatusCode() == http.StatusServiceUnavailable,
+		resp.StatusCode() == http.StatusGatewayTimeout:
+		retry = true
+	}
+
+	if retry {
+		reason := resp.Status()
+		if err != nil {
+			reason = err.Error()
+		}
+		Logln(fmt.Sprintf("%sRetrying %s %s (attempt %d): %s", LogPrefixWarning, resp.Request.Method,
+			resp.Request.URL, resp.Request.Attempt, reason))
+	}
+	return retry
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait time given by the server in the "Retry-After" header in seconds or as an HTTP date,
+// 0 to use the exponential backoff if the header is not given
+func retryAfter(client *resty.Client, resp *resty.Response) (time.Duration, error) {
+	value := resp.Header().Get(HeaderRetryAfter)
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if date, err := http.ParseTime(value); err == nil && time.Until(date) > 0 {
+		return time.Until(date), nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,141 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// withFastRetries sets short wait times between retries for the test
+func withFastRetries(t *testing.T) {
+	retryCount, waitTime, maxWaitTime := HttpRetryCount, HttpRetryWaitTime, HttpRetryMaxWaitTime
+	HttpRetryCount, HttpRetryWaitTime, HttpRetryMaxWaitTime = 3, 1, 10
+	ResetHttpClients()
+	t.Cleanup(func() {
+		HttpRetryCount, HttpRetryWaitTime, HttpRetryMaxWaitTime = retryCount, waitTime, maxWaitTime
+		ResetHttpClients()
+	})
+}
+
+func TestInvokeGETRequestRetriesBadGateway(t *testing.T) {
+	withFastRetries(t)
+	attempts := 0
+	var httpStub = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer httpStub.Close()
+
+	resp, err := InvokeGETRequest(context.Background(), httpStub.URL, make(map[string]string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != http.StatusOK || attempts != 3 {
+		t.Errorf("got status %d after %d attempts, want 200 after 3 attempts", resp.StatusCode(), attempts)
+	}
+}
+
+func TestInvokePOSTRequestDoesNotRetryBadGateway(t *testing.T) {
+	withFastRetries(t)
+	attempts := 0
+	var httpStub = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer httpStub.Close()
+
+	resp, _ := InvokePOSTRequest(context.Background(), httpStub.URL, make(map[string]string), "")
+	if resp.StatusCode() != http.StatusBadGateway || attempts != 1 {
+		t.Errorf("got status %d after %d attempts, want 502 after 1 attempt", resp.StatusCode(), attempts)
+	}
+}
+
+func TestInvokePOSTRequestRetriesTooManyRequests(t *testing.T) {
+	withFastRetries(t)
+	attempts := 0
+	var httpStub = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set(HeaderRetryAfter, "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer httpStub.Close()
+
+	resp, _ := InvokePOSTRequest(context.Background(), httpStub.URL, make(map[string]string), "")
+	if resp.StatusCode() != http.StatusCreated || attempts != 2 {
+		t.Errorf("got status %d after %d attempts, want 201 after 2 attempts", resp.StatusCode(), attempts)
+	}
+}
+
+func TestInvokeGETRequestStopsAtDeadlineOfContext(t *testing.T) {
+	withFastRetries(t)
+	release := make(chan struct{})
+	var httpStub = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer httpStub.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := InvokeGETRequest(ctx, httpStub.URL, make(map[string]string))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request returned after %s, want it to return at the deadline of the context", elapsed)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set(HeaderRetryAfter, "5")
+	client := GetHttpClient("https://localhost:9443")
+	waitTime, err := retryAfter(client, &resty.Response{RawResponse: resp})
+	if err != nil || waitTime.Seconds() != 5 {
+		t.Errorf("got wait time %v, want 5s", waitTime)
+	}
+}
+
+func TestGetHttpClientSharedPerHost(t *testing.T) {
+	ResetHttpClients()
+	defer ResetHttpClients()
+	if GetHttpClient("https://localhost:9443/api/am/publisher/v1/apis") !=
+		GetHttpClient("https://localhost:9443/api/am/admin/v1/export") {
+		t.Errorf("requests to the same host should share the HTTP client")
+	}
+	if GetHttpClient("https://localhost:9443/apis") == GetHttpClient("https://localhost:9164/management") {
+		t.Errorf("requests to different hosts should not share the HTTP client")
+	}
+}
@@ -0,0 +1,254 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+const recordedExchangeFileExt = ".yaml"
+
+// RecordedExchange is a fixture of a request sent by apictl and the response received, recorded with --record. The
+// bodies are kept in separate files next to the fixture, so that files such as exported projects are kept as they are.
+// Binary bodies are only recorded with --include-binary-bodies, since the credentials in them cannot be redacted
+type RecordedExchange struct {
+	Request  RecordedRequest  `yaml:"request"`
+	Response RecordedResponse `yaml:"response"`
+}
+
+// RecordedRequest is the request of a RecordedExchange
+type RecordedRequest struct {
+	Method  string      `yaml:"method"`
+	URL     string      `yaml:"url"`
+	Headers http.Header `yaml:"headers,omitempty"`
+	Body    string      `yaml:"body,omitempty"`
+	Comment string      `yaml:"comment,omitempty"`
+}
+
+// RecordedResponse is the response of a RecordedExchange
+type RecordedResponse struct {
+	Status  int         `yaml:"status"`
+	Headers http.Header `yaml:"headers,omitempty"`
+	Body    string      `yaml:"body,omitempty"`
+	Comment string      `yaml:"comment,omitempty"`
+}
+
+// exchangeRecorder writes the traced requests and responses to a directory as RecordedExchange fixtures
+type exchangeRecorder struct {
+	dir                 string
+	includeBinaryBodies bool
+	count               int
+	mutex               sync.Mutex
+}
+
+// newExchangeRecorder creates a recorder for the directory. The fixtures are numbered after the ones already in the
+// directory, so that the recordings of several commands can be kept together
+func newExchangeRecorder(dir string, includeBinaryBodies bool) (*exchangeRecorder, error) {
+	if err := CreateDirIfNotExist(dir); err != nil {
+		return nil, err
+	}
+	files, err := recordedExchangeFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &exchangeRecorder{dir: dir, includeBinaryBodies: includeBinaryBodies, count: len(files)}, nil
+}
+
+func (r *exchangeRecorder) record(exchange *tracedExchange) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.count++
+	name := fmt.Sprintf("%04d", r.count)
+
+	req, resp := exchange.request, exchange.response
+	fixture := RecordedExchange{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     RedactURL(req.URL),
+			Headers: RedactHeaders(req.Header),
+		},
+		Response: RecordedResponse{
+			Status:  resp.StatusCode,
+			Headers: RedactHeaders(resp.Header),
+		},
+	}
+	var err error
+	fixture.Request.Body, fixture.Request.Comment, err = r.writeBody(name+"-request.body",
+		req.Header.Get(HeaderContentType), exchange.requestBody)
+	if err != nil {
+		return err
+	}
+	fixture.Response.Body, fixture.Response.Comment, err = r.writeBody(name+"-response.body",
+		resp.Header.Get(HeaderContentType), exchange.responseBody)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(fixture)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(r.dir, name+recordedExchangeFileExt), data, 0600)
+}
+
+// writeBody writes the body with the credentials redacted to the file of the name, and returns the name of the file
+// written or a comment explaining why the body is not written
+func (r *exchangeRecorder) writeBody(name, contentType string, body []byte) (string, string, error) {
+	if len(body) == 0 {
+		return "", "", nil
+	}
+	if !isTextBody(body) && !r.includeBinaryBodies {
+		return "", binaryBodyOmittedComment(body), nil
+	}
+	if err := ioutil.WriteFile(filepath.Join(r.dir, name), RedactBody(contentType, body), 0600); err != nil {
+		return "", "", err
+	}
+	return name, "", nil
+}
+
+func recordedExchangeFiles(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "[0-9][0-9][0-9][0-9]"+recordedExchangeFileExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// replayedExchange is a recorded exchange loaded to be replayed
+type replayedExchange struct {
+	method   string
+	path     string
+	query    string
+	status   int
+	headers  http.Header
+	body     []byte
+	replayed bool
+}
+
+// ReplayHandler serves the responses recorded with --record to the matching requests, e.g. from an httptest server, to
+// reproduce a problem without the server it was recorded against
+type ReplayHandler struct {
+	exchanges []*replayedExchange
+	mutex     sync.Mutex
+}
+
+// NewReplayHandler loads the exchanges recorded in the directory
+func NewReplayHandler(dir string) (*ReplayHandler, error) {
+	files, err := recordedExchangeFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no recorded exchanges found in %s", dir)
+	}
+
+	handler := &ReplayHandler{}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var fixture RecordedExchange
+		if err := yaml.Unmarshal(data, &fixture); err != nil {
+			return nil, fmt.Errorf("invalid recorded exchange %s: %w", file, err)
+		}
+		requestURL, err := url.Parse(fixture.Request.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recorded exchange %s: %w", file, err)
+		}
+		exchange := &replayedExchange{
+			method:  fixture.Request.Method,
+			path:    requestURL.Path,
+			query:   requestURL.Query().Encode(),
+			status:  fixture.Response.Status,
+			headers: fixture.Response.Headers,
+		}
+		if fixture.Response.Body != "" {
+			exchange.body, err = ioutil.ReadFile(filepath.Join(dir, fixture.Response.Body))
+			if err != nil {
+				return nil, err
+			}
+		}
+		handler.exchanges = append(handler.exchanges, exchange)
+	}
+	return handler, nil
+}
+
+// ServeHTTP responds with the first exchange recorded for the method, the path and the query of the request that is
+// not replayed yet, preferring the ones with the same query. The last matching exchange is replayed again once all of
+// them are replayed, e.g. for a request polling the status of an operation
+func (h *ReplayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mutex.Lock()
+	exchange := h.match(r)
+	h.mutex.Unlock()
+
+	if exchange == nil {
+		http.Error(w, "no recorded exchange for "+r.Method+" "+r.URL.RequestURI(), http.StatusNotFound)
+		return
+	}
+	for name, values := range exchange.headers {
+		if strings.EqualFold(name, "Content-Length") {
+			continue
+		}
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	w.WriteHeader(exchange.status)
+	_, _ = w.Write(exchange.body)
+}
+
+func (h *ReplayHandler) match(r *http.Request) *replayedExchange {
+	query := r.URL.Query().Encode()
+	var samePath, lastReplayed *replayedExchange
+	for _, exchange := range h.exchanges {
+		if exchange.method != r.Method || exchange.path != r.URL.Path {
+			continue
+		}
+		if exchange.replayed {
+			if exchange.query == query || lastReplayed == nil {
+				lastReplayed = exchange
+			}
+			continue
+		}
+		if exchange.query == query {
+			exchange.replayed = true
+			return exchange
+		}
+		if samePath == nil {
+			samePath = exchange
+		}
+	}
+	if samePath != nil {
+		samePath.replayed = true
+		return samePath
+	}
+	return lastReplayed
+}
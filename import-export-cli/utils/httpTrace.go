@@ -0,0 +1,573 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// RedactedValue replaces the credentials in the traced and recorded HTTP requests and responses
+const RedactedValue = "REDACTED"
+
+// HttpTraceConfig selects how the HTTP requests and responses are traced
+type HttpTraceConfig struct {
+	// Log prints the method, URL, status, time and headers of each request and response to the standard error
+	Log bool
+	// HarFile is the file the requests and responses are written to in the HAR format
+	HarFile string
+	// RecordDir is the directory replayable fixtures of the requests and responses are written to
+	RecordDir string
+	// IncludeBinaryBodies writes the binary bodies, such as exported projects, to the HAR file and the fixtures. They
+	// are omitted otherwise since the credentials in them cannot be redacted
+	IncludeBinaryBodies bool
+	// Version is the version of apictl written to the HAR file
+	Version string
+}
+
+// httpTraceWriter is where the traces of the requests and responses are printed
+var httpTraceWriter io.Writer = os.Stderr
+
+var httpTraceConfig HttpTraceConfig
+var httpHar *harWriter
+var httpRecorder *exchangeRecorder
+
+// sensitiveHeaders are the headers carrying credentials, in the canonical format
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"Internal-Key":        true,
+	"Apikey":              true,
+}
+
+// EnableHttpTrace traces the requests sent by the HTTP clients and the responses received as given by the config
+func EnableHttpTrace(config HttpTraceConfig) error {
+	if httpHar != nil {
+		_ = httpHar.close()
+	}
+	httpHar, httpRecorder = nil, nil
+	if config.HarFile != "" {
+		har, err := newHarWriter(config.HarFile, config.Version, config.IncludeBinaryBodies)
+		if err != nil {
+			return err
+		}
+		httpHar = har
+	}
+	if config.RecordDir != "" {
+		recorder, err := newExchangeRecorder(config.RecordDir, config.IncludeBinaryBodies)
+		if err != nil {
+			return err
+		}
+		httpRecorder = recorder
+	}
+	httpTraceConfig = config
+	ResetHttpClients()
+	return nil
+}
+
+func httpTraceEnabled() bool {
+	return httpTraceConfig.Log || httpHar != nil || httpRecorder != nil
+}
+
+// tracingTransport traces the requests sent through the underlying transport and the responses received
+type tracingTransport struct {
+	next http.RoundTripper
+}
+
+// tracedExchange is a request sent and the response received or the error occurred, with the bodies if captured
+type tracedExchange struct {
+	started      time.Time
+	elapsed      time.Duration
+	request      *http.Request
+	requestBody  []byte
+	response     *http.Response
+	responseBody []byte
+	err          error
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	captureBodies := httpHar != nil || httpRecorder != nil
+	exchange := &tracedExchange{started: time.Now(), request: req}
+	if captureBodies {
+		body, err := readRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+		exchange.requestBody = body
+	}
+	if httpTraceConfig.Log {
+		printTracedRequest(req)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err == nil && captureBodies {
+		body, readErr := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if readErr != nil {
+			err = readErr
+			resp = nil
+		} else {
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			exchange.responseBody = body
+		}
+	}
+	exchange.elapsed = time.Since(exchange.started)
+	exchange.response = resp
+	exchange.err = err
+
+	if httpTraceConfig.Log {
+		printTracedResponse(exchange)
+	}
+	if httpHar != nil {
+		if harErr := httpHar.add(exchange); harErr != nil {
+			fmt.Fprintln(os.Stderr, LogPrefixWarning+"Error writing the HAR file:", harErr)
+		}
+	}
+	if httpRecorder != nil && resp != nil {
+		if recordErr := httpRecorder.record(exchange); recordErr != nil {
+			fmt.Fprintln(os.Stderr, LogPrefixWarning+"Error recording the HTTP exchange:", recordErr)
+		}
+	}
+	return resp, err
+}
+
+// readRequestBody reads the body of the request, leaving a body that can still be sent
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return ioutil.ReadAll(body)
+	}
+	data, err := ioutil.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+func printTracedRequest(req *http.Request) {
+	fmt.Fprintf(httpTraceWriter, "%s> %s %s\n", LogPrefixTrace, req.Method, RedactURL(req.URL))
+	printTracedHeaders(">", req.Header)
+}
+
+func printTracedResponse(exchange *tracedExchange) {
+	elapsed := exchange.elapsed.Round(time.Millisecond)
+	if exchange.err != nil {
+		fmt.Fprintf(httpTraceWriter, "%s< %s %s failed after %v: %v\n", LogPrefixTrace, exchange.request.Method,
+			RedactURL(exchange.request.URL), elapsed, exchange.err)
+		return
+	}
+	fmt.Fprintf(httpTraceWriter, "%s< %s %s %s (%v)\n", LogPrefixTrace, exchange.response.Status,
+		exchange.request.Method, RedactURL(exchange.request.URL), elapsed)
+	printTracedHeaders("<", exchange.response.Header)
+}
+
+func printTracedHeaders(direction string, header http.Header) {
+	header = RedactHeaders(header)
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range header[name] {
+			fmt.Fprintf(httpTraceWriter, "%s%s   %s: %s\n", LogPrefixTrace, direction, name, value)
+		}
+	}
+}
+
+// isSensitiveName returns whether a header, query parameter or body field of the name carries credentials
+func isSensitiveName(name string) bool {
+	if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+		return true
+	}
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	return strings.HasSuffix(normalized, "token") || strings.HasSuffix(normalized, "secret") ||
+		strings.HasSuffix(normalized, "password") || normalized == "apikey"
+}
+
+// RedactHeaders returns a copy of the headers with the values of the headers carrying credentials redacted
+func RedactHeaders(header http.Header) http.Header {
+	redacted := make(http.Header, len(header))
+	for name, values := range header {
+		if isSensitiveName(name) {
+			redactedValues := make([]string, len(values))
+			for i := range values {
+				redactedValues[i] = RedactedValue
+			}
+			redacted[name] = redactedValues
+			continue
+		}
+		redacted[name] = append([]string(nil), values...)
+	}
+	return redacted
+}
+
+// RedactURL returns the URL with the password and the query parameters carrying credentials redacted
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	redacted := *u
+	if _, ok := u.User.Password(); ok {
+		redacted.User = url.UserPassword(u.User.Username(), RedactedValue)
+	}
+	if query, changed := redactValues(u.Query()); changed {
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.String()
+}
+
+func redactValues(values url.Values) (url.Values, bool) {
+	changed := false
+	for name, fieldValues := range values {
+		if !isSensitiveName(name) {
+			continue
+		}
+		for i := range fieldValues {
+			fieldValues[i] = RedactedValue
+		}
+		changed = true
+	}
+	return values, changed
+}
+
+// RedactBody returns the body with the values of the fields carrying credentials redacted if it is a JSON document or
+// a URL encoded form, and as it is otherwise
+func RedactBody(contentType string, body []byte) []byte {
+	if strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		if values, changed := redactValues(values); changed {
+			return []byte(values.Encode())
+		}
+		return body
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(trimmed))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return body
+	}
+	if !redactJSON(document) {
+		return body
+	}
+	redacted, err := json.Marshal(document)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactJSON redacts the string values of the fields carrying credentials in the decoded JSON document and returns
+// whether any value is redacted
+func redactJSON(document interface{}) bool {
+	changed := false
+	switch value := document.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			if _, isString := field.(string); isString && isSensitiveName(name) {
+				value[name] = RedactedValue
+				changed = true
+				continue
+			}
+			changed = redactJSON(field) || changed
+		}
+	case []interface{}:
+		for _, item := range value {
+			changed = redactJSON(item) || changed
+		}
+	}
+	return changed
+}
+
+// harWriter writes the traced requests and responses to a file in the HTTP Archive (HAR) 1.2 format. Each entry is
+// written in place of the end of the document, which is written again after it, so that the file is complete even if
+// apictl exits on an error
+type harWriter struct {
+	file                *os.File
+	includeBinaryBodies bool
+	// end is the offset of the end of the document following the last entry
+	end     int64
+	entries int
+	mutex   sync.Mutex
+}
+
+// harEntryIndent is the indentation of the entries in the HAR file
+const harEntryIndent = "      "
+
+type harLog struct {
+	Log struct {
+		Version string     `json:"version"`
+		Creator harCreator `json:"creator"`
+		Entries []harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// newHarWriter creates the HAR file at the path with the document without entries
+func newHarWriter(path, version string, includeBinaryBodies bool) (*harWriter, error) {
+	var har harLog
+	har.Log.Version = "1.2"
+	har.Log.Creator = harCreator{Name: ProjectName, Version: version}
+	har.Log.Entries = []harEntry{}
+	data, err := json.MarshalIndent(har, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	// the document is split after the opening bracket of the entries, where the entries are written
+	start := bytes.Index(data, []byte(`"entries": [`)) + len(`"entries": [`)
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+	w := &harWriter{file: file, includeBinaryBodies: includeBinaryBodies, end: int64(start)}
+	if _, err = file.Write(data[:start]); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if err = w.writeEnd(); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *harWriter) add(exchange *tracedExchange) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	data, err := json.MarshalIndent(newHarEntry(exchange, w.includeBinaryBodies), harEntryIndent, "  ")
+	if err != nil {
+		return err
+	}
+	separator := "\n" + harEntryIndent
+	if w.entries > 0 {
+		separator = "," + separator
+	}
+	data = append([]byte(separator), data...)
+	if _, err = w.file.WriteAt(data, w.end); err != nil {
+		return err
+	}
+	w.end += int64(len(data))
+	w.entries++
+	return w.writeEnd()
+}
+
+// writeEnd writes the end of the document after the last entry
+func (w *harWriter) writeEnd() error {
+	end := "\n    ]\n  }\n}"
+	if _, err := w.file.WriteAt([]byte(end), w.end); err != nil {
+		return err
+	}
+	return w.file.Truncate(w.end + int64(len(end)))
+}
+
+func (w *harWriter) close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.file.Close()
+}
+
+func newHarEntry(exchange *tracedExchange, includeBinaryBodies bool) harEntry {
+	req := exchange.request
+	elapsed := float64(exchange.elapsed) / float64(time.Millisecond)
+	entry := harEntry{
+		StartedDateTime: exchange.started.Format(time.RFC3339Nano),
+		Time:            elapsed,
+		Timings:         harTimings{Wait: elapsed},
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         RedactURL(req.URL),
+			HTTPVersion: req.Proto,
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(req.Header),
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(exchange.requestBody),
+		},
+	}
+	query, _ := redactValues(req.URL.Query())
+	for _, name := range sortedKeys(query) {
+		for _, value := range query[name] {
+			entry.Request.QueryString = append(entry.Request.QueryString, harNameValue{Name: name, Value: value})
+		}
+	}
+	if len(exchange.requestBody) > 0 {
+		contentType := req.Header.Get(HeaderContentType)
+		entry.Request.PostData = &harPostData{MimeType: contentType}
+		if isTextBody(exchange.requestBody) {
+			entry.Request.PostData.Text = string(RedactBody(contentType, exchange.requestBody))
+		} else {
+			// the post data of a HAR file has no encoding, so a binary request body is never included
+			entry.Request.PostData.Comment = binaryBodyOmittedComment(exchange.requestBody)
+		}
+	}
+
+	resp := exchange.response
+	if resp == nil {
+		entry.Comment = fmt.Sprint(exchange.err)
+		entry.Response = harResponse{Cookies: []harNameValue{}, Headers: []harNameValue{}, HeadersSize: -1,
+			BodySize: -1}
+		return entry
+	}
+	contentType := resp.Header.Get(HeaderContentType)
+	entry.Response = harResponse{
+		Status:      resp.StatusCode,
+		StatusText:  http.StatusText(resp.StatusCode),
+		HTTPVersion: resp.Proto,
+		Cookies:     []harNameValue{},
+		Headers:     harHeaders(resp.Header),
+		Content:     harContent{Size: len(exchange.responseBody), MimeType: contentType},
+		RedirectURL: resp.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    len(exchange.responseBody),
+	}
+	if isTextBody(exchange.responseBody) {
+		entry.Response.Content.Text = string(RedactBody(contentType, exchange.responseBody))
+	} else if includeBinaryBodies {
+		entry.Response.Content.Text = base64.StdEncoding.EncodeToString(exchange.responseBody)
+		entry.Response.Content.Encoding = "base64"
+	} else {
+		entry.Response.Content.Comment = binaryBodyOmittedComment(exchange.responseBody)
+	}
+	return entry
+}
+
+// binaryBodyOmittedComment notes that the binary body is omitted from a HAR file or a fixture
+func binaryBodyOmittedComment(body []byte) string {
+	return fmt.Sprintf("binary body of %d bytes is not included", len(body))
+}
+
+// isTextBody returns whether the body can be written as text, i.e. it is not the content of a file such as a zip
+func isTextBody(body []byte) bool {
+	return utf8.Valid(body) && bytes.IndexByte(body, 0) < 0
+}
+
+func harHeaders(header http.Header) []harNameValue {
+	header = RedactHeaders(header)
+	headers := []harNameValue{}
+	for _, name := range sortedKeys(header) {
+		for _, value := range header[name] {
+			headers = append(headers, harNameValue{Name: name, Value: value})
+		}
+	}
+	return headers
+}
+
+func sortedKeys(values map[string][]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
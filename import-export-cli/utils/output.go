@@ -0,0 +1,203 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// OutputFormat is the format of the result document requested with the global --output flag. It is empty when the
+// output of the command is meant to be read by humans
+var OutputFormat string
+
+const (
+	OutputFormatJSON = "json"
+	OutputFormatYAML = "yaml"
+)
+
+// Exit codes of apictl. Scripts can rely on these instead of the messages printed by the commands
+const (
+	ExitCodeSuccess        = 0
+	ExitCodeError          = 1
+	ExitCodeUsage          = 2
+	ExitCodeNotFound       = 3
+	ExitCodeConflict       = 4
+	ExitCodeUnauthorized   = 5
+	ExitCodeValidation     = 6
+	ExitCodePartialFailure = 7
+)
+
+// Statuses of a result document
+const (
+	ResultStatusSuccess = "success"
+	ResultStatusError   = "error"
+	ResultStatusPartial = "partial"
+)
+
+// ErrInvalidUsage is wrapped by the errors caused by invalid commands, flags or arguments
+var ErrInvalidUsage = errors.New("invalid usage")
+
+// ErrPartialFailure is wrapped by the errors of the commands which completed only a part of the requested operations
+var ErrPartialFailure = errors.New("partial failure")
+
+// ExitCoder is implemented by the errors which know the exit code apictl should return for them
+type ExitCoder interface {
+	ExitCode() int
+}
+
+// CommandResult is the document printed to the standard output when an output format is requested
+type CommandResult struct {
+	Command  string       `json:"command"`
+	Status   string       `json:"status"`
+	ExitCode int          `json:"exitCode"`
+	Data     interface{}  `json:"data,omitempty"`
+	Error    *ResultError `json:"error,omitempty"`
+}
+
+// ResultError describes the failure of a command in a result document
+type ResultError struct {
+	Message    string `json:"message"`
+	Reason     string `json:"reason,omitempty"`
+	StatusCode int    `json:"statusCode,omitempty"`
+}
+
+// resultWriter is the original standard output. The standard output is redirected to the standard error when an
+// output format is requested, so the result document is the only thing written to it
+var resultWriter io.Writer = os.Stdout
+
+var resultCommand string
+var resultData interface{}
+
+// SetResultCommand sets the path of the command the result document is printed for
+func SetResultCommand(command string) {
+	resultCommand = command
+}
+
+// SetOutputFormat validates the requested output format and redirects the messages meant for humans to the standard
+// error if a result document needs to be printed
+func SetOutputFormat(format string) error {
+	format = strings.ToLower(format)
+	if format != "" && format != OutputFormatJSON && format != OutputFormatYAML {
+		return fmt.Errorf("%w: unsupported output format %q, supported formats are %s and %s", ErrInvalidUsage,
+			format, OutputFormatJSON, OutputFormatYAML)
+	}
+	OutputFormat = format
+	if OutputFormat != "" {
+		os.Stdout = os.Stderr
+	}
+	return nil
+}
+
+// SetResultData sets the data included in the result document of the current command
+func SetResultData(data interface{}) {
+	resultData = data
+}
+
+// WriteSuccessResult prints the result document of a successful command if an output format is requested
+func WriteSuccessResult() {
+	if OutputFormat == "" {
+		return
+	}
+	if err := writeResult(resultWriter, NewCommandResult(resultCommand, resultData, "", nil)); err != nil {
+		fmt.Fprintln(os.Stderr, "Error writing the result:", err)
+	}
+}
+
+// NewCommandResult creates the result document of a command. The command is considered successful when msg and err are
+// both empty
+func NewCommandResult(command string, data interface{}, msg string, err error) *CommandResult {
+	result := &CommandResult{Command: command, Status: ResultStatusSuccess, Data: data}
+	if msg == "" && err == nil {
+		return result
+	}
+	result.ExitCode = ExitCodeOf(err)
+	result.Status = ResultStatusError
+	if result.ExitCode == ExitCodePartialFailure {
+		result.Status = ResultStatusPartial
+	}
+	result.Error = &ResultError{Message: msg}
+	if err != nil {
+		result.Error.Reason = err.Error()
+		var responseError *ResponseError
+		if errors.As(err, &responseError) {
+			result.Error.StatusCode = responseError.StatusCode
+		}
+	}
+	return result
+}
+
+// ExitCodeOf returns the exit code apictl returns when a command fails with the given error
+func ExitCodeOf(err error) int {
+	if err == nil {
+		return ExitCodeError
+	}
+	var exitCoder ExitCoder
+	if errors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+	if errors.Is(err, ErrInvalidUsage) {
+		return ExitCodeUsage
+	}
+	if errors.Is(err, ErrPartialFailure) {
+		return ExitCodePartialFailure
+	}
+	var responseError *ResponseError
+	if errors.As(err, &responseError) {
+		return ExitCodeOfStatus(responseError.StatusCode)
+	}
+	return ExitCodeError
+}
+
+// ExitCodeOfStatus returns the exit code for a failed HTTP response with the given status code
+func ExitCodeOfStatus(statusCode int) int {
+	switch statusCode {
+	case http.StatusNotFound:
+		return ExitCodeNotFound
+	case http.StatusConflict:
+		return ExitCodeConflict
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ExitCodeUnauthorized
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ExitCodeValidation
+	}
+	return ExitCodeError
+}
+
+func writeResult(w io.Writer, result *CommandResult) error {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	if OutputFormat == OutputFormatYAML {
+		data, err = JsonToYaml(data)
+		if err != nil {
+			return err
+		}
+	} else {
+		data = append(data, '\n')
+	}
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,140 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type sampleExitCoder struct{}
+
+func (sampleExitCoder) Error() string { return "sample" }
+func (sampleExitCoder) ExitCode() int { return 42 }
+
+func TestExitCodeOf(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{nil, ExitCodeError},
+		{errors.New("unknown"), ExitCodeError},
+		{&ResponseError{StatusCode: http.StatusNotFound}, ExitCodeNotFound},
+		{&ResponseError{StatusCode: http.StatusConflict}, ExitCodeConflict},
+		{&ResponseError{StatusCode: http.StatusUnauthorized}, ExitCodeUnauthorized},
+		{&ResponseError{StatusCode: http.StatusForbidden}, ExitCodeUnauthorized},
+		{&ResponseError{StatusCode: http.StatusBadRequest}, ExitCodeValidation},
+		{&ResponseError{StatusCode: http.StatusInternalServerError}, ExitCodeError},
+		{fmt.Errorf("wrapped: %w", NewNotFoundError("missing")), ExitCodeNotFound},
+		{fmt.Errorf("wrapped: %w", NewMissingError("environment dev not found")), ExitCodeNotFound},
+		{fmt.Errorf("%w: 2 of 5 failed", ErrPartialFailure), ExitCodePartialFailure},
+		{fmt.Errorf("%w: unknown flag", ErrInvalidUsage), ExitCodeUsage},
+		{sampleExitCoder{}, 42},
+	}
+	for _, test := range tests {
+		if got := ExitCodeOf(test.err); got != test.want {
+			t.Errorf("ExitCodeOf(%v) = %d, want %d", test.err, got, test.want)
+		}
+	}
+}
+
+func TestNewCommandResult(t *testing.T) {
+	result := NewCommandResult("apictl get apis", []string{"a"}, "", nil)
+	if result.Status != ResultStatusSuccess || result.ExitCode != ExitCodeSuccess || result.Error != nil {
+		t.Errorf("Unexpected result for a successful command: %+v", result)
+	}
+
+	result = NewCommandResult("apictl delete api", nil, "Error deleting API",
+		&ResponseError{StatusCode: http.StatusConflict, Message: "in use"})
+	if result.Status != ResultStatusError || result.ExitCode != ExitCodeConflict {
+		t.Errorf("Unexpected result for a failed command: %+v", result)
+	}
+	if result.Error.Message != "Error deleting API" || result.Error.Reason != "in use" ||
+		result.Error.StatusCode != http.StatusConflict {
+		t.Errorf("Unexpected error in the result: %+v", result.Error)
+	}
+
+	result = NewCommandResult("apictl get apis", nil, "APIM does not exists in dev Add it using add env",
+		NewMissingError("APIM environment dev not found"))
+	if result.ExitCode != ExitCodeNotFound || result.Error.StatusCode != 0 {
+		t.Errorf("A missing environment should not be reported with an HTTP status: %+v", result.Error)
+	}
+
+	result = NewCommandResult("apictl vcs deploy", nil, "There are project deployment failures.", ErrPartialFailure)
+	if result.Status != ResultStatusPartial || result.ExitCode != ExitCodePartialFailure {
+		t.Errorf("Unexpected result for a partially failed command: %+v", result)
+	}
+}
+
+func TestWriteResult(t *testing.T) {
+	defer func() { OutputFormat = "" }()
+	result := NewCommandResult("apictl export api", map[string]string{"path": "/tmp/a.zip"}, "", nil)
+
+	OutputFormat = OutputFormatJSON
+	var buf bytes.Buffer
+	if err := writeResult(&buf, result); err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Result is not valid json: %v", err)
+	}
+	if decoded["status"] != ResultStatusSuccess || decoded["data"].(map[string]interface{})["path"] != "/tmp/a.zip" {
+		t.Errorf("Unexpected json result: %s", buf.String())
+	}
+
+	OutputFormat = OutputFormatYAML
+	buf.Reset()
+	if err := writeResult(&buf, result); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "command: apictl export api\n") ||
+		!strings.Contains(buf.String(), "path: /tmp/a.zip\n") {
+		t.Errorf("Unexpected yaml result: %s", buf.String())
+	}
+}
+
+func TestEnvEndpointsJSONKeys(t *testing.T) {
+	envs := map[string]EnvEndpoints{"dev": {ApiManagerEndpoint: "https://localhost:9443",
+		Connection: ConnectionConfig{CACertFile: "/tmp/ca.pem", NoProxy: "localhost"}}}
+	data, err := json.Marshal(envs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"dev":{"apim":"https://localhost:9443","publisher":"","devportal":"","registration":"","admin":"",` +
+		`"token":"","mi":"","connection":{"ca_cert":"/tmp/ca.pem","no_proxy":"localhost"}}}`
+	if string(data) != want {
+		t.Errorf("Unexpected json of environments:\n got %s\nwant %s", data, want)
+	}
+}
+
+func TestSetOutputFormatRejectsUnknownFormats(t *testing.T) {
+	err := SetOutputFormat("xml")
+	if !errors.Is(err, ErrInvalidUsage) {
+		t.Errorf("Expected an invalid usage error, got %v", err)
+	}
+	if OutputFormat != "" {
+		t.Errorf("Output format should not be set to an unsupported format")
+	}
+}
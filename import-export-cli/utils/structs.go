@@ -32,10 +32,15 @@ type MainConfig struct {
 	Config         Config                  `yaml:"config"`
 	Environments   map[string]EnvEndpoints `yaml:"environments"`
 	MgwAdapterEnvs map[string]MgwEndpoints `yaml:"mgw-clusters"`
+	Contexts       map[string]Context      `yaml:"contexts,omitempty"`
+	CurrentContext string                  `yaml:"current-context,omitempty"`
 }
 
 type Config struct {
 	HttpRequestTimeout    int    `yaml:"http_request_timeout"`
+	HttpRetryCount        int    `yaml:"http_retry_count,omitempty"`         // negative to disable retries
+	HttpRetryWaitTime     int    `yaml:"http_retry_wait_time,omitempty"`     // milliseconds
+	HttpRetryMaxWaitTime  int    `yaml:"http_retry_max_wait_time,omitempty"` // milliseconds
 	ExportDirectory       string `yaml:"export_directory"`
 	KubernetesMode        bool   `yaml:"kubernetes_mode"`
 	TokenType             string `yaml:"token_type"`
@@ -44,6 +49,7 @@ type Config struct {
 	VCSSourceRepoPath     string `yaml:"vcs_source_repo_path"`
 	VCSDeploymentRepoPath string `yaml:"vcs_deployment_repo_path"`
 	TLSRenegotiationMode  string `yaml:"tls-renegotiation-mode"`
+	MgwCredentialHelper   string `yaml:"mgw_credential_helper,omitempty"`
 }
 
 type EnvKeys struct {
@@ -53,17 +59,49 @@ type EnvKeys struct {
 }
 
 type EnvEndpoints struct {
-	ApiManagerEndpoint   string `yaml:"apim"`
-	PublisherEndpoint    string `yaml:"publisher"`
-	DevPortalEndpoint    string `yaml:"devportal"`
-	RegistrationEndpoint string `yaml:"registration"`
-	AdminEndpoint        string `yaml:"admin"`
-	TokenEndpoint        string `yaml:"token"`
-	MiManagementEndpoint string `yaml:"mi"`
+	ApiManagerEndpoint   string           `yaml:"apim" json:"apim"`
+	PublisherEndpoint    string           `yaml:"publisher" json:"publisher"`
+	DevPortalEndpoint    string           `yaml:"devportal" json:"devportal"`
+	RegistrationEndpoint string           `yaml:"registration" json:"registration"`
+	AdminEndpoint        string           `yaml:"admin" json:"admin"`
+	TokenEndpoint        string           `yaml:"token" json:"token"`
+	MiManagementEndpoint string           `yaml:"mi" json:"mi"`
+	Connection           ConnectionConfig `yaml:"connection,omitempty" json:"connection,omitempty"`
+}
+
+// Context bundles the environments and defaults used when a command is run without specifying them
+type Context struct {
+	APIMEnvironment string `yaml:"apim,omitempty" json:"apim,omitempty"`
+	MIEnvironment   string `yaml:"mi,omitempty" json:"mi,omitempty"`
+	MgwCluster      string `yaml:"mgw-cluster,omitempty" json:"mgwCluster,omitempty"`
+	Tenant          string `yaml:"tenant,omitempty" json:"tenant,omitempty"`
+	Output          string `yaml:"output,omitempty" json:"output,omitempty"`
 }
 
 type MgwEndpoints struct {
-	AdapterEndpoint string `yaml:"adapter"`
+	AdapterEndpoint string           `yaml:"adapter" json:"adapter"`
+	Connection      ConnectionConfig `yaml:"connection,omitempty" json:"connection,omitempty"`
+}
+
+// ConnectionConfig represents TLS and proxy settings used when connecting to the endpoints of an environment
+type ConnectionConfig struct {
+	// PEM CA bundle trusted in addition to the certs directory
+	CACertFile string `yaml:"ca_cert,omitempty" json:"ca_cert,omitempty"`
+	// PEM client certificate for mutual TLS
+	ClientCertFile string `yaml:"client_cert,omitempty" json:"client_cert,omitempty"`
+	// PEM private key of the client certificate
+	ClientKeyFile string `yaml:"client_key,omitempty" json:"client_key,omitempty"`
+	// Name to verify the server certificate instead of the host
+	ServerName string `yaml:"server_name,omitempty" json:"server_name,omitempty"`
+	// Skip verifying the server certificate
+	Insecure bool `yaml:"insecure,omitempty" json:"insecure,omitempty"`
+	// HTTP proxy URL
+	Proxy string `yaml:"proxy,omitempty" json:"proxy,omitempty"`
+	// Comma separated hosts and domains connected without the proxy
+	NoProxy string `yaml:"no_proxy,omitempty" json:"no_proxy,omitempty"`
+
+	// ClientCertPassword is the password of a PKCS#12 client certificate, which is not stored in the config file
+	ClientCertPassword string `yaml:"-" json:"-"`
 }
 
 // ---------------- End of Structs for YAML Config Files ---------------------------------
@@ -19,6 +19,7 @@
 package utils
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -264,7 +265,7 @@ func GetClientIDSecret(username, password, url string) (clientID string, clientS
 	headers[HeaderAuthorization] = HeaderValueAuthBasicPrefix + " " + GetBase64EncodedCredentials(username, password)
 
 	// POST request using resty
-	resp, err := InvokePOSTRequest(url, headers, body)
+	resp, err := InvokePOSTRequest(context.Background(), url, headers, body)
 
 	if err != nil {
 		HandleErrorAndExit("Error in connecting.", err)
@@ -323,15 +324,14 @@ func GetOAuthTokens(username, password, b64EncodedClientIDClientSecret, url stri
 	headers[HeaderAccept] = HeaderValueApplicationJSON
 
 	Logln(LogPrefixInfo + "connecting to " + url)
-	resp, err := InvokePOSTRequest(url, headers, body)
+	resp, err := InvokePOSTRequest(context.Background(), url, headers, body)
 
 	if err != nil {
 		return nil, err
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return nil, errors.New("Unable to connect. " +
-			"Status: " + resp.Status())
+		return nil, NewResponseError(resp, "Unable to connect. Status: "+resp.Status())
 	}
 
 	responseDataMap := make(map[string]string) // a map to hold response data
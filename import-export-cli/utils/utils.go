@@ -20,238 +20,95 @@ package utils
 
 import (
 	"bufio"
-	"crypto/tls"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
-	"time"
 
 	"github.com/go-resty/resty/v2"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
 // Invoke http-post request using go-resty
-func InvokePOSTRequest(url string, headers map[string]string, body interface{}) (*resty.Response, error) {
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetBody(body).Post(url)
+func InvokePOSTRequest(ctx context.Context, url string, headers map[string]string, body interface{}) (*resty.Response,
+	error) {
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetBody(body).Post(url)
 }
 
 // Invoke http-post request without body using go-resty
-func InvokePOSTRequestWithoutBody(url string, headers map[string]string) (*resty.Response, error) {
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).Post(url)
+func InvokePOSTRequestWithoutBody(ctx context.Context, url string, headers map[string]string) (*resty.Response, error) {
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).Post(url)
 }
 
 // Invoke http-post request with query parameters using go-resty
-func InvokePOSTRequestWithQueryParam(queryParam map[string]string, url string, headers map[string]string,
-	body string) (*resty.Response, error) {
-
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetQueryParams(queryParam).SetBody(body).Post(url)
+func InvokePOSTRequestWithQueryParam(ctx context.Context, queryParam map[string]string, url string,
+	headers map[string]string, body string) (*resty.Response, error) {
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetQueryParams(queryParam).SetBody(body).Post(url)
 }
 
 // Invoke http-post request with file & query parameters using go-resty
-func InvokePOSTRequestWithFileAndQueryParams(queryParam map[string]string, url string, headers map[string]string,
-	fileParamName, filePath string) (*resty.Response, error) {
-
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetQueryParams(queryParam).
+func InvokePOSTRequestWithFileAndQueryParams(ctx context.Context, queryParam map[string]string, url string,
+	headers map[string]string, fileParamName, filePath string) (*resty.Response, error) {
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetQueryParams(queryParam).
 		SetFile(fileParamName, filePath).Post(url)
 }
 
 // Invoke http-get request using go-resty
-func InvokeGETRequest(url string, headers map[string]string) (*resty.Response, error) {
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).Get(url)
+func InvokeGETRequest(ctx context.Context, url string, headers map[string]string) (*resty.Response, error) {
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).Get(url)
 }
 
 // Invoke http-get request with query param
-func InvokeGETRequestWithQueryParam(queryParam string, paramValue string, url string, headers map[string]string) (
+func InvokeGETRequestWithQueryParam(ctx context.Context, queryParam string, paramValue string, url string,
+	headers map[string]string) (
 	*resty.Response, error) {
-
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetQueryParam(queryParam, paramValue).Get(url)
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetQueryParam(queryParam, paramValue).Get(url)
 }
 
 // Invoke http-get request with multiple query params
-func InvokeGETRequestWithMultipleQueryParams(queryParam map[string]string, url string, headers map[string]string) (
+func InvokeGETRequestWithMultipleQueryParams(ctx context.Context, queryParam map[string]string, url string,
+	headers map[string]string) (
 	*resty.Response, error) {
-
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetQueryParams(queryParam).Get(url)
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetQueryParams(queryParam).Get(url)
 }
 
 // Invoke http-get request with query params as string
-func InvokeGETRequestWithQueryParamsString(url, queryParams string, headers map[string]string) (
+func InvokeGETRequestWithQueryParamsString(ctx context.Context, url, queryParams string, headers map[string]string) (
 	*resty.Response, error) {
-
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetQueryString(queryParams).Get(url)
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetQueryString(queryParams).Get(url)
 }
 
 // Invoke http-put request with multiple query params
-func InvokePutRequest(queryParam map[string]string, url string, headers map[string]string, body string) (
+func InvokePutRequest(ctx context.Context, queryParam map[string]string, url string, headers map[string]string,
+	body string) (
 	*resty.Response, error) {
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetQueryParams(queryParam).SetBody(body).Put(url)
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetQueryParams(queryParam).SetBody(body).Put(url)
 }
 
-func InvokePUTRequestWithoutQueryParams(url string, headers map[string]string, body interface{}) (*resty.Response, error) {
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetBody(body).Put(url)
+func InvokePUTRequestWithoutQueryParams(ctx context.Context, url string, headers map[string]string,
+	body interface{}) (*resty.Response, error) {
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetBody(body).Put(url)
 }
 
 // Invoke http-delete request using go-resty
-func InvokeDELETERequest(url string, headers map[string]string) (*resty.Response, error) {
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).Delete(url)
+func InvokeDELETERequest(ctx context.Context, url string, headers map[string]string) (*resty.Response, error) {
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).Delete(url)
 }
 
 // Invoke http-delete request with multiple query params
-func InvokeDELETERequestWithParams(url string, params map[string]string, headers map[string]string) (
+func InvokeDELETERequestWithParams(ctx context.Context, url string, params map[string]string,
+	headers map[string]string) (
 	*resty.Response, error) {
-
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetQueryParams(params).Delete(url)
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetQueryParams(params).Delete(url)
 }
 
 // Invoke http-patch request using go-resty
-func InvokePATCHRequest(url string, headers map[string]string, body map[string]string) (*resty.Response, error) {
-	client := resty.New()
-
-	if Insecure {
-		client.SetTLSClientConfig(
-			&tls.Config{InsecureSkipVerify: true, // To bypass errors in SSL certificates
-				Renegotiation: TLSRenegotiationMode})
-	} else {
-		client.SetTLSClientConfig(GetTlsConfigWithCertificate())
-	}
-
-	client.SetTimeout(time.Duration(HttpRequestTimeout) * time.Millisecond)
-	return client.R().SetHeaders(headers).SetBody(body).Patch(url)
+func InvokePATCHRequest(ctx context.Context, url string, headers map[string]string,
+	body map[string]string) (*resty.Response, error) {
+	return GetHttpClient(url).R().SetContext(ctx).SetHeaders(headers).SetBody(body).Patch(url)
 }
 
 func PromptForUsername() string {
@@ -331,7 +188,7 @@ func WriteToFileSystem(exportAPIName, exportAPIVersion, exportEnvironment, expor
 // SetToK8sMode sets the "api-ctl" mode to kubernetes
 func SetToK8sMode() {
 	// read the existing config vars
-	configVars := GetMainConfigFromFile(MainConfigFilePath)
+	configVars := ReadMainConfigFile(MainConfigFilePath)
 	configVars.Config.KubernetesMode = true
 	WriteConfigFile(configVars, MainConfigFilePath)
 }
@@ -19,6 +19,7 @@
 package utils
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -34,9 +35,9 @@ func TestInvokePOSTRequestUnreachable(t *testing.T) {
 	}))
 	defer httpStub.Close()
 
-	resp, err := InvokePOSTRequest(httpStub.URL, make(map[string]string), "")
+	resp, err := InvokePOSTRequest(context.Background(), httpStub.URL, make(map[string]string), "")
 	if resp.StatusCode() != http.StatusInternalServerError {
-		t.Errorf("Error in InvokePOSTRequest(): %s\n", err)
+		t.Errorf("Error in InvokePOSTRequest(context.Background()): %s\n", err)
 	}
 
 }
@@ -51,9 +52,9 @@ func TestInvokePOSTRequestOK(t *testing.T) {
 	}))
 	defer httpStub.Close()
 
-	resp, err := InvokePOSTRequest(httpStub.URL, make(map[string]string), "")
+	resp, err := InvokePOSTRequest(context.Background(), httpStub.URL, make(map[string]string), "")
 	if resp.StatusCode() != http.StatusOK {
-		t.Errorf("Error in InvokePOSTRequest(): %s\n", err)
+		t.Errorf("Error in InvokePOSTRequest(context.Background()): %s\n", err)
 	}
 }
 